@@ -2,66 +2,77 @@ package access
 
 import (
 	"context"
+	"di_container/internal/converter"
 	"di_container/internal/model"
+	"di_container/internal/sys"
 	"di_container/internal/utils"
 	desc "di_container/pkg/access_v1"
-	"errors"
-	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/types/known/emptypb"
-	"strings"
 )
 
-var accessibleRoles map[string]string
-
 func (i *Implementation) Check(ctx context.Context, req *desc.CheckRequest) (*emptypb.Empty, error) {
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return nil, errors.New("Metadata is not provided")
-	}
+	accessReq := converter.ToAccessRequestFromDesc(req)
 
-	authHeader, ok := md["authorization"]
-	if !ok || len(authHeader) == 0 {
-		return nil, errors.New("Authorization header is not provided")
-	}
+	if apiKey, ok := utils.ExtractAPIKey(ctx); ok {
+		_, err := i.accessService.CheckAPIKey(ctx, apiKey, accessReq)
+		if err != nil {
+			return nil, err
+		}
 
-	if !strings.HasPrefix(authHeader[0], i.config.AuthPrefix) {
-		return nil, errors.New("Invalid authorization")
+		return &emptypb.Empty{}, nil
 	}
 
-	accessToken := strings.TrimPrefix(authHeader[0], i.config.AuthPrefix)
+	accessToken, err := utils.ExtractToken(ctx, i.config.AuthPrefix)
+	if err != nil {
+		return nil, sys.NewCommonError(err.Error(), codes.Unauthenticated)
+	}
 
-	claims, err := utils.VerifyToken(accessToken, []byte(i.config.AccessTokenSecretKey))
+	_, err = i.accessService.Check(ctx, accessToken, accessReq)
 	if err != nil {
-		return nil, errors.New("Access token is invalid")
+		return nil, err
 	}
 
-	accessibleMap, err := i.accessibleRoles(ctx)
+	return &emptypb.Empty{}, nil
+}
+
+func (i *Implementation) BatchCheck(ctx context.Context, req *desc.BatchCheckRequest) (*desc.BatchCheckResponse, error) {
+	accessToken, err := utils.ExtractToken(ctx, i.config.AuthPrefix)
 	if err != nil {
-		return nil, errors.New("Failed to get accessible roles")
+		return nil, sys.NewCommonError(err.Error(), codes.Unauthenticated)
 	}
 
-	role, ok := accessibleMap[req.GetEndpointAddress()]
-	if !ok {
-		return &emptypb.Empty{}, nil
+	decisions, err := i.accessService.BatchCheck(ctx, accessToken, converter.ToAccessRequestsFromDesc(req.GetChecks()))
+	if err != nil {
+		return nil, err
 	}
 
-	if role == claims.Role {
-		return &emptypb.Empty{}, nil
+	res := make([]*desc.Decision, 0, len(decisions))
+	for idx, decision := range decisions {
+		res = append(res, converter.ToDecisionFromService(req.GetChecks()[idx].GetEndpointAddress(), decision))
 	}
 
-	return nil, errors.New("Access denied")
+	return &desc.BatchCheckResponse{Decisions: res}, nil
 }
 
-func (i *Implementation) accessibleRoles(ctx context.Context) (map[string]string, error) {
-	if accessibleRoles == nil {
-		accessibleRoles = make(map[string]string)
-
-		// Лезем в базу за данными о доступных ролях для каждого эндпоинта
-		// Можно кешировать данные, чтобы не лезть в базу каждый раз
+func (i *Implementation) Explain(ctx context.Context, req *desc.ExplainRequest) (*desc.ExplainResponse, error) {
+	claims, ok := utils.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, sys.NewCommonError("user is not authenticated", codes.Unauthenticated)
+	}
 
-		// Например, для эндпоинта /note_v1.NoteV1/Get доступна только роль admin
-		accessibleRoles[model.ExamplePath] = "admin"
+	decision, err := i.accessService.Explain(ctx, claims, &model.AccessRequest{
+		Method:   req.GetEndpointAddress(),
+		Resource: req.GetResource(),
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return accessibleRoles, nil
+	return &desc.ExplainResponse{
+		Allowed: decision.Allowed,
+		Rule:    decision.Rule,
+		Line:    int64(decision.Line),
+		Reason:  converter.ToReasonFromService(decision.Reason),
+	}, nil
 }
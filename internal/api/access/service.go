@@ -2,14 +2,19 @@ package access
 
 import (
 	"di_container/internal/config/env"
+	"di_container/internal/service"
 	desc "di_container/pkg/access_v1"
 )
 
 type Implementation struct {
 	desc.UnimplementedAccessV1Server
-	config *env.TokenConfigData
+	config        *env.TokenConfigData
+	accessService service.AccessService
 }
 
-func NewImplementation(config *env.TokenConfigData) *Implementation {
-	return &Implementation{config: config}
+func NewImplementation(config *env.TokenConfigData, accessService service.AccessService) *Implementation {
+	return &Implementation{
+		config:        config,
+		accessService: accessService,
+	}
 }
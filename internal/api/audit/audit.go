@@ -0,0 +1,36 @@
+package audit
+
+import (
+	"context"
+	"di_container/internal/converter"
+	"di_container/internal/model"
+	desc "di_container/pkg/audit_v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) QueryAuditLog(ctx context.Context, req *desc.QueryAuditLogRequest) (*desc.QueryAuditLogResponse, error) {
+	page, err := i.auditService.Query(ctx, converter.ToAuditFilterFromQuery(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.QueryAuditLogResponse{
+		Events:          converter.ToAuditEventsFromService(page.Events),
+		NextPageAfterId: page.NextAfterID,
+	}, nil
+}
+
+func (i *Implementation) ExportAuditLog(req *desc.ExportAuditLogRequest, stream desc.AuditV1_ExportAuditLogServer) error {
+	return i.auditService.Export(stream.Context(), converter.ToAuditFilterFromExport(req), func(event *model.AuditEvent) error {
+		return stream.Send(converter.ToAuditEventFromService(event))
+	})
+}
+
+func (i *Implementation) VerifyAuditLog(ctx context.Context, _ *emptypb.Empty) (*desc.VerifyAuditLogResponse, error) {
+	res, err := i.auditService.Verify(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToVerifyAuditLogResponseFromService(res), nil
+}
@@ -0,0 +1,15 @@
+package audit
+
+import (
+	"di_container/internal/service"
+	desc "di_container/pkg/audit_v1"
+)
+
+type Implementation struct {
+	desc.UnimplementedAuditV1Server
+	auditService service.AuditService
+}
+
+func NewImplementation(auditService service.AuditService) *Implementation {
+	return &Implementation{auditService: auditService}
+}
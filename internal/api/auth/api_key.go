@@ -0,0 +1,58 @@
+package auth
+
+import (
+	"context"
+	"di_container/internal/converter"
+	"di_container/internal/sys"
+	"di_container/internal/utils"
+	desc "di_container/pkg/auth_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) CreateAPIKey(ctx context.Context, req *desc.CreateAPIKeyRequest) (*desc.CreateAPIKeyResponse, error) {
+	claims, ok := utils.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, sys.NewCommonError("user is not authenticated", codes.Unauthenticated)
+	}
+
+	apiKey, key, err := i.authService.CreateAPIKey(ctx, claims, converter.ToAPIKeyInfoFromDesc(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.CreateAPIKeyResponse{
+		ApiKey: converter.ToAPIKeyFromService(apiKey),
+		Key:    key,
+	}, nil
+}
+
+func (i *Implementation) ListAPIKeys(ctx context.Context, _ *emptypb.Empty) (*desc.ListAPIKeysResponse, error) {
+	claims, ok := utils.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, sys.NewCommonError("user is not authenticated", codes.Unauthenticated)
+	}
+
+	keys, err := i.authService.ListAPIKeys(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.ListAPIKeysResponse{
+		ApiKeys: converter.ToAPIKeysFromService(keys),
+	}, nil
+}
+
+func (i *Implementation) RevokeAPIKey(ctx context.Context, req *desc.RevokeAPIKeyRequest) (*emptypb.Empty, error) {
+	claims, ok := utils.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, sys.NewCommonError("user is not authenticated", codes.Unauthenticated)
+	}
+
+	err := i.authService.RevokeAPIKey(ctx, claims, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,23 @@
+package auth
+
+import (
+	"context"
+	"di_container/internal/sys"
+	"di_container/internal/utils"
+	desc "di_container/pkg/auth_v1"
+	"google.golang.org/grpc/codes"
+)
+
+func (i *Implementation) Impersonate(ctx context.Context, req *desc.ImpersonateRequest) (*desc.ImpersonateResponse, error) {
+	claims, ok := utils.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, sys.NewCommonError("user is not authenticated", codes.Unauthenticated)
+	}
+
+	accessToken, err := i.authService.Impersonate(ctx, claims, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.ImpersonateResponse{AccessToken: accessToken}, nil
+}
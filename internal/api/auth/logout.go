@@ -0,0 +1,33 @@
+package auth
+
+import (
+	"context"
+	"di_container/internal/sys"
+	"di_container/internal/utils"
+	desc "di_container/pkg/auth_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) Logout(ctx context.Context, req *desc.LogoutRequest) (*emptypb.Empty, error) {
+	err := i.authService.Logout(ctx, req.GetRefreshToken())
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (i *Implementation) RevokeAllSessions(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	claims, ok := utils.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, sys.NewCommonError("user is not authenticated", codes.Unauthenticated)
+	}
+
+	err := i.authService.RevokeAllSessions(ctx, claims.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,50 @@
+package auth
+
+import (
+	"context"
+	"di_container/internal/sys"
+	"di_container/internal/utils"
+	desc "di_container/pkg/auth_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) VerifyMFA(ctx context.Context, req *desc.VerifyMFARequest) (*desc.LoginResponse, error) {
+	refreshToken, err := i.authService.VerifyMFA(ctx, req.GetMfaChallengeToken(), req.GetCode())
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.LoginResponse{RefreshToken: refreshToken}, nil
+}
+
+func (i *Implementation) EnrollTOTP(ctx context.Context, _ *emptypb.Empty) (*desc.EnrollTOTPResponse, error) {
+	claims, ok := utils.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, sys.NewCommonError("user is not authenticated", codes.Unauthenticated)
+	}
+
+	enrollment, err := i.authService.EnrollTOTP(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.EnrollTOTPResponse{
+		OtpauthUri:    enrollment.URI,
+		RecoveryCodes: enrollment.RecoveryCodes,
+	}, nil
+}
+
+func (i *Implementation) ConfirmTOTP(ctx context.Context, req *desc.ConfirmTOTPRequest) (*emptypb.Empty, error) {
+	claims, ok := utils.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, sys.NewCommonError("user is not authenticated", codes.Unauthenticated)
+	}
+
+	err := i.authService.ConfirmTOTP(ctx, claims, req.GetCode())
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
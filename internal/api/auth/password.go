@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"context"
+	"di_container/internal/sys"
+	"di_container/internal/utils"
+	desc "di_container/pkg/auth_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) RequestPasswordReset(ctx context.Context, req *desc.RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	err := i.authService.RequestPasswordReset(ctx, req.GetEmail())
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (i *Implementation) ResetPassword(ctx context.Context, req *desc.ResetPasswordRequest) (*emptypb.Empty, error) {
+	err := i.authService.ResetPassword(ctx, req.GetToken(), req.GetPassword())
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (i *Implementation) RequestEmailVerification(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	claims, ok := utils.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, sys.NewCommonError("user is not authenticated", codes.Unauthenticated)
+	}
+
+	err := i.authService.RequestEmailVerification(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (i *Implementation) VerifyEmail(ctx context.Context, req *desc.VerifyEmailRequest) (*emptypb.Empty, error) {
+	err := i.authService.VerifyEmail(ctx, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
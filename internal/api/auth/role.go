@@ -0,0 +1,24 @@
+package auth
+
+import (
+	"context"
+	"di_container/internal/sys"
+	"di_container/internal/utils"
+	desc "di_container/pkg/auth_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) SetUserRole(ctx context.Context, req *desc.SetUserRoleRequest) (*emptypb.Empty, error) {
+	claims, ok := utils.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, sys.NewCommonError("user is not authenticated", codes.Unauthenticated)
+	}
+
+	err := i.authService.SetUserRole(ctx, claims, req.GetUsername(), req.GetRole())
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
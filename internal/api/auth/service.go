@@ -1,15 +1,15 @@
 package auth
 
 import (
-	"di_container/internal/config/env"
+	"di_container/internal/service"
 	desc "di_container/pkg/auth_v1"
 )
 
 type Implementation struct {
 	desc.UnimplementedAuthV1Server
-	config *env.TokenConfigData
+	authService service.AuthService
 }
 
-func NewImplementation(config *env.TokenConfigData) *Implementation {
-	return &Implementation{config: config}
+func NewImplementation(authService service.AuthService) *Implementation {
+	return &Implementation{authService: authService}
 }
@@ -0,0 +1,41 @@
+package auth
+
+import (
+	"context"
+	"di_container/internal/converter"
+	"di_container/internal/sys"
+	"di_container/internal/utils"
+	desc "di_container/pkg/auth_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) ListSessions(ctx context.Context, req *desc.ListSessionsRequest) (*desc.ListSessionsResponse, error) {
+	claims, ok := utils.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, sys.NewCommonError("user is not authenticated", codes.Unauthenticated)
+	}
+
+	sessions, err := i.authService.ListSessions(ctx, claims, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.ListSessionsResponse{
+		Sessions: converter.ToSessionsFromService(sessions),
+	}, nil
+}
+
+func (i *Implementation) TerminateSession(ctx context.Context, req *desc.TerminateSessionRequest) (*emptypb.Empty, error) {
+	claims, ok := utils.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, sys.NewCommonError("user is not authenticated", codes.Unauthenticated)
+	}
+
+	err := i.authService.TerminateSession(ctx, claims, req.GetSessionId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -31,7 +31,7 @@ func (i *Implementation) Get(ctx context.Context, req *desc.GetRequest) (*desc.G
 		return nil, errors.Errorf("id is empty")
 	}
 	time.Sleep(time.Duration(rand.Intn(1000)) * time.Millisecond)
-	logger.Info("Getting note...", zap.Int64("id", req.GetId()))
+	logger.FromContext(ctx).Info("Getting note...", zap.Int64("id", req.GetId()))
 	noteObj, err := i.noteService.Get(ctx, req.GetId())
 	if err != nil {
 		return nil, err
@@ -9,12 +9,14 @@ import (
 type Implementation struct {
 	desc.UnimplementedNoteV1Server
 	noteService        service.NoteService
+	quotaService       service.QuotaService
 	otherServiceClient rpc.OtherServiceClient
 }
 
-func NewImplementation(noteService service.NoteService, otherServiceClient rpc.OtherServiceClient) *Implementation {
+func NewImplementation(noteService service.NoteService, quotaService service.QuotaService, otherServiceClient rpc.OtherServiceClient) *Implementation {
 	return &Implementation{
 		noteService:        noteService,
+		quotaService:       quotaService,
 		otherServiceClient: otherServiceClient,
 	}
 }
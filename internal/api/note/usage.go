@@ -0,0 +1,17 @@
+package note
+
+import (
+	"context"
+	"di_container/internal/converter"
+	desc "di_container/pkg/note_v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) GetUsage(ctx context.Context, _ *emptypb.Empty) (*desc.GetUsageResponse, error) {
+	report, err := i.quotaService.GetUsage(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToUsageReportFromService(report), nil
+}
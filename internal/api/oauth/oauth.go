@@ -0,0 +1,185 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"di_container/internal/logger"
+	"di_container/internal/model"
+	"di_container/internal/tenant"
+)
+
+type tokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+}
+
+type introspectionResponse struct {
+	Active    bool   `json:"active"`
+	Username  string `json:"username,omitempty"`
+	Sub       string `json:"sub,omitempty"`
+	Scope     string `json:"scope,omitempty"`
+	TokenType string `json:"token_type,omitempty"`
+	Exp       int64  `json:"exp,omitempty"`
+	Iat       int64  `json:"iat,omitempty"`
+	Jti       string `json:"jti,omitempty"`
+}
+
+type errorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+// Token обрабатывает POST /oauth/token (RFC 6749, раздел 4.4 и 6)
+func (i *Implementation) Token(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	if err := r.ParseForm(); err != nil {
+		writeError(w, model.NewOAuthError(model.OAuthErrorInvalidRequest, "malformed form body"))
+		return
+	}
+
+	ctx, err := tenantContext(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	var token *model.OAuthToken
+
+	switch grantType := r.PostForm.Get("grant_type"); grantType {
+	case model.OAuthGrantClientCredentials:
+		clientID, clientSecret := clientCredentials(r)
+		token, err = i.oauthService.ClientCredentials(ctx, clientID, clientSecret, strings.Fields(r.PostForm.Get("scope")))
+	case model.OAuthGrantRefreshToken:
+		refreshToken := r.PostForm.Get("refresh_token")
+		if refreshToken == "" {
+			writeError(w, model.NewOAuthError(model.OAuthErrorInvalidRequest, "refresh_token is required"))
+			return
+		}
+		token, err = i.oauthService.RefreshToken(ctx, refreshToken)
+	default:
+		writeError(w, model.NewOAuthError(model.OAuthErrorUnsupportedGrantType, "unsupported grant_type "+grantType))
+		return
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, tokenResponse{
+		AccessToken:  token.AccessToken,
+		TokenType:    token.TokenType,
+		ExpiresIn:    int64(token.ExpiresIn.Seconds()),
+		RefreshToken: token.RefreshToken,
+		Scope:        strings.Join(token.Scopes, " "),
+	})
+}
+
+// Introspect обрабатывает POST /oauth/introspect (RFC 7662)
+func (i *Implementation) Introspect(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	if err := r.ParseForm(); err != nil {
+		writeError(w, model.NewOAuthError(model.OAuthErrorInvalidRequest, "malformed form body"))
+		return
+	}
+
+	token := r.PostForm.Get("token")
+	if token == "" {
+		writeError(w, model.NewOAuthError(model.OAuthErrorInvalidRequest, "token is required"))
+		return
+	}
+
+	ctx, err := tenantContext(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	clientID, clientSecret := clientCredentials(r)
+	res, err := i.oauthService.Introspect(ctx, clientID, clientSecret, token)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if !res.Active {
+		writeJSON(w, http.StatusOK, introspectionResponse{})
+		return
+	}
+
+	resp := introspectionResponse{
+		Active:    true,
+		Username:  res.Username,
+		Sub:       res.Username,
+		Scope:     strings.Join(res.Scopes, " "),
+		TokenType: res.TokenType,
+		Exp:       res.ExpiresAt.Unix(),
+		Jti:       res.TokenID,
+	}
+	if !res.IssuedAt.IsZero() {
+		resp.Iat = res.IssuedAt.Unix()
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// clientCredentials достает учетные данные клиента из Basic авторизации или из тела запроса
+func clientCredentials(r *http.Request) (string, string) {
+	if clientID, clientSecret, ok := r.BasicAuth(); ok {
+		return clientID, clientSecret
+	}
+
+	return r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+}
+
+// tenantContext выбирает арендатора по заголовку X-Tenant-Id так же, как для gRPC запросов без токена
+func tenantContext(r *http.Request) (context.Context, error) {
+	id := r.Header.Get(tenant.Header)
+	if id == "" {
+		id = tenant.Default
+	}
+
+	if err := tenant.Validate(id); err != nil {
+		return nil, model.NewOAuthError(model.OAuthErrorInvalidRequest, err.Error())
+	}
+
+	return tenant.WithTenant(r.Context(), id), nil
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	var oauthErr *model.OAuthError
+	if !errors.As(err, &oauthErr) {
+		logger.Error("oauth request failed", zap.Error(err))
+		writeJSON(w, http.StatusInternalServerError, errorResponse{Error: "server_error"})
+		return
+	}
+
+	status := http.StatusBadRequest
+	if oauthErr.Code == model.OAuthErrorInvalidClient {
+		w.Header().Set("WWW-Authenticate", `Basic realm="oauth"`)
+		status = http.StatusUnauthorized
+	}
+
+	writeJSON(w, status, errorResponse{
+		Error:            oauthErr.Code,
+		ErrorDescription: oauthErr.Description,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+	w.WriteHeader(status)
+
+	err := json.NewEncoder(w).Encode(body)
+	if err != nil {
+		logger.Error("failed to write oauth response", zap.Error(err))
+	}
+}
@@ -0,0 +1,17 @@
+package oauth
+
+import (
+	"di_container/internal/service"
+)
+
+// Implementation HTTP обработчики OAuth2 endpoints. Они не проходят через gRPC,
+// поэтому регистрируются на HTTP сервере напрямую
+type Implementation struct {
+	oauthService service.OAuthService
+}
+
+func NewImplementation(oauthService service.OAuthService) *Implementation {
+	return &Implementation{
+		oauthService: oauthService,
+	}
+}
@@ -2,17 +2,25 @@ package app
 
 import (
 	"context"
+	"di_container/internal/circuit_breaker"
+	otherService "di_container/internal/client/rpc/other_service"
 	"di_container/internal/closer"
+	"di_container/internal/concurrency_limiter"
 	"di_container/internal/config"
 	"di_container/internal/interceptor"
 	"di_container/internal/logger"
 	"di_container/internal/metric"
-	"di_container/internal/rate_limiter"
+	"di_container/internal/request_id"
 	"di_container/internal/tracing"
+	"di_container/internal/utils"
 	descAccess "di_container/pkg/access_v1"
+	descAudit "di_container/pkg/audit_v1"
 	descAuth "di_container/pkg/auth_v1"
 	desc "di_container/pkg/note_v1"
+	descOther "di_container/pkg/other_note_v1"
+	"encoding/json"
 	"flag"
+	"fmt"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sony/gobreaker"
 	"go.uber.org/zap"
@@ -21,6 +29,7 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
@@ -34,6 +43,8 @@ import (
 	"github.com/rs/cors"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -173,52 +184,139 @@ func (a *App) initGRPCServer(ctx context.Context) error {
 	//	log.Fatalf("Failed to load TLS keys: %s", err)
 	//}
 
-	//conn, err := grpc.Dial(
-	//	fmt.Sprintf(":%d", a.serviceProvider.GRPCConfig().OtherPort()),
-	//	grpc.WithTransportCredentials(insecure.NewCredentials()),
-	//	grpc.WithUnaryInterceptor(otgrpc.OpenTracingClientInterceptor(opentracing.GlobalTracer())),
-	//)
-	//if err != nil {
-	//	log.Fatalf("failed to dial GRPC client: %v", err)
-	//}
-
-	//otherServiceClient := otherService.New(descOther.NewOtherNoteV1Client(conn))
-
-	rateLimiter := rate_limiter.NewTokenBucketLimiter(ctx, 10, time.Second)
-
-	cb := gobreaker.NewCircuitBreaker(gobreaker.Settings{
-		Name:        "my-service",
+	breakers := circuit_breaker.NewBreakers(gobreaker.Settings{
 		MaxRequests: 3,
 		Timeout:     5 * time.Second,
 		ReadyToTrip: func(counts gobreaker.Counts) bool {
+			// Несколько первых ошибок редко вызываемого метода еще не говорят о сбое
+			if counts.Requests < 5 {
+				return false
+			}
+
 			failureRatio := float64(counts.TotalFailures) / float64(counts.Requests)
 			return failureRatio >= 0.6
 		},
-		OnStateChange: func(name string, from gobreaker.State, to gobreaker.State) {
-			log.Printf("Circuit Breaker: %s, changed from %s, to %s\n", name, from, to)
-		},
 	})
 
+	authInterceptor := interceptor.NewAuthInterceptor(
+		a.serviceProvider.TokenConfig().AuthPrefix,
+		a.serviceProvider.AccessService(ctx),
+		descAuth.AuthV1_Login_FullMethodName,
+		descAuth.AuthV1_GetRefreshToken_FullMethodName,
+		descAuth.AuthV1_GetAccessToken_FullMethodName,
+		descAuth.AuthV1_Logout_FullMethodName,
+		descAuth.AuthV1_VerifyMFA_FullMethodName,
+		descAuth.AuthV1_RequestPasswordReset_FullMethodName,
+		descAuth.AuthV1_ResetPassword_FullMethodName,
+		descAuth.AuthV1_VerifyEmail_FullMethodName,
+		// Check и BatchCheck сами разбирают токен и возвращают результат проверки
+		descAccess.AccessV1_Check_FullMethodName,
+		descAccess.AccessV1_BatchCheck_FullMethodName,
+		grpc_health_v1.Health_Check_FullMethodName,
+		grpc_health_v1.Health_Watch_FullMethodName,
+	)
+
+	// Тела этих методов содержат пароли, токены, коды второго фактора или ключи API
+	logInterceptor := interceptor.NewLogInterceptor(
+		descAuth.AuthV1_Login_FullMethodName,
+		descAuth.AuthV1_GetRefreshToken_FullMethodName,
+		descAuth.AuthV1_GetAccessToken_FullMethodName,
+		descAuth.AuthV1_Logout_FullMethodName,
+		descAuth.AuthV1_VerifyMFA_FullMethodName,
+		descAuth.AuthV1_EnrollTOTP_FullMethodName,
+		descAuth.AuthV1_ConfirmTOTP_FullMethodName,
+		descAuth.AuthV1_ResetPassword_FullMethodName,
+		descAuth.AuthV1_VerifyEmail_FullMethodName,
+		descAuth.AuthV1_CreateAPIKey_FullMethodName,
+		descAuth.AuthV1_Impersonate_FullMethodName,
+	)
+
+	rateLimiterInterceptor := interceptor.NewRateLimiterInterceptor(
+		a.serviceProvider.RateLimiter(ctx),
+		a.serviceProvider.RateLimitConfig().MaxWait(),
+	)
+	circuitBreakerInterceptor := interceptor.NewCircuitBreakerInterceptor(breakers)
+	concurrencyLimiterInterceptor := interceptor.NewConcurrencyLimiterInterceptor(
+		concurrency_limiter.NewAIMDLimiter(a.serviceProvider.ConcurrencyLimitConfig().Settings()),
+	)
+	recoveryInterceptor := interceptor.NewRecoveryInterceptor(a.serviceProvider.GRPCConfig().ExposeErrorID())
+	deadlineConfig := a.serviceProvider.DeadlineConfig()
+	deadlineInterceptor := interceptor.NewDeadlineInterceptor(
+		deadlineConfig.DefaultTimeout(),
+		deadlineConfig.MaxTimeout(),
+		deadlineConfig.MinBudget(),
+		deadlineConfig.MethodTimeouts(),
+	)
+	quotaInterceptor := interceptor.NewQuotaInterceptor(
+		a.serviceProvider.QuotaService(ctx),
+		grpc_health_v1.Health_Check_FullMethodName,
+		grpc_health_v1.Health_Watch_FullMethodName,
+	)
+
+	// Соединение устанавливается при первом вызове, поэтому сервис стартует, даже если другой еще недоступен
+	conn, err := grpc.Dial(
+		fmt.Sprintf(":%d", a.serviceProvider.GRPCConfig().OtherPort()),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(
+			interceptor.RequestIDClientInterceptor,
+			deadlineInterceptor.UnaryClient,
+			circuitBreakerInterceptor.UnaryClient,
+		),
+	)
+	if err != nil {
+		log.Fatalf("failed to dial GRPC client: %v", err)
+	}
+	closer.Add(conn.Close)
+
+	otherServiceClient := otherService.New(descOther.NewOtherNoteV1Client(conn))
+
 	a.grpcServer = grpc.NewServer(
 		grpc.Creds(insecure.NewCredentials()),
 		grpc.UnaryInterceptor(
 			grpcMiddleware.ChainUnaryServer(
+				interceptor.RequestIDInterceptor,
+				a.serviceProvider.ClientInfoInterceptor().Unary,
+				recoveryInterceptor.Unary,
 				interceptor.ErrorCodesInterceptor,
-				interceptor.NewRateLimiterInterceptor(rateLimiter).Unary,
-				interceptor.NewCircuitBreakerInterceptor(cb).Unary,
-				interceptor.LogInterceptor,
+				deadlineInterceptor.Unary,
+				interceptor.TenantInterceptor,
+				concurrencyLimiterInterceptor.Unary,
+				authInterceptor.Unary,
+				rateLimiterInterceptor.Unary,
+				quotaInterceptor.Unary,
+				circuitBreakerInterceptor.Unary,
+				logInterceptor.Unary,
 				interceptor.ValidateInterceptor,
 				interceptor.MetricsInterceptor,
 				//interceptor.ServerTracingInterceptor,
 			),
 		),
+		// Потоковые методы проходят ту же цепочку, что и унарные
+		grpc.ChainStreamInterceptor(
+			interceptor.RequestIDStreamInterceptor,
+			a.serviceProvider.ClientInfoInterceptor().Stream,
+			recoveryInterceptor.Stream,
+			interceptor.ErrorCodesStreamInterceptor,
+			deadlineInterceptor.Stream,
+			interceptor.TenantStreamInterceptor,
+			concurrencyLimiterInterceptor.Stream,
+			authInterceptor.Stream,
+			rateLimiterInterceptor.Stream,
+			quotaInterceptor.Stream,
+			circuitBreakerInterceptor.Stream,
+			interceptor.LogStreamInterceptor,
+			interceptor.ValidateStreamInterceptor,
+			interceptor.MetricsStreamInterceptor,
+		),
 	)
 
 	reflection.Register(a.grpcServer)
+	grpc_health_v1.RegisterHealthServer(a.grpcServer, health.NewServer())
 
-	desc.RegisterNoteV1Server(a.grpcServer, a.serviceProvider.GetNoteImpl(ctx))
-	descAuth.RegisterAuthV1Server(a.grpcServer, a.serviceProvider.GetAuthImpl())
-	descAccess.RegisterAccessV1Server(a.grpcServer, a.serviceProvider.GetAccessImpl())
+	desc.RegisterNoteV1Server(a.grpcServer, a.serviceProvider.GetNoteImpl(ctx, otherServiceClient))
+	descAuth.RegisterAuthV1Server(a.grpcServer, a.serviceProvider.GetAuthImpl(ctx))
+	descAccess.RegisterAccessV1Server(a.grpcServer, a.serviceProvider.GetAccessImpl(ctx))
+	descAudit.RegisterAuditV1Server(a.grpcServer, a.serviceProvider.GetAuditImpl(ctx))
 
 	return nil
 }
@@ -251,10 +349,16 @@ func (a *App) runHTTPServer() error {
 }
 
 func (a *App) initHTTPServer(ctx context.Context) error {
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+		runtime.WithErrorHandler(httpErrorHandler),
+	)
 
 	opts := []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		// Только вызовам гейтвея сервер верит в x-forwarded-for
+		grpc.WithChainUnaryInterceptor(a.serviceProvider.ClientInfoInterceptor().UnaryClient),
+		grpc.WithChainStreamInterceptor(a.serviceProvider.ClientInfoInterceptor().StreamClient),
 	}
 
 	err := desc.RegisterNoteV1HandlerFromEndpoint(ctx, mux, a.serviceProvider.GRPCConfig().Address(), opts)
@@ -263,16 +367,34 @@ func (a *App) initHTTPServer(ctx context.Context) error {
 		return err
 	}
 
+	err = mux.HandlePath(http.MethodGet, "/.well-known/jwks.json", serveJWKS(a.serviceProvider.AccessTokenKeySet()))
+	if err != nil {
+		return err
+	}
+
+	oauthImpl := a.serviceProvider.GetOAuthImpl(ctx)
+
+	err = mux.HandlePath(http.MethodPost, "/oauth/token", oauthImpl.Token)
+	if err != nil {
+		return err
+	}
+
+	err = mux.HandlePath(http.MethodPost, "/oauth/introspect", oauthImpl.Introspect)
+	if err != nil {
+		return err
+	}
+
 	corsMiddleware := cors.New(cors.Options{
 		AllowedOrigins:   []string{"*"},
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Content-Type", "Content-Length", "Authorization"},
+		AllowedHeaders:   []string{"Accept", "Content-Type", "Content-Length", "Authorization", "X-Api-Key", "X-Tenant-Id", "X-Priority", "X-Request-Id"},
+		ExposedHeaders:   []string{"X-Request-Id"},
 		AllowCredentials: true,
 	})
 
 	a.httpServer = &http.Server{
 		Addr:    a.serviceProvider.HTTPConfig().Address(),
-		Handler: corsMiddleware.Handler(mux),
+		Handler: corsMiddleware.Handler(requestIDMiddleware(mux)),
 	}
 
 	return nil
@@ -347,6 +469,50 @@ func serveSwaggerFile(path string) http.HandlerFunc {
 	}
 }
 
+// incomingHeaderMatcher пробрасывает в gRPC ключ x-api-key, арендатора x-tenant-id, важность x-priority
+// и идентификатор запроса x-request-id вдобавок к стандартным заголовкам
+func incomingHeaderMatcher(key string) (string, bool) {
+	if utils.IsAPIKeyHeader(key) || utils.IsTenantHeader(key) || utils.IsPriorityHeader(key) || utils.IsRequestIDHeader(key) {
+		return strings.ToLower(key), true
+	}
+
+	name, ok := runtime.DefaultHeaderMatcher(key)
+	// Адрес клиента гейтвей дописывает сам, подставить свой через Grpc-Metadata- клиент не может
+	if ok && strings.EqualFold(name, utils.ForwardedForHeader) {
+		return "", false
+	}
+
+	return name, ok
+}
+
+// requestIDMiddleware назначает HTTP запросу идентификатор еще до гейтвея, чтобы он был и в ответе,
+// и в логах обработчиков OAuth, которые не проходят через gRPC
+func requestIDMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(request_id.Header)
+		if !request_id.Valid(id) {
+			id = request_id.New()
+			r.Header.Set(request_id.Header, id)
+		}
+		w.Header().Set(request_id.Header, id)
+
+		ctx := logger.ContextWithFields(request_id.WithRequestID(r.Context(), id), zap.String("request_id", id))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func serveJWKS(keySet *utils.KeySet) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "public, max-age=300")
+
+		err := json.NewEncoder(w).Encode(keySet.JWKS())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
+
 func getCore(level zap.AtomicLevel) zapcore.Core {
 	stdout := zapcore.AddSync(os.Stdout)
 
@@ -0,0 +1,98 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// httpError тело ответа с ошибкой в формате Google JSON API: {"error": {"code", "status", "message", "details"}}.
+// details - google.rpc детали статуса с полем "@type", по которому клиент выбирает нужную
+type httpError struct {
+	Error httpErrorBody `json:"error"`
+}
+
+type httpErrorBody struct {
+	Code    int               `json:"code"`
+	Status  string            `json:"status"`
+	Message string            `json:"message"`
+	Details []json.RawMessage `json:"details"`
+}
+
+// httpErrorHandler отдает ошибки gRPC в одном формате для всех методов гейтвея и переносит RetryInfo
+// в заголовок Retry-After
+func httpErrorHandler(_ context.Context, _ *runtime.ServeMux, _ runtime.Marshaler, w http.ResponseWriter, _ *http.Request, err error) {
+	// HTTPStatusError гейтвей возвращает, когда HTTP статус задан явно, например для ошибок маршрутизации
+	var customStatus *runtime.HTTPStatusError
+	if errors.As(err, &customStatus) {
+		err = customStatus.Err
+	}
+
+	st := status.Convert(err)
+
+	httpStatus := runtime.HTTPStatusFromCode(st.Code())
+	if customStatus != nil {
+		httpStatus = customStatus.HTTPStatus
+	}
+
+	body := httpError{
+		Error: httpErrorBody{
+			Code:    httpStatus,
+			Status:  code.Code_name[int32(st.Code())],
+			Message: st.Message(),
+			Details: make([]json.RawMessage, 0, len(st.Proto().GetDetails())),
+		},
+	}
+
+	for _, detail := range st.Proto().GetDetails() {
+		data, errMarshal := protojson.Marshal(detail)
+		if errMarshal != nil {
+			continue
+		}
+		body.Error.Details = append(body.Error.Details, data)
+	}
+
+	for _, detail := range st.Details() {
+		if retryInfo, ok := detail.(*errdetails.RetryInfo); ok {
+			seconds := math.Ceil(retryInfo.GetRetryDelay().AsDuration().Seconds())
+			w.Header().Set("Retry-After", strconv.Itoa(int(seconds)))
+		}
+	}
+
+	if st.Code() == codes.Unauthenticated {
+		w.Header().Set("WWW-Authenticate", bearerChallenge(st.Message()))
+	}
+
+	w.Header().Del("Trailer")
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+
+	if err = json.NewEncoder(w).Encode(body); err != nil {
+		log.Printf("failed to write error response: %v", err)
+	}
+}
+
+// bearerChallenge вызов Bearer по RFC 6750. В error_description допустимы только печатные ASCII символы
+// без кавычки и обратной косой черты, остальные заменяются пробелом
+func bearerChallenge(description string) string {
+	description = strings.Map(func(r rune) rune {
+		if r < 0x20 || r > 0x7e || r == '"' || r == '\\' {
+			return ' '
+		}
+		return r
+	}, description)
+
+	return `Bearer error="invalid_token", error_description="` + description + `"`
+}
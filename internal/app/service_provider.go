@@ -3,40 +3,96 @@ package app
 import (
 	"context"
 	"di_container/internal/api/access"
+	"di_container/internal/api/audit"
 	"di_container/internal/api/auth"
 	"di_container/internal/api/note"
+	"di_container/internal/api/oauth"
 	"di_container/internal/client/db"
 	"di_container/internal/client/db/pg"
 	"di_container/internal/client/db/transaction"
+	"di_container/internal/client/mail"
+	mailFile "di_container/internal/client/mail/file"
+	mailSMTP "di_container/internal/client/mail/smtp"
 	"di_container/internal/client/rpc"
 	"di_container/internal/closer"
 	"di_container/internal/config"
 	"di_container/internal/config/env"
+	"di_container/internal/interceptor"
+	"di_container/internal/policy"
+	"di_container/internal/rate_limiter"
 	"di_container/internal/repository"
+	apiKeyRepository "di_container/internal/repository/api_key"
+	auditEventRepository "di_container/internal/repository/audit_event"
+	loginAttemptRepository "di_container/internal/repository/login_attempt"
 	noteRepository "di_container/internal/repository/note"
+	rateLimitBucketRepository "di_container/internal/repository/rate_limit_bucket"
+	recoveryCodeRepository "di_container/internal/repository/recovery_code"
+	refreshTokenRepository "di_container/internal/repository/refresh_token"
+	sessionRepository "di_container/internal/repository/session"
+	usageRepository "di_container/internal/repository/usage"
+	userRepository "di_container/internal/repository/user"
+	userTokenRepository "di_container/internal/repository/user_token"
 	"di_container/internal/service"
+	accessService "di_container/internal/service/access"
+	auditService "di_container/internal/service/audit"
+	authService "di_container/internal/service/auth"
 	noteService "di_container/internal/service/note"
+	oauthService "di_container/internal/service/oauth"
+	quotaService "di_container/internal/service/quota"
+	"di_container/internal/utils"
+	descAuth "di_container/pkg/auth_v1"
 	"log"
 )
 
 type serviceProvider struct {
-	pgConfig      config.PGConfig
-	grpcConfig    config.GRPCConfig
-	httpConfig    config.HTTPConfig
-	swaggerConfig config.SwaggerConfig
-	tokenConfig   *env.TokenConfigData
-
-	dbClient            db.Client
-	txManager           db.TxManager
-	noteRepository      repository.NoteRepository
-	noteOtherRepository repository.OtherNoteRepository
-
-	noteService service.NoteService
-	authService service.AuthService
+	pgConfig            config.PGConfig
+	grpcConfig          config.GRPCConfig
+	httpConfig          config.HTTPConfig
+	swaggerConfig       config.SwaggerConfig
+	tokenConfig         *env.TokenConfigData
+	loginConfig         config.LoginConfig
+	mfaConfig           config.MFAConfig
+	accessPolicyConfig  config.AccessPolicyConfig
+	mailConfig          config.MailConfig
+	userTokenConfig     config.UserTokenConfig
+	impersonationConfig config.ImpersonationConfig
+	quotaConfig         config.QuotaConfig
+	rateLimitConfig     config.RateLimitConfig
+	concurrencyConfig   config.ConcurrencyLimitConfig
+	deadlineConfig      config.DeadlineConfig
+	accessKeySet        *utils.KeySet
+	accessPolicy        *policy.Policy
+
+	dbClient                  db.Client
+	txManager                 db.TxManager
+	mailer                    mail.Mailer
+	rateLimiter               rate_limiter.Limiter
+	clientInfoInterceptor     *interceptor.ClientInfoInterceptor
+	noteRepository            repository.NoteRepository
+	noteOtherRepository       repository.OtherNoteRepository
+	refreshTokenRepository    repository.RefreshTokenRepository
+	sessionRepository         repository.SessionRepository
+	userRepository            repository.UserRepository
+	loginAttemptRepository    repository.LoginAttemptRepository
+	recoveryCodeRepository    repository.RecoveryCodeRepository
+	userTokenRepository       repository.UserTokenRepository
+	apiKeyRepository          repository.APIKeyRepository
+	auditEventRepository      repository.AuditEventRepository
+	usageRepository           repository.UsageRepository
+	rateLimitBucketRepository repository.RateLimitBucketRepository
+
+	noteService   service.NoteService
+	authService   service.AuthService
+	accessService service.AccessService
+	oauthService  service.OAuthService
+	auditService  service.AuditService
+	quotaService  service.QuotaService
 
 	noteImpl   *note.Implementation
 	authImpl   *auth.Implementation
 	accessImpl *access.Implementation
+	oauthImpl  *oauth.Implementation
+	auditImpl  *audit.Implementation
 }
 
 func newServiceProvider() *serviceProvider {
@@ -68,6 +124,21 @@ func (s *serviceProvider) GRPCConfig() config.GRPCConfig {
 	return s.grpcConfig
 }
 
+// ClientInfoInterceptor общий для gRPC сервера и гейтвея: гейтвей помечает вызовы секретом,
+// который создается при старте и известен только этому процессу
+func (s *serviceProvider) ClientInfoInterceptor() *interceptor.ClientInfoInterceptor {
+	if s.clientInfoInterceptor == nil {
+		secret, err := utils.GenerateTokenID()
+		if err != nil {
+			log.Fatalf("failed to generate gateway secret: %s", err.Error())
+		}
+
+		s.clientInfoInterceptor = interceptor.NewClientInfoInterceptor(secret, s.GRPCConfig().TrustedProxies())
+	}
+
+	return s.clientInfoInterceptor
+}
+
 func (s *serviceProvider) HTTPConfig() config.HTTPConfig {
 	if s.httpConfig == nil {
 		cfg, err := env.NewHTTPConfig()
@@ -107,6 +178,163 @@ func (s *serviceProvider) TokenConfig() *env.TokenConfigData {
 	return s.tokenConfig
 }
 
+func (s *serviceProvider) LoginConfig() config.LoginConfig {
+	if s.loginConfig == nil {
+		cfg, err := env.NewLoginConfig()
+		if err != nil {
+			log.Fatalf("Failed to get login config: %s", err.Error())
+		}
+
+		s.loginConfig = cfg
+	}
+
+	return s.loginConfig
+}
+
+func (s *serviceProvider) MFAConfig() config.MFAConfig {
+	if s.mfaConfig == nil {
+		cfg, err := env.NewMFAConfig()
+		if err != nil {
+			log.Fatalf("Failed to get mfa config: %s", err.Error())
+		}
+
+		s.mfaConfig = cfg
+	}
+
+	return s.mfaConfig
+}
+
+func (s *serviceProvider) MailConfig() config.MailConfig {
+	if s.mailConfig == nil {
+		cfg, err := env.NewMailConfig()
+		if err != nil {
+			log.Fatalf("Failed to get mail config: %s", err.Error())
+		}
+
+		s.mailConfig = cfg
+	}
+
+	return s.mailConfig
+}
+
+func (s *serviceProvider) UserTokenConfig() config.UserTokenConfig {
+	if s.userTokenConfig == nil {
+		cfg, err := env.NewUserTokenConfig()
+		if err != nil {
+			log.Fatalf("Failed to get user token config: %s", err.Error())
+		}
+
+		s.userTokenConfig = cfg
+	}
+
+	return s.userTokenConfig
+}
+
+func (s *serviceProvider) ImpersonationConfig() config.ImpersonationConfig {
+	if s.impersonationConfig == nil {
+		cfg, err := env.NewImpersonationConfig()
+		if err != nil {
+			log.Fatalf("Failed to get impersonation config: %s", err.Error())
+		}
+
+		s.impersonationConfig = cfg
+	}
+
+	return s.impersonationConfig
+}
+
+func (s *serviceProvider) QuotaConfig() config.QuotaConfig {
+	if s.quotaConfig == nil {
+		cfg, err := env.NewQuotaConfig()
+		if err != nil {
+			log.Fatalf("Failed to get quota config: %s", err.Error())
+		}
+
+		s.quotaConfig = cfg
+	}
+
+	return s.quotaConfig
+}
+
+func (s *serviceProvider) RateLimitConfig() config.RateLimitConfig {
+	if s.rateLimitConfig == nil {
+		cfg, err := env.NewRateLimitConfig()
+		if err != nil {
+			log.Fatalf("Failed to get rate limit config: %s", err.Error())
+		}
+
+		s.rateLimitConfig = cfg
+	}
+
+	return s.rateLimitConfig
+}
+
+func (s *serviceProvider) ConcurrencyLimitConfig() config.ConcurrencyLimitConfig {
+	if s.concurrencyConfig == nil {
+		cfg, err := env.NewConcurrencyLimitConfig()
+		if err != nil {
+			log.Fatalf("Failed to get concurrency limit config: %s", err.Error())
+		}
+
+		s.concurrencyConfig = cfg
+	}
+
+	return s.concurrencyConfig
+}
+
+func (s *serviceProvider) DeadlineConfig() config.DeadlineConfig {
+	if s.deadlineConfig == nil {
+		cfg, err := env.NewDeadlineConfig()
+		if err != nil {
+			log.Fatalf("Failed to get deadline config: %s", err.Error())
+		}
+
+		s.deadlineConfig = cfg
+	}
+
+	return s.deadlineConfig
+}
+
+func (s *serviceProvider) AccessPolicyConfig() config.AccessPolicyConfig {
+	if s.accessPolicyConfig == nil {
+		s.accessPolicyConfig = env.NewAccessPolicyConfig()
+	}
+
+	return s.accessPolicyConfig
+}
+
+func (s *serviceProvider) AccessPolicy() *policy.Policy {
+	if s.accessPolicy == nil {
+		p, err := policy.Load(s.AccessPolicyConfig().Path())
+		if err != nil {
+			log.Fatalf("Failed to load access policy: %s", err.Error())
+		}
+
+		s.accessPolicy = p
+	}
+
+	return s.accessPolicy
+}
+
+func (s *serviceProvider) AccessTokenKeySet() *utils.KeySet {
+	if s.accessKeySet == nil {
+		cfg := s.TokenConfig()
+		if len(cfg.AccessTokenKeysDir) == 0 {
+			s.accessKeySet = utils.NewHMACKeySet([]byte(cfg.AccessTokenSecretKey))
+			return s.accessKeySet
+		}
+
+		ks, err := utils.LoadKeySet(cfg.AccessTokenKeysDir, cfg.AccessTokenSigningKeyID)
+		if err != nil {
+			log.Fatalf("Failed to load access token keys: %s", err.Error())
+		}
+
+		s.accessKeySet = ks
+	}
+
+	return s.accessKeySet
+}
+
 func (s *serviceProvider) DBClient(ctx context.Context) db.Client {
 	if s.dbClient == nil {
 		cl, err := pg.New(ctx, s.PGConfig().DSN())
@@ -134,6 +362,42 @@ func (s *serviceProvider) TxManager(ctx context.Context) db.TxManager {
 	return s.txManager
 }
 
+func (s *serviceProvider) Mailer() mail.Mailer {
+	if s.mailer == nil {
+		cfg := s.MailConfig()
+		if cfg.Driver() == config.MailDriverSMTP {
+			s.mailer = mailSMTP.New(cfg.SMTPHost(), cfg.SMTPPort(), cfg.SMTPUsername(), cfg.SMTPPassword(), cfg.From())
+			return s.mailer
+		}
+
+		s.mailer = mailFile.New(cfg.FilePath())
+	}
+
+	return s.mailer
+}
+
+func (s *serviceProvider) RateLimiter(ctx context.Context) rate_limiter.Limiter {
+	if s.rateLimiter == nil {
+		cfg := s.RateLimitConfig()
+		if cfg.Backend() == config.RateLimitBackendPostgres {
+			s.rateLimiter = rate_limiter.NewDistributedLimiter(
+				ctx,
+				s.RateLimitBucketRepository(ctx),
+				s.TxManager(ctx),
+				cfg.DefaultPolicy(),
+				cfg.MethodPolicies(),
+				cfg.LocalBatch(),
+				cfg.IdleTimeout(),
+			)
+			return s.rateLimiter
+		}
+
+		s.rateLimiter = rate_limiter.NewKeyedLimiter(ctx, cfg.DefaultPolicy(), cfg.MethodPolicies(), cfg.IdleTimeout())
+	}
+
+	return s.rateLimiter
+}
+
 func (s *serviceProvider) NoteRepository(ctx context.Context) repository.NoteRepository {
 	if s.noteRepository == nil {
 		s.noteRepository = noteRepository.NewRepository(s.DBClient(ctx))
@@ -142,39 +406,222 @@ func (s *serviceProvider) NoteRepository(ctx context.Context) repository.NoteRep
 	return s.noteRepository
 }
 
+func (s *serviceProvider) RefreshTokenRepository(ctx context.Context) repository.RefreshTokenRepository {
+	if s.refreshTokenRepository == nil {
+		s.refreshTokenRepository = refreshTokenRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.refreshTokenRepository
+}
+
+func (s *serviceProvider) SessionRepository(ctx context.Context) repository.SessionRepository {
+	if s.sessionRepository == nil {
+		s.sessionRepository = sessionRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.sessionRepository
+}
+
+func (s *serviceProvider) UserRepository(ctx context.Context) repository.UserRepository {
+	if s.userRepository == nil {
+		s.userRepository = userRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.userRepository
+}
+
+func (s *serviceProvider) LoginAttemptRepository(ctx context.Context) repository.LoginAttemptRepository {
+	if s.loginAttemptRepository == nil {
+		s.loginAttemptRepository = loginAttemptRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.loginAttemptRepository
+}
+
+func (s *serviceProvider) RecoveryCodeRepository(ctx context.Context) repository.RecoveryCodeRepository {
+	if s.recoveryCodeRepository == nil {
+		s.recoveryCodeRepository = recoveryCodeRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.recoveryCodeRepository
+}
+
+func (s *serviceProvider) UserTokenRepository(ctx context.Context) repository.UserTokenRepository {
+	if s.userTokenRepository == nil {
+		s.userTokenRepository = userTokenRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.userTokenRepository
+}
+
+func (s *serviceProvider) APIKeyRepository(ctx context.Context) repository.APIKeyRepository {
+	if s.apiKeyRepository == nil {
+		s.apiKeyRepository = apiKeyRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.apiKeyRepository
+}
+
+func (s *serviceProvider) AuditEventRepository(ctx context.Context) repository.AuditEventRepository {
+	if s.auditEventRepository == nil {
+		s.auditEventRepository = auditEventRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.auditEventRepository
+}
+
+func (s *serviceProvider) UsageRepository(ctx context.Context) repository.UsageRepository {
+	if s.usageRepository == nil {
+		s.usageRepository = usageRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.usageRepository
+}
+
+func (s *serviceProvider) RateLimitBucketRepository(ctx context.Context) repository.RateLimitBucketRepository {
+	if s.rateLimitBucketRepository == nil {
+		s.rateLimitBucketRepository = rateLimitBucketRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.rateLimitBucketRepository
+}
+
 func (s *serviceProvider) NoteService(ctx context.Context) service.NoteService {
 	if s.noteService == nil {
 		s.noteService = noteService.NewService(
 			s.NoteRepository(ctx),
 			s.TxManager(ctx),
+			s.QuotaService(ctx),
 		)
 	}
 
 	return s.noteService
 }
 
+func (s *serviceProvider) AuthService(ctx context.Context) service.AuthService {
+	if s.authService == nil {
+		s.authService = authService.NewService(
+			s.TokenConfig(),
+			s.LoginConfig(),
+			s.MFAConfig(),
+			s.UserTokenConfig(),
+			s.ImpersonationConfig(),
+			s.AccessTokenKeySet(),
+			s.UserRepository(ctx),
+			s.RefreshTokenRepository(ctx),
+			s.SessionRepository(ctx),
+			s.LoginAttemptRepository(ctx),
+			s.RecoveryCodeRepository(ctx),
+			s.UserTokenRepository(ctx),
+			s.APIKeyRepository(ctx),
+			s.TxManager(ctx),
+			s.Mailer(),
+			s.AuditService(ctx),
+		)
+	}
+
+	return s.authService
+}
+
+func (s *serviceProvider) AccessService(ctx context.Context) service.AccessService {
+	if s.accessService == nil {
+		s.accessService = accessService.NewService(
+			s.AccessTokenKeySet(),
+			s.AccessPolicy(),
+			s.APIKeyRepository(ctx),
+			s.UserRepository(ctx),
+			s.AuditService(ctx),
+			// Под чужой учетной записью нельзя управлять ключами, вторым фактором, сессиями и ролями
+			descAuth.AuthV1_RevokeAllSessions_FullMethodName,
+			descAuth.AuthV1_TerminateSession_FullMethodName,
+			descAuth.AuthV1_EnrollTOTP_FullMethodName,
+			descAuth.AuthV1_ConfirmTOTP_FullMethodName,
+			descAuth.AuthV1_RequestEmailVerification_FullMethodName,
+			descAuth.AuthV1_CreateAPIKey_FullMethodName,
+			descAuth.AuthV1_RevokeAPIKey_FullMethodName,
+			descAuth.AuthV1_SetUserRole_FullMethodName,
+			descAuth.AuthV1_Impersonate_FullMethodName,
+		)
+	}
+
+	return s.accessService
+}
+
+func (s *serviceProvider) AuditService(ctx context.Context) service.AuditService {
+	if s.auditService == nil {
+		s.auditService = auditService.NewService(
+			s.AuditEventRepository(ctx),
+			s.TxManager(ctx),
+		)
+	}
+
+	return s.auditService
+}
+
+func (s *serviceProvider) QuotaService(ctx context.Context) service.QuotaService {
+	if s.quotaService == nil {
+		s.quotaService = quotaService.NewService(
+			s.UsageRepository(ctx),
+			s.TxManager(ctx),
+			s.QuotaConfig(),
+		)
+	}
+
+	return s.quotaService
+}
+
+func (s *serviceProvider) OAuthService(ctx context.Context) service.OAuthService {
+	if s.oauthService == nil {
+		s.oauthService = oauthService.NewService(
+			s.TokenConfig(),
+			s.AccessTokenKeySet(),
+			s.AuthService(ctx),
+			s.APIKeyRepository(ctx),
+			s.UserRepository(ctx),
+			s.RefreshTokenRepository(ctx),
+		)
+	}
+
+	return s.oauthService
+}
+
 func (s *serviceProvider) GetNoteImpl(ctx context.Context, client rpc.OtherServiceClient) *note.Implementation {
 	if s.noteImpl == nil {
-		s.noteImpl = note.NewImplementation(s.NoteService(ctx), client)
+		s.noteImpl = note.NewImplementation(s.NoteService(ctx), s.QuotaService(ctx), client)
 	}
 
 	return s.noteImpl
 }
 
-func (s *serviceProvider) GetAuthImpl() *auth.Implementation {
+func (s *serviceProvider) GetAuthImpl(ctx context.Context) *auth.Implementation {
 	if s.authImpl == nil {
-		tokenConfig := s.TokenConfig()
-		s.authImpl = auth.NewImplementation(tokenConfig)
+		s.authImpl = auth.NewImplementation(s.AuthService(ctx))
 	}
 
 	return s.authImpl
 }
 
-func (s *serviceProvider) GetAccessImpl() *access.Implementation {
+func (s *serviceProvider) GetAccessImpl(ctx context.Context) *access.Implementation {
 	if s.accessImpl == nil {
 		tokenConfig := s.TokenConfig()
-		s.accessImpl = access.NewImplementation(tokenConfig)
+		s.accessImpl = access.NewImplementation(tokenConfig, s.AccessService(ctx))
 	}
 
 	return s.accessImpl
 }
+
+func (s *serviceProvider) GetOAuthImpl(ctx context.Context) *oauth.Implementation {
+	if s.oauthImpl == nil {
+		s.oauthImpl = oauth.NewImplementation(s.OAuthService(ctx))
+	}
+
+	return s.oauthImpl
+}
+
+func (s *serviceProvider) GetAuditImpl(ctx context.Context) *audit.Implementation {
+	if s.auditImpl == nil {
+		s.auditImpl = audit.NewImplementation(s.AuditService(ctx))
+	}
+
+	return s.auditImpl
+}
@@ -0,0 +1,139 @@
+package circuit_breaker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/sony/gobreaker"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"di_container/internal/logger"
+	"di_container/internal/metric"
+	"di_container/internal/sys"
+	"di_container/internal/sys/validate"
+)
+
+// defaultOpenTimeout значение, которое gobreaker использует при нулевом Timeout
+const defaultOpenTimeout = 60 * time.Second
+
+// Breakers набор независимых выключателей, по одному на имя: полный метод gRPC или адрес внешнего сервиса.
+// Сбои одного метода не закрывают доступ к остальным
+type Breakers struct {
+	settings gobreaker.Settings
+
+	mu       sync.Mutex
+	breakers map[string]*gobreaker.CircuitBreaker
+}
+
+// NewBreakers создает набор. Каждый выключатель получает копию settings со своим именем.
+// Если IsSuccessful не задан, сбоем считаются только ошибки из IsFailure
+func NewBreakers(settings gobreaker.Settings) *Breakers {
+	if settings.IsSuccessful == nil {
+		settings.IsSuccessful = func(err error) bool {
+			return !IsFailure(err)
+		}
+	}
+
+	return &Breakers{
+		settings: settings,
+		breakers: make(map[string]*gobreaker.CircuitBreaker),
+	}
+}
+
+// Execute выполняет req через выключатель name. Пока выключатель разомкнут, req не вызывается
+// и возвращается gobreaker.ErrOpenState или gobreaker.ErrTooManyRequests
+func (b *Breakers) Execute(name string, req func() (interface{}, error)) (interface{}, error) {
+	return b.get(name).Execute(req)
+}
+
+// OpenTimeout сколько выключатель остается разомкнутым, прежде чем пропустить пробные запросы
+func (b *Breakers) OpenTimeout() time.Duration {
+	if b.settings.Timeout <= 0 {
+		return defaultOpenTimeout
+	}
+
+	return b.settings.Timeout
+}
+
+func (b *Breakers) get(name string) *gobreaker.CircuitBreaker {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if cb, ok := b.breakers[name]; ok {
+		return cb
+	}
+
+	settings := b.settings
+	settings.Name = name
+	settings.OnStateChange = onStateChange(b.settings.OnStateChange)
+
+	cb := gobreaker.NewCircuitBreaker(settings)
+	b.breakers[name] = cb
+	metric.SetCircuitBreakerState(name, float64(gobreaker.StateClosed))
+
+	return cb
+}
+
+func onStateChange(next func(name string, from gobreaker.State, to gobreaker.State)) func(name string, from gobreaker.State, to gobreaker.State) {
+	return func(name string, from gobreaker.State, to gobreaker.State) {
+		metric.SetCircuitBreakerState(name, float64(to))
+		metric.IncCircuitBreakerTransition(name, from.String(), to.String())
+
+		fields := []zap.Field{
+			zap.String("breaker", name),
+			zap.String("from", from.String()),
+			zap.String("to", to.String()),
+		}
+		if to == gobreaker.StateOpen {
+			logger.Warn("circuit breaker opened", fields...)
+		} else {
+			logger.Info("circuit breaker state changed", fields...)
+		}
+
+		if next != nil {
+			next(name, from, to)
+		}
+	}
+}
+
+// IsOpen сообщает, что запрос отклонен выключателем, а не выполнен
+func IsOpen(err error) bool {
+	return errors.Is(err, gobreaker.ErrOpenState) || errors.Is(err, gobreaker.ErrTooManyRequests)
+}
+
+// IsFailure отличает сбои сервиса от ошибок клиента. Неверные аргументы, отказ в доступе,
+// отсутствие записи или превышение лимита говорят о запросе, а не о здоровье сервиса, и выключатель не размыкают
+func IsFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var code codes.Code
+	switch {
+	case sys.IsCommonError(err):
+		code = sys.GetCommonError(err).Code()
+	case validate.IsValidationError(err):
+		code = codes.InvalidArgument
+	case errors.Is(err, context.Canceled):
+		code = codes.Canceled
+	case errors.Is(err, context.DeadlineExceeded):
+		code = codes.DeadlineExceeded
+	default:
+		s, ok := status.FromError(err)
+		if !ok {
+			return true
+		}
+		code = s.Code()
+	}
+
+	switch code {
+	case codes.Unknown, codes.DeadlineExceeded, codes.Internal, codes.Unavailable, codes.DataLoss:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,126 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/sony/gobreaker"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"di_container/internal/circuit_breaker"
+	"di_container/internal/logger"
+	"di_container/internal/metric"
+	"di_container/internal/sys"
+	"di_container/internal/sys/validate"
+)
+
+func TestMain(m *testing.M) {
+	logger.Init(zapcore.NewNopCore())
+	if err := metric.Init(context.Background()); err != nil {
+		panic(err)
+	}
+
+	os.Exit(m.Run())
+}
+
+func newBreakers() *circuit_breaker.Breakers {
+	return circuit_breaker.NewBreakers(gobreaker.Settings{
+		Timeout: time.Minute,
+		ReadyToTrip: func(counts gobreaker.Counts) bool {
+			return counts.ConsecutiveFailures >= 2
+		},
+	})
+}
+
+func fail(err error) func() (interface{}, error) {
+	return func() (interface{}, error) {
+		return nil, err
+	}
+}
+
+func TestBreakers(t *testing.T) {
+	t.Parallel()
+
+	unavailable := status.Error(codes.Unavailable, "db is down")
+
+	t.Run("breaker per name", func(t *testing.T) {
+		t.Parallel()
+
+		breakers := newBreakers()
+
+		for i := 0; i < 2; i++ {
+			_, err := breakers.Execute("/note_v1.NoteV1/Get", fail(unavailable))
+			require.ErrorIs(t, err, unavailable)
+		}
+
+		_, err := breakers.Execute("/note_v1.NoteV1/Get", fail(nil))
+		require.True(t, circuit_breaker.IsOpen(err))
+
+		_, err = breakers.Execute("/note_v1.NoteV1/Create", fail(nil))
+		require.NoError(t, err)
+	})
+
+	t.Run("client errors do not open breaker", func(t *testing.T) {
+		t.Parallel()
+
+		breakers := newBreakers()
+		invalid := status.Error(codes.InvalidArgument, "title is required")
+
+		for i := 0; i < 5; i++ {
+			_, err := breakers.Execute("/note_v1.NoteV1/Create", fail(invalid))
+			require.ErrorIs(t, err, invalid)
+		}
+
+		_, err := breakers.Execute("/note_v1.NoteV1/Create", fail(nil))
+		require.NoError(t, err)
+	})
+
+	t.Run("open timeout", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, time.Minute, newBreakers().OpenTimeout())
+		require.Equal(t, 60*time.Second, circuit_breaker.NewBreakers(gobreaker.Settings{}).OpenTimeout())
+	})
+}
+
+func TestIsFailure(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "nil", err: nil, want: false},
+		{name: "plain error", err: errors.New("connection reset"), want: true},
+		{name: "unavailable", err: status.Error(codes.Unavailable, ""), want: true},
+		{name: "deadline exceeded", err: status.Error(codes.DeadlineExceeded, ""), want: true},
+		{name: "internal", err: status.Error(codes.Internal, ""), want: true},
+		{name: "invalid argument", err: status.Error(codes.InvalidArgument, ""), want: false},
+		{name: "not found", err: status.Error(codes.NotFound, ""), want: false},
+		{name: "permission denied", err: status.Error(codes.PermissionDenied, ""), want: false},
+		{name: "resource exhausted", err: status.Error(codes.ResourceExhausted, ""), want: false},
+		{name: "canceled", err: status.Error(codes.Canceled, ""), want: false},
+		{name: "common not found", err: sys.NewCommonError("note not found", codes.NotFound), want: false},
+		{name: "common unavailable", err: sys.NewCommonError("storage unavailable", codes.Unavailable), want: true},
+		{name: "validation", err: validate.NewValidationErrors("title is required"), want: false},
+		{name: "context canceled", err: fmt.Errorf("query: %w", context.Canceled), want: false},
+		{name: "context deadline", err: fmt.Errorf("query: %w", context.DeadlineExceeded), want: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, circuit_breaker.IsFailure(tt.err))
+		})
+	}
+}
@@ -14,7 +14,13 @@ type pgClient struct {
 }
 
 func New(ctx context.Context, dsn string) (db.Client, error) {
-	dbc, err := pgxpool.Connect(ctx, dsn)
+	config, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		return nil, errors.Errorf("failed to parse db config: %v", err)
+	}
+	config.BeforeAcquire = prepareConn
+
+	dbc, err := pgxpool.ConnectConfig(ctx, config)
 	if err != nil {
 		return nil, errors.Errorf("failed to connect to db: %v", err)
 	}
@@ -4,13 +4,16 @@ import (
 	"context"
 	"di_container/internal/client/db"
 	"di_container/internal/client/db/prettier"
-	"fmt"
-	"log"
+	"di_container/internal/logger"
+	"di_container/internal/tenant"
+	"strconv"
+	"time"
 
 	"github.com/georgysavva/scany/pgxscan"
 	"github.com/jackc/pgconn"
 	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
+	"go.uber.org/zap"
 )
 
 type key string
@@ -100,11 +103,44 @@ func MakeContextTx(ctx context.Context, tx pgx.Tx) context.Context {
 	return context.WithValue(ctx, TxKey, tx)
 }
 
+// prepareConn выставляет соединению арендатора из контекста в настройку app.tenant_id, по которой работают политики RLS,
+// и statement_timeout по оставшемуся времени запроса. Соединение берется из пула на каждый запрос вне транзакции
+// и один раз на транзакцию, поэтому настройки всегда соответствуют текущему запросу. Без арендатора настройка пустая
+// и политики не пропускают ни одной строки. Таймаут останавливает запрос в самой базе, даже если отмена
+// с клиента не дошла, а без дедлайна в контексте он снимается
+func prepareConn(ctx context.Context, conn *pgx.Conn) bool {
+	_, err := conn.Exec(ctx,
+		"SELECT set_config('app.tenant_id', $1, false), set_config('statement_timeout', $2, false)",
+		tenant.FromContext(ctx),
+		statementTimeout(ctx),
+	)
+	if err != nil {
+		logger.FromContext(ctx).Error("failed to prepare connection",
+			zap.String("tenant", tenant.FromContext(ctx)),
+			zap.Error(err),
+		)
+		return false
+	}
+
+	return true
+}
+
+// statementTimeout оставшееся до дедлайна время в миллисекундах, "0" - без ограничения
+func statementTimeout(ctx context.Context) string {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return "0"
+	}
+
+	// Меньше миллисекунды Postgres округлит до нуля и снимет ограничение
+	return strconv.FormatInt(max(time.Until(deadline).Milliseconds(), 1), 10)
+}
+
+// logQuery пишет запрос в лог запроса, поэтому рядом с ним видны идентификатор запроса, арендатор и пользователь
 func logQuery(ctx context.Context, q db.Query, args ...interface{}) {
 	prettyQuery := prettier.Pretty(q.QueryRaw, prettier.PlacholderDollar, args...)
-	log.Println(
-		ctx,
-		fmt.Sprintf("sql: %s", q.Name),
-		fmt.Sprintf("query: %s", &prettyQuery),
+	logger.FromContext(ctx).Info("sql",
+		zap.String("name", q.Name),
+		zap.String("query", prettyQuery),
 	)
 }
@@ -0,0 +1,48 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"di_container/internal/client/mail"
+	"di_container/internal/logger"
+)
+
+// client сохраняет письма в файл вместо отправки. Используется для локальной разработки и тестов
+type client struct {
+	path string
+	mu   sync.Mutex
+}
+
+// New создает отправителя, который дописывает письма в файл path. Если path пустой, письма пишутся в лог
+func New(path string) *client {
+	return &client{path: path}
+}
+
+func (c *client) Send(_ context.Context, msg *mail.Message) error {
+	if len(c.path) == 0 {
+		logger.Info("mail sent",
+			zap.String("to", msg.To),
+			zap.String("subject", msg.Subject),
+			zap.String("body", msg.Body),
+		)
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	f, err := os.OpenFile(c.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = fmt.Fprintf(f, "Date: %s\nTo: %s\nSubject: %s\n\n%s\n\n", time.Now().Format(time.RFC1123Z), msg.To, msg.Subject, msg.Body)
+	return err
+}
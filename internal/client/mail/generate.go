@@ -0,0 +1,4 @@
+package mail
+
+//go:generate sh -c "rm -rf mocks && mkdir -p mocks"
+//go:generate minimock -i Mailer -o ./mocks/ -s "_minimock.go"
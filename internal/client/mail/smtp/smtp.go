@@ -0,0 +1,60 @@
+package smtp
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+
+	"di_container/internal/client/mail"
+)
+
+type client struct {
+	addr string
+	host string
+	from string
+	auth smtp.Auth
+}
+
+// New создает отправителя писем через SMTP сервер. Если username пустой, письма отправляются без авторизации
+func New(host string, port int64, username string, password string, from string) *client {
+	var auth smtp.Auth
+	if len(username) > 0 {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return &client{
+		addr: net.JoinHostPort(host, strconv.FormatInt(port, 10)),
+		host: host,
+		from: from,
+		auth: auth,
+	}
+}
+
+func (c *client) Send(ctx context.Context, msg *mail.Message) error {
+	// Переводы строк в заголовках позволили бы подставить в письмо свои заголовки
+	if strings.ContainsAny(msg.To, "\r\n") || strings.ContainsAny(msg.Subject, "\r\n") {
+		return errors.New("mail headers must not contain line breaks")
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", c.from)
+	fmt.Fprintf(&buf, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	buf.WriteString(msg.Body)
+
+	// net/smtp не принимает контекст, поэтому отправка не прерывается по его отмене,
+	// но уже отмененный запрос письмо не отправит
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return smtp.SendMail(c.addr, c.auth, c.from, []string{msg.To}, buf.Bytes())
+}
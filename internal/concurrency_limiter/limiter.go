@@ -0,0 +1,231 @@
+package concurrency_limiter
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	"di_container/internal/metric"
+)
+
+// ErrLimitExceeded запрос отклонен, потому что сервер перегружен
+var ErrLimitExceeded = errors.New("concurrency limit exceeded")
+
+// defaultBackoffRatio во сколько раз уменьшается лимит при признаке перегрузки
+const defaultBackoffRatio = 0.9
+
+type Settings struct {
+	InitialLimit int
+	MinLimit     int
+	MaxLimit     int
+	// LatencyThreshold ответ дольше этого считается признаком перегрузки
+	LatencyThreshold time.Duration
+	// BackoffRatio во сколько раз уменьшается лимит при перегрузке. Ноль - defaultBackoffRatio
+	BackoffRatio float64
+	// QueueSize сколько запросов могут ждать освобождения места. Неважные запросы не ждут никогда
+	QueueSize    int
+	QueueTimeout time.Duration
+}
+
+// AIMDLimiter ограничивает число одновременно выполняемых запросов. Лимит подбирается по задержке ответов:
+// пока ответы быстрые, он растет на единицу за каждые limit ответов, а при медленном ответе или истекшем дедлайне
+// уменьшается в BackoffRatio раз, но не чаще раза на запросы, начатые до прошлого уменьшения. Так сервер держит очередь короткой под перегрузкой и не отказывает, пока есть запас
+type AIMDLimiter struct {
+	settings Settings
+
+	mu       sync.Mutex
+	limit    float64
+	inFlight int
+	waiters  []*waiter
+	// decreasedAt когда лимит уменьшался последний раз
+	decreasedAt time.Time
+}
+
+type waiter struct {
+	priority Priority
+	ready    chan struct{}
+}
+
+func NewAIMDLimiter(settings Settings) *AIMDLimiter {
+	if settings.BackoffRatio <= 0 || settings.BackoffRatio >= 1 {
+		settings.BackoffRatio = defaultBackoffRatio
+	}
+	settings.MinLimit = max(settings.MinLimit, 1)
+	settings.MaxLimit = max(settings.MaxLimit, settings.MinLimit)
+
+	limiter := &AIMDLimiter{
+		settings: settings,
+		limit:    float64(min(max(settings.InitialLimit, settings.MinLimit), settings.MaxLimit)),
+	}
+	limiter.report()
+
+	return limiter
+}
+
+// Limit текущий лимит одновременных запросов
+func (l *AIMDLimiter) Limit() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return int(l.limit)
+}
+
+// Acquire занимает место для запроса. Если места нет, важный запрос ждет в очереди не дольше QueueTimeout.
+// Полученный Token нужно вернуть через Release, когда запрос выполнен
+func (l *AIMDLimiter) Acquire(ctx context.Context, priority Priority) (*Token, error) {
+	l.mu.Lock()
+
+	if len(l.waiters) == 0 && l.admits(priority) {
+		l.inFlight++
+		l.report()
+		l.mu.Unlock()
+
+		return l.newToken(), nil
+	}
+
+	if priority == PriorityLow || len(l.waiters) >= l.settings.QueueSize {
+		l.mu.Unlock()
+		metric.IncShedRequests(priority.String())
+
+		return nil, ErrLimitExceeded
+	}
+
+	w := &waiter{priority: priority, ready: make(chan struct{})}
+	l.enqueue(w)
+	l.report()
+	l.mu.Unlock()
+
+	timer := time.NewTimer(l.settings.QueueTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-w.ready:
+		return l.newToken(), nil
+	case <-timer.C:
+		if l.dequeue(w) {
+			metric.IncShedRequests(priority.String())
+			return nil, ErrLimitExceeded
+		}
+		// Место освободилось одновременно с истечением ожидания
+		return l.newToken(), nil
+	case <-ctx.Done():
+		if !l.dequeue(w) {
+			l.releaseSlot()
+		}
+		return nil, ctx.Err()
+	}
+}
+
+// Admits проверяет без занятия места, примет ли лимитер запрос. Подходит для долгих потоков,
+// которые не должны занимать место все время жизни
+func (l *AIMDLimiter) Admits(priority Priority) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return len(l.waiters) == 0 && l.admits(priority)
+}
+
+func (l *AIMDLimiter) admits(priority Priority) bool {
+	return float64(l.inFlight) < math.Max(1, l.limit*priority.share())
+}
+
+// enqueue ставит ожидающего за всеми не менее важными
+func (l *AIMDLimiter) enqueue(w *waiter) {
+	i := len(l.waiters)
+	for i > 0 && l.waiters[i-1].priority < w.priority {
+		i--
+	}
+
+	l.waiters = append(l.waiters, nil)
+	copy(l.waiters[i+1:], l.waiters[i:])
+	l.waiters[i] = w
+}
+
+// dequeue убирает ожидающего из очереди. false - его уже пропустили и место за ним
+func (l *AIMDLimiter) dequeue(w *waiter) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for i, current := range l.waiters {
+		if current == w {
+			l.waiters = append(l.waiters[:i], l.waiters[i+1:]...)
+			l.report()
+			return true
+		}
+	}
+
+	return false
+}
+
+// release освобождает место запроса, начатого в started, и подстраивает лимит по его задержке
+func (l *AIMDLimiter) release(started time.Time, latency time.Duration, dropped bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.inFlight--
+
+	switch {
+	case dropped || latency > l.settings.LatencyThreshold:
+		// Запросы, начатые до прошлого уменьшения, застали еще старый лимит. Если бы каждый из них снова
+		// уменьшал лимит, одна волна медленных ответов обрушила бы его до MinLimit
+		if started.Before(l.decreasedAt) {
+			break
+		}
+		l.limit = math.Max(float64(l.settings.MinLimit), l.limit*l.settings.BackoffRatio)
+		l.decreasedAt = time.Now()
+	case float64(l.inFlight+1)*2 >= l.limit:
+		// Лимит растет, только когда он действительно используется, иначе он разрастется при слабой нагрузке
+		l.limit = math.Min(float64(l.settings.MaxLimit), l.limit+1/l.limit)
+	}
+
+	l.admitWaiters()
+}
+
+// releaseSlot возвращает место, которое получил, но не использовал ожидающий. Запрос не выполнялся,
+// поэтому лимит не меняется
+func (l *AIMDLimiter) releaseSlot() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.inFlight--
+	l.admitWaiters()
+}
+
+// admitWaiters пропускает ожидающих, пока для них есть место
+func (l *AIMDLimiter) admitWaiters() {
+	for len(l.waiters) > 0 && l.admits(l.waiters[0].priority) {
+		w := l.waiters[0]
+		l.waiters = l.waiters[1:]
+		l.inFlight++
+		close(w.ready)
+	}
+
+	l.report()
+}
+
+func (l *AIMDLimiter) report() {
+	metric.SetConcurrencyLimit(l.limit)
+	metric.SetConcurrencyInFlight(l.inFlight)
+	metric.SetConcurrencyQueueDepth(len(l.waiters))
+}
+
+func (l *AIMDLimiter) newToken() *Token {
+	return &Token{limiter: l, started: time.Now()}
+}
+
+// Token место, занятое запросом
+type Token struct {
+	limiter *AIMDLimiter
+	started time.Time
+	once    sync.Once
+}
+
+// Release освобождает место и сообщает лимитеру, сколько выполнялся запрос. dropped - запрос не успел
+// до дедлайна, это признак перегрузки независимо от задержки. Повторный вызов ничего не делает
+func (t *Token) Release(latency time.Duration, dropped bool) {
+	t.once.Do(func() {
+		t.limiter.release(t.started, latency, dropped)
+	})
+}
@@ -0,0 +1,51 @@
+package concurrency_limiter
+
+import "strings"
+
+// Header заголовок, которым клиент указывает важность запроса
+const Header = "x-priority"
+
+// Priority важность запроса. При перегрузке первыми отклоняются запросы с меньшей важностью
+type Priority int
+
+const (
+	PriorityLow Priority = iota
+	PriorityNormal
+	PriorityCritical
+)
+
+// ParsePriority разбирает значение заголовка. Неизвестное или пустое значение - PriorityNormal
+func ParsePriority(value string) Priority {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "low":
+		return PriorityLow
+	case "critical":
+		return PriorityCritical
+	default:
+		return PriorityNormal
+	}
+}
+
+func (p Priority) String() string {
+	switch p {
+	case PriorityLow:
+		return "low"
+	case PriorityCritical:
+		return "critical"
+	default:
+		return "normal"
+	}
+}
+
+// share какую часть лимита могут занять запросы этой важности. Оставшееся место держится
+// за более важными запросами, поэтому при росте нагрузки отказы начинаются с неважных
+func (p Priority) share() float64 {
+	switch p {
+	case PriorityLow:
+		return 0.7
+	case PriorityCritical:
+		return 1
+	default:
+		return 0.9
+	}
+}
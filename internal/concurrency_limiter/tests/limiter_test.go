@@ -0,0 +1,201 @@
+package tests
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"di_container/internal/concurrency_limiter"
+	"di_container/internal/metric"
+)
+
+func TestMain(m *testing.M) {
+	if err := metric.Init(context.Background()); err != nil {
+		panic(err)
+	}
+
+	os.Exit(m.Run())
+}
+
+func newLimiter(limit int, queueSize int, queueTimeout time.Duration) *concurrency_limiter.AIMDLimiter {
+	return concurrency_limiter.NewAIMDLimiter(concurrency_limiter.Settings{
+		InitialLimit:     limit,
+		MinLimit:         1,
+		MaxLimit:         100,
+		LatencyThreshold: 100 * time.Millisecond,
+		BackoffRatio:     0.5,
+		QueueSize:        queueSize,
+		QueueTimeout:     queueTimeout,
+	})
+}
+
+func TestAIMDLimiter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("low priority is shed first", func(t *testing.T) {
+		t.Parallel()
+
+		limiter := newLimiter(10, 0, 0)
+
+		for i := 0; i < 7; i++ {
+			_, err := limiter.Acquire(ctx, concurrency_limiter.PriorityLow)
+			require.NoError(t, err)
+		}
+
+		_, err := limiter.Acquire(ctx, concurrency_limiter.PriorityLow)
+		require.ErrorIs(t, err, concurrency_limiter.ErrLimitExceeded)
+
+		for i := 0; i < 2; i++ {
+			_, err = limiter.Acquire(ctx, concurrency_limiter.PriorityNormal)
+			require.NoError(t, err)
+		}
+
+		_, err = limiter.Acquire(ctx, concurrency_limiter.PriorityNormal)
+		require.ErrorIs(t, err, concurrency_limiter.ErrLimitExceeded)
+
+		_, err = limiter.Acquire(ctx, concurrency_limiter.PriorityCritical)
+		require.NoError(t, err)
+
+		_, err = limiter.Acquire(ctx, concurrency_limiter.PriorityCritical)
+		require.ErrorIs(t, err, concurrency_limiter.ErrLimitExceeded)
+	})
+
+	t.Run("queued request gets released slot", func(t *testing.T) {
+		t.Parallel()
+
+		limiter := newLimiter(1, 1, time.Second)
+
+		token, err := limiter.Acquire(ctx, concurrency_limiter.PriorityCritical)
+		require.NoError(t, err)
+
+		acquired := make(chan error, 1)
+		go func() {
+			_, err := limiter.Acquire(ctx, concurrency_limiter.PriorityCritical)
+			acquired <- err
+		}()
+
+		require.Eventually(t, func() bool {
+			return !limiter.Admits(concurrency_limiter.PriorityCritical)
+		}, time.Second, time.Millisecond)
+
+		token.Release(time.Millisecond, false)
+		require.NoError(t, <-acquired)
+	})
+
+	t.Run("queue timeout", func(t *testing.T) {
+		t.Parallel()
+
+		limiter := newLimiter(1, 1, 10*time.Millisecond)
+
+		_, err := limiter.Acquire(ctx, concurrency_limiter.PriorityCritical)
+		require.NoError(t, err)
+
+		_, err = limiter.Acquire(ctx, concurrency_limiter.PriorityCritical)
+		require.ErrorIs(t, err, concurrency_limiter.ErrLimitExceeded)
+	})
+
+	t.Run("canceled while queued", func(t *testing.T) {
+		t.Parallel()
+
+		limiter := newLimiter(1, 1, time.Second)
+
+		_, err := limiter.Acquire(ctx, concurrency_limiter.PriorityCritical)
+		require.NoError(t, err)
+
+		canceledCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+
+		_, err = limiter.Acquire(canceledCtx, concurrency_limiter.PriorityCritical)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("slow responses shrink limit", func(t *testing.T) {
+		t.Parallel()
+
+		limiter := newLimiter(8, 0, 0)
+
+		token, err := limiter.Acquire(ctx, concurrency_limiter.PriorityNormal)
+		require.NoError(t, err)
+		token.Release(time.Second, false)
+		require.Equal(t, 4, limiter.Limit())
+
+		token, err = limiter.Acquire(ctx, concurrency_limiter.PriorityNormal)
+		require.NoError(t, err)
+		token.Release(time.Millisecond, true)
+		require.Equal(t, 2, limiter.Limit())
+
+		// Повторный Release не влияет на лимит
+		token.Release(time.Second, true)
+		require.Equal(t, 2, limiter.Limit())
+	})
+
+	t.Run("slow responses from one window shrink limit once", func(t *testing.T) {
+		t.Parallel()
+
+		limiter := newLimiter(8, 0, 0)
+
+		tokens := make([]*concurrency_limiter.Token, 4)
+		for i := range tokens {
+			token, err := limiter.Acquire(ctx, concurrency_limiter.PriorityCritical)
+			require.NoError(t, err)
+			tokens[i] = token
+		}
+
+		for _, token := range tokens {
+			token.Release(time.Second, false)
+		}
+		require.Equal(t, 4, limiter.Limit())
+
+		// Запрос, начатый после уменьшения, снова уменьшает лимит
+		token, err := limiter.Acquire(ctx, concurrency_limiter.PriorityCritical)
+		require.NoError(t, err)
+		token.Release(time.Millisecond, true)
+		require.Equal(t, 2, limiter.Limit())
+	})
+
+	t.Run("fast responses under load grow limit", func(t *testing.T) {
+		t.Parallel()
+
+		limiter := newLimiter(2, 0, 0)
+
+		for i := 0; i < 10; i++ {
+			first, err := limiter.Acquire(ctx, concurrency_limiter.PriorityCritical)
+			require.NoError(t, err)
+			second, err := limiter.Acquire(ctx, concurrency_limiter.PriorityCritical)
+			require.NoError(t, err)
+
+			first.Release(time.Millisecond, false)
+			second.Release(time.Millisecond, false)
+		}
+
+		require.Greater(t, limiter.Limit(), 2)
+	})
+
+	t.Run("idle server does not grow limit", func(t *testing.T) {
+		t.Parallel()
+
+		limiter := newLimiter(10, 0, 0)
+
+		for i := 0; i < 50; i++ {
+			token, err := limiter.Acquire(ctx, concurrency_limiter.PriorityNormal)
+			require.NoError(t, err)
+			token.Release(time.Millisecond, false)
+		}
+
+		require.Equal(t, 10, limiter.Limit())
+	})
+}
+
+func TestParsePriority(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, concurrency_limiter.PriorityLow, concurrency_limiter.ParsePriority("low"))
+	require.Equal(t, concurrency_limiter.PriorityCritical, concurrency_limiter.ParsePriority(" Critical "))
+	require.Equal(t, concurrency_limiter.PriorityNormal, concurrency_limiter.ParsePriority(""))
+	require.Equal(t, concurrency_limiter.PriorityNormal, concurrency_limiter.ParsePriority("urgent"))
+}
@@ -1,7 +1,14 @@
 package config
 
 import (
+	"net"
+	"time"
+
 	"github.com/joho/godotenv"
+
+	"di_container/internal/concurrency_limiter"
+	"di_container/internal/model"
+	"di_container/internal/rate_limiter"
 )
 
 func Load(path string) error {
@@ -15,6 +22,8 @@ func Load(path string) error {
 type GRPCConfig interface {
 	Address() string
 	OtherPort() int64
+	ExposeErrorID() bool
+	TrustedProxies() []*net.IPNet
 }
 
 type PGConfig interface {
@@ -31,3 +40,82 @@ type SwaggerConfig interface {
 
 type TokenConfig interface {
 }
+
+type LoginConfig interface {
+	UserMaxFailures() int64
+	IPMaxFailures() int64
+	FailureWindow() time.Duration
+	LockoutBase() time.Duration
+	LockoutMax() time.Duration
+}
+
+type MFAConfig interface {
+	Issuer() string
+	EncryptionKey() []byte
+	ChallengeExpiration() time.Duration
+}
+
+const (
+	MailDriverSMTP = "smtp"
+	MailDriverFile = "file"
+)
+
+type MailConfig interface {
+	Driver() string
+	From() string
+	SMTPHost() string
+	SMTPPort() int64
+	SMTPUsername() string
+	SMTPPassword() string
+	FilePath() string
+}
+
+type UserTokenConfig interface {
+	PasswordResetExpiration() time.Duration
+	EmailVerificationExpiration() time.Duration
+}
+
+type ImpersonationConfig interface {
+	TokenExpiration() time.Duration
+}
+
+type AccessPolicyConfig interface {
+	Path() string
+}
+
+// QuotaConfig лимиты, которые действуют для каждого арендатора и для каждого пользователя внутри него
+type QuotaConfig interface {
+	TenantLimits() model.QuotaLimits
+	UserLimits() model.QuotaLimits
+}
+
+const (
+	// RateLimitBackendMemory считает лимиты в памяти каждой реплики
+	RateLimitBackendMemory = "memory"
+	// RateLimitBackendPostgres считает лимиты в Postgres, общими для всех реплик
+	RateLimitBackendPostgres = "postgres"
+)
+
+// RateLimitConfig лимиты запросов для каждого клиента. Для отдельных методов можно задать свои
+type RateLimitConfig interface {
+	Backend() string
+	LocalBatch() int
+	DefaultPolicy() rate_limiter.Policy
+	MethodPolicies() map[string]rate_limiter.Policy
+	IdleTimeout() time.Duration
+	MaxWait() time.Duration
+}
+
+// ConcurrencyLimitConfig границы и параметры подстройки лимита одновременных запросов
+type ConcurrencyLimitConfig interface {
+	Settings() concurrency_limiter.Settings
+}
+
+// DeadlineConfig сроки выполнения запросов. Для отдельных методов срок задается свой и служит для них
+// и значением по умолчанию, и верхней границей
+type DeadlineConfig interface {
+	DefaultTimeout() time.Duration
+	MaxTimeout() time.Duration
+	MinBudget() time.Duration
+	MethodTimeouts() map[string]time.Duration
+}
@@ -0,0 +1,26 @@
+package env
+
+import (
+	"di_container/internal/config"
+	"os"
+)
+
+var _ config.AccessPolicyConfig = (*accessPolicyConfig)(nil)
+
+const accessPolicyFileEnvName = "ACCESS_POLICY_FILE"
+
+type accessPolicyConfig struct {
+	path string
+}
+
+// NewAccessPolicyConfig читает путь к файлу политики доступа. Путь необязателен:
+// без него используется встроенная политика
+func NewAccessPolicyConfig() *accessPolicyConfig {
+	return &accessPolicyConfig{
+		path: os.Getenv(accessPolicyFileEnvName),
+	}
+}
+
+func (cfg *accessPolicyConfig) Path() string {
+	return cfg.path
+}
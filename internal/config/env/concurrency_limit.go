@@ -0,0 +1,74 @@
+package env
+
+import (
+	"errors"
+
+	"di_container/internal/concurrency_limiter"
+	"di_container/internal/config"
+)
+
+var _ config.ConcurrencyLimitConfig = (*concurrencyLimitConfig)(nil)
+
+const (
+	concurrencyLimitInitialEnvName          = "CONCURRENCY_LIMIT_INITIAL"
+	concurrencyLimitMinEnvName              = "CONCURRENCY_LIMIT_MIN"
+	concurrencyLimitMaxEnvName              = "CONCURRENCY_LIMIT_MAX"
+	concurrencyLimitLatencyThresholdEnvName = "CONCURRENCY_LIMIT_LATENCY_THRESHOLD"
+	concurrencyLimitQueueSizeEnvName        = "CONCURRENCY_LIMIT_QUEUE_SIZE"
+	concurrencyLimitQueueTimeoutEnvName     = "CONCURRENCY_LIMIT_QUEUE_TIMEOUT"
+)
+
+type concurrencyLimitConfig struct {
+	settings concurrency_limiter.Settings
+}
+
+func NewConcurrencyLimitConfig() (*concurrencyLimitConfig, error) {
+	initial, err := getInt(concurrencyLimitInitialEnvName)
+	if err != nil {
+		return nil, err
+	}
+
+	minLimit, err := getInt(concurrencyLimitMinEnvName)
+	if err != nil {
+		return nil, err
+	}
+
+	maxLimit, err := getInt(concurrencyLimitMaxEnvName)
+	if err != nil {
+		return nil, err
+	}
+
+	if minLimit < 1 || maxLimit < minLimit || initial < minLimit || initial > maxLimit {
+		return nil, errors.New("concurrency limits must satisfy 1 <= min <= initial <= max")
+	}
+
+	latencyThreshold, err := getDuration(concurrencyLimitLatencyThresholdEnvName)
+	if err != nil {
+		return nil, err
+	}
+
+	queueSize, err := getInt(concurrencyLimitQueueSizeEnvName)
+	if err != nil {
+		return nil, err
+	}
+
+	queueTimeout, err := getDuration(concurrencyLimitQueueTimeoutEnvName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &concurrencyLimitConfig{
+		settings: concurrency_limiter.Settings{
+			InitialLimit:     int(initial),
+			MinLimit:         int(minLimit),
+			MaxLimit:         int(maxLimit),
+			LatencyThreshold: latencyThreshold,
+			QueueSize:        int(queueSize),
+			QueueTimeout:     queueTimeout,
+		},
+	}, nil
+}
+
+func (cfg *concurrencyLimitConfig) Settings() concurrency_limiter.Settings {
+	return cfg.settings
+}
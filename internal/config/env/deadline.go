@@ -0,0 +1,104 @@
+package env
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"time"
+
+	"di_container/internal/config"
+)
+
+var _ config.DeadlineConfig = (*deadlineConfig)(nil)
+
+const (
+	deadlineDefaultEnvName   = "DEADLINE_DEFAULT"
+	deadlineMaxEnvName       = "DEADLINE_MAX"
+	deadlineMinBudgetEnvName = "DEADLINE_MIN_BUDGET"
+	// deadlineMethodsEnvName сроки отдельных методов в виде "/pkg.Service/Method=30s,..."
+	deadlineMethodsEnvName = "DEADLINE_METHODS"
+)
+
+type deadlineConfig struct {
+	defaultTimeout time.Duration
+	maxTimeout     time.Duration
+	minBudget      time.Duration
+	methodTimeouts map[string]time.Duration
+}
+
+func NewDeadlineConfig() (*deadlineConfig, error) {
+	defaultTimeout, err := getDuration(deadlineDefaultEnvName)
+	if err != nil {
+		return nil, err
+	}
+
+	maxTimeout, err := getDuration(deadlineMaxEnvName)
+	if err != nil {
+		return nil, err
+	}
+
+	minBudget, err := getDuration(deadlineMinBudgetEnvName)
+	if err != nil {
+		return nil, err
+	}
+
+	if defaultTimeout <= 0 || maxTimeout < defaultTimeout || minBudget < 0 || minBudget >= defaultTimeout {
+		return nil, errors.New("deadlines must satisfy 0 <= min budget < default <= max")
+	}
+
+	methodTimeouts, err := parseMethodTimeouts(os.Getenv(deadlineMethodsEnvName))
+	if err != nil {
+		return nil, err
+	}
+
+	return &deadlineConfig{
+		defaultTimeout: defaultTimeout,
+		maxTimeout:     maxTimeout,
+		minBudget:      minBudget,
+		methodTimeouts: methodTimeouts,
+	}, nil
+}
+
+// DefaultTimeout срок для запроса, клиент которого не указал дедлайн
+func (cfg *deadlineConfig) DefaultTimeout() time.Duration {
+	return cfg.defaultTimeout
+}
+
+// MaxTimeout больше этого срока запрос не выполняется, даже если клиент готов ждать дольше
+func (cfg *deadlineConfig) MaxTimeout() time.Duration {
+	return cfg.maxTimeout
+}
+
+// MinBudget запрос, у которого осталось меньше этого времени, отклоняется сразу: он все равно не успеет
+func (cfg *deadlineConfig) MinBudget() time.Duration {
+	return cfg.minBudget
+}
+
+func (cfg *deadlineConfig) MethodTimeouts() map[string]time.Duration {
+	return cfg.methodTimeouts
+}
+
+func parseMethodTimeouts(str string) (map[string]time.Duration, error) {
+	timeouts := make(map[string]time.Duration)
+	if len(strings.TrimSpace(str)) == 0 {
+		return timeouts, nil
+	}
+
+	invalid := errors.New("invalid " + deadlineMethodsEnvName + " value")
+
+	for _, item := range strings.Split(str, ",") {
+		method, value, ok := strings.Cut(strings.TrimSpace(item), "=")
+		if !ok || len(method) == 0 {
+			return nil, invalid
+		}
+
+		timeout, err := time.ParseDuration(value)
+		if err != nil || timeout <= 0 {
+			return nil, invalid
+		}
+
+		timeouts[method] = timeout
+	}
+
+	return timeouts, nil
+}
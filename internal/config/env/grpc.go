@@ -6,6 +6,7 @@ import (
 	"net"
 	"os"
 	"strconv"
+	"strings"
 )
 
 var _ config.GRPCConfig = (*grpcConfig)(nil)
@@ -14,12 +15,18 @@ const (
 	grpcHostEnvName      = "GRPC_HOST"
 	grpcPortEnvName      = "GRPC_PORT"
 	grpcOtherPortEnvName = "GRPC_OTHER_PORT"
+	// grpcExposeErrorIDEnvName необязательный, по умолчанию false
+	grpcExposeErrorIDEnvName = "GRPC_EXPOSE_ERROR_ID"
+	// grpcTrustedProxiesEnvName необязательный список адресов и подсетей через запятую, например "10.0.0.0/8,192.168.1.10"
+	grpcTrustedProxiesEnvName = "GRPC_TRUSTED_PROXIES"
 )
 
 type grpcConfig struct {
-	host      string
-	port      string
-	otherPort int64
+	host           string
+	port           string
+	otherPort      int64
+	exposeErrorID  bool
+	trustedProxies []*net.IPNet
 }
 
 func NewGRPCConfig() (*grpcConfig, error) {
@@ -42,10 +49,25 @@ func NewGRPCConfig() (*grpcConfig, error) {
 		return nil, err
 	}
 
+	exposeErrorID := false
+	if str := os.Getenv(grpcExposeErrorIDEnvName); len(str) > 0 {
+		exposeErrorID, err = strconv.ParseBool(str)
+		if err != nil {
+			return nil, errors.New("invalid " + grpcExposeErrorIDEnvName + " value")
+		}
+	}
+
+	trustedProxies, err := parseNetworks(os.Getenv(grpcTrustedProxiesEnvName))
+	if err != nil {
+		return nil, errors.New("invalid " + grpcTrustedProxiesEnvName + " value")
+	}
+
 	return &grpcConfig{
-		host:      host,
-		port:      port,
-		otherPort: otherPortInt,
+		host:           host,
+		port:           port,
+		otherPort:      otherPortInt,
+		exposeErrorID:  exposeErrorID,
+		trustedProxies: trustedProxies,
 	}, nil
 }
 
@@ -56,3 +78,45 @@ func (cfg *grpcConfig) Address() string {
 func (cfg *grpcConfig) OtherPort() int64 {
 	return cfg.otherPort
 }
+
+// ExposeErrorID отдавать ли клиенту идентификатор внутренней ошибки, по которому ее можно найти в логах
+func (cfg *grpcConfig) ExposeErrorID() bool {
+	return cfg.exposeErrorID
+}
+
+// TrustedProxies прокси, которым можно верить в x-forwarded-for. Гейтвей этого процесса доверенный всегда
+func (cfg *grpcConfig) TrustedProxies() []*net.IPNet {
+	return cfg.trustedProxies
+}
+
+// parseNetworks разбирает список подсетей через запятую. Адрес без маски - подсеть из одного адреса
+func parseNetworks(str string) ([]*net.IPNet, error) {
+	var res []*net.IPNet
+	for _, item := range strings.Split(str, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		if !strings.Contains(item, "/") {
+			ip := net.ParseIP(item)
+			if ip == nil {
+				return nil, errors.New("invalid address " + item)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			res = append(res, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, network, err := net.ParseCIDR(item)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, network)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,30 @@
+package env
+
+import (
+	"di_container/internal/config"
+	"time"
+)
+
+var _ config.ImpersonationConfig = (*impersonationConfig)(nil)
+
+const impersonationTokenExpirationEnvName = "IMPERSONATION_TOKEN_EXPIRATION"
+
+type impersonationConfig struct {
+	tokenExpiration time.Duration
+}
+
+func NewImpersonationConfig() (*impersonationConfig, error) {
+	tokenExpiration, err := getDuration(impersonationTokenExpirationEnvName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &impersonationConfig{
+		tokenExpiration: tokenExpiration,
+	}, nil
+}
+
+// TokenExpiration время жизни access токена, выданного для входа под другим пользователем
+func (cfg *impersonationConfig) TokenExpiration() time.Duration {
+	return cfg.tokenExpiration
+}
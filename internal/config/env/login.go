@@ -0,0 +1,114 @@
+package env
+
+import (
+	"di_container/internal/config"
+	"errors"
+	"os"
+	"strconv"
+	"time"
+)
+
+var _ config.LoginConfig = (*loginConfig)(nil)
+
+const (
+	loginUserMaxFailuresEnvName = "LOGIN_USER_MAX_FAILURES"
+	loginIPMaxFailuresEnvName   = "LOGIN_IP_MAX_FAILURES"
+	loginFailureWindowEnvName   = "LOGIN_FAILURE_WINDOW"
+	loginLockoutBaseEnvName     = "LOGIN_LOCKOUT_BASE"
+	loginLockoutMaxEnvName      = "LOGIN_LOCKOUT_MAX"
+)
+
+type loginConfig struct {
+	userMaxFailures int64
+	ipMaxFailures   int64
+	failureWindow   time.Duration
+	lockoutBase     time.Duration
+	lockoutMax      time.Duration
+}
+
+func NewLoginConfig() (*loginConfig, error) {
+	userMaxFailures, err := getInt(loginUserMaxFailuresEnvName)
+	if err != nil {
+		return nil, err
+	}
+
+	ipMaxFailures, err := getInt(loginIPMaxFailuresEnvName)
+	if err != nil {
+		return nil, err
+	}
+
+	failureWindow, err := getDuration(loginFailureWindowEnvName)
+	if err != nil {
+		return nil, err
+	}
+
+	lockoutBase, err := getDuration(loginLockoutBaseEnvName)
+	if err != nil {
+		return nil, err
+	}
+
+	lockoutMax, err := getDuration(loginLockoutMaxEnvName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &loginConfig{
+		userMaxFailures: userMaxFailures,
+		ipMaxFailures:   ipMaxFailures,
+		failureWindow:   failureWindow,
+		lockoutBase:     lockoutBase,
+		lockoutMax:      lockoutMax,
+	}, nil
+}
+
+// UserMaxFailures количество неудачных попыток входа под одним логином, после которого вход блокируется
+func (cfg *loginConfig) UserMaxFailures() int64 {
+	return cfg.userMaxFailures
+}
+
+// IPMaxFailures количество неудачных попыток входа с одного адреса, после которого вход блокируется
+func (cfg *loginConfig) IPMaxFailures() int64 {
+	return cfg.ipMaxFailures
+}
+
+// FailureWindow время, через которое счетчик неудачных попыток сбрасывается
+func (cfg *loginConfig) FailureWindow() time.Duration {
+	return cfg.failureWindow
+}
+
+// LockoutBase длительность первой блокировки, каждая следующая неудачная попытка ее удваивает
+func (cfg *loginConfig) LockoutBase() time.Duration {
+	return cfg.lockoutBase
+}
+
+func (cfg *loginConfig) LockoutMax() time.Duration {
+	return cfg.lockoutMax
+}
+
+func getInt(envName string) (int64, error) {
+	str := os.Getenv(envName)
+	if len(str) == 0 {
+		return 0, errors.New(envName + " not found")
+	}
+
+	val, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		return 0, errors.New("invalid " + envName + " value")
+	}
+
+	return val, nil
+}
+
+func getDuration(envName string) (time.Duration, error) {
+	str := os.Getenv(envName)
+	if len(str) == 0 {
+		return 0, errors.New(envName + " not found")
+	}
+
+	val, err := time.ParseDuration(str)
+	if err != nil {
+		return 0, errors.New("invalid " + envName + " value")
+	}
+
+	return val, nil
+}
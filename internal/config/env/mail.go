@@ -0,0 +1,94 @@
+package env
+
+import (
+	"di_container/internal/config"
+	"errors"
+	"os"
+)
+
+var _ config.MailConfig = (*mailConfig)(nil)
+
+const (
+	mailDriverEnvName       = "MAIL_DRIVER"
+	mailFromEnvName         = "MAIL_FROM"
+	mailSMTPHostEnvName     = "MAIL_SMTP_HOST"
+	mailSMTPPortEnvName     = "MAIL_SMTP_PORT"
+	mailSMTPUsernameEnvName = "MAIL_SMTP_USERNAME"
+	mailSMTPPasswordEnvName = "MAIL_SMTP_PASSWORD"
+	mailFilePathEnvName     = "MAIL_FILE_PATH"
+)
+
+type mailConfig struct {
+	driver       string
+	from         string
+	smtpHost     string
+	smtpPort     int64
+	smtpUsername string
+	smtpPassword string
+	filePath     string
+}
+
+func NewMailConfig() (*mailConfig, error) {
+	driver := os.Getenv(mailDriverEnvName)
+	if driver != config.MailDriverSMTP && driver != config.MailDriverFile {
+		return nil, errors.New("mail driver must be smtp or file")
+	}
+
+	from := os.Getenv(mailFromEnvName)
+	if len(from) == 0 {
+		return nil, errors.New("mail from not found")
+	}
+
+	cfg := &mailConfig{
+		driver:       driver,
+		from:         from,
+		smtpUsername: os.Getenv(mailSMTPUsernameEnvName),
+		smtpPassword: os.Getenv(mailSMTPPasswordEnvName),
+		filePath:     os.Getenv(mailFilePathEnvName),
+	}
+
+	if driver == config.MailDriverSMTP {
+		cfg.smtpHost = os.Getenv(mailSMTPHostEnvName)
+		if len(cfg.smtpHost) == 0 {
+			return nil, errors.New("mail smtp host not found")
+		}
+
+		smtpPort, err := getInt(mailSMTPPortEnvName)
+		if err != nil {
+			return nil, err
+		}
+		cfg.smtpPort = smtpPort
+	}
+
+	return cfg, nil
+}
+
+// Driver способ отправки писем: smtp или file для локальной разработки
+func (cfg *mailConfig) Driver() string {
+	return cfg.driver
+}
+
+func (cfg *mailConfig) From() string {
+	return cfg.from
+}
+
+func (cfg *mailConfig) SMTPHost() string {
+	return cfg.smtpHost
+}
+
+func (cfg *mailConfig) SMTPPort() int64 {
+	return cfg.smtpPort
+}
+
+func (cfg *mailConfig) SMTPUsername() string {
+	return cfg.smtpUsername
+}
+
+func (cfg *mailConfig) SMTPPassword() string {
+	return cfg.smtpPassword
+}
+
+// FilePath файл, в который драйвер file складывает письма. Если не задан, письма пишутся в лог
+func (cfg *mailConfig) FilePath() string {
+	return cfg.filePath
+}
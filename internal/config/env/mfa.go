@@ -0,0 +1,67 @@
+package env
+
+import (
+	"di_container/internal/config"
+	"encoding/base64"
+	"errors"
+	"os"
+	"time"
+)
+
+var _ config.MFAConfig = (*mfaConfig)(nil)
+
+const (
+	mfaIssuerEnvName              = "MFA_ISSUER"
+	mfaEncryptionKeyEnvName       = "MFA_ENCRYPTION_KEY"
+	mfaChallengeExpirationEnvName = "MFA_CHALLENGE_EXPIRATION"
+
+	mfaEncryptionKeyLength = 32
+)
+
+type mfaConfig struct {
+	issuer              string
+	encryptionKey       []byte
+	challengeExpiration time.Duration
+}
+
+func NewMFAConfig() (*mfaConfig, error) {
+	issuer := os.Getenv(mfaIssuerEnvName)
+	if len(issuer) == 0 {
+		return nil, errors.New("mfa issuer not found")
+	}
+
+	encryptionKeyStr := os.Getenv(mfaEncryptionKeyEnvName)
+	if len(encryptionKeyStr) == 0 {
+		return nil, errors.New("mfa encryption key not found")
+	}
+	encryptionKey, err := base64.StdEncoding.DecodeString(encryptionKeyStr)
+	if err != nil || len(encryptionKey) != mfaEncryptionKeyLength {
+		return nil, errors.New("mfa encryption key must be 32 bytes encoded in base64")
+	}
+
+	challengeExpiration, err := getDuration(mfaChallengeExpirationEnvName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mfaConfig{
+		issuer:              issuer,
+		encryptionKey:       encryptionKey,
+		challengeExpiration: challengeExpiration,
+	}, nil
+}
+
+// Issuer название сервиса, которое показывает приложение-аутентификатор
+func (cfg *mfaConfig) Issuer() string {
+	return cfg.issuer
+}
+
+// EncryptionKey ключ AES-256, которым шифруются TOTP секреты в базе
+func (cfg *mfaConfig) EncryptionKey() []byte {
+	return cfg.encryptionKey
+}
+
+// ChallengeExpiration время, за которое нужно ввести код после ввода пароля
+func (cfg *mfaConfig) ChallengeExpiration() time.Duration {
+	return cfg.challengeExpiration
+}
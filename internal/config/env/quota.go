@@ -0,0 +1,90 @@
+package env
+
+import (
+	"di_container/internal/config"
+	"di_container/internal/model"
+)
+
+var _ config.QuotaConfig = (*quotaConfig)(nil)
+
+const (
+	quotaTenantNotesEnvName           = "QUOTA_TENANT_NOTES"
+	quotaTenantContentBytesEnvName    = "QUOTA_TENANT_CONTENT_BYTES"
+	quotaTenantAttachmentBytesEnvName = "QUOTA_TENANT_ATTACHMENT_BYTES"
+	quotaTenantRequestsPerDayEnvName  = "QUOTA_TENANT_REQUESTS_PER_DAY"
+	quotaUserNotesEnvName             = "QUOTA_USER_NOTES"
+	quotaUserContentBytesEnvName      = "QUOTA_USER_CONTENT_BYTES"
+	quotaUserAttachmentBytesEnvName   = "QUOTA_USER_ATTACHMENT_BYTES"
+	quotaUserRequestsPerDayEnvName    = "QUOTA_USER_REQUESTS_PER_DAY"
+)
+
+type quotaConfig struct {
+	tenantLimits model.QuotaLimits
+	userLimits   model.QuotaLimits
+}
+
+func NewQuotaConfig() (*quotaConfig, error) {
+	tenantLimits, err := getQuotaLimits(
+		quotaTenantNotesEnvName,
+		quotaTenantContentBytesEnvName,
+		quotaTenantAttachmentBytesEnvName,
+		quotaTenantRequestsPerDayEnvName,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	userLimits, err := getQuotaLimits(
+		quotaUserNotesEnvName,
+		quotaUserContentBytesEnvName,
+		quotaUserAttachmentBytesEnvName,
+		quotaUserRequestsPerDayEnvName,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &quotaConfig{
+		tenantLimits: tenantLimits,
+		userLimits:   userLimits,
+	}, nil
+}
+
+// TenantLimits лимиты арендатора целиком. Ноль - без ограничения
+func (cfg *quotaConfig) TenantLimits() model.QuotaLimits {
+	return cfg.tenantLimits
+}
+
+// UserLimits лимиты каждого пользователя. Ноль - без ограничения
+func (cfg *quotaConfig) UserLimits() model.QuotaLimits {
+	return cfg.userLimits
+}
+
+func getQuotaLimits(notesEnvName, contentBytesEnvName, attachmentBytesEnvName, requestsPerDayEnvName string) (model.QuotaLimits, error) {
+	var (
+		limits model.QuotaLimits
+		err    error
+	)
+
+	limits.Notes, err = getInt(notesEnvName)
+	if err != nil {
+		return model.QuotaLimits{}, err
+	}
+
+	limits.ContentBytes, err = getInt(contentBytesEnvName)
+	if err != nil {
+		return model.QuotaLimits{}, err
+	}
+
+	limits.AttachmentBytes, err = getInt(attachmentBytesEnvName)
+	if err != nil {
+		return model.QuotaLimits{}, err
+	}
+
+	limits.RequestsPerDay, err = getInt(requestsPerDayEnvName)
+	if err != nil {
+		return model.QuotaLimits{}, err
+	}
+
+	return limits, nil
+}
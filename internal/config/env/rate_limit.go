@@ -0,0 +1,168 @@
+package env
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"di_container/internal/config"
+	"di_container/internal/rate_limiter"
+)
+
+var _ config.RateLimitConfig = (*rateLimitConfig)(nil)
+
+const (
+	rateLimitBackendEnvName     = "RATE_LIMIT_BACKEND"
+	rateLimitLocalBatchEnvName  = "RATE_LIMIT_LOCAL_BATCH"
+	rateLimitRateEnvName        = "RATE_LIMIT_RATE"
+	rateLimitBurstEnvName       = "RATE_LIMIT_BURST"
+	rateLimitIdleTimeoutEnvName = "RATE_LIMIT_IDLE_TIMEOUT"
+	rateLimitMaxWaitEnvName     = "RATE_LIMIT_MAX_WAIT"
+	// rateLimitMethodsEnvName лимиты отдельных методов в виде "/pkg.Service/Method=rate:burst,..."
+	rateLimitMethodsEnvName = "RATE_LIMIT_METHODS"
+)
+
+type rateLimitConfig struct {
+	backend        string
+	localBatch     int
+	defaultPolicy  rate_limiter.Policy
+	methodPolicies map[string]rate_limiter.Policy
+	idleTimeout    time.Duration
+	maxWait        time.Duration
+}
+
+func NewRateLimitConfig() (*rateLimitConfig, error) {
+	backend := os.Getenv(rateLimitBackendEnvName)
+	if len(backend) == 0 {
+		backend = config.RateLimitBackendMemory
+	}
+	if backend != config.RateLimitBackendMemory && backend != config.RateLimitBackendPostgres {
+		return nil, errors.New("invalid " + rateLimitBackendEnvName + " value")
+	}
+
+	localBatch := int64(1)
+	if len(os.Getenv(rateLimitLocalBatchEnvName)) > 0 {
+		var err error
+		localBatch, err = getInt(rateLimitLocalBatchEnvName)
+		if err != nil {
+			return nil, err
+		}
+		if localBatch < 1 {
+			return nil, errors.New("invalid " + rateLimitLocalBatchEnvName + " value")
+		}
+	}
+
+	rateStr := os.Getenv(rateLimitRateEnvName)
+	if len(rateStr) == 0 {
+		return nil, errors.New(rateLimitRateEnvName + " not found")
+	}
+
+	rate, err := strconv.ParseFloat(rateStr, 64)
+	if err != nil || rate < 0 {
+		return nil, errors.New("invalid " + rateLimitRateEnvName + " value")
+	}
+
+	burst, err := getInt(rateLimitBurstEnvName)
+	if err != nil {
+		return nil, err
+	}
+
+	idleTimeout, err := getDuration(rateLimitIdleTimeoutEnvName)
+	if err != nil {
+		return nil, err
+	}
+	if idleTimeout <= 0 {
+		return nil, errors.New("invalid " + rateLimitIdleTimeoutEnvName + " value")
+	}
+
+	var maxWait time.Duration
+	if len(os.Getenv(rateLimitMaxWaitEnvName)) > 0 {
+		maxWait, err = getDuration(rateLimitMaxWaitEnvName)
+		if err != nil {
+			return nil, err
+		}
+		if maxWait < 0 {
+			return nil, errors.New("invalid " + rateLimitMaxWaitEnvName + " value")
+		}
+	}
+
+	methodPolicies, err := parseMethodPolicies(os.Getenv(rateLimitMethodsEnvName))
+	if err != nil {
+		return nil, err
+	}
+
+	return &rateLimitConfig{
+		backend:        backend,
+		localBatch:     int(localBatch),
+		defaultPolicy:  rate_limiter.Policy{Rate: rate, Burst: int(burst)},
+		methodPolicies: methodPolicies,
+		idleTimeout:    idleTimeout,
+		maxWait:        maxWait,
+	}, nil
+}
+
+// Backend где считаются лимиты: config.RateLimitBackendMemory или config.RateLimitBackendPostgres
+func (cfg *rateLimitConfig) Backend() string {
+	return cfg.backend
+}
+
+// LocalBatch сколько токенов реплика берет из общего ведра за одно обращение к базе
+func (cfg *rateLimitConfig) LocalBatch() int {
+	return cfg.localBatch
+}
+
+// DefaultPolicy лимит для методов, которых нет в MethodPolicies
+func (cfg *rateLimitConfig) DefaultPolicy() rate_limiter.Policy {
+	return cfg.defaultPolicy
+}
+
+func (cfg *rateLimitConfig) MethodPolicies() map[string]rate_limiter.Policy {
+	return cfg.methodPolicies
+}
+
+// IdleTimeout через сколько удаляется ведро клиента, который не присылал запросов
+func (cfg *rateLimitConfig) IdleTimeout() time.Duration {
+	return cfg.idleTimeout
+}
+
+// MaxWait сколько запрос может ждать токен, прежде чем получить отказ. Ноль - отказывать сразу
+func (cfg *rateLimitConfig) MaxWait() time.Duration {
+	return cfg.maxWait
+}
+
+func parseMethodPolicies(str string) (map[string]rate_limiter.Policy, error) {
+	policies := make(map[string]rate_limiter.Policy)
+	if len(strings.TrimSpace(str)) == 0 {
+		return policies, nil
+	}
+
+	invalid := errors.New("invalid " + rateLimitMethodsEnvName + " value")
+
+	for _, item := range strings.Split(str, ",") {
+		method, limits, ok := strings.Cut(strings.TrimSpace(item), "=")
+		if !ok || len(method) == 0 {
+			return nil, invalid
+		}
+
+		rateStr, burstStr, ok := strings.Cut(limits, ":")
+		if !ok {
+			return nil, invalid
+		}
+
+		rate, err := strconv.ParseFloat(rateStr, 64)
+		if err != nil || rate < 0 {
+			return nil, invalid
+		}
+
+		burst, err := strconv.Atoi(burstStr)
+		if err != nil || burst < 0 {
+			return nil, invalid
+		}
+
+		policies[method] = rate_limiter.Policy{Rate: rate, Burst: burst}
+	}
+
+	return policies, nil
+}
@@ -13,6 +13,8 @@ const (
 	refreshTokenExpirationEnvName = "REFRESH_TOKEN_EXPIRATION"
 	accessTokenExpirationEnvName  = "ACCESS_TOKEN_EXPIRATION"
 	authPrefixEnvName             = "AUTH_PREFIX"
+	accessTokenKeysDirEnvName     = "ACCESS_TOKEN_KEYS_DIR"
+	accessTokenSigningKeyEnvName  = "ACCESS_TOKEN_SIGNING_KEY_ID"
 )
 
 type TokenConfig interface {
@@ -24,6 +26,10 @@ type TokenConfigData struct {
 	RefreshTokenExpiration time.Duration
 	AccessTokenExpiration  time.Duration
 	AuthPrefix             string
+	// Если задана директория с ключами, access токены подписываются асимметрично (RS256/EdDSA)
+	// ключом AccessTokenSigningKeyID, иначе HS256 с AccessTokenSecretKey
+	AccessTokenKeysDir      string
+	AccessTokenSigningKeyID string
 }
 
 func NewTokenConfig() (*TokenConfigData, error) {
@@ -31,8 +37,13 @@ func NewTokenConfig() (*TokenConfigData, error) {
 	if len(refreshTokenSecretKey) == 0 {
 		return nil, errors.New("refresh token secret key not found")
 	}
+	accessTokenKeysDir := os.Getenv(accessTokenKeysDirEnvName)
+	accessTokenSigningKeyID := os.Getenv(accessTokenSigningKeyEnvName)
+	if len(accessTokenKeysDir) != 0 && len(accessTokenSigningKeyID) == 0 {
+		return nil, errors.New("access token signing key id not found")
+	}
 	accessTokenSecretKey := os.Getenv(accessTokenSecretKeyEnvName)
-	if len(accessTokenSecretKey) == 0 {
+	if len(accessTokenSecretKey) == 0 && len(accessTokenKeysDir) == 0 {
 		return nil, errors.New("access token secret key not found")
 	}
 	refreshTokenExpirationStr := os.Getenv(refreshTokenExpirationEnvName)
@@ -57,10 +68,12 @@ func NewTokenConfig() (*TokenConfigData, error) {
 	}
 
 	return &TokenConfigData{
-		RefreshTokenSecretKey:  refreshTokenSecretKey,
-		AccessTokenSecretKey:   accessTokenSecretKey,
-		RefreshTokenExpiration: time.Duration(refreshTokenExpiration) * time.Minute,
-		AccessTokenExpiration:  time.Duration(accessTokenExpiration) * time.Minute,
-		AuthPrefix:             authPrefix,
+		RefreshTokenSecretKey:   refreshTokenSecretKey,
+		AccessTokenSecretKey:    accessTokenSecretKey,
+		RefreshTokenExpiration:  time.Duration(refreshTokenExpiration) * time.Minute,
+		AccessTokenExpiration:   time.Duration(accessTokenExpiration) * time.Minute,
+		AuthPrefix:              authPrefix,
+		AccessTokenKeysDir:      accessTokenKeysDir,
+		AccessTokenSigningKeyID: accessTokenSigningKeyID,
 	}, nil
 }
@@ -0,0 +1,45 @@
+package env
+
+import (
+	"di_container/internal/config"
+	"time"
+)
+
+var _ config.UserTokenConfig = (*userTokenConfig)(nil)
+
+const (
+	passwordResetExpirationEnvName     = "PASSWORD_RESET_EXPIRATION"
+	emailVerificationExpirationEnvName = "EMAIL_VERIFICATION_EXPIRATION"
+)
+
+type userTokenConfig struct {
+	passwordResetExpiration     time.Duration
+	emailVerificationExpiration time.Duration
+}
+
+func NewUserTokenConfig() (*userTokenConfig, error) {
+	passwordResetExpiration, err := getDuration(passwordResetExpirationEnvName)
+	if err != nil {
+		return nil, err
+	}
+
+	emailVerificationExpiration, err := getDuration(emailVerificationExpirationEnvName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &userTokenConfig{
+		passwordResetExpiration:     passwordResetExpiration,
+		emailVerificationExpiration: emailVerificationExpiration,
+	}, nil
+}
+
+// PasswordResetExpiration время жизни токена сброса пароля
+func (cfg *userTokenConfig) PasswordResetExpiration() time.Duration {
+	return cfg.passwordResetExpiration
+}
+
+// EmailVerificationExpiration время жизни токена подтверждения почты
+func (cfg *userTokenConfig) EmailVerificationExpiration() time.Duration {
+	return cfg.emailVerificationExpiration
+}
@@ -0,0 +1,51 @@
+package converter
+
+import (
+	"di_container/internal/model"
+	desc "di_container/pkg/access_v1"
+)
+
+func ToAccessRequestFromDesc(req *desc.CheckRequest) *model.AccessRequest {
+	return &model.AccessRequest{
+		Method:   req.GetEndpointAddress(),
+		Resource: req.GetResource(),
+		Explicit: true,
+	}
+}
+
+func ToAccessRequestsFromDesc(reqs []*desc.CheckRequest) []*model.AccessRequest {
+	res := make([]*model.AccessRequest, 0, len(reqs))
+	for _, req := range reqs {
+		res = append(res, ToAccessRequestFromDesc(req))
+	}
+
+	return res
+}
+
+func ToDecisionFromService(endpointAddress string, decision *model.AccessDecision) *desc.Decision {
+	return &desc.Decision{
+		EndpointAddress: endpointAddress,
+		Allowed:         decision.Allowed,
+		Reason:          ToReasonFromService(decision.Reason),
+		Rule:            decision.Rule,
+	}
+}
+
+func ToReasonFromService(reason model.AccessReason) desc.Reason {
+	switch reason {
+	case model.AccessReasonAllowedByRule:
+		return desc.Reason_ALLOWED_BY_RULE
+	case model.AccessReasonAllowedByDefault:
+		return desc.Reason_ALLOWED_BY_DEFAULT
+	case model.AccessReasonDeniedByRule:
+		return desc.Reason_DENIED_BY_RULE
+	case model.AccessReasonDeniedByDefault:
+		return desc.Reason_DENIED_BY_DEFAULT
+	case model.AccessReasonDeniedByScope:
+		return desc.Reason_DENIED_BY_SCOPE
+	case model.AccessReasonDeniedByImpersonation:
+		return desc.Reason_DENIED_BY_IMPERSONATION
+	}
+
+	return desc.Reason_REASON_UNSPECIFIED
+}
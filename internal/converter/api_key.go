@@ -0,0 +1,52 @@
+package converter
+
+import (
+	"database/sql"
+
+	"di_container/internal/model"
+	desc "di_container/pkg/auth_v1"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ToAPIKeyFromService(key *model.APIKey) *desc.APIKey {
+	return &desc.APIKey{
+		Id:         key.ID,
+		Name:       key.Info.Name,
+		Prefix:     key.Prefix,
+		Scopes:     key.Info.Scopes,
+		ExpiresAt:  toTimestamp(key.Info.ExpiresAt),
+		LastUsedAt: toTimestamp(key.LastUsedAt),
+		CreatedAt:  timestamppb.New(key.CreatedAt),
+	}
+}
+
+func ToAPIKeysFromService(keys []*model.APIKey) []*desc.APIKey {
+	res := make([]*desc.APIKey, 0, len(keys))
+	for _, k := range keys {
+		res = append(res, ToAPIKeyFromService(k))
+	}
+
+	return res
+}
+
+func ToAPIKeyInfoFromDesc(req *desc.CreateAPIKeyRequest) *model.APIKeyInfo {
+	var expiresAt sql.NullTime
+	if req.GetExpiresAt() != nil {
+		expiresAt = sql.NullTime{Time: req.GetExpiresAt().AsTime(), Valid: true}
+	}
+
+	return &model.APIKeyInfo{
+		Name:      req.GetName(),
+		Scopes:    req.GetScopes(),
+		ExpiresAt: expiresAt,
+	}
+}
+
+func toTimestamp(t sql.NullTime) *timestamppb.Timestamp {
+	if !t.Valid {
+		return nil
+	}
+
+	return timestamppb.New(t.Time)
+}
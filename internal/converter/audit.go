@@ -0,0 +1,71 @@
+package converter
+
+import (
+	"time"
+
+	"di_container/internal/model"
+	desc "di_container/pkg/audit_v1"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ToAuditEventFromService(event *model.AuditEvent) *desc.AuditEvent {
+	return &desc.AuditEvent{
+		Id:           event.ID,
+		Type:         event.Type,
+		Actor:        event.Actor,
+		Impersonator: event.Impersonator,
+		Subject:      event.Subject,
+		ClientIp:     event.IP,
+		Method:       event.Method,
+		Outcome:      event.Outcome,
+		Reason:       event.Reason,
+		PrevHash:     event.PrevHash,
+		Hash:         event.Hash,
+		CreatedAt:    timestamppb.New(event.CreatedAt),
+	}
+}
+
+func ToAuditEventsFromService(events []*model.AuditEvent) []*desc.AuditEvent {
+	res := make([]*desc.AuditEvent, 0, len(events))
+	for _, e := range events {
+		res = append(res, ToAuditEventFromService(e))
+	}
+
+	return res
+}
+
+func ToAuditFilterFromQuery(req *desc.QueryAuditLogRequest) *model.AuditFilter {
+	return &model.AuditFilter{
+		From:    fromTimestamp(req.GetFrom()),
+		To:      fromTimestamp(req.GetTo()),
+		Actor:   req.GetActor(),
+		AfterID: req.GetPageAfterId(),
+		Limit:   req.GetPageSize(),
+	}
+}
+
+func ToAuditFilterFromExport(req *desc.ExportAuditLogRequest) *model.AuditFilter {
+	return &model.AuditFilter{
+		From:  fromTimestamp(req.GetFrom()),
+		To:    fromTimestamp(req.GetTo()),
+		Actor: req.GetActor(),
+	}
+}
+
+func ToVerifyAuditLogResponseFromService(res *model.AuditVerification) *desc.VerifyAuditLogResponse {
+	return &desc.VerifyAuditLogResponse{
+		Valid:          res.Valid,
+		Checked:        res.Checked,
+		FirstInvalidId: res.FirstInvalidID,
+	}
+}
+
+// fromTimestamp возвращает нулевое время для незаданного поля, чтобы оно не ограничивало выборку
+func fromTimestamp(t *timestamppb.Timestamp) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+
+	return t.AsTime()
+}
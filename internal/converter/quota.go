@@ -0,0 +1,33 @@
+package converter
+
+import (
+	"di_container/internal/model"
+	desc "di_container/pkg/note_v1"
+)
+
+func ToUsageReportFromService(report *model.UsageReport) *desc.GetUsageResponse {
+	return &desc.GetUsageResponse{
+		Tenant:       toUsageFromService(report.Tenant),
+		TenantLimits: toQuotaLimitsFromService(report.TenantLimits),
+		User:         toUsageFromService(report.User),
+		UserLimits:   toQuotaLimitsFromService(report.UserLimits),
+	}
+}
+
+func toUsageFromService(usage model.Usage) *desc.Usage {
+	return &desc.Usage{
+		Notes:           usage.Notes,
+		ContentBytes:    usage.ContentBytes,
+		AttachmentBytes: usage.AttachmentBytes,
+		RequestsToday:   usage.RequestsToday,
+	}
+}
+
+func toQuotaLimitsFromService(limits model.QuotaLimits) *desc.QuotaLimits {
+	return &desc.QuotaLimits{
+		Notes:           limits.Notes,
+		ContentBytes:    limits.ContentBytes,
+		AttachmentBytes: limits.AttachmentBytes,
+		RequestsPerDay:  limits.RequestsPerDay,
+	}
+}
@@ -0,0 +1,34 @@
+package converter
+
+import (
+	"di_container/internal/model"
+	desc "di_container/pkg/auth_v1"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ToSessionFromService(session *model.Session) *desc.Session {
+	var lastRefreshAt *timestamppb.Timestamp
+	if session.LastRefreshAt.Valid {
+		lastRefreshAt = timestamppb.New(session.LastRefreshAt.Time)
+	}
+
+	return &desc.Session{
+		Id:            session.ID,
+		Username:      session.Username,
+		ClientIp:      session.Client.IP,
+		UserAgent:     session.Client.UserAgent,
+		CreatedAt:     timestamppb.New(session.CreatedAt),
+		LastRefreshAt: lastRefreshAt,
+		ExpiresAt:     timestamppb.New(session.ExpiresAt),
+	}
+}
+
+func ToSessionsFromService(sessions []*model.Session) []*desc.Session {
+	res := make([]*desc.Session, 0, len(sessions))
+	for _, s := range sessions {
+		res = append(res, ToSessionFromService(s))
+	}
+
+	return res
+}
@@ -0,0 +1,116 @@
+package interceptor
+
+import (
+	"context"
+	"di_container/internal/logger"
+	"di_container/internal/model"
+	"di_container/internal/service"
+	"di_container/internal/tenant"
+	"di_container/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type AuthInterceptor struct {
+	authPrefix    string
+	accessService service.AccessService
+	publicMethods map[string]struct{}
+}
+
+// NewAuthInterceptor создает интерцептор, который проверяет access токен и права доступа
+// для каждого метода, кроме перечисленных в publicMethods
+func NewAuthInterceptor(authPrefix string, accessService service.AccessService, publicMethods ...string) *AuthInterceptor {
+	methods := make(map[string]struct{}, len(publicMethods))
+	for _, m := range publicMethods {
+		methods[m] = struct{}{}
+	}
+
+	return &AuthInterceptor{
+		authPrefix:    authPrefix,
+		accessService: accessService,
+		publicMethods: methods,
+	}
+}
+
+func (a *AuthInterceptor) Unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if _, ok := a.publicMethods[info.FullMethod]; ok {
+		return handler(ctx, req)
+	}
+
+	claims, err := a.authorize(ctx, &model.AccessRequest{
+		Method:  info.FullMethod,
+		Request: utils.RequestAttributes(req),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(withClaims(ctx, claims), req)
+}
+
+// Stream проверяет доступ при открытии потока. Сообщения клиента к этому моменту не прочитаны,
+// поэтому атрибуты request.* в правилах политики для потоковых методов пустые
+func (a *AuthInterceptor) Stream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if _, ok := a.publicMethods[info.FullMethod]; ok {
+		return handler(srv, ss)
+	}
+
+	claims, err := a.authorize(ss.Context(), &model.AccessRequest{
+		Method: info.FullMethod,
+	})
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, &serverStream{
+		ServerStream: ss,
+		ctx:          withClaims(ss.Context(), claims),
+	})
+}
+
+func (a *AuthInterceptor) authorize(ctx context.Context, accessReq *model.AccessRequest) (*model.UserClaims, error) {
+	var (
+		claims *model.UserClaims
+		err    error
+	)
+
+	// Машинные клиенты вместо токена передают ключ в x-api-key
+	if apiKey, ok := utils.ExtractAPIKey(ctx); ok {
+		claims, err = a.accessService.CheckAPIKey(ctx, apiKey, accessReq)
+	} else {
+		accessToken, errToken := utils.ExtractToken(ctx, a.authPrefix)
+		if errToken != nil {
+			return nil, status.Error(codes.Unauthenticated, errToken.Error())
+		}
+
+		claims, err = a.accessService.Check(ctx, accessToken, accessReq)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Токен одного арендатора не дает доступа к данным другого, даже если тот указан явно
+	if id, ok := utils.ExtractTenant(ctx); ok && id != claims.TenantID() {
+		return nil, status.Error(codes.PermissionDenied, "token belongs to another tenant")
+	}
+
+	return claims, nil
+}
+
+// withClaims кладет claims в контекст, закрепляет арендатора токена и помечает ими все логи запроса.
+// При входе под другим пользователем в логах видны обе учетные записи
+func withClaims(ctx context.Context, claims *model.UserClaims) context.Context {
+	fields := []zap.Field{
+		zap.String("username", claims.Username),
+		zap.String("tenant", claims.TenantID()),
+	}
+	if claims.Impersonator != "" {
+		fields = append(fields, zap.String("impersonator", claims.Impersonator))
+	}
+
+	ctx = tenant.WithTenant(utils.MakeContextClaims(ctx, claims), claims.TenantID())
+
+	return logger.ContextWithFields(ctx, fields...)
+}
@@ -2,34 +2,63 @@ package interceptor
 
 import (
 	"context"
-	"github.com/sony/gobreaker"
+
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+
+	"di_container/internal/circuit_breaker"
+	"di_container/internal/sys"
 )
 
+// clientBreakerPrefix отличает выключатели внешних сервисов от выключателей собственных методов
+const clientBreakerPrefix = "client:"
+
 type CircuitBreakerInterceptor struct {
-	cb *gobreaker.CircuitBreaker
+	breakers *circuit_breaker.Breakers
 }
 
-func NewCircuitBreakerInterceptor(cb *gobreaker.CircuitBreaker) *CircuitBreakerInterceptor {
+func NewCircuitBreakerInterceptor(breakers *circuit_breaker.Breakers) *CircuitBreakerInterceptor {
 	return &CircuitBreakerInterceptor{
-		cb: cb,
+		breakers: breakers,
 	}
 }
 
+// Unary ведет отдельный выключатель для каждого метода
 func (c *CircuitBreakerInterceptor) Unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-	res, err := c.cb.Execute(func() (interface{}, error) {
+	res, err := c.breakers.Execute(info.FullMethod, func() (interface{}, error) {
 		return handler(ctx, req)
 	})
-
 	if err != nil {
-		if err == gobreaker.ErrOpenState {
-			return nil, status.Error(codes.Unavailable, "service")
-		}
-
-		return nil, err
+		return nil, c.openError(err)
 	}
 
 	return res, nil
 }
+
+// Stream считает поток одним вызовом: его ошибка учитывается при закрытии потока
+func (c *CircuitBreakerInterceptor) Stream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	_, err := c.breakers.Execute(info.FullMethod, func() (interface{}, error) {
+		return nil, handler(srv, ss)
+	})
+
+	return c.openError(err)
+}
+
+// UnaryClient ведет отдельный выключатель для каждого внешнего сервиса, чтобы его недоступность
+// не тратила время запросов, которые все равно завершатся ошибкой
+func (c *CircuitBreakerInterceptor) UnaryClient(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	_, err := c.breakers.Execute(clientBreakerPrefix+cc.Target(), func() (interface{}, error) {
+		return nil, invoker(ctx, method, req, reply, cc, opts...)
+	})
+
+	return c.openError(err)
+}
+
+func (c *CircuitBreakerInterceptor) openError(err error) error {
+	if circuit_breaker.IsOpen(err) {
+		return sys.NewRetryableError("service unavailable", codes.Unavailable, c.breakers.OpenTimeout()).
+			WithReason("CIRCUIT_OPEN")
+	}
+
+	return err
+}
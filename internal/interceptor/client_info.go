@@ -0,0 +1,122 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/subtle"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"di_container/internal/utils"
+)
+
+// gatewaySecretHeader секрет процесса, которым гейтвей помечает свои вызовы
+const gatewaySecretHeader = "x-gateway-secret"
+
+// ClientInfoInterceptor определяет адрес клиента. x-forwarded-for может прислать кто угодно, поэтому он
+// учитывается только для вызовов гейтвея этого процесса и доверенных прокси. Остальные клиенты получают
+// адрес своего соединения, а заголовок удаляется у всех, чтобы дальше по цепочке его никто не прочитал
+type ClientInfoInterceptor struct {
+	gatewaySecret  string
+	trustedProxies []*net.IPNet
+}
+
+// NewClientInfoInterceptor создает интерсептор. gatewaySecret должен знать только гейтвей этого процесса
+func NewClientInfoInterceptor(gatewaySecret string, trustedProxies []*net.IPNet) *ClientInfoInterceptor {
+	return &ClientInfoInterceptor{gatewaySecret: gatewaySecret, trustedProxies: trustedProxies}
+}
+
+func (c *ClientInfoInterceptor) Unary(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	return handler(c.withClientIP(ctx), req)
+}
+
+func (c *ClientInfoInterceptor) Stream(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &serverStream{
+		ServerStream: ss,
+		ctx:          c.withClientIP(ss.Context()),
+	})
+}
+
+// UnaryClient помечает вызовы гейтвея секретом процесса
+func (c *ClientInfoInterceptor) UnaryClient(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(metadata.AppendToOutgoingContext(ctx, gatewaySecretHeader, c.gatewaySecret), method, req, reply, cc, opts...)
+}
+
+func (c *ClientInfoInterceptor) StreamClient(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return streamer(metadata.AppendToOutgoingContext(ctx, gatewaySecretHeader, c.gatewaySecret), desc, cc, method, opts...)
+}
+
+func (c *ClientInfoInterceptor) withClientIP(ctx context.Context) context.Context {
+	ip := utils.PeerIP(ctx)
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return utils.WithClientIP(ctx, ip)
+	}
+
+	if c.fromGateway(md) || c.trusted(net.ParseIP(ip)) {
+		ip = c.forwardedClientIP(md.Get(utils.ForwardedForHeader), ip)
+	}
+
+	md = md.Copy()
+	md.Delete(utils.ForwardedForHeader)
+	md.Delete(gatewaySecretHeader)
+
+	return utils.WithClientIP(metadata.NewIncomingContext(ctx, md), ip)
+}
+
+func (c *ClientInfoInterceptor) fromGateway(md metadata.MD) bool {
+	if c.gatewaySecret == "" {
+		return false
+	}
+
+	for _, value := range md.Get(gatewaySecretHeader) {
+		if subtle.ConstantTimeCompare([]byte(value), []byte(c.gatewaySecret)) == 1 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// forwardedClientIP идет по x-forwarded-for справа налево: правый адрес записал доверенный источник,
+// каждый следующий - прокси перед ним. Первый адрес не из доверенных прокси и есть клиент
+func (c *ClientInfoInterceptor) forwardedClientIP(values []string, peerIP string) string {
+	var hops []string
+	for _, value := range values {
+		hops = append(hops, strings.Split(value, ",")...)
+	}
+
+	res := peerIP
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+
+		ip := net.ParseIP(hop)
+		if ip == nil {
+			break
+		}
+
+		res = hop
+		if !c.trusted(ip) {
+			break
+		}
+	}
+
+	return res
+}
+
+func (c *ClientInfoInterceptor) trusted(ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+
+	for _, network := range c.trustedProxies {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,65 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"di_container/internal/concurrency_limiter"
+	"di_container/internal/sys"
+	"di_container/internal/utils"
+)
+
+// shedRetryAfter через сколько клиенту предлагается повторить отклоненный из-за перегрузки запрос
+const shedRetryAfter = time.Second
+
+type ConcurrencyLimiterInterceptor struct {
+	limiter *concurrency_limiter.AIMDLimiter
+}
+
+func NewConcurrencyLimiterInterceptor(limiter *concurrency_limiter.AIMDLimiter) *ConcurrencyLimiterInterceptor {
+	return &ConcurrencyLimiterInterceptor{limiter: limiter}
+}
+
+// Unary занимает место на время запроса и сообщает лимитеру, сколько он выполнялся
+// Место освобождается и при панике в обработчике, иначе каждая паника навсегда уменьшала бы лимит
+func (c *ConcurrencyLimiterInterceptor) Unary(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (res interface{}, err error) {
+	token, err := c.limiter.Acquire(ctx, utils.ExtractPriority(ctx))
+	if err != nil {
+		return nil, shedError(err)
+	}
+
+	start := time.Now()
+	defer func() {
+		token.Release(time.Since(start), isDeadlineExceeded(err))
+	}()
+
+	return handler(ctx, req)
+}
+
+// Stream не занимает место: потоки живут долго и заняли бы весь лимит. При перегрузке новые потоки
+// отклоняются так же, как запросы той же важности
+func (c *ConcurrencyLimiterInterceptor) Stream(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	priority := utils.ExtractPriority(ss.Context())
+	if !c.limiter.Admits(priority) && priority != concurrency_limiter.PriorityCritical {
+		return shedError(concurrency_limiter.ErrLimitExceeded)
+	}
+
+	return handler(srv, ss)
+}
+
+func shedError(err error) error {
+	if errors.Is(err, concurrency_limiter.ErrLimitExceeded) {
+		return sys.NewRetryableError("server is overloaded", codes.Unavailable, shedRetryAfter).WithReason("OVERLOADED")
+	}
+
+	return status.FromContextError(err).Err()
+}
+
+func isDeadlineExceeded(err error) bool {
+	return errors.Is(err, context.DeadlineExceeded) || status.Code(err) == codes.DeadlineExceeded
+}
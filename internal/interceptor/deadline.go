@@ -0,0 +1,72 @@
+package interceptor
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DeadlineInterceptor задает каждому запросу срок. Контекст с дедлайном доходит до запросов в базу
+// и до вызовов других сервисов, поэтому работа прекращается, как только клиент перестал ждать ответа
+type DeadlineInterceptor struct {
+	defaultTimeout time.Duration
+	maxTimeout     time.Duration
+	minBudget      time.Duration
+	methodTimeouts map[string]time.Duration
+}
+
+// NewDeadlineInterceptor создает интерцептор. Срок из methodTimeouts для своего метода заменяет
+// и defaultTimeout, и maxTimeout
+func NewDeadlineInterceptor(defaultTimeout, maxTimeout, minBudget time.Duration, methodTimeouts map[string]time.Duration) *DeadlineInterceptor {
+	return &DeadlineInterceptor{
+		defaultTimeout: defaultTimeout,
+		maxTimeout:     maxTimeout,
+		minBudget:      minBudget,
+		methodTimeouts: methodTimeouts,
+	}
+}
+
+// Unary задает срок, если клиент его не указал, и сокращает слишком долгий
+func (d *DeadlineInterceptor) Unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	defaultTimeout, maxTimeout := d.defaultTimeout, d.maxTimeout
+	if timeout, ok := d.methodTimeouts[info.FullMethod]; ok {
+		defaultTimeout, maxTimeout = timeout, timeout
+	}
+
+	timeout := defaultTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = min(time.Until(deadline), maxTimeout)
+	}
+
+	if timeout < d.minBudget {
+		return nil, status.Error(codes.DeadlineExceeded, "deadline is too short to process the request")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	return handler(ctx, req)
+}
+
+// Stream только отклоняет потоки с почти истекшим сроком. Потоки вроде Health/Watch живут долго,
+// поэтому срок по умолчанию им не задается
+func (d *DeadlineInterceptor) Stream(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if deadline, ok := ss.Context().Deadline(); ok && time.Until(deadline) < d.minBudget {
+		return status.Error(codes.DeadlineExceeded, "deadline is too short to process the request")
+	}
+
+	return handler(srv, ss)
+}
+
+// UnaryClient не отправляет вызов другому сервису, если до дедлайна осталось меньше minBudget.
+// Оставшийся срок gRPC передает вызываемому сервису сам
+func (d *DeadlineInterceptor) UnaryClient(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < d.minBudget {
+		return status.Error(codes.DeadlineExceeded, "deadline is too short to call "+cc.Target())
+	}
+
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
@@ -2,17 +2,29 @@ package interceptor
 
 import (
 	"context"
+	"di_container/internal/logger"
+	"di_container/internal/model"
+	"di_container/internal/request_id"
 	"di_container/internal/sys"
 	"di_container/internal/sys/codes"
 	"di_container/internal/sys/validate"
 	"errors"
 	"fmt"
-	"github.com/fatih/color"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	grpcCodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"math"
+	"strconv"
 )
 
+const retryAfterKey = "retry-after"
+
 type GRPCStatusInterface interface {
 	GRPCStatus() *status.Status
 }
@@ -23,32 +35,141 @@ func ErrorCodesInterceptor(ctx context.Context, req interface{}, info *grpc.Unar
 		return res, nil
 	}
 
-	fmt.Printf(color.RedString("error: %s\n", err.Error()))
+	return res, toStatusError(ctx, err)
+}
+
+func ErrorCodesStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	err := handler(srv, ss)
+	if nil == err {
+		return nil
+	}
+
+	return toStatusError(ss.Context(), err)
+}
+
+// errorDomain домен причин в google.rpc.ErrorInfo
+const errorDomain = "di_container"
+
+// localizedMessageLocale язык сообщений, которые сервис возвращает клиенту
+const localizedMessageLocale = "en-US"
+
+// toStatusError переводит ошибку сервиса в gRPC статус с google.rpc деталями: ErrorInfo со стабильной причиной,
+// BadRequest для ошибок валидации, RetryInfo и QuotaFailure для лимитов. Внутренние ошибки клиенту не раскрываются
+func toStatusError(ctx context.Context, err error) error {
+	method, _ := grpc.Method(ctx)
+	logger.FromContext(ctx).Error("request failed", zap.String("method", method), zap.Error(err))
+
+	return statusError(ctx, err)
+}
+
+// statusError то же, что toStatusError, но без записи в лог, для ошибок, которые уже залогированы
+func statusError(ctx context.Context, err error) error {
+	var (
+		st      *status.Status
+		reason  string
+		errMeta map[string]string
+		details []protoadapt.MessageV1
+	)
 
 	switch {
 	case sys.IsCommonError(err):
 		commEr := sys.GetCommonError(err)
-		code := toGRPCCode(codes.Code(commEr.Code()))
+		st = status.New(toGRPCCode(codes.Code(commEr.Code())), commEr.Error())
+		reason = commEr.Reason()
+		errMeta = commEr.Metadata()
+
+		if retryAfter := commEr.RetryAfter(); retryAfter > 0 {
+			seconds := strconv.Itoa(int(math.Ceil(retryAfter.Seconds())))
+			_ = grpc.SetTrailer(ctx, metadata.Pairs(retryAfterKey, seconds))
+
+			details = append(details, &errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)})
+		}
+
+		if violations := commEr.QuotaViolations(); len(violations) > 0 {
+			details = append(details, quotaFailure(violations))
+		}
 
-		err = status.Error(code, commEr.Error())
+		details = append(details, &errdetails.LocalizedMessage{Locale: localizedMessageLocale, Message: commEr.Error()})
 	case validate.IsValidationError(err):
-		err = status.Error(grpcCodes.InvalidArgument, err.Error())
+		ve := validate.GetValidationErrors(err)
+		st = status.New(grpcCodes.InvalidArgument, ve.Error())
+		reason = "VALIDATION_FAILED"
+
+		details = append(details,
+			badRequest(ve.Violations),
+			&errdetails.LocalizedMessage{Locale: localizedMessageLocale, Message: ve.Error()},
+		)
 	default:
+		// Готовый статус с деталями возвращается как есть, без деталей - дополняется ErrorInfo
 		var se GRPCStatusInterface
 		if errors.As(err, &se) {
-			return nil, se.GRPCStatus().Err()
-		} else {
-			if errors.Is(err, context.DeadlineExceeded) {
-				err = status.Error(grpcCodes.DeadlineExceeded, err.Error())
-			} else if errors.Is(err, context.Canceled) {
-				err = status.Error(grpcCodes.Canceled, err.Error())
-			} else {
-				err = status.Error(grpcCodes.Internal, "internal error")
+			st = se.GRPCStatus()
+			if len(st.Proto().GetDetails()) > 0 {
+				return st.Err()
 			}
+		} else if errors.Is(err, context.DeadlineExceeded) {
+			st = status.New(grpcCodes.DeadlineExceeded, err.Error())
+		} else if errors.Is(err, context.Canceled) {
+			st = status.New(grpcCodes.Canceled, err.Error())
+		} else {
+			st = status.New(grpcCodes.Internal, "internal error")
 		}
 	}
 
-	return res, err
+	return withDetails(ctx, st, reason, errMeta, details...)
+}
+
+// withDetails прикладывает к статусу детали. ErrorInfo есть всегда: без явной причины ей служит код статуса,
+// в метаданных идентификатор запроса, по которому ошибку можно найти в логах, и метаданные самой ошибки
+func withDetails(ctx context.Context, st *status.Status, reason string, errMeta map[string]string, details ...protoadapt.MessageV1) error {
+	if reason == "" {
+		reason = code.Code_name[int32(st.Code())]
+	}
+
+	info := &errdetails.ErrorInfo{
+		Reason:   reason,
+		Domain:   errorDomain,
+		Metadata: make(map[string]string, len(errMeta)+1),
+	}
+	for k, v := range errMeta {
+		info.Metadata[k] = v
+	}
+	if id := request_id.FromContext(ctx); id != "" {
+		info.Metadata["request_id"] = id
+	}
+
+	withDetails, err := st.WithDetails(append([]protoadapt.MessageV1{info}, details...)...)
+	if err != nil {
+		return st.Err()
+	}
+
+	return withDetails.Err()
+}
+
+// badRequest google.rpc.BadRequest с нарушением для каждого поля
+func badRequest(violations []validate.FieldViolation) *errdetails.BadRequest {
+	res := &errdetails.BadRequest{}
+	for _, v := range violations {
+		res.FieldViolations = append(res.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		})
+	}
+
+	return res
+}
+
+// quotaFailure google.rpc.QuotaFailure, чтобы клиент видел, какая квота превышена
+func quotaFailure(violations []model.QuotaViolation) *errdetails.QuotaFailure {
+	failure := &errdetails.QuotaFailure{}
+	for _, v := range violations {
+		failure.Violations = append(failure.Violations, &errdetails.QuotaFailure_Violation{
+			Subject:     v.Subject,
+			Description: fmt.Sprintf("%s: used %d of %d", v.Quota, v.Used, v.Limit),
+		})
+	}
+
+	return failure
 }
 
 func toGRPCCode(code codes.Code) grpcCodes.Code {
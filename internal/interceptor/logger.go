@@ -5,18 +5,97 @@ import (
 	"di_container/internal/logger"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"sync/atomic"
 	"time"
 )
 
-func LogInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+// LogInterceptor логирует запросы вместе с телами запроса и ответа. У методов, которые передают пароли,
+// токены, коды и ключи, тела не логируются: лог читает больше людей, чем должно видеть секреты
+type LogInterceptor struct {
+	sensitiveMethods map[string]struct{}
+}
+
+func NewLogInterceptor(sensitiveMethods ...string) *LogInterceptor {
+	methods := make(map[string]struct{}, len(sensitiveMethods))
+	for _, m := range sensitiveMethods {
+		methods[m] = struct{}{}
+	}
+
+	return &LogInterceptor{sensitiveMethods: methods}
+}
+
+func (l *LogInterceptor) Unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 	now := time.Now()
 
 	res, err := handler(ctx, req)
+
+	_, sensitive := l.sensitiveMethods[info.FullMethod]
+	payload := func(key string, v interface{}) zap.Field {
+		if sensitive {
+			return zap.Skip()
+		}
+		return zap.Any(key, v)
+	}
+
 	if err != nil {
-		logger.Error(err.Error(), zap.String("method", info.FullMethod), zap.Any("req", req))
+		logger.FromContext(ctx).Error(err.Error(), zap.String("method", info.FullMethod), payload("req", req))
 	}
 
-	logger.Info("request", zap.String("method", info.FullMethod), zap.Any("req", req), zap.Any("res", res), zap.Duration("duration", time.Since(now)))
+	logger.FromContext(ctx).Info("request", zap.String("method", info.FullMethod), payload("req", req), payload("res", res), zap.Duration("duration", time.Since(now)))
 
 	return res, err
 }
+
+// LogStreamInterceptor логирует открытие и закрытие потока. Сами сообщения не логируются,
+// их может быть много, в итоговой записи только их количество
+func LogStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	now := time.Now()
+	log := logger.FromContext(ss.Context()).With(zap.String("method", info.FullMethod))
+
+	log.Info("stream opened",
+		zap.Bool("client_stream", info.IsClientStream),
+		zap.Bool("server_stream", info.IsServerStream),
+	)
+
+	stream := &loggingStream{ServerStream: ss}
+	err := handler(srv, stream)
+
+	fields := []zap.Field{
+		zap.Int64("received", stream.received.Load()),
+		zap.Int64("sent", stream.sent.Load()),
+		zap.Duration("duration", time.Since(now)),
+	}
+	if err != nil {
+		log.Error("stream failed", append(fields, zap.Error(err))...)
+		return err
+	}
+
+	log.Info("stream closed", fields...)
+
+	return nil
+}
+
+// loggingStream считает сообщения. Чтение и отправка могут идти из разных горутин
+type loggingStream struct {
+	grpc.ServerStream
+	received atomic.Int64
+	sent     atomic.Int64
+}
+
+func (s *loggingStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		s.received.Add(1)
+	}
+
+	return err
+}
+
+func (s *loggingStream) SendMsg(m interface{}) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		s.sent.Add(1)
+	}
+
+	return err
+}
@@ -7,6 +7,11 @@ import (
 	"time"
 )
 
+const (
+	messageReceived = "received"
+	messageSent     = "sent"
+)
+
 func MetricsInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 	metric.IncRequestCounter()
 
@@ -15,13 +20,55 @@ func MetricsInterceptor(ctx context.Context, req interface{}, info *grpc.UnarySe
 	res, err := handler(ctx, req)
 	diffTime := time.Since(timeStart)
 
+	observeResponse(info.FullMethod, diffTime, err)
+
+	return res, err
+}
+
+// MetricsStreamInterceptor считает поток одним запросом, время ответа - временем жизни потока,
+// а сообщения в обе стороны - отдельным счетчиком
+func MetricsStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	metric.IncRequestCounter()
+
+	timeStart := time.Now()
+
+	err := handler(srv, &metricsStream{ServerStream: ss, method: info.FullMethod})
+	diffTime := time.Since(timeStart)
+
+	observeResponse(info.FullMethod, diffTime, err)
+
+	return err
+}
+
+func observeResponse(method string, duration time.Duration, err error) {
 	if err != nil {
-		metric.IncResponseCounter("error", info.FullMethod)
-		metric.HistogramResponseTimeObserve("error", diffTime.Seconds())
+		metric.IncResponseCounter("error", method)
+		metric.HistogramResponseTimeObserve("error", duration.Seconds())
 	} else {
-		metric.IncResponseCounter("success", info.FullMethod)
-		metric.HistogramResponseTimeObserve("success", diffTime.Seconds())
+		metric.IncResponseCounter("success", method)
+		metric.HistogramResponseTimeObserve("success", duration.Seconds())
 	}
+}
 
-	return res, err
+type metricsStream struct {
+	grpc.ServerStream
+	method string
+}
+
+func (s *metricsStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		metric.IncStreamMessageCounter(s.method, messageReceived)
+	}
+
+	return err
+}
+
+func (s *metricsStream) SendMsg(m interface{}) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		metric.IncStreamMessageCounter(s.method, messageSent)
+	}
+
+	return err
 }
@@ -0,0 +1,46 @@
+package interceptor
+
+import (
+	"context"
+	"di_container/internal/service"
+	"google.golang.org/grpc"
+)
+
+type QuotaInterceptor struct {
+	quotaService  service.QuotaService
+	exemptMethods map[string]struct{}
+}
+
+// NewQuotaInterceptor создает интерцептор, который учитывает запросы в дневной квоте арендатора и пользователя.
+// Методы из exemptMethods, например проверки здоровья, не учитываются
+func NewQuotaInterceptor(quotaService service.QuotaService, exemptMethods ...string) *QuotaInterceptor {
+	methods := make(map[string]struct{}, len(exemptMethods))
+	for _, m := range exemptMethods {
+		methods[m] = struct{}{}
+	}
+
+	return &QuotaInterceptor{
+		quotaService:  quotaService,
+		exemptMethods: methods,
+	}
+}
+
+func (q *QuotaInterceptor) Unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if _, ok := q.exemptMethods[info.FullMethod]; !ok {
+		if err := q.quotaService.CountRequest(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return handler(ctx, req)
+}
+
+func (q *QuotaInterceptor) Stream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if _, ok := q.exemptMethods[info.FullMethod]; !ok {
+		if err := q.quotaService.CountRequest(ss.Context()); err != nil {
+			return err
+		}
+	}
+
+	return handler(srv, ss)
+}
@@ -2,26 +2,102 @@ package interceptor
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"di_container/internal/logger"
 	rateLimiter "di_container/internal/rate_limiter"
+	"di_container/internal/sys"
+	"di_container/internal/utils"
 )
 
 type RateLimiterInterceptor struct {
-	rateLimiter *rateLimiter.TokenBucketLimiter
+	rateLimiter rateLimiter.Limiter
+	maxWait     time.Duration
 }
 
-func NewRateLimiterInterceptor(rateLimiter *rateLimiter.TokenBucketLimiter) *RateLimiterInterceptor {
-	return &RateLimiterInterceptor{rateLimiter: rateLimiter}
+// NewRateLimiterInterceptor создает интерсептор. Если токен появится не позже maxWait, запрос резервирует его
+// и ждет вместо отказа. Ноль - отказывать сразу
+func NewRateLimiterInterceptor(rateLimiter rateLimiter.Limiter, maxWait time.Duration) *RateLimiterInterceptor {
+	return &RateLimiterInterceptor{rateLimiter: rateLimiter, maxWait: maxWait}
 }
 
 func (r *RateLimiterInterceptor) Unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-	if !r.rateLimiter.Allow() {
-		return nil, status.Error(codes.ResourceExhausted, "too many requests")
+	if err := r.allow(ctx, info.FullMethod); err != nil {
+		return nil, err
 	}
 
 	return handler(ctx, req)
 }
+
+// Stream учитывает в лимите открытие потока. Сообщения внутри потока не ограничиваются
+func (r *RateLimiterInterceptor) Stream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := r.allow(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+
+	return handler(srv, ss)
+}
+
+func (r *RateLimiterInterceptor) allow(ctx context.Context, method string) error {
+	key := rateLimitKey(ctx)
+
+	allowed, retryAfter, err := r.check(ctx, key, method)
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return status.FromContextError(ctxErr).Err()
+	}
+	if err != nil {
+		// Недоступное хранилище лимитов не должно останавливать весь сервис
+		logger.FromContext(ctx).Warn("rate limiter failed, request allowed", zap.String("method", method), zap.Error(err))
+		return nil
+	}
+	if allowed {
+		return nil
+	}
+
+	// При нулевой скорости срок неизвестен, клиенту предлагается повторить попытку через секунду
+	if retryAfter <= 0 {
+		retryAfter = time.Second
+	}
+
+	return sys.NewRetryableError("too many requests", codes.ResourceExhausted, retryAfter).WithReason("RATE_LIMITED")
+}
+
+// check разрешает запрос сразу или, если задан maxWait, ждет зарезервированный токен.
+// Короткую паузу выгоднее переждать на сервере, чем заставлять клиента повторять запрос.
+// Ждать дольше maxWait и дедлайна запроса бессмысленно
+func (r *RateLimiterInterceptor) check(ctx context.Context, key string, method string) (bool, time.Duration, error) {
+	if r.maxWait <= 0 {
+		return r.rateLimiter.Allow(ctx, key, method)
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, r.maxWait)
+	defer cancel()
+
+	allowed, retryAfter, err := r.rateLimiter.Wait(waitCtx, key, method)
+	// Ожидание прервал maxWait, а не клиент: это обычный отказ по лимиту
+	if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+		return false, 0, nil
+	}
+
+	return allowed, retryAfter, err
+}
+
+// rateLimitKey определяет, чей лимит расходует запрос: ключа API, пользователя из токена или адреса клиента.
+// Ключ API хранится в виде хэша, чтобы не держать секрет в памяти дольше запроса
+func rateLimitKey(ctx context.Context) string {
+	if apiKey, ok := utils.ExtractAPIKey(ctx); ok {
+		return "api_key:" + utils.HashSecret(apiKey)
+	}
+
+	if claims, ok := utils.ClaimsFromContext(ctx); ok {
+		return "user:" + claims.TenantID() + "/" + claims.Username
+	}
+
+	return "ip:" + utils.ClientInfoFromContext(ctx).IP
+}
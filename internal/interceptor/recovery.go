@@ -0,0 +1,96 @@
+package interceptor
+
+import (
+	"context"
+	"runtime/debug"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+
+	"di_container/internal/logger"
+	"di_container/internal/metric"
+	"di_container/internal/sys"
+	"di_container/internal/utils"
+)
+
+// errorIDKey трейлер, в котором клиент получает идентификатор ошибки, чтобы найти ее в логах
+const errorIDKey = "x-error-id"
+
+// errorIDMetadataKey ключ идентификатора ошибки в метаданных ErrorInfo
+const errorIDMetadataKey = "error_id"
+
+// RecoveryInterceptor не дает панике в обработчике уронить весь процесс. Стоит сразу за интерсепторами
+// идентификатора запроса и адреса клиента, чтобы они попали в лог паники, и перед всеми остальными, чтобы
+// перехватывать панику и в них. Сами эти два интерсептора настолько просты, что паниковать им нечем
+type RecoveryInterceptor struct {
+	exposeErrorID bool
+}
+
+// NewRecoveryInterceptor создает интерсептор. exposeErrorID - отдавать клиенту идентификатор ошибки в трейлере
+// и в ErrorInfo
+func NewRecoveryInterceptor(exposeErrorID bool) *RecoveryInterceptor {
+	return &RecoveryInterceptor{exposeErrorID: exposeErrorID}
+}
+
+func (r *RecoveryInterceptor) Unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (res interface{}, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			errorID := recovered(ctx, info.FullMethod, p)
+			if r.exposeErrorID && errorID != "" {
+				_ = grpc.SetTrailer(ctx, metadata.Pairs(errorIDKey, errorID))
+			}
+
+			res, err = nil, r.internalError(ctx, errorID)
+		}
+	}()
+
+	return handler(ctx, req)
+}
+
+func (r *RecoveryInterceptor) Stream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			errorID := recovered(ss.Context(), info.FullMethod, p)
+			if r.exposeErrorID && errorID != "" {
+				ss.SetTrailer(metadata.Pairs(errorIDKey, errorID))
+			}
+
+			err = r.internalError(ss.Context(), errorID)
+		}
+	}()
+
+	return handler(srv, ss)
+}
+
+// internalError ошибка для клиента вместо паники. Интерсептор ошибок стоит глубже в цепочке и ее не увидит,
+// поэтому статус с ErrorInfo собирается здесь же
+func (r *RecoveryInterceptor) internalError(ctx context.Context, errorID string) error {
+	err := sys.NewCommonError("internal error", codes.Internal)
+	if r.exposeErrorID && errorID != "" {
+		err = err.WithMetadata(errorIDMetadataKey, errorID)
+	}
+
+	return statusError(ctx, err)
+}
+
+// recovered логирует панику со стеком и возвращает идентификатор, под которым она записана
+func recovered(ctx context.Context, method string, p interface{}) string {
+	metric.IncPanicCounter(method)
+
+	// Без идентификатора паника все равно попадет в лог, просто ее будет труднее найти
+	errorID, _ := utils.GenerateTokenID()
+
+	client := utils.ClientInfoFromContext(ctx)
+	logger.FromContext(ctx).Error("panic recovered",
+		zap.String("error_id", errorID),
+		zap.String("method", method),
+		zap.String("ip", client.IP),
+		zap.String("user_agent", client.UserAgent),
+		zap.Any("panic", p),
+		zap.ByteString("stack", debug.Stack()),
+	)
+
+	return errorID
+}
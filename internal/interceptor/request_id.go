@@ -0,0 +1,56 @@
+package interceptor
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"di_container/internal/logger"
+	"di_container/internal/request_id"
+)
+
+// RequestIDInterceptor берет идентификатор запроса из заголовка x-request-id или создает новый, помечает им
+// все логи запроса и возвращает клиенту в заголовке ответа, чтобы жалобу клиента можно было найти в логах
+func RequestIDInterceptor(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, id := withRequestID(ctx)
+	_ = grpc.SetHeader(ctx, metadata.Pairs(request_id.Header, id))
+
+	return handler(ctx, req)
+}
+
+func RequestIDStreamInterceptor(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, id := withRequestID(ss.Context())
+	_ = ss.SetHeader(metadata.Pairs(request_id.Header, id))
+
+	return handler(srv, &serverStream{
+		ServerStream: ss,
+		ctx:          ctx,
+	})
+}
+
+// RequestIDClientInterceptor передает идентификатор запроса в вызовы других сервисов
+func RequestIDClientInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if id := request_id.FromContext(ctx); id != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, request_id.Header, id)
+	}
+
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+func withRequestID(ctx context.Context) (context.Context, string) {
+	var id string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(request_id.Header); len(values) > 0 && request_id.Valid(values[0]) {
+			id = values[0]
+		}
+	}
+	if id == "" {
+		id = request_id.New()
+	}
+
+	ctx = request_id.WithRequestID(ctx, id)
+
+	return logger.ContextWithFields(ctx, zap.String("request_id", id)), id
+}
@@ -0,0 +1,46 @@
+package interceptor
+
+import (
+	"context"
+	"di_container/internal/tenant"
+	"di_container/internal/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TenantInterceptor выбирает арендатора запроса по заголовку x-tenant-id, без заголовка - tenant.Default.
+// Для запросов с токеном или API ключом интерцептор авторизации затем закрепляет арендатора учетной записи
+func TenantInterceptor(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := withTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+func TenantStreamInterceptor(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := withTenant(ss.Context())
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, &serverStream{
+		ServerStream: ss,
+		ctx:          ctx,
+	})
+}
+
+func withTenant(ctx context.Context) (context.Context, error) {
+	id, ok := utils.ExtractTenant(ctx)
+	if !ok {
+		id = tenant.Default
+	}
+
+	if err := tenant.Validate(id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return tenant.WithTenant(ctx, id), nil
+}
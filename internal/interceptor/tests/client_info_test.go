@@ -0,0 +1,89 @@
+package tests
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"di_container/internal/interceptor"
+	"di_container/internal/utils"
+)
+
+func withPeer(ip string, md metadata.MD) context.Context {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 50000},
+	})
+
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+func TestClientInfoInterceptor(t *testing.T) {
+	t.Parallel()
+
+	const secret = "gateway-secret"
+
+	_, proxies, err := net.ParseCIDR("10.0.0.0/8")
+	require.NoError(t, err)
+
+	clientInfo := interceptor.NewClientInfoInterceptor(secret, []*net.IPNet{proxies})
+	info := &grpc.UnaryServerInfo{FullMethod: "/auth_v1.AuthV1/Login"}
+
+	resolve := func(t *testing.T, ctx context.Context) (string, metadata.MD) {
+		var (
+			ip string
+			md metadata.MD
+		)
+		_, err := clientInfo.Unary(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			ip = utils.ClientInfoFromContext(ctx).IP
+			md, _ = metadata.FromIncomingContext(ctx)
+			return nil, nil
+		})
+		require.NoError(t, err)
+
+		return ip, md
+	}
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		ip   string
+	}{
+		{
+			name: "direct client cannot forge address",
+			ctx:  withPeer("203.0.113.7", metadata.Pairs("x-forwarded-for", "198.51.100.1")),
+			ip:   "203.0.113.7",
+		},
+		{
+			name: "wrong gateway secret is ignored",
+			ctx:  withPeer("203.0.113.7", metadata.Pairs("x-forwarded-for", "198.51.100.1", "x-gateway-secret", "guess")),
+			ip:   "203.0.113.7",
+		},
+		{
+			name: "gateway takes address it has seen, not the one sent by client",
+			ctx:  withPeer("127.0.0.1", metadata.Pairs("x-forwarded-for", "198.51.100.1, 203.0.113.7", "x-gateway-secret", secret)),
+			ip:   "203.0.113.7",
+		},
+		{
+			name: "trusted proxies are skipped",
+			ctx:  withPeer("10.0.0.1", metadata.Pairs("x-forwarded-for", "198.51.100.1, 203.0.113.7, 10.0.0.2")),
+			ip:   "203.0.113.7",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ip, md := resolve(t, tt.ctx)
+			require.Equal(t, tt.ip, ip)
+			require.Empty(t, md.Get("x-forwarded-for"))
+			require.Empty(t, md.Get("x-gateway-secret"))
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package tests
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"di_container/internal/concurrency_limiter"
+	"di_container/internal/interceptor"
+	"di_container/internal/metric"
+)
+
+func TestMain(m *testing.M) {
+	if err := metric.Init(context.Background()); err != nil {
+		panic(err)
+	}
+
+	os.Exit(m.Run())
+}
+
+func TestConcurrencyLimiterInterceptor(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	info := &grpc.UnaryServerInfo{FullMethod: "/note_v1.NoteV1/Get"}
+
+	t.Run("slot is released when handler panics", func(t *testing.T) {
+		t.Parallel()
+
+		limiter := concurrency_limiter.NewAIMDLimiter(concurrency_limiter.Settings{
+			InitialLimit:     1,
+			MinLimit:         1,
+			MaxLimit:         1,
+			LatencyThreshold: time.Second,
+			BackoffRatio:     0.5,
+		})
+		limiterInterceptor := interceptor.NewConcurrencyLimiterInterceptor(limiter)
+
+		panicking := func(ctx context.Context, req interface{}) (interface{}, error) {
+			panic("boom")
+		}
+
+		for i := 0; i < 3; i++ {
+			require.Panics(t, func() {
+				_, _ = limiterInterceptor.Unary(ctx, nil, info, panicking)
+			})
+		}
+
+		res, err := limiterInterceptor.Unary(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return "ok", nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, "ok", res)
+	})
+}
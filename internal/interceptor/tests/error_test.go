@@ -0,0 +1,132 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"di_container/internal/interceptor"
+	"di_container/internal/logger"
+)
+
+// pgvError повторяет ошибки, которые генерирует protoc-gen-validate
+type pgvError struct {
+	field  string
+	reason string
+	cause  error
+}
+
+func (e pgvError) Field() string  { return e.field }
+func (e pgvError) Reason() string { return e.reason }
+func (e pgvError) Cause() error   { return e.cause }
+func (e pgvError) Error() string  { return fmt.Sprintf("invalid %s: %s", e.field, e.reason) }
+
+type pgvMultiError []error
+
+func (m pgvMultiError) Error() string      { return fmt.Sprintf("%d errors", len(m)) }
+func (m pgvMultiError) AllErrors() []error { return m }
+
+func TestErrorCodesInterceptor(t *testing.T) {
+	t.Parallel()
+
+	logger.Init(zapcore.NewNopCore())
+
+	ctx := context.Background()
+	info := &grpc.UnaryServerInfo{FullMethod: "/note_v1.NoteV1/Create"}
+
+	call := func(err error) *status.Status {
+		_, err = interceptor.ErrorCodesInterceptor(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, err
+		})
+
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		return st
+	}
+
+	t.Run("protoc-gen-validate error", func(t *testing.T) {
+		t.Parallel()
+
+		st := call(pgvError{
+			field:  "Info",
+			reason: "embedded message failed validation",
+			cause:  pgvError{field: "Title", reason: "value length must be at least 1 runes"},
+		})
+		require.Equal(t, codes.InvalidArgument, st.Code())
+
+		br := badRequest(st)
+		require.NotNil(t, br)
+		require.Len(t, br.GetFieldViolations(), 1)
+		require.Equal(t, "Info.Title", br.GetFieldViolations()[0].GetField())
+		require.Equal(t, "value length must be at least 1 runes", br.GetFieldViolations()[0].GetDescription())
+
+		ei := errorInfo(st)
+		require.NotNil(t, ei)
+		require.Equal(t, "VALIDATION_FAILED", ei.GetReason())
+	})
+
+	t.Run("protoc-gen-validate multi error", func(t *testing.T) {
+		t.Parallel()
+
+		st := call(pgvMultiError{
+			pgvError{field: "Id", reason: "value must be greater than 0"},
+			pgvError{field: "Limit", reason: "value must be less than 100"},
+		})
+		require.Equal(t, codes.InvalidArgument, st.Code())
+
+		br := badRequest(st)
+		require.NotNil(t, br)
+		require.Len(t, br.GetFieldViolations(), 2)
+	})
+
+	t.Run("status without details gets error info", func(t *testing.T) {
+		t.Parallel()
+
+		st := call(status.Error(codes.NotFound, "note not found"))
+		require.Equal(t, codes.NotFound, st.Code())
+		require.Equal(t, "note not found", st.Message())
+
+		ei := errorInfo(st)
+		require.NotNil(t, ei)
+		require.Equal(t, "NOT_FOUND", ei.GetReason())
+	})
+
+	t.Run("status with details is kept", func(t *testing.T) {
+		t.Parallel()
+
+		withDetails, err := status.New(codes.FailedPrecondition, "conflict").WithDetails(&errdetails.ErrorInfo{Reason: "CUSTOM"})
+		require.NoError(t, err)
+
+		st := call(withDetails.Err())
+		require.Len(t, st.Details(), 1)
+
+		ei := errorInfo(st)
+		require.NotNil(t, ei)
+		require.Equal(t, "CUSTOM", ei.GetReason())
+	})
+}
+
+func errorInfo(st *status.Status) *errdetails.ErrorInfo {
+	for _, d := range st.Details() {
+		if v, ok := d.(*errdetails.ErrorInfo); ok {
+			return v
+		}
+	}
+	return nil
+}
+
+func badRequest(st *status.Status) *errdetails.BadRequest {
+	for _, d := range st.Details() {
+		if v, ok := d.(*errdetails.BadRequest); ok {
+			return v
+		}
+	}
+	return nil
+}
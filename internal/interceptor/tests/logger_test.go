@@ -0,0 +1,49 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc"
+
+	"di_container/internal/interceptor"
+	"di_container/internal/logger"
+)
+
+// Тест подменяет глобальный логгер, поэтому не выполняется параллельно с остальными
+func TestLogInterceptor(t *testing.T) {
+	const (
+		sensitiveMethod = "/auth_v1.AuthV1/CreateAPIKey"
+		plainMethod     = "/note_v1.NoteV1/Get"
+	)
+
+	core, logs := observer.New(zapcore.InfoLevel)
+	logger.Init(core)
+	defer logger.Init(zapcore.NewNopCore())
+
+	log := interceptor.NewLogInterceptor(sensitiveMethod)
+
+	call := func(method string) map[string]interface{} {
+		_, err := log.Unary(context.Background(), "request", &grpc.UnaryServerInfo{FullMethod: method},
+			func(context.Context, interface{}) (interface{}, error) {
+				return "secret", nil
+			})
+		require.NoError(t, err)
+
+		entries := logs.TakeAll()
+		require.Len(t, entries, 1)
+		return entries[0].ContextMap()
+	}
+
+	fields := call(plainMethod)
+	require.Equal(t, "request", fields["req"])
+	require.Equal(t, "secret", fields["res"])
+
+	fields = call(sensitiveMethod)
+	require.Equal(t, sensitiveMethod, fields["method"])
+	require.NotContains(t, fields, "req")
+	require.NotContains(t, fields, "res")
+}
@@ -0,0 +1,48 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+
+	"di_container/internal/interceptor"
+	"di_container/internal/rate_limiter"
+	"di_container/internal/sys"
+)
+
+func TestRateLimiterInterceptorForgedForwardedFor(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	clientInfo := interceptor.NewClientInfoInterceptor("gateway-secret", nil)
+	rateLimiter := interceptor.NewRateLimiterInterceptor(
+		rate_limiter.NewKeyedLimiter(ctx, rate_limiter.Policy{Rate: 0, Burst: 1}, nil, time.Minute), 0)
+	info := &grpc.UnaryServerInfo{FullMethod: "/note_v1.NoteV1/Get"}
+
+	call := func(forwardedFor string) error {
+		reqCtx := withPeer("203.0.113.7", metadata.Pairs("x-forwarded-for", forwardedFor))
+
+		_, err := clientInfo.Unary(reqCtx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return rateLimiter.Unary(ctx, req, info, func(context.Context, interface{}) (interface{}, error) {
+				return nil, nil
+			})
+		})
+		return err
+	}
+
+	require.NoError(t, call("198.51.100.1"))
+
+	// Другой адрес в заголовке не дает клиенту нового ведра
+	err := call("198.51.100.2")
+
+	commonErr := sys.GetCommonError(err)
+	require.NotNil(t, commonErr)
+	require.Equal(t, codes.ResourceExhausted, commonErr.Code())
+}
@@ -0,0 +1,59 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"di_container/internal/interceptor"
+	"di_container/internal/logger"
+	"di_container/internal/request_id"
+)
+
+func TestRecoveryInterceptor(t *testing.T) {
+	t.Parallel()
+
+	logger.Init(zapcore.NewNopCore())
+
+	ctx := request_id.WithRequestID(context.Background(), "request-1")
+	info := &grpc.UnaryServerInfo{FullMethod: "/note_v1.NoteV1/Get"}
+
+	call := func(exposeErrorID bool) *status.Status {
+		_, err := interceptor.NewRecoveryInterceptor(exposeErrorID).Unary(ctx, nil, info,
+			func(context.Context, interface{}) (interface{}, error) {
+				panic("boom")
+			})
+
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		return st
+	}
+
+	t.Run("error info carries error id", func(t *testing.T) {
+		t.Parallel()
+
+		st := call(true)
+		require.Equal(t, codes.Internal, st.Code())
+		require.Equal(t, "internal error", st.Message())
+
+		ei := errorInfo(st)
+		require.NotNil(t, ei)
+		require.Equal(t, "INTERNAL", ei.GetReason())
+		require.NotEmpty(t, ei.GetMetadata()["error_id"])
+		require.Equal(t, "request-1", ei.GetMetadata()["request_id"])
+	})
+
+	t.Run("error id is hidden", func(t *testing.T) {
+		t.Parallel()
+
+		ei := errorInfo(call(false))
+		require.NotNil(t, ei)
+		require.NotContains(t, ei.GetMetadata(), "error_id")
+		require.Equal(t, "request-1", ei.GetMetadata()["request_id"])
+	})
+}
@@ -19,3 +19,25 @@ func ValidateInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryS
 
 	return handler(ctx, req)
 }
+
+// ValidateStreamInterceptor проверяет каждое сообщение клиента по мере чтения.
+// Невалидное сообщение возвращается обработчику ошибкой RecvMsg
+func ValidateStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &validatingStream{ServerStream: ss})
+}
+
+type validatingStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatingStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	if val, ok := m.(validator); ok {
+		return val.Validate()
+	}
+
+	return nil
+}
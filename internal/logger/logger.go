@@ -1,12 +1,16 @@
 package logger
 
 import (
+	"context"
+
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
 
 var globalLogger *zap.Logger
 
+type fieldsKey struct{}
+
 func Init(core zapcore.Core, options ...zap.Option) {
 	globalLogger = zap.New(core, options...)
 }
@@ -38,3 +42,24 @@ func Fatal(msg string, fields ...zap.Field) {
 func WithOptions(opts ...zap.Option) *zap.Logger {
 	return globalLogger.WithOptions(opts...)
 }
+
+// ContextWithFields добавляет в контекст поля, которые попадут во все записи логгера из FromContext
+func ContextWithFields(ctx context.Context, fields ...zap.Field) context.Context {
+	existing, _ := ctx.Value(fieldsKey{}).([]zap.Field)
+
+	merged := make([]zap.Field, 0, len(existing)+len(fields))
+	merged = append(merged, existing...)
+	merged = append(merged, fields...)
+
+	return context.WithValue(ctx, fieldsKey{}, merged)
+}
+
+// FromContext возвращает логгер с полями, добавленными в контекст через ContextWithFields
+func FromContext(ctx context.Context) *zap.Logger {
+	fields, _ := ctx.Value(fieldsKey{}).([]zap.Field)
+	if len(fields) == 0 {
+		return globalLogger
+	}
+
+	return globalLogger.With(fields...)
+}
@@ -15,6 +15,14 @@ type Metrics struct {
 	requestCounter        prometheus.Counter
 	responseCounter       *prometheus.CounterVec
 	histogramResponseTime *prometheus.HistogramVec
+	streamMessageCounter  *prometheus.CounterVec
+	circuitBreakerState   *prometheus.GaugeVec
+	circuitBreakerChanges *prometheus.CounterVec
+	concurrencyLimit      prometheus.Gauge
+	concurrencyInFlight   prometheus.Gauge
+	concurrencyQueueDepth prometheus.Gauge
+	shedRequestCounter    *prometheus.CounterVec
+	panicCounter          *prometheus.CounterVec
 }
 
 var metrics *Metrics
@@ -48,6 +56,75 @@ func Init(_ context.Context) error {
 			},
 			[]string{"status"},
 		),
+		streamMessageCounter: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: "grpc",
+				Name:      appName + "_stream_messages_total",
+				Help:      "Количество сообщений в потоковых методах",
+			},
+			[]string{"method", "direction"},
+		),
+		circuitBreakerState: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: "circuit_breaker",
+				Name:      appName + "_state",
+				Help:      "Состояние выключателя: 0 - замкнут, 1 - полуоткрыт, 2 - разомкнут",
+			},
+			[]string{"name"},
+		),
+		circuitBreakerChanges: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: "circuit_breaker",
+				Name:      appName + "_transitions_total",
+				Help:      "Количество переходов выключателя между состояниями",
+			},
+			[]string{"name", "from", "to"},
+		),
+		concurrencyLimit: promauto.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: "concurrency",
+				Name:      appName + "_limit",
+				Help:      "Текущий лимит одновременно выполняемых запросов",
+			},
+		),
+		concurrencyInFlight: promauto.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: "concurrency",
+				Name:      appName + "_in_flight",
+				Help:      "Количество выполняемых запросов",
+			},
+		),
+		concurrencyQueueDepth: promauto.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: "concurrency",
+				Name:      appName + "_queue_depth",
+				Help:      "Количество запросов, ожидающих места",
+			},
+		),
+		shedRequestCounter: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: "concurrency",
+				Name:      appName + "_shed_requests_total",
+				Help:      "Количество запросов, отклоненных из-за перегрузки",
+			},
+			[]string{"priority"},
+		),
+		panicCounter: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: "grpc",
+				Name:      appName + "_panics_total",
+				Help:      "Количество паник в обработчиках",
+			},
+			[]string{"method"},
+		),
 	}
 
 	return nil
@@ -64,3 +141,37 @@ func IncResponseCounter(status string, method string) {
 func HistogramResponseTimeObserve(status string, time float64) {
 	metrics.histogramResponseTime.WithLabelValues(status).Observe(time)
 }
+
+// IncStreamMessageCounter учитывает сообщение потока. direction - received или sent
+func IncStreamMessageCounter(method string, direction string) {
+	metrics.streamMessageCounter.WithLabelValues(method, direction).Inc()
+}
+
+// SetCircuitBreakerState запоминает состояние выключателя name
+func SetCircuitBreakerState(name string, state float64) {
+	metrics.circuitBreakerState.WithLabelValues(name).Set(state)
+}
+
+func IncCircuitBreakerTransition(name string, from string, to string) {
+	metrics.circuitBreakerChanges.WithLabelValues(name, from, to).Inc()
+}
+
+func SetConcurrencyLimit(limit float64) {
+	metrics.concurrencyLimit.Set(limit)
+}
+
+func SetConcurrencyInFlight(inFlight int) {
+	metrics.concurrencyInFlight.Set(float64(inFlight))
+}
+
+func SetConcurrencyQueueDepth(depth int) {
+	metrics.concurrencyQueueDepth.Set(float64(depth))
+}
+
+func IncShedRequests(priority string) {
+	metrics.shedRequestCounter.WithLabelValues(priority).Inc()
+}
+
+func IncPanicCounter(method string) {
+	metrics.panicCounter.WithLabelValues(method).Inc()
+}
@@ -0,0 +1,42 @@
+package model
+
+// Caller пользователь, для которого принимается решение о доступе
+type Caller struct {
+	Username string
+	Roles    []string
+}
+
+// AccessRequest все, на что могут ссылаться правила политики доступа
+type AccessRequest struct {
+	Caller Caller
+	Method string
+	// Resource атрибуты ресурса, например владелец заметки. Их передает сервис, которому принадлежит ресурс
+	Resource map[string]string
+	// Request скалярные поля самого gRPC запроса
+	Request map[string]string
+	// Explicit проверку явно запросили через AccessV1, а не AuthInterceptor перед вызовом метода.
+	// Разрешения пишутся в журнал аудита только для явных проверок
+	Explicit bool
+}
+
+// AccessReason причина решения о доступе
+type AccessReason string
+
+const (
+	AccessReasonAllowedByRule    AccessReason = "allowed_by_rule"
+	AccessReasonAllowedByDefault AccessReason = "allowed_by_default"
+	AccessReasonDeniedByRule     AccessReason = "denied_by_rule"
+	AccessReasonDeniedByDefault  AccessReason = "denied_by_default"
+	// AccessReasonDeniedByScope метод не входит в scope токена, политика не проверялась
+	AccessReasonDeniedByScope AccessReason = "denied_by_scope"
+	// AccessReasonDeniedByImpersonation токен выдан для входа под другим пользователем, а метод управляет учетными данными
+	AccessReasonDeniedByImpersonation AccessReason = "denied_by_impersonation"
+)
+
+// AccessDecision решение о доступе. Rule пустой, если не сработало ни одно правило и применен эффект по умолчанию
+type AccessDecision struct {
+	Allowed bool
+	Reason  AccessReason
+	Rule    string
+	Line    int
+}
@@ -0,0 +1,37 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// APIKeyScopeAll разрешает ключу все методы, доступные роли его владельца
+const APIKeyScopeAll = "*"
+
+// APIKey ключ для машинных клиентов. Сам ключ показывается один раз при создании, хранится только его хеш
+type APIKey struct {
+	ID         int64
+	Username   string
+	Info       APIKeyInfo
+	Prefix     string
+	Hash       string
+	LastUsedAt sql.NullTime
+	CreatedAt  time.Time
+	RevokedAt  sql.NullTime
+}
+
+type APIKeyInfo struct {
+	Name string
+	// Scopes полные имена методов (/note_v1.NoteV1/Get) или сервисов с * на конце (/note_v1.NoteV1/*)
+	Scopes    []string
+	ExpiresAt sql.NullTime
+}
+
+func (k *APIKey) IsActive() bool {
+	return !k.RevokedAt.Valid && (!k.Info.ExpiresAt.Valid || k.Info.ExpiresAt.Time.After(time.Now()))
+}
+
+// Allows проверяет, входит ли метод в scopes ключа
+func (k *APIKey) Allows(endpointAddress string) bool {
+	return ScopesAllow(k.Info.Scopes, endpointAddress)
+}
@@ -0,0 +1,63 @@
+package model
+
+import "time"
+
+// Типы событий журнала аудита
+const (
+	AuditEventLogin            = "login"
+	AuditEventTokenRefresh     = "token_refresh"
+	AuditEventTokenRevoke      = "token_revoke"
+	AuditEventAccessCheck      = "access_check"
+	AuditEventPermissionChange = "permission_change"
+	AuditEventImpersonation    = "impersonation"
+)
+
+// Исходы событий журнала аудита
+const (
+	AuditOutcomeSuccess = "success"
+	AuditOutcomeFailure = "failure"
+	AuditOutcomeAllowed = "allowed"
+	AuditOutcomeDenied  = "denied"
+)
+
+// AuditEvent запись журнала аудита. Actor тот, кто выполнил действие, Subject то, над чем оно
+// выполнено, например user:alice или session:<id>. Если Actor действовал через вход под другим пользователем,
+// Impersonator - сотрудник, который на самом деле выполнил действие. Hash считается по полям события и PrevHash,
+// поэтому изменение любой записи ломает цепочку
+type AuditEvent struct {
+	ID           int64
+	Type         string
+	Actor        string
+	Impersonator string
+	Subject      string
+	IP           string
+	Method       string
+	Outcome      string
+	Reason       string
+	PrevHash     string
+	Hash         string
+	CreatedAt    time.Time
+}
+
+// AuditFilter условия выборки журнала. Нулевые значения не ограничивают выборку.
+// Actor совпадает и с событиями, которые он выполнил под другим пользователем
+type AuditFilter struct {
+	From    time.Time
+	To      time.Time
+	Actor   string
+	AfterID int64
+	Limit   uint64
+}
+
+// AuditPage страница журнала. NextAfterID равен 0, если страница последняя
+type AuditPage struct {
+	Events      []*AuditEvent
+	NextAfterID int64
+}
+
+// AuditVerification результат проверки цепочки хешей. FirstInvalidID - первая запись, на которой цепочка разорвана
+type AuditVerification struct {
+	Valid          bool
+	Checked        int64
+	FirstInvalidID int64
+}
@@ -1,13 +1,58 @@
 package model
 
-import "github.com/dgrijalva/jwt-go"
+import (
+	"strings"
+
+	"github.com/dgrijalva/jwt-go"
+
+	"di_container/internal/tenant"
+)
 
 const (
 	ExamplePath = "/note_v1.NoteV1/Get"
+
+	// PurposeMFAChallenge токен, подтверждающий только ввод пароля. Доступа он не дает
+	PurposeMFAChallenge = "mfa_challenge"
 )
 
 type UserClaims struct {
 	jwt.StandardClaims
 	Username string `json:"username"`
 	Role     string `json:"role"`
+	Purpose  string `json:"purpose,omitempty"`
+	// Scope ограничивает токен перечисленными через пробел методами, как scope в OAuth2.
+	// Пустой scope не ограничивает токен сверх роли
+	Scope string `json:"scope,omitempty"`
+	// Impersonator сотрудник, который действует от имени Username. Такой токен не может управлять учетными данными
+	Impersonator string `json:"impersonator,omitempty"`
+	Tenant       string `json:"tenant,omitempty"`
+}
+
+// TenantID возвращает арендатора токена. Токены, выпущенные до разделения на арендаторов, принадлежат tenant.Default
+func (c *UserClaims) TenantID() string {
+	if c.Tenant == "" {
+		return tenant.Default
+	}
+
+	return c.Tenant
+}
+
+func (c *UserClaims) Scopes() []string {
+	return strings.Fields(c.Scope)
+}
+
+// ScopesAllow проверяет, что метод входит в scopes. Scope может быть полным именем метода,
+// сервисом с * на конце (/note_v1.NoteV1/*) или * для всех методов
+func ScopesAllow(scopes []string, endpointAddress string) bool {
+	for _, scope := range scopes {
+		if scope == APIKeyScopeAll || scope == endpointAddress {
+			return true
+		}
+
+		if strings.HasSuffix(scope, "*") && strings.HasPrefix(endpointAddress, strings.TrimSuffix(scope, "*")) {
+			return true
+		}
+	}
+
+	return false
 }
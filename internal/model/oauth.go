@@ -0,0 +1,50 @@
+package model
+
+import "time"
+
+const (
+	OAuthGrantClientCredentials = "client_credentials"
+	OAuthGrantRefreshToken      = "refresh_token"
+
+	OAuthTokenTypeBearer = "Bearer"
+
+	OAuthErrorInvalidRequest       = "invalid_request"
+	OAuthErrorInvalidClient        = "invalid_client"
+	OAuthErrorInvalidGrant         = "invalid_grant"
+	OAuthErrorInvalidScope         = "invalid_scope"
+	OAuthErrorUnsupportedGrantType = "unsupported_grant_type"
+)
+
+// OAuthToken ответ token endpoint по RFC 6749
+type OAuthToken struct {
+	AccessToken  string
+	TokenType    string
+	ExpiresIn    time.Duration
+	RefreshToken string
+	Scopes       []string
+}
+
+// TokenIntrospection ответ introspection endpoint по RFC 7662. Для недействительного токена заполнен только Active
+type TokenIntrospection struct {
+	Active    bool
+	Username  string
+	Scopes    []string
+	TokenType string
+	ExpiresAt time.Time
+	IssuedAt  time.Time
+	TokenID   string
+}
+
+// OAuthError ошибка в терминах OAuth2, которую token endpoint возвращает клиенту как есть
+type OAuthError struct {
+	Code        string
+	Description string
+}
+
+func NewOAuthError(code string, description string) *OAuthError {
+	return &OAuthError{Code: code, Description: description}
+}
+
+func (e *OAuthError) Error() string {
+	return e.Code + ": " + e.Description
+}
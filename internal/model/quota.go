@@ -0,0 +1,61 @@
+package model
+
+// Виды квот. Они же попадают в детали ошибки ResourceExhausted
+const (
+	QuotaNotes           = "notes"
+	QuotaContentBytes    = "content_bytes"
+	QuotaAttachmentBytes = "attachment_bytes"
+	QuotaRequestsPerDay  = "requests_per_day"
+)
+
+// QuotaLimits лимиты арендатора или пользователя. Нулевой лимит не ограничивает
+type QuotaLimits struct {
+	Notes           int64
+	ContentBytes    int64
+	AttachmentBytes int64
+	RequestsPerDay  int64
+}
+
+// Usage потребление арендатора или пользователя. RequestsToday считается по UTC дням
+type Usage struct {
+	Notes        int64
+	ContentBytes int64
+	// AttachmentBytes размер вложений. Запись вложения передает его в QuotaService.Consume в своей транзакции,
+	// удаление - с минусом, как заметки передают ContentBytes
+	AttachmentBytes int64
+	RequestsToday   int64
+}
+
+// UsageReport потребление и лимиты арендатора целиком и вызывающего пользователя
+type UsageReport struct {
+	Tenant       Usage
+	TenantLimits QuotaLimits
+	User         Usage
+	UserLimits   QuotaLimits
+}
+
+// QuotaViolation превышенная квота. Subject - кто ее превысил, например tenant:acme или user:alice
+type QuotaViolation struct {
+	Subject string
+	Quota   string
+	Limit   int64
+	Used    int64
+}
+
+// Exceeded возвращает квоты, которые превышает usage
+func (l QuotaLimits) Exceeded(subject string, usage *Usage) []QuotaViolation {
+	var res []QuotaViolation
+
+	check := func(quota string, limit int64, used int64) {
+		if limit > 0 && used > limit {
+			res = append(res, QuotaViolation{Subject: subject, Quota: quota, Limit: limit, Used: used})
+		}
+	}
+
+	check(QuotaNotes, l.Notes, usage.Notes)
+	check(QuotaContentBytes, l.ContentBytes, usage.ContentBytes)
+	check(QuotaAttachmentBytes, l.AttachmentBytes, usage.AttachmentBytes)
+	check(QuotaRequestsPerDay, l.RequestsPerDay, usage.RequestsToday)
+
+	return res
+}
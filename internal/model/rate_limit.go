@@ -0,0 +1,11 @@
+package model
+
+import "time"
+
+// RateLimitBucket общее для всех реплик ведро токенов. Now - время базы, по нему реплики с разными часами
+// одинаково считают пополнение
+type RateLimitBucket struct {
+	Tokens    float64
+	UpdatedAt time.Time
+	Now       time.Time
+}
@@ -0,0 +1,21 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// RefreshToken выданный refresh токен. Все токены, полученные ротацией из одного Login, образуют семейство
+type RefreshToken struct {
+	ID        string
+	FamilyID  string
+	Username  string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	RotatedAt sql.NullTime
+	RevokedAt sql.NullTime
+}
+
+func (t *RefreshToken) IsActive() bool {
+	return !t.RotatedAt.Valid && !t.RevokedAt.Valid
+}
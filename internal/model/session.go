@@ -0,0 +1,23 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Session сессия пользователя, которой соответствует семейство refresh токенов
+type Session struct {
+	ID            string
+	Username      string
+	Client        ClientInfo
+	CreatedAt     time.Time
+	LastRefreshAt sql.NullTime
+	ExpiresAt     time.Time
+	RevokedAt     sql.NullTime
+}
+
+// ClientInfo данные о клиенте, с которого пришел запрос
+type ClientInfo struct {
+	IP        string
+	UserAgent string
+}
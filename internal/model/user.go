@@ -1,6 +1,46 @@
 package model
 
+import (
+	"database/sql"
+	"time"
+)
+
 type UserInfo struct {
 	Username string `json:"username"`
 	Role     string `json:"role"`
+	Tenant   string `json:"tenant"`
+}
+
+const RoleAdmin = "admin"
+
+type User struct {
+	ID            int64
+	Username      string
+	PasswordHash  string
+	Role          string
+	Email         sql.NullString
+	EmailVerified bool
+	TOTP          TOTPInfo
+	CreatedAt     time.Time
+	UpdatedAt     sql.NullTime
+}
+
+// TOTPInfo настройки второго фактора. Секрет хранится зашифрованным
+type TOTPInfo struct {
+	EncryptedSecret sql.NullString
+	Enabled         bool
+	LastStep        int64
+}
+
+// LoginResult результат входа по паролю. Если у пользователя включен второй фактор,
+// вместо refresh токена выдается MFAChallengeToken, который обменивается на refresh токен вместе с кодом
+type LoginResult struct {
+	RefreshToken      string
+	MFAChallengeToken string
+}
+
+// TOTPEnrollment данные для настройки приложения-аутентификатора. Коды восстановления показываются один раз
+type TOTPEnrollment struct {
+	URI           string
+	RecoveryCodes []string
 }
@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+const (
+	UserTokenPurposePasswordReset     = "password_reset"
+	UserTokenPurposeEmailVerification = "email_verification"
+)
+
+// UserToken одноразовый токен из письма. В базе хранится только хеш, сам токен знает лишь получатель письма
+type UserToken struct {
+	Hash      string
+	UserID    int64
+	Purpose   string
+	ExpiresAt time.Time
+}
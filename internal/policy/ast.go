@@ -0,0 +1,155 @@
+package policy
+
+import (
+	"strings"
+
+	"di_container/internal/model"
+)
+
+// value значение атрибута или литерала. Атрибут, которого нет в запросе, равен null
+type value struct {
+	str    string
+	list   []string
+	isList bool
+	null   bool
+}
+
+func (v value) equal(other value) bool {
+	if v.null || other.null {
+		return v.null && other.null
+	}
+
+	if v.isList || other.isList {
+		if !v.isList || !other.isList || len(v.list) != len(other.list) {
+			return false
+		}
+		for i := range v.list {
+			if v.list[i] != other.list[i] {
+				return false
+			}
+		}
+		return true
+	}
+
+	return v.str == other.str
+}
+
+type boolExpr interface {
+	eval(req *model.AccessRequest) bool
+}
+
+type valueExpr interface {
+	value(req *model.AccessRequest) value
+	isList() bool
+}
+
+type literal struct {
+	v value
+}
+
+func (l literal) value(_ *model.AccessRequest) value {
+	return l.v
+}
+
+func (l literal) isList() bool {
+	return l.v.isList
+}
+
+type attribute struct {
+	path string
+}
+
+func (a attribute) value(req *model.AccessRequest) value {
+	switch {
+	case a.path == attrMethod:
+		return value{str: req.Method}
+	case a.path == attrCallerUsername:
+		return value{str: req.Caller.Username}
+	case a.path == attrCallerRole:
+		if len(req.Caller.Roles) == 0 {
+			return value{null: true}
+		}
+		return value{str: req.Caller.Roles[0]}
+	case a.path == attrCallerRoles:
+		return value{list: req.Caller.Roles, isList: true}
+	case strings.HasPrefix(a.path, attrResourcePrefix):
+		return lookup(req.Resource, strings.TrimPrefix(a.path, attrResourcePrefix))
+	case strings.HasPrefix(a.path, attrRequestPrefix):
+		return lookup(req.Request, strings.TrimPrefix(a.path, attrRequestPrefix))
+	}
+
+	return value{null: true}
+}
+
+func (a attribute) isList() bool {
+	return a.path == attrCallerRoles
+}
+
+func lookup(attrs map[string]string, key string) value {
+	v, ok := attrs[key]
+	if !ok {
+		return value{null: true}
+	}
+
+	return value{str: v}
+}
+
+type boolConst bool
+
+func (c boolConst) eval(_ *model.AccessRequest) bool {
+	return bool(c)
+}
+
+type notExpr struct {
+	x boolExpr
+}
+
+func (n notExpr) eval(req *model.AccessRequest) bool {
+	return !n.x.eval(req)
+}
+
+type andExpr struct {
+	left, right boolExpr
+}
+
+func (a andExpr) eval(req *model.AccessRequest) bool {
+	return a.left.eval(req) && a.right.eval(req)
+}
+
+type orExpr struct {
+	left, right boolExpr
+}
+
+func (o orExpr) eval(req *model.AccessRequest) bool {
+	return o.left.eval(req) || o.right.eval(req)
+}
+
+type compareExpr struct {
+	op          string
+	left, right valueExpr
+}
+
+func (c compareExpr) eval(req *model.AccessRequest) bool {
+	left, right := c.left.value(req), c.right.value(req)
+
+	switch c.op {
+	case opEq:
+		return left.equal(right)
+	case opNeq:
+		return !left.equal(right)
+	case opIn:
+		if left.null {
+			return false
+		}
+		for _, item := range right.list {
+			if item == left.str {
+				return true
+			}
+		}
+		return false
+	case opStartsWith:
+		return !left.null && !right.null && strings.HasPrefix(left.str, right.str)
+	}
+
+	return false
+}
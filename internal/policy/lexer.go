@@ -0,0 +1,135 @@
+package policy
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenEq
+	tokenNeq
+	tokenAnd
+	tokenOr
+	tokenNot
+	tokenLParen
+	tokenRParen
+	tokenLBracket
+	tokenRBracket
+	tokenComma
+	tokenColon
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	line int
+}
+
+func (t token) String() string {
+	if t.kind == tokenEOF {
+		return "end of policy"
+	}
+
+	return fmt.Sprintf("%q", t.text)
+}
+
+// lex разбивает текст политики на токены. Комментарии начинаются с # и идут до конца строки
+func lex(src string) ([]token, error) {
+	var (
+		tokens []token
+		line   = 1
+		runes  = []rune(src)
+	)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+
+		switch {
+		case r == '\n':
+			line++
+			i++
+		case unicode.IsSpace(r):
+			i++
+		case r == '#':
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+		case r == '"':
+			var sb strings.Builder
+			i++
+			for ; i < len(runes) && runes[i] != '"'; i++ {
+				if runes[i] == '\n' {
+					return nil, fmt.Errorf("line %d: unterminated string", line)
+				}
+				if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+				}
+				sb.WriteRune(runes[i])
+			}
+			if i == len(runes) {
+				return nil, fmt.Errorf("line %d: unterminated string", line)
+			}
+			i++
+			tokens = append(tokens, token{kind: tokenString, text: sb.String(), line: line})
+		case isIdentRune(r):
+			start := i
+			for i < len(runes) && (isIdentRune(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: string(runes[start:i]), line: line})
+		default:
+			kind, width, ok := punctuation(runes[i:])
+			if !ok {
+				return nil, fmt.Errorf("line %d: unexpected character %q", line, r)
+			}
+			tokens = append(tokens, token{kind: kind, text: string(runes[i : i+width]), line: line})
+			i += width
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF, line: line}), nil
+}
+
+func isIdentRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func punctuation(runes []rune) (tokenKind, int, bool) {
+	if len(runes) >= 2 {
+		switch string(runes[:2]) {
+		case "==":
+			return tokenEq, 2, true
+		case "!=":
+			return tokenNeq, 2, true
+		case "&&":
+			return tokenAnd, 2, true
+		case "||":
+			return tokenOr, 2, true
+		}
+	}
+
+	switch runes[0] {
+	case '!':
+		return tokenNot, 1, true
+	case '(':
+		return tokenLParen, 1, true
+	case ')':
+		return tokenRParen, 1, true
+	case '[':
+		return tokenLBracket, 1, true
+	case ']':
+		return tokenRBracket, 1, true
+	case ',':
+		return tokenComma, 1, true
+	case ':':
+		return tokenColon, 1, true
+	}
+
+	return 0, 0, false
+}
@@ -0,0 +1,295 @@
+package policy
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	keywordAllow   = "allow"
+	keywordDeny    = "deny"
+	keywordDefault = "default"
+	keywordTrue    = "true"
+	keywordFalse   = "false"
+
+	opEq         = "=="
+	opNeq        = "!="
+	opIn         = "in"
+	opStartsWith = "startsWith"
+
+	attrMethod         = "method"
+	attrCallerUsername = "caller.username"
+	attrCallerRole     = "caller.role"
+	attrCallerRoles    = "caller.roles"
+	attrResourcePrefix = "resource."
+	attrRequestPrefix  = "request."
+)
+
+var reserved = map[string]struct{}{
+	keywordAllow:   {},
+	keywordDeny:    {},
+	keywordDefault: {},
+	opIn:           {},
+	opStartsWith:   {},
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) errorf(t token, format string, args ...interface{}) error {
+	return fmt.Errorf("line %d: %s", t.line, fmt.Sprintf(format, args...))
+}
+
+func (p *parser) expect(kind tokenKind, what string) (token, error) {
+	t := p.next()
+	if t.kind != kind {
+		return t, p.errorf(t, "expected %s, got %s", what, t)
+	}
+	return t, nil
+}
+
+func (p *parser) isKeyword(word string) bool {
+	t := p.peek()
+	return t.kind == tokenIdent && t.text == word
+}
+
+// parsePolicy разбирает последовательность правил:
+//
+//	allow|deny <имя>: <условие>
+//	default allow|deny
+func (p *parser) parsePolicy() (*Policy, error) {
+	policy := &Policy{defaultEffect: EffectDeny}
+	names := make(map[string]struct{})
+	hasDefault := false
+
+	for p.peek().kind != tokenEOF {
+		t := p.next()
+		if t.kind != tokenIdent {
+			return nil, p.errorf(t, "expected rule, got %s", t)
+		}
+
+		switch t.text {
+		case keywordDefault:
+			if hasDefault {
+				return nil, p.errorf(t, "default effect is already set")
+			}
+			effect, err := p.parseEffect()
+			if err != nil {
+				return nil, err
+			}
+			policy.defaultEffect = effect
+			hasDefault = true
+		case keywordAllow, keywordDeny:
+			name, err := p.expect(tokenIdent, "rule name")
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := reserved[name.text]; ok || strings.Contains(name.text, ".") {
+				return nil, p.errorf(name, "invalid rule name %s", name)
+			}
+			if _, ok := names[name.text]; ok {
+				return nil, p.errorf(name, "duplicate rule name %s", name)
+			}
+			names[name.text] = struct{}{}
+
+			if _, err = p.expect(tokenColon, `":"`); err != nil {
+				return nil, err
+			}
+
+			cond, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+
+			policy.rules = append(policy.rules, &rule{
+				effect: Effect(t.text),
+				name:   name.text,
+				cond:   cond,
+				line:   t.line,
+			})
+		default:
+			return nil, p.errorf(t, "expected allow, deny or default, got %s", t)
+		}
+	}
+
+	return policy, nil
+}
+
+func (p *parser) parseEffect() (Effect, error) {
+	t := p.next()
+	if t.kind != tokenIdent || (t.text != keywordAllow && t.text != keywordDeny) {
+		return "", p.errorf(t, "expected allow or deny, got %s", t)
+	}
+
+	return Effect(t.text), nil
+}
+
+func (p *parser) parseOr() (boolExpr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().kind == tokenOr {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orExpr{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseAnd() (boolExpr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().kind == tokenAnd {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = andExpr{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseUnary() (boolExpr, error) {
+	switch p.peek().kind {
+	case tokenNot:
+		p.next()
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notExpr{x: x}, nil
+	case tokenLParen:
+		p.next()
+		x, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if _, err = p.expect(tokenRParen, `")"`); err != nil {
+			return nil, err
+		}
+		return x, nil
+	}
+
+	return p.parseComparison()
+}
+
+func (p *parser) parseComparison() (boolExpr, error) {
+	start := p.peek()
+	left, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+
+	t := p.peek()
+	var op string
+	switch {
+	case t.kind == tokenEq:
+		op = opEq
+	case t.kind == tokenNeq:
+		op = opNeq
+	case t.kind == tokenIdent && (t.text == opIn || t.text == opStartsWith):
+		op = t.text
+	default:
+		// Голые true и false допустимы как условия, остальные значения нужно с чем-то сравнить
+		if start.kind == tokenIdent && (start.text == keywordTrue || start.text == keywordFalse) {
+			return boolConst(start.text == keywordTrue), nil
+		}
+		return nil, p.errorf(t, "expected comparison after %s", start)
+	}
+	p.next()
+
+	right, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+
+	switch op {
+	case opIn:
+		if left.isList() || !right.isList() {
+			return nil, p.errorf(t, "in expects a value on the left and a list on the right")
+		}
+	case opStartsWith:
+		if left.isList() || right.isList() {
+			return nil, p.errorf(t, "startsWith expects values on both sides")
+		}
+	}
+
+	return compareExpr{op: op, left: left, right: right}, nil
+}
+
+func (p *parser) parseValue() (valueExpr, error) {
+	t := p.next()
+
+	switch t.kind {
+	case tokenString:
+		return literal{v: value{str: t.text}}, nil
+	case tokenLBracket:
+		var items []string
+		for p.peek().kind != tokenRBracket {
+			item, err := p.expect(tokenString, "string")
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item.text)
+
+			if p.peek().kind != tokenComma {
+				break
+			}
+			p.next()
+		}
+		if _, err := p.expect(tokenRBracket, `"]"`); err != nil {
+			return nil, err
+		}
+		return literal{v: value{list: items, isList: true}}, nil
+	case tokenIdent:
+		if t.text == keywordTrue || t.text == keywordFalse {
+			return literal{v: value{str: t.text}}, nil
+		}
+		if !isAttribute(t.text) {
+			return nil, p.errorf(t, "unknown attribute %s", t)
+		}
+		return attribute{path: t.text}, nil
+	}
+
+	return nil, p.errorf(t, "expected value, got %s", t)
+}
+
+func isAttribute(path string) bool {
+	switch path {
+	case attrMethod, attrCallerUsername, attrCallerRole, attrCallerRoles:
+		return true
+	}
+
+	for _, prefix := range []string{attrResourcePrefix, attrRequestPrefix} {
+		if strings.HasPrefix(path, prefix) && len(path) > len(prefix) && !strings.Contains(strings.TrimPrefix(path, prefix), ".") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,84 @@
+package policy
+
+import (
+	_ "embed"
+	"os"
+
+	"di_container/internal/model"
+)
+
+// Effect решение правила
+type Effect string
+
+const (
+	EffectAllow Effect = keywordAllow
+	EffectDeny  Effect = keywordDeny
+)
+
+//go:embed default.policy
+var defaultPolicy string
+
+type rule struct {
+	effect Effect
+	name   string
+	cond   boolExpr
+	line   int
+}
+
+// Policy набор правил доступа. Правила проверяются по порядку, решение принимает первое сработавшее.
+// Если не сработало ни одно, применяется эффект default, по умолчанию deny
+type Policy struct {
+	rules         []*rule
+	defaultEffect Effect
+}
+
+// Parse разбирает политику. Ошибки типов (например, in со значением справа) обнаруживаются при разборе,
+// поэтому вычисление правил ошибок не возвращает
+func Parse(src string) (*Policy, error) {
+	tokens, err := lex(src)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	return p.parsePolicy()
+}
+
+// Load читает политику из файла. Если путь пустой, используется встроенная политика по умолчанию
+func Load(path string) (*Policy, error) {
+	if len(path) == 0 {
+		return Parse(defaultPolicy)
+	}
+
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(string(src))
+}
+
+// Evaluate принимает решение по запросу и сообщает, какое правило его определило
+func (p *Policy) Evaluate(req *model.AccessRequest) *model.AccessDecision {
+	for _, r := range p.rules {
+		if r.cond.eval(req) {
+			reason := model.AccessReasonDeniedByRule
+			if r.effect == EffectAllow {
+				reason = model.AccessReasonAllowedByRule
+			}
+
+			return &model.AccessDecision{
+				Allowed: r.effect == EffectAllow,
+				Reason:  reason,
+				Rule:    r.name,
+				Line:    r.line,
+			}
+		}
+	}
+
+	if p.defaultEffect == EffectAllow {
+		return &model.AccessDecision{Allowed: true, Reason: model.AccessReasonAllowedByDefault}
+	}
+
+	return &model.AccessDecision{Reason: model.AccessReasonDeniedByDefault}
+}
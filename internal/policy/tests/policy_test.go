@@ -0,0 +1,143 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"di_container/internal/model"
+	"di_container/internal/policy"
+)
+
+const notesPolicy = `
+# Админам доступно все
+allow admin: "admin" in caller.roles
+
+deny private_note: method == "/note_v1.NoteV1/Get"
+    && resource.is_public != true
+    && resource.owner != caller.username
+
+allow notes: method startsWith "/note_v1.NoteV1/" && !(caller.role in ["guest", "banned"])
+
+default deny
+`
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+
+	p, err := policy.Parse(notesPolicy)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		req     *model.AccessRequest
+		allowed bool
+		rule    string
+	}{
+		{
+			name: "admin case",
+			req: &model.AccessRequest{
+				Caller: model.Caller{Username: "root", Roles: []string{"admin"}},
+				Method: "/note_v1.NoteV1/Get",
+			},
+			allowed: true,
+			rule:    "admin",
+		},
+		{
+			name: "foreign private note case",
+			req: &model.AccessRequest{
+				Caller:   model.Caller{Username: "bob", Roles: []string{"user"}},
+				Method:   "/note_v1.NoteV1/Get",
+				Resource: map[string]string{"owner": "alice", "is_public": "false"},
+			},
+			allowed: false,
+			rule:    "private_note",
+		},
+		{
+			name: "public note case",
+			req: &model.AccessRequest{
+				Caller:   model.Caller{Username: "bob", Roles: []string{"user"}},
+				Method:   "/note_v1.NoteV1/Get",
+				Resource: map[string]string{"owner": "alice", "is_public": "true"},
+			},
+			allowed: true,
+			rule:    "notes",
+		},
+		{
+			name: "own note case",
+			req: &model.AccessRequest{
+				Caller:   model.Caller{Username: "alice", Roles: []string{"user"}},
+				Method:   "/note_v1.NoteV1/Get",
+				Resource: map[string]string{"owner": "alice"},
+			},
+			allowed: true,
+			rule:    "notes",
+		},
+		{
+			name: "banned case",
+			req: &model.AccessRequest{
+				Caller: model.Caller{Username: "eve", Roles: []string{"banned"}},
+				Method: "/note_v1.NoteV1/Create",
+			},
+			allowed: false,
+			rule:    "",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			decision := p.Evaluate(tt.req)
+			require.Equal(t, tt.allowed, decision.Allowed)
+			require.Equal(t, tt.rule, decision.Rule)
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		src  string
+	}{
+		{name: "unknown attribute", src: `allow a: user.name == "x"`},
+		{name: "missing comparison", src: `allow a: caller.username`},
+		{name: "in without list", src: `allow a: caller.role in "admin"`},
+		{name: "duplicate rule", src: `allow a: true deny a: false`},
+		{name: "unterminated string", src: `allow a: method == "/note`},
+		{name: "double default", src: `default allow default deny`},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := policy.Parse(tt.src)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestDefaultPolicy(t *testing.T) {
+	t.Parallel()
+
+	p, err := policy.Load("")
+	require.NoError(t, err)
+
+	decision := p.Evaluate(&model.AccessRequest{
+		Caller: model.Caller{Username: "bob", Roles: []string{"user"}},
+		Method: "/note_v1.NoteV1/Get",
+	})
+	require.False(t, decision.Allowed)
+	require.Equal(t, "note_get_admin_only", decision.Rule)
+
+	decision = p.Evaluate(&model.AccessRequest{
+		Caller: model.Caller{Username: "bob", Roles: []string{"user"}},
+		Method: "/note_v1.NoteV1/Create",
+	})
+	require.True(t, decision.Allowed)
+}
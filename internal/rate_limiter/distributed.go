@@ -0,0 +1,287 @@
+package rate_limiter
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"di_container/internal/client/db"
+	"di_container/internal/logger"
+	"di_container/internal/repository"
+	"di_container/internal/tenant"
+)
+
+var _ Limiter = (*DistributedLimiter)(nil)
+
+// localLeaseTTL сколько реплика может расходовать взятые из базы токены. Неизрасходованные за это время
+// токены пропадают, поэтому лимит может оказаться строже заданного, но никогда не мягче
+const localLeaseTTL = time.Second
+
+// DistributedLimiter ведет ведра в Postgres, поэтому лимит общий для всех реплик.
+// Чтобы не ходить в базу на каждый запрос, реплика берет токены пачками по batch и расходует их локально,
+// а отказ базы запоминает до момента, когда появится следующий токен.
+// Ведра, которые заведомо снова полные, периодически удаляются из базы, иначе каждый новый клиент
+// навсегда оставлял бы там строку
+type DistributedLimiter struct {
+	bucketRepository repository.RateLimitBucketRepository
+	txManager        db.TxManager
+	policies         policies
+	batch            int
+	idleTimeout      time.Duration
+	// retention сколько хранится необновлявшееся ведро: не меньше времени наполнения самого медленного
+	retention time.Duration
+
+	mu     sync.Mutex
+	leases map[leaseKey]*lease
+	// tenants арендаторы, ходившие в базу с прошлой очистки. Из-за RLS удалять приходится от имени каждого
+	tenants map[string]struct{}
+}
+
+type leaseKey struct {
+	tenant string
+	key    string
+	method string
+}
+
+// lease токены, выданные базой этой реплике, или отказ до deniedUntil
+type lease struct {
+	tokens      int
+	expiresAt   time.Time
+	deniedUntil time.Time
+}
+
+func NewDistributedLimiter(
+	ctx context.Context,
+	bucketRepository repository.RateLimitBucketRepository,
+	txManager db.TxManager,
+	defaultPolicy Policy,
+	methodPolicies map[string]Policy,
+	batch int,
+	idleTimeout time.Duration,
+) *DistributedLimiter {
+	limiter := &DistributedLimiter{
+		bucketRepository: bucketRepository,
+		txManager:        txManager,
+		policies:         policies{defaultPolicy: defaultPolicy, methodPolicies: methodPolicies},
+		batch:            max(batch, 1),
+		idleTimeout:      idleTimeout,
+		retention:        retention(idleTimeout, defaultPolicy, methodPolicies),
+		leases:           make(map[leaseKey]*lease),
+		tenants:          make(map[string]struct{}),
+	}
+
+	go limiter.startEviction(ctx)
+
+	return limiter
+}
+
+func (l *DistributedLimiter) Allow(ctx context.Context, key string, method string) (bool, time.Duration, error) {
+	k := leaseKey{tenant: tenant.FromContext(ctx), key: key, method: method}
+
+	if allowed, retryAfter, ok := l.allowLocal(k, time.Now()); ok {
+		return allowed, retryAfter, nil
+	}
+
+	policy := l.policies.get(method)
+	granted, retryAfter, err := l.take(ctx, key, method, policy)
+	if err != nil {
+		return false, 0, err
+	}
+
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if granted == 0 {
+		l.leases[k] = &lease{deniedUntil: now.Add(retryAfter)}
+		return false, retryAfter, nil
+	}
+
+	// Пока реплика ходила в базу, параллельный запрос мог взять свою пачку, ее токены не выбрасываются
+	current, ok := l.leases[k]
+	if !ok || !now.Before(current.expiresAt) {
+		current = &lease{}
+		l.leases[k] = current
+	}
+	current.tokens += granted - 1
+	current.expiresAt = now.Add(localLeaseTTL)
+	current.deniedUntil = time.Time{}
+
+	return true, 0, nil
+}
+
+// Wait сначала пробует взять токен как Allow. Если токенов нет, резервирует один в общем ведре в долг:
+// ведро уходит в минус, и остальные реплики ждут дольше. Если вызывающий перестанет ждать,
+// зарезервированный токен пропадает, лимит от этого только строже
+func (l *DistributedLimiter) Wait(ctx context.Context, key string, method string) (bool, time.Duration, error) {
+	allowed, _, err := l.Allow(ctx, key, method)
+	if err != nil || allowed {
+		return allowed, 0, err
+	}
+
+	delay, reserved, err := l.reserve(ctx, key, method, l.policies.get(method))
+	if err != nil {
+		return false, 0, err
+	}
+	if !reserved {
+		return false, delay, nil
+	}
+
+	if err = sleep(ctx, delay); err != nil {
+		return false, 0, err
+	}
+
+	return true, 0, nil
+}
+
+// allowLocal решает по локальному кэшу. ok=false значит, что без базы не обойтись
+func (l *DistributedLimiter) allowLocal(k leaseKey, now time.Time) (allowed bool, retryAfter time.Duration, ok bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	current, found := l.leases[k]
+	if !found {
+		return false, 0, false
+	}
+
+	if now.Before(current.deniedUntil) {
+		return false, current.deniedUntil.Sub(now), true
+	}
+
+	if current.tokens > 0 && now.Before(current.expiresAt) {
+		current.tokens--
+		return true, 0, true
+	}
+
+	return false, 0, false
+}
+
+// take берет из общего ведра до batch токенов. Пополнение считается по времени базы
+func (l *DistributedLimiter) take(ctx context.Context, key string, method string, policy Policy) (int, time.Duration, error) {
+	var (
+		granted    int
+		retryAfter time.Duration
+	)
+
+	l.touchTenant(tenant.FromContext(ctx))
+
+	err := l.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		bucket, errTx := l.bucketRepository.Lock(ctx, key, method, float64(policy.Burst))
+		if errTx != nil {
+			return errTx
+		}
+
+		elapsed := math.Max(0, bucket.Now.Sub(bucket.UpdatedAt).Seconds())
+		available := math.Min(float64(policy.Burst), bucket.Tokens+elapsed*policy.Rate)
+
+		granted = int(math.Min(float64(l.batch), math.Floor(available)))
+		if granted == 0 {
+			if policy.Rate > 0 {
+				retryAfter = time.Duration((1 - available) / policy.Rate * float64(time.Second))
+			}
+			return nil
+		}
+
+		return l.bucketRepository.Update(ctx, key, method, available-float64(granted), bucket.Now)
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return granted, retryAfter, nil
+}
+
+// reserve списывает из общего ведра один токен, даже если его еще нет, и возвращает, через сколько он появится.
+// Токен, который не появится до дедлайна ctx, не резервируется
+func (l *DistributedLimiter) reserve(ctx context.Context, key string, method string, policy Policy) (time.Duration, bool, error) {
+	var (
+		delay    time.Duration
+		reserved bool
+	)
+
+	l.touchTenant(tenant.FromContext(ctx))
+
+	start := time.Now()
+
+	err := l.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		bucket, errTx := l.bucketRepository.Lock(ctx, key, method, float64(policy.Burst))
+		if errTx != nil {
+			return errTx
+		}
+
+		elapsed := math.Max(0, bucket.Now.Sub(bucket.UpdatedAt).Seconds())
+		available := math.Min(float64(policy.Burst), bucket.Tokens+elapsed*policy.Rate)
+
+		if available < 1 {
+			if policy.Rate <= 0 || policy.Burst < 1 {
+				return nil
+			}
+			delay = time.Duration((1 - available) / policy.Rate * float64(time.Second))
+		}
+
+		if !fitsDeadline(ctx, start, delay) {
+			return nil
+		}
+
+		reserved = true
+		return l.bucketRepository.Update(ctx, key, method, available-1, bucket.Now)
+	})
+	if err != nil {
+		return 0, false, err
+	}
+
+	return delay, reserved, nil
+}
+
+func (l *DistributedLimiter) startEviction(ctx context.Context) {
+	ticker := time.NewTicker(l.idleTimeout)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			l.evict(now)
+			l.deleteIdle(ctx)
+		}
+	}
+}
+
+func (l *DistributedLimiter) touchTenant(id string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tenants[id] = struct{}{}
+}
+
+// deleteIdle удаляет из базы необновлявшиеся ведра арендаторов, которые ходили в базу с прошлой очистки.
+// Ошибка только логируется: лишние строки не влияют на лимит, их удалит следующая очистка
+func (l *DistributedLimiter) deleteIdle(ctx context.Context) {
+	l.mu.Lock()
+	tenants := l.tenants
+	l.tenants = make(map[string]struct{})
+	l.mu.Unlock()
+
+	for id := range tenants {
+		err := l.bucketRepository.DeleteIdle(tenant.WithTenant(ctx, id), l.retention)
+		if err != nil {
+			logger.Warn("failed to delete idle rate limit buckets", zap.String("tenant", id), zap.Error(err))
+		}
+	}
+}
+
+func (l *DistributedLimiter) evict(now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for k, current := range l.leases {
+		if !now.Before(current.expiresAt) && !now.Before(current.deniedUntil) {
+			delete(l.leases, k)
+		}
+	}
+}
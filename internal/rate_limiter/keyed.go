@@ -0,0 +1,168 @@
+package rate_limiter
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+var _ Limiter = (*KeyedLimiter)(nil)
+
+// Policy лимит для одного ключа: Rate запросов в секунду в среднем и не больше Burst подряд
+type Policy struct {
+	Rate  float64
+	Burst int
+}
+
+// KeyedLimiter ведет отдельное ведро на каждую пару ключ клиента и метод, поэтому один шумный клиент
+// не расходует лимит остальных. Лимит действует в пределах одной реплики.
+// Ведра, которыми не пользовались дольше idleTimeout, но не раньше, чем они успели бы наполниться, удаляются
+type KeyedLimiter struct {
+	policies    policies
+	idleTimeout time.Duration
+
+	mu      sync.Mutex
+	buckets map[bucketKey]*bucket
+}
+
+type bucketKey struct {
+	key    string
+	method string
+}
+
+func NewKeyedLimiter(ctx context.Context, defaultPolicy Policy, methodPolicies map[string]Policy, idleTimeout time.Duration) *KeyedLimiter {
+	limiter := &KeyedLimiter{
+		policies:    policies{defaultPolicy: defaultPolicy, methodPolicies: methodPolicies},
+		idleTimeout: retention(idleTimeout, defaultPolicy, methodPolicies),
+		buckets:     make(map[bucketKey]*bucket),
+	}
+
+	go limiter.startEviction(ctx)
+
+	return limiter
+}
+
+// Allow расходует токен из ведра ключа key для метода method. Если токена нет, возвращает,
+// через сколько он появится
+func (l *KeyedLimiter) Allow(_ context.Context, key string, method string) (bool, time.Duration, error) {
+	now := time.Now()
+
+	r := l.bucket(key, method, now).Reserve(now, 1)
+	if !r.OK() {
+		return false, 0, nil
+	}
+
+	if delay := r.Delay(now); delay > 0 {
+		r.Cancel()
+		return false, delay, nil
+	}
+
+	return true, 0, nil
+}
+
+// Wait резервирует токен в ведре ключа и ждет его. Если ожидание прервано, токен возвращается в ведро
+func (l *KeyedLimiter) Wait(ctx context.Context, key string, method string) (bool, time.Duration, error) {
+	now := time.Now()
+
+	r := l.bucket(key, method, now).Reserve(now, 1)
+	if !r.OK() {
+		return false, 0, nil
+	}
+
+	delay := r.Delay(now)
+	if delay == 0 {
+		return true, 0, nil
+	}
+
+	if !fitsDeadline(ctx, now, delay) {
+		r.Cancel()
+		return false, delay, nil
+	}
+
+	if err := sleep(ctx, delay); err != nil {
+		r.Cancel()
+		return false, 0, err
+	}
+
+	return true, 0, nil
+}
+
+// bucket возвращает ведро пары ключ и метод, создавая полное, если его еще нет
+func (l *KeyedLimiter) bucket(key string, method string, now time.Time) *TokenBucketLimiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	k := bucketKey{key: key, method: method}
+	b, ok := l.buckets[k]
+	if !ok {
+		policy := l.policies.get(method)
+		b = &bucket{limiter: NewTokenBucketLimiter(policy.Rate, policy.Burst)}
+		l.buckets[k] = b
+	}
+	b.lastSeen = now
+
+	return b.limiter
+}
+
+type policies struct {
+	defaultPolicy  Policy
+	methodPolicies map[string]Policy
+}
+
+func (p policies) get(method string) Policy {
+	if policy, ok := p.methodPolicies[method]; ok {
+		return policy
+	}
+
+	return p.defaultPolicy
+}
+
+// retention время, за которое любое ведро наполняется заново. Удаленное после него ведро создается
+// полным, поэтому удаление не смягчает лимит. Ведра с нулевой скоростью не наполняются никогда,
+// для них достаточно idleTimeout
+func retention(idleTimeout time.Duration, defaultPolicy Policy, methodPolicies map[string]Policy) time.Duration {
+	res := idleTimeout
+
+	refill := func(p Policy) {
+		if p.Rate > 0 {
+			res = max(res, durationFromTokens(float64(p.Burst), p.Rate))
+		}
+	}
+
+	refill(defaultPolicy)
+	for _, p := range methodPolicies {
+		refill(p)
+	}
+
+	return res
+}
+
+func (l *KeyedLimiter) startEviction(ctx context.Context) {
+	ticker := time.NewTicker(l.idleTimeout)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			l.evict(now)
+		}
+	}
+}
+
+func (l *KeyedLimiter) evict(now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for k, b := range l.buckets {
+		if now.Sub(b.lastSeen) > l.idleTimeout {
+			delete(l.buckets, k)
+		}
+	}
+}
+
+type bucket struct {
+	limiter  *TokenBucketLimiter
+	lastSeen time.Time
+}
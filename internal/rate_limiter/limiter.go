@@ -0,0 +1,39 @@
+package rate_limiter
+
+import (
+	"context"
+	"time"
+)
+
+// Limiter решает, можно ли выполнить запрос клиента key к методу method. Если нельзя, возвращает,
+// через сколько стоит повторить. Ошибка означает, что хранилище лимитов недоступно и решение не принято
+type Limiter interface {
+	Allow(ctx context.Context, key string, method string) (bool, time.Duration, error)
+	// Wait резервирует токен и ждет его появления, пока не истечет ctx. Зарезервированный токен
+	// достается только вызывающему, поэтому после ожидания запрос гарантированно разрешен.
+	// Если токен не успеет появиться до дедлайна ctx, Wait не ждет и возвращает, через сколько повторить
+	Wait(ctx context.Context, key string, method string) (bool, time.Duration, error)
+}
+
+// sleep ждет d или отмены ctx
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// fitsDeadline успеет ли ожидание длиной wait закончиться до дедлайна ctx
+func fitsDeadline(ctx context.Context, now time.Time, wait time.Duration) bool {
+	deadline, ok := ctx.Deadline()
+	return !ok || deadline.Sub(now) >= wait
+}
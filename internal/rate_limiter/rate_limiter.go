@@ -2,47 +2,181 @@ package rate_limiter
 
 import (
 	"context"
+	"errors"
+	"math"
+	"sync"
 	"time"
 )
 
+// ErrExceedsLimit запрошено больше токенов, чем ведро может накопить, или скорость нулевая и токены не появятся
+var ErrExceedsLimit = errors.New("rate limiter: request exceeds limit")
+
+// ErrWaitDeadline токен появится позже, чем истечет контекст
+var ErrWaitDeadline = errors.New("rate limiter: wait would exceed context deadline")
+
+// TokenBucketLimiter ведро токенов, которое пополняется со скоростью rate токенов в секунду и вмещает не больше burst.
+// Число токенов пересчитывается по времени при каждом обращении, поэтому фоновые горутины не нужны
+// и скорость может быть любой, в том числе меньше одного токена в секунду.
+// Число токенов может уйти в минус: так выданные резервации становятся в очередь друг за другом
 type TokenBucketLimiter struct {
-	tokenBucketCh chan struct{}
+	mu     sync.Mutex
+	rate   float64
+	burst  int
+	tokens float64
+	last   time.Time
 }
 
-func NewTokenBucketLimiter(ctx context.Context, limit int, period time.Duration) *TokenBucketLimiter {
-	limiter := &TokenBucketLimiter{
-		tokenBucketCh: make(chan struct{}, limit),
+func NewTokenBucketLimiter(rate float64, burst int) *TokenBucketLimiter {
+	return &TokenBucketLimiter{
+		rate:   rate,
+		burst:  burst,
+		tokens: float64(burst),
+		last:   time.Now(),
 	}
+}
+
+// Allow расходует токен, если он есть, и никогда не ждет
+func (l *TokenBucketLimiter) Allow() bool {
+	return l.AllowN(time.Now(), 1)
+}
+
+// AllowN расходует n токенов на момент now, только если все они есть
+func (l *TokenBucketLimiter) AllowN(now time.Time, n int) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
 
-	for i := 0; i < limit; i++ {
-		limiter.tokenBucketCh <- struct{}{}
+	l.advance(now)
+	if l.tokens < float64(n) {
+		return false
+	}
+
+	l.tokens -= float64(n)
+	return true
+}
+
+// Wait ждет токен, пока не отменен ctx. Если токен заведомо не появится до дедлайна ctx, возвращает ошибку сразу
+func (l *TokenBucketLimiter) Wait(ctx context.Context) error {
+	r := l.Reserve(time.Now(), 1)
+	if !r.OK() {
+		return ErrExceedsLimit
 	}
 
-	replenishmentInterval := period.Nanoseconds() / int64(limit)
-	go limiter.startPeriodicReplenishment(ctx, time.Duration(replenishmentInterval))
+	delay := r.Delay(time.Now())
+	if delay == 0 {
+		return nil
+	}
 
-	return limiter
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+		r.Cancel()
+		return ErrWaitDeadline
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		r.Cancel()
+		return ctx.Err()
+	}
 }
 
-func (l *TokenBucketLimiter) startPeriodicReplenishment(ctx context.Context, interval time.Duration) {
-	ticker := time.NewTicker(interval)
-	defer ticker.Stop()
+// Reserve занимает n токенов на момент now, даже если их пока нет. Вызывающий должен подождать Delay
+// или отказаться от резервации через Cancel
+func (l *TokenBucketLimiter) Reserve(now time.Time, n int) *Reservation {
+	l.mu.Lock()
+	defer l.mu.Unlock()
 
-	for {
-		select {
-		case <-ctx.Done():
-			return
-		case <-ticker.C:
-			l.tokenBucketCh <- struct{}{}
+	l.advance(now)
+
+	if n > l.burst {
+		return &Reservation{}
+	}
+
+	var wait time.Duration
+	if missing := float64(n) - l.tokens; missing > 0 {
+		if l.rate <= 0 {
+			return &Reservation{}
 		}
+		wait = durationFromTokens(missing, l.rate)
+	}
+
+	l.tokens -= float64(n)
+
+	return &Reservation{
+		ok:        true,
+		limiter:   l,
+		tokens:    n,
+		timeToAct: now.Add(wait),
 	}
 }
 
-func (l *TokenBucketLimiter) Allow() bool {
-	select {
-	case <-l.tokenBucketCh:
-		return true
-	default:
-		return false
+// SetLimit меняет скорость и размер ведра. Накопленные токены сохраняются, но не больше нового burst
+func (l *TokenBucketLimiter) SetLimit(rate float64, burst int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.advance(time.Now())
+	l.rate = rate
+	l.burst = burst
+	l.tokens = math.Min(l.tokens, float64(burst))
+}
+
+// advance начисляет токены за время с прошлого обращения. Время, ушедшее назад, не учитывается
+func (l *TokenBucketLimiter) advance(now time.Time) {
+	if now.After(l.last) {
+		l.tokens = math.Min(float64(l.burst), l.tokens+now.Sub(l.last).Seconds()*l.rate)
+		l.last = now
 	}
 }
+
+// Reservation токены, занятые заранее
+type Reservation struct {
+	ok        bool
+	limiter   *TokenBucketLimiter
+	tokens    int
+	timeToAct time.Time
+
+	cancelOnce sync.Once
+}
+
+// OK false, если резервацию невозможно выполнить ни через какое время
+func (r *Reservation) OK() bool {
+	return r.ok
+}
+
+// Delay сколько ждать с момента now, прежде чем действовать
+func (r *Reservation) Delay(now time.Time) time.Duration {
+	if !r.ok || !r.timeToAct.After(now) {
+		return 0
+	}
+
+	return r.timeToAct.Sub(now)
+}
+
+// Cancel возвращает токены в ведро, чтобы их могли занять следующие. Если время резервации уже наступило,
+// токены считаются израсходованными. Повторный вызов ничего не делает
+func (r *Reservation) Cancel() {
+	if !r.ok {
+		return
+	}
+
+	r.cancelOnce.Do(func() {
+		r.limiter.mu.Lock()
+		defer r.limiter.mu.Unlock()
+
+		now := time.Now()
+		if !r.timeToAct.After(now) {
+			return
+		}
+
+		r.limiter.advance(now)
+		r.limiter.tokens = math.Min(float64(r.limiter.burst), r.limiter.tokens+float64(r.tokens))
+	})
+}
+
+func durationFromTokens(tokens float64, rate float64) time.Duration {
+	return time.Duration(tokens / rate * float64(time.Second))
+}
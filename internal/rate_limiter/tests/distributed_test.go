@@ -0,0 +1,180 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"di_container/internal/client/db"
+	dbMocks "di_container/internal/client/db/mocks"
+	"di_container/internal/model"
+	"di_container/internal/rate_limiter"
+	repoMocks "di_container/internal/repository/mocks"
+	"di_container/internal/tenant"
+)
+
+func newTxManagerMock(mc *minimock.Controller) *dbMocks.TxManagerMock {
+	txManagerMock := dbMocks.NewTxManagerMock(mc)
+	txManagerMock.ReadCommittedMock.Optional().Set(func(ctx context.Context, f db.Handler) error {
+		return f(ctx)
+	})
+
+	return txManagerMock
+}
+
+func TestDistributedLimiter(t *testing.T) {
+	t.Parallel()
+
+	ctx := tenant.WithTenant(context.Background(), "acme")
+	now := time.Date(2024, 7, 25, 12, 0, 0, 0, time.UTC)
+
+	t.Run("serves batch from local cache", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		repoMock := repoMocks.NewRateLimitBucketRepositoryMock(mc)
+		repoMock.LockMock.Times(1).Expect(ctx, "user:a", method, 10).Return(&model.RateLimitBucket{
+			Tokens:    10,
+			UpdatedAt: now,
+			Now:       now,
+		}, nil)
+		repoMock.UpdateMock.Times(1).Expect(ctx, "user:a", method, 7, now).Return(nil)
+
+		limiter := rate_limiter.NewDistributedLimiter(ctx, repoMock, newTxManagerMock(mc), rate_limiter.Policy{Rate: 1, Burst: 10}, nil, 3, time.Minute)
+
+		for i := 0; i < 3; i++ {
+			allowed, _, err := limiter.Allow(ctx, "user:a", method)
+			require.NoError(t, err)
+			require.True(t, allowed)
+		}
+	})
+
+	t.Run("refills by database time and caches denial", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		repoMock := repoMocks.NewRateLimitBucketRepositoryMock(mc)
+		repoMock.LockMock.Times(1).Return(&model.RateLimitBucket{
+			Tokens:    0,
+			UpdatedAt: now.Add(-500 * time.Millisecond),
+			Now:       now,
+		}, nil)
+
+		limiter := rate_limiter.NewDistributedLimiter(ctx, repoMock, newTxManagerMock(mc), rate_limiter.Policy{Rate: 1, Burst: 10}, nil, 3, time.Minute)
+
+		allowed, retryAfter, err := limiter.Allow(ctx, "user:a", method)
+		require.NoError(t, err)
+		require.False(t, allowed)
+		require.Equal(t, 500*time.Millisecond, retryAfter)
+
+		allowed, retryAfter, err = limiter.Allow(ctx, "user:a", method)
+		require.NoError(t, err)
+		require.False(t, allowed)
+		require.Greater(t, retryAfter, time.Duration(0))
+	})
+
+	t.Run("tenants do not share local cache", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		repoMock := repoMocks.NewRateLimitBucketRepositoryMock(mc)
+		repoMock.LockMock.Times(2).Return(&model.RateLimitBucket{Tokens: 10, UpdatedAt: now, Now: now}, nil)
+		repoMock.UpdateMock.Times(2).Return(nil)
+
+		limiter := rate_limiter.NewDistributedLimiter(ctx, repoMock, newTxManagerMock(mc), rate_limiter.Policy{Rate: 1, Burst: 10}, nil, 5, time.Minute)
+
+		allowed, _, err := limiter.Allow(ctx, "ip:127.0.0.1", method)
+		require.NoError(t, err)
+		require.True(t, allowed)
+
+		allowed, _, err = limiter.Allow(tenant.WithTenant(context.Background(), "other"), "ip:127.0.0.1", method)
+		require.NoError(t, err)
+		require.True(t, allowed)
+	})
+
+	t.Run("wait reserves token in debt", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		repoMock := repoMocks.NewRateLimitBucketRepositoryMock(mc)
+		repoMock.LockMock.Times(2).Return(&model.RateLimitBucket{Tokens: 0.5, UpdatedAt: now, Now: now}, nil)
+
+		limiter := rate_limiter.NewDistributedLimiter(ctx, repoMock, newTxManagerMock(mc), rate_limiter.Policy{Rate: 10, Burst: 10}, nil, 3, time.Minute)
+
+		waitCtx, cancel := context.WithTimeout(ctx, time.Second)
+		defer cancel()
+
+		repoMock.UpdateMock.Times(1).Expect(waitCtx, "user:a", method, -0.5, now).Return(nil)
+
+		start := time.Now()
+		allowed, _, err := limiter.Wait(waitCtx, "user:a", method)
+		require.NoError(t, err)
+		require.True(t, allowed)
+		require.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+	})
+
+	t.Run("wait does not reserve token beyond deadline", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		repoMock := repoMocks.NewRateLimitBucketRepositoryMock(mc)
+		repoMock.LockMock.Times(2).Return(&model.RateLimitBucket{Tokens: 0, UpdatedAt: now, Now: now}, nil)
+
+		limiter := rate_limiter.NewDistributedLimiter(ctx, repoMock, newTxManagerMock(mc), rate_limiter.Policy{Rate: 1, Burst: 10}, nil, 3, time.Minute)
+
+		waitCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+
+		allowed, retryAfter, err := limiter.Wait(waitCtx, "user:a", method)
+		require.NoError(t, err)
+		require.False(t, allowed)
+		require.Equal(t, time.Second, retryAfter)
+	})
+
+	t.Run("deletes idle buckets of active tenants", func(t *testing.T) {
+		t.Parallel()
+
+		evictionCtx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		mc := minimock.NewController(t)
+		repoMock := repoMocks.NewRateLimitBucketRepositoryMock(mc)
+		repoMock.LockMock.Return(&model.RateLimitBucket{Tokens: 10, UpdatedAt: now, Now: now}, nil)
+		repoMock.UpdateMock.Return(nil)
+
+		// Ведро хранится, пока не наполнится заново, даже если idleTimeout короче
+		deleted := make(chan string, 10)
+		repoMock.DeleteIdleMock.Set(func(ctx context.Context, idleTimeout time.Duration) error {
+			require.Equal(t, 10*time.Second, idleTimeout)
+			deleted <- tenant.FromContext(ctx)
+			return nil
+		})
+
+		limiter := rate_limiter.NewDistributedLimiter(evictionCtx, repoMock, newTxManagerMock(mc), rate_limiter.Policy{Rate: 1, Burst: 10}, nil, 3, 10*time.Millisecond)
+
+		allowed, _, err := limiter.Allow(ctx, "user:a", method)
+		require.NoError(t, err)
+		require.True(t, allowed)
+
+		require.Equal(t, "acme", <-deleted)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		t.Parallel()
+
+		repoErr := errors.New("connection refused")
+
+		mc := minimock.NewController(t)
+		repoMock := repoMocks.NewRateLimitBucketRepositoryMock(mc)
+		repoMock.LockMock.Return(nil, repoErr)
+
+		limiter := rate_limiter.NewDistributedLimiter(ctx, repoMock, newTxManagerMock(mc), rate_limiter.Policy{Rate: 1, Burst: 10}, nil, 5, time.Minute)
+
+		_, _, err := limiter.Allow(ctx, "user:a", method)
+		require.ErrorIs(t, err, repoErr)
+	})
+}
@@ -0,0 +1,179 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"di_container/internal/rate_limiter"
+)
+
+const (
+	method      = "/note_v1.NoteV1/Get"
+	otherMethod = "/note_v1.NoteV1/Create"
+)
+
+func TestKeyedLimiter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("burst then reject with retry after", func(t *testing.T) {
+		t.Parallel()
+
+		limiter := rate_limiter.NewKeyedLimiter(ctx, rate_limiter.Policy{Rate: 1, Burst: 2}, nil, time.Minute)
+
+		for i := 0; i < 2; i++ {
+			allowed, _, _ := limiter.Allow(ctx, "user:a", method)
+			require.True(t, allowed)
+		}
+
+		allowed, retryAfter, _ := limiter.Allow(ctx, "user:a", method)
+		require.False(t, allowed)
+		require.Greater(t, retryAfter, time.Duration(0))
+		require.LessOrEqual(t, retryAfter, time.Second)
+	})
+
+	t.Run("keys and methods have separate buckets", func(t *testing.T) {
+		t.Parallel()
+
+		limiter := rate_limiter.NewKeyedLimiter(ctx, rate_limiter.Policy{Rate: 0, Burst: 1}, nil, time.Minute)
+
+		allowed, _, _ := limiter.Allow(ctx, "user:a", method)
+		require.True(t, allowed)
+
+		allowed, _, _ = limiter.Allow(ctx, "user:a", method)
+		require.False(t, allowed)
+
+		allowed, _, _ = limiter.Allow(ctx, "user:b", method)
+		require.True(t, allowed)
+
+		allowed, _, _ = limiter.Allow(ctx, "user:a", otherMethod)
+		require.True(t, allowed)
+	})
+
+	t.Run("method policy overrides default", func(t *testing.T) {
+		t.Parallel()
+
+		limiter := rate_limiter.NewKeyedLimiter(
+			context.Background(),
+			rate_limiter.Policy{Rate: 0, Burst: 5},
+			map[string]rate_limiter.Policy{otherMethod: {Rate: 0, Burst: 1}},
+			time.Minute,
+		)
+
+		allowed, _, _ := limiter.Allow(ctx, "ip:127.0.0.1", otherMethod)
+		require.True(t, allowed)
+
+		allowed, _, _ = limiter.Allow(ctx, "ip:127.0.0.1", otherMethod)
+		require.False(t, allowed)
+
+		for i := 0; i < 5; i++ {
+			allowed, _, _ = limiter.Allow(ctx, "ip:127.0.0.1", method)
+			require.True(t, allowed)
+		}
+	})
+
+	t.Run("refills over time", func(t *testing.T) {
+		t.Parallel()
+
+		limiter := rate_limiter.NewKeyedLimiter(ctx, rate_limiter.Policy{Rate: 100, Burst: 1}, nil, time.Minute)
+
+		allowed, _, _ := limiter.Allow(ctx, "user:a", method)
+		require.True(t, allowed)
+
+		allowed, retryAfter, _ := limiter.Allow(ctx, "user:a", method)
+		require.False(t, allowed)
+
+		time.Sleep(retryAfter + 5*time.Millisecond)
+
+		allowed, _, _ = limiter.Allow(ctx, "user:a", method)
+		require.True(t, allowed)
+	})
+
+	t.Run("wait reserves token for each waiter", func(t *testing.T) {
+		t.Parallel()
+
+		limiter := rate_limiter.NewKeyedLimiter(ctx, rate_limiter.Policy{Rate: 20, Burst: 1}, nil, time.Minute)
+
+		allowed, _, _ := limiter.Allow(ctx, "user:a", method)
+		require.True(t, allowed)
+
+		waitCtx, cancel := context.WithTimeout(ctx, time.Second)
+		defer cancel()
+
+		// Каждый ожидающий получает свой токен, а не повторяет попытку после общей паузы
+		start := time.Now()
+		results := make(chan bool, 2)
+		for i := 0; i < 2; i++ {
+			go func() {
+				allowed, _, err := limiter.Wait(waitCtx, "user:a", method)
+				require.NoError(t, err)
+				results <- allowed
+			}()
+		}
+
+		require.True(t, <-results)
+		require.True(t, <-results)
+		require.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+	})
+
+	t.Run("wait does not reserve token beyond deadline", func(t *testing.T) {
+		t.Parallel()
+
+		limiter := rate_limiter.NewKeyedLimiter(ctx, rate_limiter.Policy{Rate: 1, Burst: 1}, nil, time.Minute)
+
+		allowed, _, _ := limiter.Allow(ctx, "user:a", method)
+		require.True(t, allowed)
+
+		waitCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+
+		allowed, retryAfter, err := limiter.Wait(waitCtx, "user:a", method)
+		require.NoError(t, err)
+		require.False(t, allowed)
+		require.Greater(t, retryAfter, 900*time.Millisecond)
+
+		// Отмененный резерв не отодвигает следующий токен
+		_, retryAfter, _ = limiter.Allow(ctx, "user:a", method)
+		require.LessOrEqual(t, retryAfter, time.Second)
+	})
+
+	t.Run("idle bucket is evicted", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		// Без удаления ведро с нулевой скоростью никогда не наполнится снова
+		limiter := rate_limiter.NewKeyedLimiter(ctx, rate_limiter.Policy{Rate: 0, Burst: 1}, nil, 10*time.Millisecond)
+
+		allowed, _, _ := limiter.Allow(ctx, "user:a", method)
+		require.True(t, allowed)
+
+		require.Eventually(t, func() bool {
+			allowed, _, _ := limiter.Allow(ctx, "user:a", method)
+			return allowed
+		}, time.Second, 50*time.Millisecond)
+	})
+
+	t.Run("bucket is not evicted before refill", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		// Слишком короткий idleTimeout не должен возвращать клиенту полное ведро раньше срока
+		limiter := rate_limiter.NewKeyedLimiter(ctx, rate_limiter.Policy{Rate: 1, Burst: 1}, nil, 10*time.Millisecond)
+
+		allowed, _, _ := limiter.Allow(ctx, "user:a", method)
+		require.True(t, allowed)
+
+		time.Sleep(100 * time.Millisecond)
+
+		allowed, _, _ = limiter.Allow(ctx, "user:a", method)
+		require.False(t, allowed)
+	})
+}
@@ -0,0 +1,152 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"di_container/internal/rate_limiter"
+)
+
+func TestTokenBucketLimiter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("allow n", func(t *testing.T) {
+		t.Parallel()
+
+		limiter := rate_limiter.NewTokenBucketLimiter(1, 5)
+		now := time.Now()
+
+		require.True(t, limiter.AllowN(now, 3))
+		require.False(t, limiter.AllowN(now, 3))
+		require.True(t, limiter.AllowN(now, 2))
+		require.False(t, limiter.AllowN(now, 1))
+
+		// Скорость меньше одного токена за тик старой реализации
+		require.False(t, limiter.AllowN(now.Add(500*time.Millisecond), 1))
+		require.True(t, limiter.AllowN(now.Add(time.Second), 1))
+	})
+
+	t.Run("reserve queues and cancel returns tokens", func(t *testing.T) {
+		t.Parallel()
+
+		limiter := rate_limiter.NewTokenBucketLimiter(10, 1)
+		now := time.Now()
+
+		first := limiter.Reserve(now, 1)
+		require.True(t, first.OK())
+		require.Zero(t, first.Delay(now))
+
+		second := limiter.Reserve(now, 1)
+		require.True(t, second.OK())
+		require.Equal(t, 100*time.Millisecond, second.Delay(now))
+
+		third := limiter.Reserve(now, 1)
+		require.Equal(t, 200*time.Millisecond, third.Delay(now))
+
+		third.Cancel()
+		second.Cancel()
+		second.Cancel()
+
+		// Cancel начисляет токены по текущему времени, поэтому задержка чуть меньше
+		require.InDelta(t, 100*time.Millisecond, limiter.Reserve(now, 1).Delay(now), float64(10*time.Millisecond))
+	})
+
+	t.Run("reserve more than burst", func(t *testing.T) {
+		t.Parallel()
+
+		limiter := rate_limiter.NewTokenBucketLimiter(10, 2)
+
+		r := limiter.Reserve(time.Now(), 3)
+		require.False(t, r.OK())
+		require.Zero(t, r.Delay(time.Now()))
+	})
+
+	t.Run("wait", func(t *testing.T) {
+		t.Parallel()
+
+		limiter := rate_limiter.NewTokenBucketLimiter(100, 1)
+		require.True(t, limiter.Allow())
+
+		start := time.Now()
+		require.NoError(t, limiter.Wait(context.Background()))
+		require.GreaterOrEqual(t, time.Since(start), 5*time.Millisecond)
+	})
+
+	t.Run("wait beyond deadline", func(t *testing.T) {
+		t.Parallel()
+
+		limiter := rate_limiter.NewTokenBucketLimiter(1, 1)
+		require.True(t, limiter.Allow())
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		require.ErrorIs(t, limiter.Wait(ctx), rate_limiter.ErrWaitDeadline)
+
+		// Отмененное ожидание не занимает токен
+		require.True(t, limiter.AllowN(time.Now().Add(time.Second), 1))
+	})
+
+	t.Run("wait with zero rate", func(t *testing.T) {
+		t.Parallel()
+
+		limiter := rate_limiter.NewTokenBucketLimiter(0, 1)
+		require.True(t, limiter.Allow())
+		require.ErrorIs(t, limiter.Wait(context.Background()), rate_limiter.ErrExceedsLimit)
+	})
+
+	t.Run("set limit", func(t *testing.T) {
+		t.Parallel()
+
+		limiter := rate_limiter.NewTokenBucketLimiter(1, 10)
+		now := time.Now()
+
+		limiter.SetLimit(1000, 2)
+		require.True(t, limiter.AllowN(now, 2))
+		require.False(t, limiter.AllowN(now, 1))
+		require.Eventually(t, limiter.Allow, time.Second, time.Millisecond)
+	})
+}
+
+func BenchmarkTokenBucketLimiterAllow(b *testing.B) {
+	limiter := rate_limiter.NewTokenBucketLimiter(1e9, 1e9)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		limiter.Allow()
+	}
+}
+
+func BenchmarkTokenBucketLimiterAllowParallel(b *testing.B) {
+	limiter := rate_limiter.NewTokenBucketLimiter(1e9, 1e9)
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			limiter.Allow()
+		}
+	})
+}
+
+func BenchmarkTokenBucketLimiterReserve(b *testing.B) {
+	limiter := rate_limiter.NewTokenBucketLimiter(1e9, 1e9)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		limiter.Reserve(time.Now(), 1)
+	}
+}
+
+func BenchmarkKeyedLimiterAllow(b *testing.B) {
+	ctx := context.Background()
+	limiter := rate_limiter.NewKeyedLimiter(ctx, rate_limiter.Policy{Rate: 1e9, Burst: 1e9}, nil, time.Minute)
+	keys := []string{"user:a", "user:b", "ip:127.0.0.1", "api_key:c"}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _, _ = limiter.Allow(ctx, keys[i%len(keys)], method)
+	}
+}
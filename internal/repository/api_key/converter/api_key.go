@@ -0,0 +1,32 @@
+package converter
+
+import (
+	"di_container/internal/model"
+	modelRepo "di_container/internal/repository/api_key/model"
+)
+
+func ToAPIKeyFromRepo(key *modelRepo.APIKey) *model.APIKey {
+	return &model.APIKey{
+		ID:       key.ID,
+		Username: key.Username,
+		Info: model.APIKeyInfo{
+			Name:      key.Name,
+			Scopes:    key.Scopes,
+			ExpiresAt: key.ExpiresAt,
+		},
+		Prefix:     key.Prefix,
+		Hash:       key.Hash,
+		LastUsedAt: key.LastUsedAt,
+		CreatedAt:  key.CreatedAt,
+		RevokedAt:  key.RevokedAt,
+	}
+}
+
+func ToAPIKeysFromRepo(keys []*modelRepo.APIKey) []*model.APIKey {
+	res := make([]*model.APIKey, 0, len(keys))
+	for _, key := range keys {
+		res = append(res, ToAPIKeyFromRepo(key))
+	}
+
+	return res
+}
@@ -0,0 +1,19 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+type APIKey struct {
+	ID         int64        `db:"id"`
+	Username   string       `db:"username"`
+	Name       string       `db:"name"`
+	Prefix     string       `db:"prefix"`
+	Hash       string       `db:"key_hash"`
+	Scopes     []string     `db:"scopes"`
+	ExpiresAt  sql.NullTime `db:"expires_at"`
+	LastUsedAt sql.NullTime `db:"last_used_at"`
+	CreatedAt  time.Time    `db:"created_at"`
+	RevokedAt  sql.NullTime `db:"revoked_at"`
+}
@@ -0,0 +1,166 @@
+package api_key
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+
+	"di_container/internal/client/db"
+	"di_container/internal/model"
+	"di_container/internal/repository"
+	"di_container/internal/repository/api_key/converter"
+	modelRepo "di_container/internal/repository/api_key/model"
+	"di_container/internal/repository/scoped"
+)
+
+const (
+	tableName = "api_key"
+
+	idColumn         = "id"
+	usernameColumn   = "username"
+	nameColumn       = "name"
+	prefixColumn     = "prefix"
+	keyHashColumn    = "key_hash"
+	scopesColumn     = "scopes"
+	expiresAtColumn  = "expires_at"
+	lastUsedAtColumn = "last_used_at"
+	createdAtColumn  = "created_at"
+	revokedAtColumn  = "revoked_at"
+
+	// touchInterval как часто обновляется время последнего использования ключа.
+	// Без него каждый запрос с ключом был бы записью в базу
+	touchInterval = time.Minute
+)
+
+var columns = []string{idColumn, usernameColumn, nameColumn, prefixColumn, keyHashColumn, scopesColumn, expiresAtColumn, lastUsedAtColumn, createdAtColumn, revokedAtColumn}
+
+type repo struct {
+	db db.Client
+}
+
+func NewRepository(db db.Client) repository.APIKeyRepository {
+	return &repo{db: db}
+}
+
+func (r *repo) Create(ctx context.Context, key *model.APIKey) (int64, error) {
+	builder := scoped.Insert(ctx, tableName).
+		Columns(usernameColumn, nameColumn, prefixColumn, keyHashColumn, scopesColumn, expiresAtColumn).
+		Values(key.Username, key.Info.Name, key.Prefix, key.Hash, key.Info.Scopes, key.Info.ExpiresAt).
+		Suffix("RETURNING id")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "api_key_repository.Create",
+		QueryRaw: query,
+	}
+
+	var id int64
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (r *repo) Get(ctx context.Context, id int64) (*model.APIKey, error) {
+	return r.get(ctx, "api_key_repository.Get", sq.Eq{idColumn: id})
+}
+
+func (r *repo) GetByHash(ctx context.Context, hash string) (*model.APIKey, error) {
+	return r.get(ctx, "api_key_repository.GetByHash", sq.Eq{keyHashColumn: hash})
+}
+
+// ListActive возвращает неотозванные и неистекшие ключи пользователя
+func (r *repo) ListActive(ctx context.Context, username string) ([]*model.APIKey, error) {
+	builder := scoped.Select(ctx, columns...).
+		From(tableName).
+		Where(sq.Eq{usernameColumn: username, revokedAtColumn: nil}).
+		Where(sq.Or{sq.Eq{expiresAtColumn: nil}, sq.Gt{expiresAtColumn: time.Now()}}).
+		OrderBy(createdAtColumn + " DESC")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "api_key_repository.ListActive",
+		QueryRaw: query,
+	}
+
+	var keys []*modelRepo.APIKey
+	err = r.db.DB().ScanAllContext(ctx, &keys, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToAPIKeysFromRepo(keys), nil
+}
+
+func (r *repo) Revoke(ctx context.Context, id int64) error {
+	builder := scoped.Update(ctx, tableName).
+		Set(revokedAtColumn, sq.Expr("now()")).
+		Where(sq.Eq{idColumn: id, revokedAtColumn: nil})
+
+	return r.exec(ctx, "api_key_repository.Revoke", builder)
+}
+
+// Touch отмечает использование ключа, но не чаще раза в touchInterval
+func (r *repo) Touch(ctx context.Context, id int64) error {
+	builder := scoped.Update(ctx, tableName).
+		Set(lastUsedAtColumn, sq.Expr("now()")).
+		Where(sq.Eq{idColumn: id}).
+		Where(sq.Or{sq.Eq{lastUsedAtColumn: nil}, sq.Lt{lastUsedAtColumn: time.Now().Add(-touchInterval)}})
+
+	return r.exec(ctx, "api_key_repository.Touch", builder)
+}
+
+func (r *repo) get(ctx context.Context, name string, where sq.Eq) (*model.APIKey, error) {
+	builder := scoped.Select(ctx, columns...).
+		From(tableName).
+		Where(where).
+		Limit(1)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     name,
+		QueryRaw: query,
+	}
+
+	var key modelRepo.APIKey
+	err = r.db.DB().ScanOneContext(ctx, &key, q, args...)
+	if pgxscan.NotFound(err) {
+		return nil, repository.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToAPIKeyFromRepo(&key), nil
+}
+
+func (r *repo) exec(ctx context.Context, name string, builder sq.UpdateBuilder) error {
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     name,
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
@@ -0,0 +1,32 @@
+package converter
+
+import (
+	"di_container/internal/model"
+	modelRepo "di_container/internal/repository/audit_event/model"
+)
+
+func ToAuditEventFromRepo(event *modelRepo.AuditEvent) *model.AuditEvent {
+	return &model.AuditEvent{
+		ID:           event.ID,
+		Type:         event.Type,
+		Actor:        event.Actor,
+		Impersonator: event.Impersonator,
+		Subject:      event.Subject,
+		IP:           event.ClientIP,
+		Method:       event.Method,
+		Outcome:      event.Outcome,
+		Reason:       event.Reason,
+		PrevHash:     event.PrevHash,
+		Hash:         event.Hash,
+		CreatedAt:    event.CreatedAt,
+	}
+}
+
+func ToAuditEventsFromRepo(events []*modelRepo.AuditEvent) []*model.AuditEvent {
+	res := make([]*model.AuditEvent, 0, len(events))
+	for _, e := range events {
+		res = append(res, ToAuditEventFromRepo(e))
+	}
+
+	return res
+}
@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+type AuditEvent struct {
+	ID           int64     `db:"id"`
+	Type         string    `db:"type"`
+	Actor        string    `db:"actor"`
+	Impersonator string    `db:"impersonator"`
+	Subject      string    `db:"subject"`
+	ClientIP     string    `db:"client_ip"`
+	Method       string    `db:"method"`
+	Outcome      string    `db:"outcome"`
+	Reason       string    `db:"reason"`
+	PrevHash     string    `db:"prev_hash"`
+	Hash         string    `db:"hash"`
+	CreatedAt    time.Time `db:"created_at"`
+}
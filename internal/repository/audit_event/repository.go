@@ -0,0 +1,156 @@
+package audit_event
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+
+	"di_container/internal/client/db"
+	"di_container/internal/model"
+	"di_container/internal/repository"
+	"di_container/internal/repository/audit_event/converter"
+	modelRepo "di_container/internal/repository/audit_event/model"
+	"di_container/internal/repository/scoped"
+	"di_container/internal/tenant"
+)
+
+const (
+	tableName = "audit_event"
+
+	idColumn           = "id"
+	typeColumn         = "type"
+	actorColumn        = "actor"
+	impersonatorColumn = "impersonator"
+	subjectColumn      = "subject"
+	clientIPColumn     = "client_ip"
+	methodColumn       = "method"
+	outcomeColumn      = "outcome"
+	reasonColumn       = "reason"
+	prevHashColumn     = "prev_hash"
+	hashColumn         = "hash"
+	createdAtColumn    = "created_at"
+
+	// chainLockKey ключ advisory блокировки, которая упорядочивает запись в цепочку хешей
+	chainLockKey = 7307001
+)
+
+var columns = []string{idColumn, typeColumn, actorColumn, impersonatorColumn, subjectColumn, clientIPColumn, methodColumn, outcomeColumn, reasonColumn, prevHashColumn, hashColumn, createdAtColumn}
+
+type repo struct {
+	db db.Client
+}
+
+func NewRepository(db db.Client) repository.AuditEventRepository {
+	return &repo{db: db}
+}
+
+// LockChain блокирует цепочку арендатора до конца транзакции, чтобы два события не сослались на один и тот же
+// предыдущий хеш. У каждого арендатора своя цепочка. Вне транзакции блокировка бесполезна
+func (r *repo) LockChain(ctx context.Context) error {
+	tenantID := tenant.FromContext(ctx)
+	if tenantID == "" {
+		return scoped.ErrNoTenant
+	}
+
+	q := db.Query{
+		Name:     "audit_event_repository.LockChain",
+		QueryRaw: "SELECT pg_advisory_xact_lock($1, hashtext($2))",
+	}
+
+	_, err := r.db.DB().ExecContext(ctx, q, chainLockKey, tenantID)
+	return err
+}
+
+// LastHash возвращает хеш последнего события или пустую строку, если журнал пуст
+func (r *repo) LastHash(ctx context.Context) (string, error) {
+	builder := scoped.Select(ctx, hashColumn).
+		From(tableName).
+		OrderBy(idColumn + " DESC").
+		Limit(1)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return "", err
+	}
+
+	q := db.Query{
+		Name:     "audit_event_repository.LastHash",
+		QueryRaw: query,
+	}
+
+	var hash string
+	err = r.db.DB().ScanOneContext(ctx, &hash, q, args...)
+	if pgxscan.NotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return hash, nil
+}
+
+func (r *repo) Create(ctx context.Context, event *model.AuditEvent) (int64, error) {
+	builder := scoped.Insert(ctx, tableName).
+		Columns(typeColumn, actorColumn, impersonatorColumn, subjectColumn, clientIPColumn, methodColumn, outcomeColumn, reasonColumn, prevHashColumn, hashColumn, createdAtColumn).
+		Values(event.Type, event.Actor, event.Impersonator, event.Subject, event.IP, event.Method, event.Outcome, event.Reason, event.PrevHash, event.Hash, event.CreatedAt).
+		Suffix("RETURNING id")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "audit_event_repository.Create",
+		QueryRaw: query,
+	}
+
+	var id int64
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// List возвращает события в порядке записи, начиная после filter.AfterID
+func (r *repo) List(ctx context.Context, filter *model.AuditFilter) ([]*model.AuditEvent, error) {
+	builder := scoped.Select(ctx, columns...).
+		From(tableName).
+		Where(sq.Gt{idColumn: filter.AfterID}).
+		OrderBy(idColumn + " ASC")
+
+	if !filter.From.IsZero() {
+		builder = builder.Where(sq.GtOrEq{createdAtColumn: filter.From})
+	}
+	if !filter.To.IsZero() {
+		builder = builder.Where(sq.Lt{createdAtColumn: filter.To})
+	}
+	if filter.Actor != "" {
+		builder = builder.Where(sq.Or{sq.Eq{actorColumn: filter.Actor}, sq.Eq{impersonatorColumn: filter.Actor}})
+	}
+	if filter.Limit > 0 {
+		builder = builder.Limit(filter.Limit)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "audit_event_repository.List",
+		QueryRaw: query,
+	}
+
+	var events []*modelRepo.AuditEvent
+	err = r.db.DB().ScanAllContext(ctx, &events, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToAuditEventsFromRepo(events), nil
+}
@@ -2,3 +2,13 @@ package repository
 
 //go:generate sh -c "rm -rf mocks && mkdir -p mocks"
 //go:generate minimock -i NoteRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i RefreshTokenRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i SessionRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i UserRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i LoginAttemptRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i RecoveryCodeRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i UserTokenRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i APIKeyRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i AuditEventRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i UsageRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i RateLimitBucketRepository -o ./mocks/ -s "_minimock.go"
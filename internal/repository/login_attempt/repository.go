@@ -0,0 +1,125 @@
+package login_attempt
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"di_container/internal/client/db"
+	"di_container/internal/repository"
+	"di_container/internal/repository/scoped"
+)
+
+const (
+	tableName = "login_attempt"
+
+	keyColumn           = "key"
+	failuresColumn      = "failures"
+	lastFailureAtColumn = "last_failure_at"
+	lockedUntilColumn   = "locked_until"
+)
+
+type repo struct {
+	db db.Client
+}
+
+func NewRepository(db db.Client) repository.LoginAttemptRepository {
+	return &repo{db: db}
+}
+
+// GetLockedUntil возвращает самое позднее время блокировки среди ключей или нулевое время, если блокировок нет
+func (r *repo) GetLockedUntil(ctx context.Context, keys ...string) (time.Time, error) {
+	builder := scoped.Select(ctx, "max("+lockedUntilColumn+")").
+		From(tableName).
+		Where(sq.Eq{keyColumn: keys})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	q := db.Query{
+		Name:     "login_attempt_repository.GetLockedUntil",
+		QueryRaw: query,
+	}
+
+	var lockedUntil sql.NullTime
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&lockedUntil)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return lockedUntil.Time, nil
+}
+
+// RegisterFailure увеличивает счетчик неудачных попыток и возвращает его новое значение.
+// Если предыдущая неудачная попытка была раньше, чем window назад, счетчик начинается заново
+func (r *repo) RegisterFailure(ctx context.Context, key string, window time.Duration) (int64, error) {
+	builder := scoped.Insert(ctx, tableName).
+		Columns(keyColumn, failuresColumn).
+		Values(key, 1).
+		Suffix(`ON CONFLICT (`+scoped.TenantColumn+`, `+keyColumn+`) DO UPDATE SET
+			`+failuresColumn+` = CASE
+				WHEN `+tableName+`.`+lastFailureAtColumn+` < now() - make_interval(secs => ?) THEN 1
+				ELSE `+tableName+`.`+failuresColumn+` + 1
+			END,
+			`+lastFailureAtColumn+` = now()
+		RETURNING `+failuresColumn, window.Seconds())
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "login_attempt_repository.RegisterFailure",
+		QueryRaw: query,
+	}
+
+	var failures int64
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&failures)
+	if err != nil {
+		return 0, err
+	}
+
+	return failures, nil
+}
+
+func (r *repo) Lock(ctx context.Context, key string, until time.Time) error {
+	builder := scoped.Update(ctx, tableName).
+		Set(lockedUntilColumn, until).
+		Where(sq.Eq{keyColumn: key})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "login_attempt_repository.Lock",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
+
+func (r *repo) Reset(ctx context.Context, key string) error {
+	builder := scoped.Delete(ctx, tableName).
+		Where(sq.Eq{keyColumn: key})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "login_attempt_repository.Reset",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
@@ -0,0 +1,2058 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.13). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i di_container/internal/repository.APIKeyRepository -o api_key_repository_minimock.go -n APIKeyRepositoryMock -p mocks
+
+import (
+	"context"
+	"di_container/internal/model"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// APIKeyRepositoryMock implements repository.APIKeyRepository
+type APIKeyRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreate          func(ctx context.Context, key *model.APIKey) (i1 int64, err error)
+	inspectFuncCreate   func(ctx context.Context, key *model.APIKey)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mAPIKeyRepositoryMockCreate
+
+	funcGet          func(ctx context.Context, id int64) (ap1 *model.APIKey, err error)
+	inspectFuncGet   func(ctx context.Context, id int64)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mAPIKeyRepositoryMockGet
+
+	funcGetByHash          func(ctx context.Context, hash string) (ap1 *model.APIKey, err error)
+	inspectFuncGetByHash   func(ctx context.Context, hash string)
+	afterGetByHashCounter  uint64
+	beforeGetByHashCounter uint64
+	GetByHashMock          mAPIKeyRepositoryMockGetByHash
+
+	funcListActive          func(ctx context.Context, username string) (apa1 []*model.APIKey, err error)
+	inspectFuncListActive   func(ctx context.Context, username string)
+	afterListActiveCounter  uint64
+	beforeListActiveCounter uint64
+	ListActiveMock          mAPIKeyRepositoryMockListActive
+
+	funcRevoke          func(ctx context.Context, id int64) (err error)
+	inspectFuncRevoke   func(ctx context.Context, id int64)
+	afterRevokeCounter  uint64
+	beforeRevokeCounter uint64
+	RevokeMock          mAPIKeyRepositoryMockRevoke
+
+	funcTouch          func(ctx context.Context, id int64) (err error)
+	inspectFuncTouch   func(ctx context.Context, id int64)
+	afterTouchCounter  uint64
+	beforeTouchCounter uint64
+	TouchMock          mAPIKeyRepositoryMockTouch
+}
+
+// NewAPIKeyRepositoryMock returns a mock for repository.APIKeyRepository
+func NewAPIKeyRepositoryMock(t minimock.Tester) *APIKeyRepositoryMock {
+	m := &APIKeyRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateMock = mAPIKeyRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*APIKeyRepositoryMockCreateParams{}
+
+	m.GetMock = mAPIKeyRepositoryMockGet{mock: m}
+	m.GetMock.callArgs = []*APIKeyRepositoryMockGetParams{}
+
+	m.GetByHashMock = mAPIKeyRepositoryMockGetByHash{mock: m}
+	m.GetByHashMock.callArgs = []*APIKeyRepositoryMockGetByHashParams{}
+
+	m.ListActiveMock = mAPIKeyRepositoryMockListActive{mock: m}
+	m.ListActiveMock.callArgs = []*APIKeyRepositoryMockListActiveParams{}
+
+	m.RevokeMock = mAPIKeyRepositoryMockRevoke{mock: m}
+	m.RevokeMock.callArgs = []*APIKeyRepositoryMockRevokeParams{}
+
+	m.TouchMock = mAPIKeyRepositoryMockTouch{mock: m}
+	m.TouchMock.callArgs = []*APIKeyRepositoryMockTouchParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAPIKeyRepositoryMockCreate struct {
+	optional           bool
+	mock               *APIKeyRepositoryMock
+	defaultExpectation *APIKeyRepositoryMockCreateExpectation
+	expectations       []*APIKeyRepositoryMockCreateExpectation
+
+	callArgs []*APIKeyRepositoryMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// APIKeyRepositoryMockCreateExpectation specifies expectation struct of the APIKeyRepository.Create
+type APIKeyRepositoryMockCreateExpectation struct {
+	mock      *APIKeyRepositoryMock
+	params    *APIKeyRepositoryMockCreateParams
+	paramPtrs *APIKeyRepositoryMockCreateParamPtrs
+	results   *APIKeyRepositoryMockCreateResults
+	Counter   uint64
+}
+
+// APIKeyRepositoryMockCreateParams contains parameters of the APIKeyRepository.Create
+type APIKeyRepositoryMockCreateParams struct {
+	ctx context.Context
+	key *model.APIKey
+}
+
+// APIKeyRepositoryMockCreateParamPtrs contains pointers to parameters of the APIKeyRepository.Create
+type APIKeyRepositoryMockCreateParamPtrs struct {
+	ctx *context.Context
+	key **model.APIKey
+}
+
+// APIKeyRepositoryMockCreateResults contains results of the APIKeyRepository.Create
+type APIKeyRepositoryMockCreateResults struct {
+	i1  int64
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mAPIKeyRepositoryMockCreate) Optional() *mAPIKeyRepositoryMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for APIKeyRepository.Create
+func (mmCreate *mAPIKeyRepositoryMockCreate) Expect(ctx context.Context, key *model.APIKey) *mAPIKeyRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("APIKeyRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &APIKeyRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("APIKeyRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &APIKeyRepositoryMockCreateParams{ctx, key}
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for APIKeyRepository.Create
+func (mmCreate *mAPIKeyRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mAPIKeyRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("APIKeyRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &APIKeyRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("APIKeyRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &APIKeyRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreate
+}
+
+// ExpectKeyParam2 sets up expected param key for APIKeyRepository.Create
+func (mmCreate *mAPIKeyRepositoryMockCreate) ExpectKeyParam2(key *model.APIKey) *mAPIKeyRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("APIKeyRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &APIKeyRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("APIKeyRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &APIKeyRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.key = &key
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the APIKeyRepository.Create
+func (mmCreate *mAPIKeyRepositoryMockCreate) Inspect(f func(ctx context.Context, key *model.APIKey)) *mAPIKeyRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for APIKeyRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by APIKeyRepository.Create
+func (mmCreate *mAPIKeyRepositoryMockCreate) Return(i1 int64, err error) *APIKeyRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("APIKeyRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &APIKeyRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &APIKeyRepositoryMockCreateResults{i1, err}
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the APIKeyRepository.Create method
+func (mmCreate *mAPIKeyRepositoryMockCreate) Set(f func(ctx context.Context, key *model.APIKey) (i1 int64, err error)) *APIKeyRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the APIKeyRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the APIKeyRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	return mmCreate.mock
+}
+
+// When sets expectation for the APIKeyRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mAPIKeyRepositoryMockCreate) When(ctx context.Context, key *model.APIKey) *APIKeyRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("APIKeyRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &APIKeyRepositoryMockCreateExpectation{
+		mock:   mmCreate.mock,
+		params: &APIKeyRepositoryMockCreateParams{ctx, key},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up APIKeyRepository.Create return parameters for the expectation previously defined by the When method
+func (e *APIKeyRepositoryMockCreateExpectation) Then(i1 int64, err error) *APIKeyRepositoryMock {
+	e.results = &APIKeyRepositoryMockCreateResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times APIKeyRepository.Create should be invoked
+func (mmCreate *mAPIKeyRepositoryMockCreate) Times(n uint64) *mAPIKeyRepositoryMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of APIKeyRepositoryMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	return mmCreate
+}
+
+func (mmCreate *mAPIKeyRepositoryMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements repository.APIKeyRepository
+func (mmCreate *APIKeyRepositoryMock) Create(ctx context.Context, key *model.APIKey) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, key)
+	}
+
+	mm_params := APIKeyRepositoryMockCreateParams{ctx, key}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := APIKeyRepositoryMockCreateParams{ctx, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("APIKeyRepositoryMock.Create got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmCreate.t.Errorf("APIKeyRepositoryMock.Create got unexpected parameter key, want: %#v, got: %#v%s\n", *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("APIKeyRepositoryMock.Create got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the APIKeyRepositoryMock.Create")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, key)
+	}
+	mmCreate.t.Fatalf("Unexpected call to APIKeyRepositoryMock.Create. %v %v", ctx, key)
+	return
+}
+
+// CreateAfterCounter returns a count of finished APIKeyRepositoryMock.Create invocations
+func (mmCreate *APIKeyRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of APIKeyRepositoryMock.Create invocations
+func (mmCreate *APIKeyRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to APIKeyRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mAPIKeyRepositoryMockCreate) Calls() []*APIKeyRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*APIKeyRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *APIKeyRepositoryMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *APIKeyRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to APIKeyRepositoryMock.Create with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to APIKeyRepositoryMock.Create")
+		} else {
+			m.t.Errorf("Expected call to APIKeyRepositoryMock.Create with params: %#v", *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Error("Expected call to APIKeyRepositoryMock.Create")
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to APIKeyRepositoryMock.Create but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), afterCreateCounter)
+	}
+}
+
+type mAPIKeyRepositoryMockGet struct {
+	optional           bool
+	mock               *APIKeyRepositoryMock
+	defaultExpectation *APIKeyRepositoryMockGetExpectation
+	expectations       []*APIKeyRepositoryMockGetExpectation
+
+	callArgs []*APIKeyRepositoryMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// APIKeyRepositoryMockGetExpectation specifies expectation struct of the APIKeyRepository.Get
+type APIKeyRepositoryMockGetExpectation struct {
+	mock      *APIKeyRepositoryMock
+	params    *APIKeyRepositoryMockGetParams
+	paramPtrs *APIKeyRepositoryMockGetParamPtrs
+	results   *APIKeyRepositoryMockGetResults
+	Counter   uint64
+}
+
+// APIKeyRepositoryMockGetParams contains parameters of the APIKeyRepository.Get
+type APIKeyRepositoryMockGetParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// APIKeyRepositoryMockGetParamPtrs contains pointers to parameters of the APIKeyRepository.Get
+type APIKeyRepositoryMockGetParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// APIKeyRepositoryMockGetResults contains results of the APIKeyRepository.Get
+type APIKeyRepositoryMockGetResults struct {
+	ap1 *model.APIKey
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mAPIKeyRepositoryMockGet) Optional() *mAPIKeyRepositoryMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for APIKeyRepository.Get
+func (mmGet *mAPIKeyRepositoryMockGet) Expect(ctx context.Context, id int64) *mAPIKeyRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("APIKeyRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &APIKeyRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("APIKeyRepositoryMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &APIKeyRepositoryMockGetParams{ctx, id}
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for APIKeyRepository.Get
+func (mmGet *mAPIKeyRepositoryMockGet) ExpectCtxParam1(ctx context.Context) *mAPIKeyRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("APIKeyRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &APIKeyRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("APIKeyRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &APIKeyRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGet
+}
+
+// ExpectIdParam2 sets up expected param id for APIKeyRepository.Get
+func (mmGet *mAPIKeyRepositoryMockGet) ExpectIdParam2(id int64) *mAPIKeyRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("APIKeyRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &APIKeyRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("APIKeyRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &APIKeyRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.id = &id
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the APIKeyRepository.Get
+func (mmGet *mAPIKeyRepositoryMockGet) Inspect(f func(ctx context.Context, id int64)) *mAPIKeyRepositoryMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for APIKeyRepositoryMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by APIKeyRepository.Get
+func (mmGet *mAPIKeyRepositoryMockGet) Return(ap1 *model.APIKey, err error) *APIKeyRepositoryMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("APIKeyRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &APIKeyRepositoryMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &APIKeyRepositoryMockGetResults{ap1, err}
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the APIKeyRepository.Get method
+func (mmGet *mAPIKeyRepositoryMockGet) Set(f func(ctx context.Context, id int64) (ap1 *model.APIKey, err error)) *APIKeyRepositoryMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the APIKeyRepository.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the APIKeyRepository.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	return mmGet.mock
+}
+
+// When sets expectation for the APIKeyRepository.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mAPIKeyRepositoryMockGet) When(ctx context.Context, id int64) *APIKeyRepositoryMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("APIKeyRepositoryMock.Get mock is already set by Set")
+	}
+
+	expectation := &APIKeyRepositoryMockGetExpectation{
+		mock:   mmGet.mock,
+		params: &APIKeyRepositoryMockGetParams{ctx, id},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up APIKeyRepository.Get return parameters for the expectation previously defined by the When method
+func (e *APIKeyRepositoryMockGetExpectation) Then(ap1 *model.APIKey, err error) *APIKeyRepositoryMock {
+	e.results = &APIKeyRepositoryMockGetResults{ap1, err}
+	return e.mock
+}
+
+// Times sets number of times APIKeyRepository.Get should be invoked
+func (mmGet *mAPIKeyRepositoryMockGet) Times(n uint64) *mAPIKeyRepositoryMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of APIKeyRepositoryMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	return mmGet
+}
+
+func (mmGet *mAPIKeyRepositoryMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements repository.APIKeyRepository
+func (mmGet *APIKeyRepositoryMock) Get(ctx context.Context, id int64) (ap1 *model.APIKey, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, id)
+	}
+
+	mm_params := APIKeyRepositoryMockGetParams{ctx, id}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ap1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := APIKeyRepositoryMockGetParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("APIKeyRepositoryMock.Get got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGet.t.Errorf("APIKeyRepositoryMock.Get got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("APIKeyRepositoryMock.Get got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the APIKeyRepositoryMock.Get")
+		}
+		return (*mm_results).ap1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, id)
+	}
+	mmGet.t.Fatalf("Unexpected call to APIKeyRepositoryMock.Get. %v %v", ctx, id)
+	return
+}
+
+// GetAfterCounter returns a count of finished APIKeyRepositoryMock.Get invocations
+func (mmGet *APIKeyRepositoryMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of APIKeyRepositoryMock.Get invocations
+func (mmGet *APIKeyRepositoryMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to APIKeyRepositoryMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mAPIKeyRepositoryMockGet) Calls() []*APIKeyRepositoryMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*APIKeyRepositoryMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *APIKeyRepositoryMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *APIKeyRepositoryMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to APIKeyRepositoryMock.Get with params: %#v", *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to APIKeyRepositoryMock.Get")
+		} else {
+			m.t.Errorf("Expected call to APIKeyRepositoryMock.Get with params: %#v", *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Error("Expected call to APIKeyRepositoryMock.Get")
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to APIKeyRepositoryMock.Get but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), afterGetCounter)
+	}
+}
+
+type mAPIKeyRepositoryMockGetByHash struct {
+	optional           bool
+	mock               *APIKeyRepositoryMock
+	defaultExpectation *APIKeyRepositoryMockGetByHashExpectation
+	expectations       []*APIKeyRepositoryMockGetByHashExpectation
+
+	callArgs []*APIKeyRepositoryMockGetByHashParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// APIKeyRepositoryMockGetByHashExpectation specifies expectation struct of the APIKeyRepository.GetByHash
+type APIKeyRepositoryMockGetByHashExpectation struct {
+	mock      *APIKeyRepositoryMock
+	params    *APIKeyRepositoryMockGetByHashParams
+	paramPtrs *APIKeyRepositoryMockGetByHashParamPtrs
+	results   *APIKeyRepositoryMockGetByHashResults
+	Counter   uint64
+}
+
+// APIKeyRepositoryMockGetByHashParams contains parameters of the APIKeyRepository.GetByHash
+type APIKeyRepositoryMockGetByHashParams struct {
+	ctx  context.Context
+	hash string
+}
+
+// APIKeyRepositoryMockGetByHashParamPtrs contains pointers to parameters of the APIKeyRepository.GetByHash
+type APIKeyRepositoryMockGetByHashParamPtrs struct {
+	ctx  *context.Context
+	hash *string
+}
+
+// APIKeyRepositoryMockGetByHashResults contains results of the APIKeyRepository.GetByHash
+type APIKeyRepositoryMockGetByHashResults struct {
+	ap1 *model.APIKey
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetByHash *mAPIKeyRepositoryMockGetByHash) Optional() *mAPIKeyRepositoryMockGetByHash {
+	mmGetByHash.optional = true
+	return mmGetByHash
+}
+
+// Expect sets up expected params for APIKeyRepository.GetByHash
+func (mmGetByHash *mAPIKeyRepositoryMockGetByHash) Expect(ctx context.Context, hash string) *mAPIKeyRepositoryMockGetByHash {
+	if mmGetByHash.mock.funcGetByHash != nil {
+		mmGetByHash.mock.t.Fatalf("APIKeyRepositoryMock.GetByHash mock is already set by Set")
+	}
+
+	if mmGetByHash.defaultExpectation == nil {
+		mmGetByHash.defaultExpectation = &APIKeyRepositoryMockGetByHashExpectation{}
+	}
+
+	if mmGetByHash.defaultExpectation.paramPtrs != nil {
+		mmGetByHash.mock.t.Fatalf("APIKeyRepositoryMock.GetByHash mock is already set by ExpectParams functions")
+	}
+
+	mmGetByHash.defaultExpectation.params = &APIKeyRepositoryMockGetByHashParams{ctx, hash}
+	for _, e := range mmGetByHash.expectations {
+		if minimock.Equal(e.params, mmGetByHash.defaultExpectation.params) {
+			mmGetByHash.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetByHash.defaultExpectation.params)
+		}
+	}
+
+	return mmGetByHash
+}
+
+// ExpectCtxParam1 sets up expected param ctx for APIKeyRepository.GetByHash
+func (mmGetByHash *mAPIKeyRepositoryMockGetByHash) ExpectCtxParam1(ctx context.Context) *mAPIKeyRepositoryMockGetByHash {
+	if mmGetByHash.mock.funcGetByHash != nil {
+		mmGetByHash.mock.t.Fatalf("APIKeyRepositoryMock.GetByHash mock is already set by Set")
+	}
+
+	if mmGetByHash.defaultExpectation == nil {
+		mmGetByHash.defaultExpectation = &APIKeyRepositoryMockGetByHashExpectation{}
+	}
+
+	if mmGetByHash.defaultExpectation.params != nil {
+		mmGetByHash.mock.t.Fatalf("APIKeyRepositoryMock.GetByHash mock is already set by Expect")
+	}
+
+	if mmGetByHash.defaultExpectation.paramPtrs == nil {
+		mmGetByHash.defaultExpectation.paramPtrs = &APIKeyRepositoryMockGetByHashParamPtrs{}
+	}
+	mmGetByHash.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetByHash
+}
+
+// ExpectHashParam2 sets up expected param hash for APIKeyRepository.GetByHash
+func (mmGetByHash *mAPIKeyRepositoryMockGetByHash) ExpectHashParam2(hash string) *mAPIKeyRepositoryMockGetByHash {
+	if mmGetByHash.mock.funcGetByHash != nil {
+		mmGetByHash.mock.t.Fatalf("APIKeyRepositoryMock.GetByHash mock is already set by Set")
+	}
+
+	if mmGetByHash.defaultExpectation == nil {
+		mmGetByHash.defaultExpectation = &APIKeyRepositoryMockGetByHashExpectation{}
+	}
+
+	if mmGetByHash.defaultExpectation.params != nil {
+		mmGetByHash.mock.t.Fatalf("APIKeyRepositoryMock.GetByHash mock is already set by Expect")
+	}
+
+	if mmGetByHash.defaultExpectation.paramPtrs == nil {
+		mmGetByHash.defaultExpectation.paramPtrs = &APIKeyRepositoryMockGetByHashParamPtrs{}
+	}
+	mmGetByHash.defaultExpectation.paramPtrs.hash = &hash
+
+	return mmGetByHash
+}
+
+// Inspect accepts an inspector function that has same arguments as the APIKeyRepository.GetByHash
+func (mmGetByHash *mAPIKeyRepositoryMockGetByHash) Inspect(f func(ctx context.Context, hash string)) *mAPIKeyRepositoryMockGetByHash {
+	if mmGetByHash.mock.inspectFuncGetByHash != nil {
+		mmGetByHash.mock.t.Fatalf("Inspect function is already set for APIKeyRepositoryMock.GetByHash")
+	}
+
+	mmGetByHash.mock.inspectFuncGetByHash = f
+
+	return mmGetByHash
+}
+
+// Return sets up results that will be returned by APIKeyRepository.GetByHash
+func (mmGetByHash *mAPIKeyRepositoryMockGetByHash) Return(ap1 *model.APIKey, err error) *APIKeyRepositoryMock {
+	if mmGetByHash.mock.funcGetByHash != nil {
+		mmGetByHash.mock.t.Fatalf("APIKeyRepositoryMock.GetByHash mock is already set by Set")
+	}
+
+	if mmGetByHash.defaultExpectation == nil {
+		mmGetByHash.defaultExpectation = &APIKeyRepositoryMockGetByHashExpectation{mock: mmGetByHash.mock}
+	}
+	mmGetByHash.defaultExpectation.results = &APIKeyRepositoryMockGetByHashResults{ap1, err}
+	return mmGetByHash.mock
+}
+
+// Set uses given function f to mock the APIKeyRepository.GetByHash method
+func (mmGetByHash *mAPIKeyRepositoryMockGetByHash) Set(f func(ctx context.Context, hash string) (ap1 *model.APIKey, err error)) *APIKeyRepositoryMock {
+	if mmGetByHash.defaultExpectation != nil {
+		mmGetByHash.mock.t.Fatalf("Default expectation is already set for the APIKeyRepository.GetByHash method")
+	}
+
+	if len(mmGetByHash.expectations) > 0 {
+		mmGetByHash.mock.t.Fatalf("Some expectations are already set for the APIKeyRepository.GetByHash method")
+	}
+
+	mmGetByHash.mock.funcGetByHash = f
+	return mmGetByHash.mock
+}
+
+// When sets expectation for the APIKeyRepository.GetByHash which will trigger the result defined by the following
+// Then helper
+func (mmGetByHash *mAPIKeyRepositoryMockGetByHash) When(ctx context.Context, hash string) *APIKeyRepositoryMockGetByHashExpectation {
+	if mmGetByHash.mock.funcGetByHash != nil {
+		mmGetByHash.mock.t.Fatalf("APIKeyRepositoryMock.GetByHash mock is already set by Set")
+	}
+
+	expectation := &APIKeyRepositoryMockGetByHashExpectation{
+		mock:   mmGetByHash.mock,
+		params: &APIKeyRepositoryMockGetByHashParams{ctx, hash},
+	}
+	mmGetByHash.expectations = append(mmGetByHash.expectations, expectation)
+	return expectation
+}
+
+// Then sets up APIKeyRepository.GetByHash return parameters for the expectation previously defined by the When method
+func (e *APIKeyRepositoryMockGetByHashExpectation) Then(ap1 *model.APIKey, err error) *APIKeyRepositoryMock {
+	e.results = &APIKeyRepositoryMockGetByHashResults{ap1, err}
+	return e.mock
+}
+
+// Times sets number of times APIKeyRepository.GetByHash should be invoked
+func (mmGetByHash *mAPIKeyRepositoryMockGetByHash) Times(n uint64) *mAPIKeyRepositoryMockGetByHash {
+	if n == 0 {
+		mmGetByHash.mock.t.Fatalf("Times of APIKeyRepositoryMock.GetByHash mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetByHash.expectedInvocations, n)
+	return mmGetByHash
+}
+
+func (mmGetByHash *mAPIKeyRepositoryMockGetByHash) invocationsDone() bool {
+	if len(mmGetByHash.expectations) == 0 && mmGetByHash.defaultExpectation == nil && mmGetByHash.mock.funcGetByHash == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetByHash.mock.afterGetByHashCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetByHash.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetByHash implements repository.APIKeyRepository
+func (mmGetByHash *APIKeyRepositoryMock) GetByHash(ctx context.Context, hash string) (ap1 *model.APIKey, err error) {
+	mm_atomic.AddUint64(&mmGetByHash.beforeGetByHashCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetByHash.afterGetByHashCounter, 1)
+
+	if mmGetByHash.inspectFuncGetByHash != nil {
+		mmGetByHash.inspectFuncGetByHash(ctx, hash)
+	}
+
+	mm_params := APIKeyRepositoryMockGetByHashParams{ctx, hash}
+
+	// Record call args
+	mmGetByHash.GetByHashMock.mutex.Lock()
+	mmGetByHash.GetByHashMock.callArgs = append(mmGetByHash.GetByHashMock.callArgs, &mm_params)
+	mmGetByHash.GetByHashMock.mutex.Unlock()
+
+	for _, e := range mmGetByHash.GetByHashMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ap1, e.results.err
+		}
+	}
+
+	if mmGetByHash.GetByHashMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetByHash.GetByHashMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetByHash.GetByHashMock.defaultExpectation.params
+		mm_want_ptrs := mmGetByHash.GetByHashMock.defaultExpectation.paramPtrs
+
+		mm_got := APIKeyRepositoryMockGetByHashParams{ctx, hash}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetByHash.t.Errorf("APIKeyRepositoryMock.GetByHash got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.hash != nil && !minimock.Equal(*mm_want_ptrs.hash, mm_got.hash) {
+				mmGetByHash.t.Errorf("APIKeyRepositoryMock.GetByHash got unexpected parameter hash, want: %#v, got: %#v%s\n", *mm_want_ptrs.hash, mm_got.hash, minimock.Diff(*mm_want_ptrs.hash, mm_got.hash))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetByHash.t.Errorf("APIKeyRepositoryMock.GetByHash got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetByHash.GetByHashMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetByHash.t.Fatal("No results are set for the APIKeyRepositoryMock.GetByHash")
+		}
+		return (*mm_results).ap1, (*mm_results).err
+	}
+	if mmGetByHash.funcGetByHash != nil {
+		return mmGetByHash.funcGetByHash(ctx, hash)
+	}
+	mmGetByHash.t.Fatalf("Unexpected call to APIKeyRepositoryMock.GetByHash. %v %v", ctx, hash)
+	return
+}
+
+// GetByHashAfterCounter returns a count of finished APIKeyRepositoryMock.GetByHash invocations
+func (mmGetByHash *APIKeyRepositoryMock) GetByHashAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetByHash.afterGetByHashCounter)
+}
+
+// GetByHashBeforeCounter returns a count of APIKeyRepositoryMock.GetByHash invocations
+func (mmGetByHash *APIKeyRepositoryMock) GetByHashBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetByHash.beforeGetByHashCounter)
+}
+
+// Calls returns a list of arguments used in each call to APIKeyRepositoryMock.GetByHash.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetByHash *mAPIKeyRepositoryMockGetByHash) Calls() []*APIKeyRepositoryMockGetByHashParams {
+	mmGetByHash.mutex.RLock()
+
+	argCopy := make([]*APIKeyRepositoryMockGetByHashParams, len(mmGetByHash.callArgs))
+	copy(argCopy, mmGetByHash.callArgs)
+
+	mmGetByHash.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetByHashDone returns true if the count of the GetByHash invocations corresponds
+// the number of defined expectations
+func (m *APIKeyRepositoryMock) MinimockGetByHashDone() bool {
+	if m.GetByHashMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetByHashMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetByHashMock.invocationsDone()
+}
+
+// MinimockGetByHashInspect logs each unmet expectation
+func (m *APIKeyRepositoryMock) MinimockGetByHashInspect() {
+	for _, e := range m.GetByHashMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to APIKeyRepositoryMock.GetByHash with params: %#v", *e.params)
+		}
+	}
+
+	afterGetByHashCounter := mm_atomic.LoadUint64(&m.afterGetByHashCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetByHashMock.defaultExpectation != nil && afterGetByHashCounter < 1 {
+		if m.GetByHashMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to APIKeyRepositoryMock.GetByHash")
+		} else {
+			m.t.Errorf("Expected call to APIKeyRepositoryMock.GetByHash with params: %#v", *m.GetByHashMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetByHash != nil && afterGetByHashCounter < 1 {
+		m.t.Error("Expected call to APIKeyRepositoryMock.GetByHash")
+	}
+
+	if !m.GetByHashMock.invocationsDone() && afterGetByHashCounter > 0 {
+		m.t.Errorf("Expected %d calls to APIKeyRepositoryMock.GetByHash but found %d calls",
+			mm_atomic.LoadUint64(&m.GetByHashMock.expectedInvocations), afterGetByHashCounter)
+	}
+}
+
+type mAPIKeyRepositoryMockListActive struct {
+	optional           bool
+	mock               *APIKeyRepositoryMock
+	defaultExpectation *APIKeyRepositoryMockListActiveExpectation
+	expectations       []*APIKeyRepositoryMockListActiveExpectation
+
+	callArgs []*APIKeyRepositoryMockListActiveParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// APIKeyRepositoryMockListActiveExpectation specifies expectation struct of the APIKeyRepository.ListActive
+type APIKeyRepositoryMockListActiveExpectation struct {
+	mock      *APIKeyRepositoryMock
+	params    *APIKeyRepositoryMockListActiveParams
+	paramPtrs *APIKeyRepositoryMockListActiveParamPtrs
+	results   *APIKeyRepositoryMockListActiveResults
+	Counter   uint64
+}
+
+// APIKeyRepositoryMockListActiveParams contains parameters of the APIKeyRepository.ListActive
+type APIKeyRepositoryMockListActiveParams struct {
+	ctx      context.Context
+	username string
+}
+
+// APIKeyRepositoryMockListActiveParamPtrs contains pointers to parameters of the APIKeyRepository.ListActive
+type APIKeyRepositoryMockListActiveParamPtrs struct {
+	ctx      *context.Context
+	username *string
+}
+
+// APIKeyRepositoryMockListActiveResults contains results of the APIKeyRepository.ListActive
+type APIKeyRepositoryMockListActiveResults struct {
+	apa1 []*model.APIKey
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListActive *mAPIKeyRepositoryMockListActive) Optional() *mAPIKeyRepositoryMockListActive {
+	mmListActive.optional = true
+	return mmListActive
+}
+
+// Expect sets up expected params for APIKeyRepository.ListActive
+func (mmListActive *mAPIKeyRepositoryMockListActive) Expect(ctx context.Context, username string) *mAPIKeyRepositoryMockListActive {
+	if mmListActive.mock.funcListActive != nil {
+		mmListActive.mock.t.Fatalf("APIKeyRepositoryMock.ListActive mock is already set by Set")
+	}
+
+	if mmListActive.defaultExpectation == nil {
+		mmListActive.defaultExpectation = &APIKeyRepositoryMockListActiveExpectation{}
+	}
+
+	if mmListActive.defaultExpectation.paramPtrs != nil {
+		mmListActive.mock.t.Fatalf("APIKeyRepositoryMock.ListActive mock is already set by ExpectParams functions")
+	}
+
+	mmListActive.defaultExpectation.params = &APIKeyRepositoryMockListActiveParams{ctx, username}
+	for _, e := range mmListActive.expectations {
+		if minimock.Equal(e.params, mmListActive.defaultExpectation.params) {
+			mmListActive.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListActive.defaultExpectation.params)
+		}
+	}
+
+	return mmListActive
+}
+
+// ExpectCtxParam1 sets up expected param ctx for APIKeyRepository.ListActive
+func (mmListActive *mAPIKeyRepositoryMockListActive) ExpectCtxParam1(ctx context.Context) *mAPIKeyRepositoryMockListActive {
+	if mmListActive.mock.funcListActive != nil {
+		mmListActive.mock.t.Fatalf("APIKeyRepositoryMock.ListActive mock is already set by Set")
+	}
+
+	if mmListActive.defaultExpectation == nil {
+		mmListActive.defaultExpectation = &APIKeyRepositoryMockListActiveExpectation{}
+	}
+
+	if mmListActive.defaultExpectation.params != nil {
+		mmListActive.mock.t.Fatalf("APIKeyRepositoryMock.ListActive mock is already set by Expect")
+	}
+
+	if mmListActive.defaultExpectation.paramPtrs == nil {
+		mmListActive.defaultExpectation.paramPtrs = &APIKeyRepositoryMockListActiveParamPtrs{}
+	}
+	mmListActive.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListActive
+}
+
+// ExpectUsernameParam2 sets up expected param username for APIKeyRepository.ListActive
+func (mmListActive *mAPIKeyRepositoryMockListActive) ExpectUsernameParam2(username string) *mAPIKeyRepositoryMockListActive {
+	if mmListActive.mock.funcListActive != nil {
+		mmListActive.mock.t.Fatalf("APIKeyRepositoryMock.ListActive mock is already set by Set")
+	}
+
+	if mmListActive.defaultExpectation == nil {
+		mmListActive.defaultExpectation = &APIKeyRepositoryMockListActiveExpectation{}
+	}
+
+	if mmListActive.defaultExpectation.params != nil {
+		mmListActive.mock.t.Fatalf("APIKeyRepositoryMock.ListActive mock is already set by Expect")
+	}
+
+	if mmListActive.defaultExpectation.paramPtrs == nil {
+		mmListActive.defaultExpectation.paramPtrs = &APIKeyRepositoryMockListActiveParamPtrs{}
+	}
+	mmListActive.defaultExpectation.paramPtrs.username = &username
+
+	return mmListActive
+}
+
+// Inspect accepts an inspector function that has same arguments as the APIKeyRepository.ListActive
+func (mmListActive *mAPIKeyRepositoryMockListActive) Inspect(f func(ctx context.Context, username string)) *mAPIKeyRepositoryMockListActive {
+	if mmListActive.mock.inspectFuncListActive != nil {
+		mmListActive.mock.t.Fatalf("Inspect function is already set for APIKeyRepositoryMock.ListActive")
+	}
+
+	mmListActive.mock.inspectFuncListActive = f
+
+	return mmListActive
+}
+
+// Return sets up results that will be returned by APIKeyRepository.ListActive
+func (mmListActive *mAPIKeyRepositoryMockListActive) Return(apa1 []*model.APIKey, err error) *APIKeyRepositoryMock {
+	if mmListActive.mock.funcListActive != nil {
+		mmListActive.mock.t.Fatalf("APIKeyRepositoryMock.ListActive mock is already set by Set")
+	}
+
+	if mmListActive.defaultExpectation == nil {
+		mmListActive.defaultExpectation = &APIKeyRepositoryMockListActiveExpectation{mock: mmListActive.mock}
+	}
+	mmListActive.defaultExpectation.results = &APIKeyRepositoryMockListActiveResults{apa1, err}
+	return mmListActive.mock
+}
+
+// Set uses given function f to mock the APIKeyRepository.ListActive method
+func (mmListActive *mAPIKeyRepositoryMockListActive) Set(f func(ctx context.Context, username string) (apa1 []*model.APIKey, err error)) *APIKeyRepositoryMock {
+	if mmListActive.defaultExpectation != nil {
+		mmListActive.mock.t.Fatalf("Default expectation is already set for the APIKeyRepository.ListActive method")
+	}
+
+	if len(mmListActive.expectations) > 0 {
+		mmListActive.mock.t.Fatalf("Some expectations are already set for the APIKeyRepository.ListActive method")
+	}
+
+	mmListActive.mock.funcListActive = f
+	return mmListActive.mock
+}
+
+// When sets expectation for the APIKeyRepository.ListActive which will trigger the result defined by the following
+// Then helper
+func (mmListActive *mAPIKeyRepositoryMockListActive) When(ctx context.Context, username string) *APIKeyRepositoryMockListActiveExpectation {
+	if mmListActive.mock.funcListActive != nil {
+		mmListActive.mock.t.Fatalf("APIKeyRepositoryMock.ListActive mock is already set by Set")
+	}
+
+	expectation := &APIKeyRepositoryMockListActiveExpectation{
+		mock:   mmListActive.mock,
+		params: &APIKeyRepositoryMockListActiveParams{ctx, username},
+	}
+	mmListActive.expectations = append(mmListActive.expectations, expectation)
+	return expectation
+}
+
+// Then sets up APIKeyRepository.ListActive return parameters for the expectation previously defined by the When method
+func (e *APIKeyRepositoryMockListActiveExpectation) Then(apa1 []*model.APIKey, err error) *APIKeyRepositoryMock {
+	e.results = &APIKeyRepositoryMockListActiveResults{apa1, err}
+	return e.mock
+}
+
+// Times sets number of times APIKeyRepository.ListActive should be invoked
+func (mmListActive *mAPIKeyRepositoryMockListActive) Times(n uint64) *mAPIKeyRepositoryMockListActive {
+	if n == 0 {
+		mmListActive.mock.t.Fatalf("Times of APIKeyRepositoryMock.ListActive mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListActive.expectedInvocations, n)
+	return mmListActive
+}
+
+func (mmListActive *mAPIKeyRepositoryMockListActive) invocationsDone() bool {
+	if len(mmListActive.expectations) == 0 && mmListActive.defaultExpectation == nil && mmListActive.mock.funcListActive == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListActive.mock.afterListActiveCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListActive.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListActive implements repository.APIKeyRepository
+func (mmListActive *APIKeyRepositoryMock) ListActive(ctx context.Context, username string) (apa1 []*model.APIKey, err error) {
+	mm_atomic.AddUint64(&mmListActive.beforeListActiveCounter, 1)
+	defer mm_atomic.AddUint64(&mmListActive.afterListActiveCounter, 1)
+
+	if mmListActive.inspectFuncListActive != nil {
+		mmListActive.inspectFuncListActive(ctx, username)
+	}
+
+	mm_params := APIKeyRepositoryMockListActiveParams{ctx, username}
+
+	// Record call args
+	mmListActive.ListActiveMock.mutex.Lock()
+	mmListActive.ListActiveMock.callArgs = append(mmListActive.ListActiveMock.callArgs, &mm_params)
+	mmListActive.ListActiveMock.mutex.Unlock()
+
+	for _, e := range mmListActive.ListActiveMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.apa1, e.results.err
+		}
+	}
+
+	if mmListActive.ListActiveMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListActive.ListActiveMock.defaultExpectation.Counter, 1)
+		mm_want := mmListActive.ListActiveMock.defaultExpectation.params
+		mm_want_ptrs := mmListActive.ListActiveMock.defaultExpectation.paramPtrs
+
+		mm_got := APIKeyRepositoryMockListActiveParams{ctx, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListActive.t.Errorf("APIKeyRepositoryMock.ListActive got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmListActive.t.Errorf("APIKeyRepositoryMock.ListActive got unexpected parameter username, want: %#v, got: %#v%s\n", *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListActive.t.Errorf("APIKeyRepositoryMock.ListActive got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListActive.ListActiveMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListActive.t.Fatal("No results are set for the APIKeyRepositoryMock.ListActive")
+		}
+		return (*mm_results).apa1, (*mm_results).err
+	}
+	if mmListActive.funcListActive != nil {
+		return mmListActive.funcListActive(ctx, username)
+	}
+	mmListActive.t.Fatalf("Unexpected call to APIKeyRepositoryMock.ListActive. %v %v", ctx, username)
+	return
+}
+
+// ListActiveAfterCounter returns a count of finished APIKeyRepositoryMock.ListActive invocations
+func (mmListActive *APIKeyRepositoryMock) ListActiveAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListActive.afterListActiveCounter)
+}
+
+// ListActiveBeforeCounter returns a count of APIKeyRepositoryMock.ListActive invocations
+func (mmListActive *APIKeyRepositoryMock) ListActiveBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListActive.beforeListActiveCounter)
+}
+
+// Calls returns a list of arguments used in each call to APIKeyRepositoryMock.ListActive.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListActive *mAPIKeyRepositoryMockListActive) Calls() []*APIKeyRepositoryMockListActiveParams {
+	mmListActive.mutex.RLock()
+
+	argCopy := make([]*APIKeyRepositoryMockListActiveParams, len(mmListActive.callArgs))
+	copy(argCopy, mmListActive.callArgs)
+
+	mmListActive.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListActiveDone returns true if the count of the ListActive invocations corresponds
+// the number of defined expectations
+func (m *APIKeyRepositoryMock) MinimockListActiveDone() bool {
+	if m.ListActiveMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListActiveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListActiveMock.invocationsDone()
+}
+
+// MinimockListActiveInspect logs each unmet expectation
+func (m *APIKeyRepositoryMock) MinimockListActiveInspect() {
+	for _, e := range m.ListActiveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to APIKeyRepositoryMock.ListActive with params: %#v", *e.params)
+		}
+	}
+
+	afterListActiveCounter := mm_atomic.LoadUint64(&m.afterListActiveCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListActiveMock.defaultExpectation != nil && afterListActiveCounter < 1 {
+		if m.ListActiveMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to APIKeyRepositoryMock.ListActive")
+		} else {
+			m.t.Errorf("Expected call to APIKeyRepositoryMock.ListActive with params: %#v", *m.ListActiveMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListActive != nil && afterListActiveCounter < 1 {
+		m.t.Error("Expected call to APIKeyRepositoryMock.ListActive")
+	}
+
+	if !m.ListActiveMock.invocationsDone() && afterListActiveCounter > 0 {
+		m.t.Errorf("Expected %d calls to APIKeyRepositoryMock.ListActive but found %d calls",
+			mm_atomic.LoadUint64(&m.ListActiveMock.expectedInvocations), afterListActiveCounter)
+	}
+}
+
+type mAPIKeyRepositoryMockRevoke struct {
+	optional           bool
+	mock               *APIKeyRepositoryMock
+	defaultExpectation *APIKeyRepositoryMockRevokeExpectation
+	expectations       []*APIKeyRepositoryMockRevokeExpectation
+
+	callArgs []*APIKeyRepositoryMockRevokeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// APIKeyRepositoryMockRevokeExpectation specifies expectation struct of the APIKeyRepository.Revoke
+type APIKeyRepositoryMockRevokeExpectation struct {
+	mock      *APIKeyRepositoryMock
+	params    *APIKeyRepositoryMockRevokeParams
+	paramPtrs *APIKeyRepositoryMockRevokeParamPtrs
+	results   *APIKeyRepositoryMockRevokeResults
+	Counter   uint64
+}
+
+// APIKeyRepositoryMockRevokeParams contains parameters of the APIKeyRepository.Revoke
+type APIKeyRepositoryMockRevokeParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// APIKeyRepositoryMockRevokeParamPtrs contains pointers to parameters of the APIKeyRepository.Revoke
+type APIKeyRepositoryMockRevokeParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// APIKeyRepositoryMockRevokeResults contains results of the APIKeyRepository.Revoke
+type APIKeyRepositoryMockRevokeResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRevoke *mAPIKeyRepositoryMockRevoke) Optional() *mAPIKeyRepositoryMockRevoke {
+	mmRevoke.optional = true
+	return mmRevoke
+}
+
+// Expect sets up expected params for APIKeyRepository.Revoke
+func (mmRevoke *mAPIKeyRepositoryMockRevoke) Expect(ctx context.Context, id int64) *mAPIKeyRepositoryMockRevoke {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("APIKeyRepositoryMock.Revoke mock is already set by Set")
+	}
+
+	if mmRevoke.defaultExpectation == nil {
+		mmRevoke.defaultExpectation = &APIKeyRepositoryMockRevokeExpectation{}
+	}
+
+	if mmRevoke.defaultExpectation.paramPtrs != nil {
+		mmRevoke.mock.t.Fatalf("APIKeyRepositoryMock.Revoke mock is already set by ExpectParams functions")
+	}
+
+	mmRevoke.defaultExpectation.params = &APIKeyRepositoryMockRevokeParams{ctx, id}
+	for _, e := range mmRevoke.expectations {
+		if minimock.Equal(e.params, mmRevoke.defaultExpectation.params) {
+			mmRevoke.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevoke.defaultExpectation.params)
+		}
+	}
+
+	return mmRevoke
+}
+
+// ExpectCtxParam1 sets up expected param ctx for APIKeyRepository.Revoke
+func (mmRevoke *mAPIKeyRepositoryMockRevoke) ExpectCtxParam1(ctx context.Context) *mAPIKeyRepositoryMockRevoke {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("APIKeyRepositoryMock.Revoke mock is already set by Set")
+	}
+
+	if mmRevoke.defaultExpectation == nil {
+		mmRevoke.defaultExpectation = &APIKeyRepositoryMockRevokeExpectation{}
+	}
+
+	if mmRevoke.defaultExpectation.params != nil {
+		mmRevoke.mock.t.Fatalf("APIKeyRepositoryMock.Revoke mock is already set by Expect")
+	}
+
+	if mmRevoke.defaultExpectation.paramPtrs == nil {
+		mmRevoke.defaultExpectation.paramPtrs = &APIKeyRepositoryMockRevokeParamPtrs{}
+	}
+	mmRevoke.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRevoke
+}
+
+// ExpectIdParam2 sets up expected param id for APIKeyRepository.Revoke
+func (mmRevoke *mAPIKeyRepositoryMockRevoke) ExpectIdParam2(id int64) *mAPIKeyRepositoryMockRevoke {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("APIKeyRepositoryMock.Revoke mock is already set by Set")
+	}
+
+	if mmRevoke.defaultExpectation == nil {
+		mmRevoke.defaultExpectation = &APIKeyRepositoryMockRevokeExpectation{}
+	}
+
+	if mmRevoke.defaultExpectation.params != nil {
+		mmRevoke.mock.t.Fatalf("APIKeyRepositoryMock.Revoke mock is already set by Expect")
+	}
+
+	if mmRevoke.defaultExpectation.paramPtrs == nil {
+		mmRevoke.defaultExpectation.paramPtrs = &APIKeyRepositoryMockRevokeParamPtrs{}
+	}
+	mmRevoke.defaultExpectation.paramPtrs.id = &id
+
+	return mmRevoke
+}
+
+// Inspect accepts an inspector function that has same arguments as the APIKeyRepository.Revoke
+func (mmRevoke *mAPIKeyRepositoryMockRevoke) Inspect(f func(ctx context.Context, id int64)) *mAPIKeyRepositoryMockRevoke {
+	if mmRevoke.mock.inspectFuncRevoke != nil {
+		mmRevoke.mock.t.Fatalf("Inspect function is already set for APIKeyRepositoryMock.Revoke")
+	}
+
+	mmRevoke.mock.inspectFuncRevoke = f
+
+	return mmRevoke
+}
+
+// Return sets up results that will be returned by APIKeyRepository.Revoke
+func (mmRevoke *mAPIKeyRepositoryMockRevoke) Return(err error) *APIKeyRepositoryMock {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("APIKeyRepositoryMock.Revoke mock is already set by Set")
+	}
+
+	if mmRevoke.defaultExpectation == nil {
+		mmRevoke.defaultExpectation = &APIKeyRepositoryMockRevokeExpectation{mock: mmRevoke.mock}
+	}
+	mmRevoke.defaultExpectation.results = &APIKeyRepositoryMockRevokeResults{err}
+	return mmRevoke.mock
+}
+
+// Set uses given function f to mock the APIKeyRepository.Revoke method
+func (mmRevoke *mAPIKeyRepositoryMockRevoke) Set(f func(ctx context.Context, id int64) (err error)) *APIKeyRepositoryMock {
+	if mmRevoke.defaultExpectation != nil {
+		mmRevoke.mock.t.Fatalf("Default expectation is already set for the APIKeyRepository.Revoke method")
+	}
+
+	if len(mmRevoke.expectations) > 0 {
+		mmRevoke.mock.t.Fatalf("Some expectations are already set for the APIKeyRepository.Revoke method")
+	}
+
+	mmRevoke.mock.funcRevoke = f
+	return mmRevoke.mock
+}
+
+// When sets expectation for the APIKeyRepository.Revoke which will trigger the result defined by the following
+// Then helper
+func (mmRevoke *mAPIKeyRepositoryMockRevoke) When(ctx context.Context, id int64) *APIKeyRepositoryMockRevokeExpectation {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("APIKeyRepositoryMock.Revoke mock is already set by Set")
+	}
+
+	expectation := &APIKeyRepositoryMockRevokeExpectation{
+		mock:   mmRevoke.mock,
+		params: &APIKeyRepositoryMockRevokeParams{ctx, id},
+	}
+	mmRevoke.expectations = append(mmRevoke.expectations, expectation)
+	return expectation
+}
+
+// Then sets up APIKeyRepository.Revoke return parameters for the expectation previously defined by the When method
+func (e *APIKeyRepositoryMockRevokeExpectation) Then(err error) *APIKeyRepositoryMock {
+	e.results = &APIKeyRepositoryMockRevokeResults{err}
+	return e.mock
+}
+
+// Times sets number of times APIKeyRepository.Revoke should be invoked
+func (mmRevoke *mAPIKeyRepositoryMockRevoke) Times(n uint64) *mAPIKeyRepositoryMockRevoke {
+	if n == 0 {
+		mmRevoke.mock.t.Fatalf("Times of APIKeyRepositoryMock.Revoke mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRevoke.expectedInvocations, n)
+	return mmRevoke
+}
+
+func (mmRevoke *mAPIKeyRepositoryMockRevoke) invocationsDone() bool {
+	if len(mmRevoke.expectations) == 0 && mmRevoke.defaultExpectation == nil && mmRevoke.mock.funcRevoke == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRevoke.mock.afterRevokeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevoke.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Revoke implements repository.APIKeyRepository
+func (mmRevoke *APIKeyRepositoryMock) Revoke(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmRevoke.beforeRevokeCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevoke.afterRevokeCounter, 1)
+
+	if mmRevoke.inspectFuncRevoke != nil {
+		mmRevoke.inspectFuncRevoke(ctx, id)
+	}
+
+	mm_params := APIKeyRepositoryMockRevokeParams{ctx, id}
+
+	// Record call args
+	mmRevoke.RevokeMock.mutex.Lock()
+	mmRevoke.RevokeMock.callArgs = append(mmRevoke.RevokeMock.callArgs, &mm_params)
+	mmRevoke.RevokeMock.mutex.Unlock()
+
+	for _, e := range mmRevoke.RevokeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRevoke.RevokeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevoke.RevokeMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevoke.RevokeMock.defaultExpectation.params
+		mm_want_ptrs := mmRevoke.RevokeMock.defaultExpectation.paramPtrs
+
+		mm_got := APIKeyRepositoryMockRevokeParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevoke.t.Errorf("APIKeyRepositoryMock.Revoke got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmRevoke.t.Errorf("APIKeyRepositoryMock.Revoke got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevoke.t.Errorf("APIKeyRepositoryMock.Revoke got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevoke.RevokeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevoke.t.Fatal("No results are set for the APIKeyRepositoryMock.Revoke")
+		}
+		return (*mm_results).err
+	}
+	if mmRevoke.funcRevoke != nil {
+		return mmRevoke.funcRevoke(ctx, id)
+	}
+	mmRevoke.t.Fatalf("Unexpected call to APIKeyRepositoryMock.Revoke. %v %v", ctx, id)
+	return
+}
+
+// RevokeAfterCounter returns a count of finished APIKeyRepositoryMock.Revoke invocations
+func (mmRevoke *APIKeyRepositoryMock) RevokeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevoke.afterRevokeCounter)
+}
+
+// RevokeBeforeCounter returns a count of APIKeyRepositoryMock.Revoke invocations
+func (mmRevoke *APIKeyRepositoryMock) RevokeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevoke.beforeRevokeCounter)
+}
+
+// Calls returns a list of arguments used in each call to APIKeyRepositoryMock.Revoke.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevoke *mAPIKeyRepositoryMockRevoke) Calls() []*APIKeyRepositoryMockRevokeParams {
+	mmRevoke.mutex.RLock()
+
+	argCopy := make([]*APIKeyRepositoryMockRevokeParams, len(mmRevoke.callArgs))
+	copy(argCopy, mmRevoke.callArgs)
+
+	mmRevoke.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevokeDone returns true if the count of the Revoke invocations corresponds
+// the number of defined expectations
+func (m *APIKeyRepositoryMock) MinimockRevokeDone() bool {
+	if m.RevokeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RevokeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RevokeMock.invocationsDone()
+}
+
+// MinimockRevokeInspect logs each unmet expectation
+func (m *APIKeyRepositoryMock) MinimockRevokeInspect() {
+	for _, e := range m.RevokeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to APIKeyRepositoryMock.Revoke with params: %#v", *e.params)
+		}
+	}
+
+	afterRevokeCounter := mm_atomic.LoadUint64(&m.afterRevokeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokeMock.defaultExpectation != nil && afterRevokeCounter < 1 {
+		if m.RevokeMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to APIKeyRepositoryMock.Revoke")
+		} else {
+			m.t.Errorf("Expected call to APIKeyRepositoryMock.Revoke with params: %#v", *m.RevokeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevoke != nil && afterRevokeCounter < 1 {
+		m.t.Error("Expected call to APIKeyRepositoryMock.Revoke")
+	}
+
+	if !m.RevokeMock.invocationsDone() && afterRevokeCounter > 0 {
+		m.t.Errorf("Expected %d calls to APIKeyRepositoryMock.Revoke but found %d calls",
+			mm_atomic.LoadUint64(&m.RevokeMock.expectedInvocations), afterRevokeCounter)
+	}
+}
+
+type mAPIKeyRepositoryMockTouch struct {
+	optional           bool
+	mock               *APIKeyRepositoryMock
+	defaultExpectation *APIKeyRepositoryMockTouchExpectation
+	expectations       []*APIKeyRepositoryMockTouchExpectation
+
+	callArgs []*APIKeyRepositoryMockTouchParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// APIKeyRepositoryMockTouchExpectation specifies expectation struct of the APIKeyRepository.Touch
+type APIKeyRepositoryMockTouchExpectation struct {
+	mock      *APIKeyRepositoryMock
+	params    *APIKeyRepositoryMockTouchParams
+	paramPtrs *APIKeyRepositoryMockTouchParamPtrs
+	results   *APIKeyRepositoryMockTouchResults
+	Counter   uint64
+}
+
+// APIKeyRepositoryMockTouchParams contains parameters of the APIKeyRepository.Touch
+type APIKeyRepositoryMockTouchParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// APIKeyRepositoryMockTouchParamPtrs contains pointers to parameters of the APIKeyRepository.Touch
+type APIKeyRepositoryMockTouchParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// APIKeyRepositoryMockTouchResults contains results of the APIKeyRepository.Touch
+type APIKeyRepositoryMockTouchResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmTouch *mAPIKeyRepositoryMockTouch) Optional() *mAPIKeyRepositoryMockTouch {
+	mmTouch.optional = true
+	return mmTouch
+}
+
+// Expect sets up expected params for APIKeyRepository.Touch
+func (mmTouch *mAPIKeyRepositoryMockTouch) Expect(ctx context.Context, id int64) *mAPIKeyRepositoryMockTouch {
+	if mmTouch.mock.funcTouch != nil {
+		mmTouch.mock.t.Fatalf("APIKeyRepositoryMock.Touch mock is already set by Set")
+	}
+
+	if mmTouch.defaultExpectation == nil {
+		mmTouch.defaultExpectation = &APIKeyRepositoryMockTouchExpectation{}
+	}
+
+	if mmTouch.defaultExpectation.paramPtrs != nil {
+		mmTouch.mock.t.Fatalf("APIKeyRepositoryMock.Touch mock is already set by ExpectParams functions")
+	}
+
+	mmTouch.defaultExpectation.params = &APIKeyRepositoryMockTouchParams{ctx, id}
+	for _, e := range mmTouch.expectations {
+		if minimock.Equal(e.params, mmTouch.defaultExpectation.params) {
+			mmTouch.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmTouch.defaultExpectation.params)
+		}
+	}
+
+	return mmTouch
+}
+
+// ExpectCtxParam1 sets up expected param ctx for APIKeyRepository.Touch
+func (mmTouch *mAPIKeyRepositoryMockTouch) ExpectCtxParam1(ctx context.Context) *mAPIKeyRepositoryMockTouch {
+	if mmTouch.mock.funcTouch != nil {
+		mmTouch.mock.t.Fatalf("APIKeyRepositoryMock.Touch mock is already set by Set")
+	}
+
+	if mmTouch.defaultExpectation == nil {
+		mmTouch.defaultExpectation = &APIKeyRepositoryMockTouchExpectation{}
+	}
+
+	if mmTouch.defaultExpectation.params != nil {
+		mmTouch.mock.t.Fatalf("APIKeyRepositoryMock.Touch mock is already set by Expect")
+	}
+
+	if mmTouch.defaultExpectation.paramPtrs == nil {
+		mmTouch.defaultExpectation.paramPtrs = &APIKeyRepositoryMockTouchParamPtrs{}
+	}
+	mmTouch.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmTouch
+}
+
+// ExpectIdParam2 sets up expected param id for APIKeyRepository.Touch
+func (mmTouch *mAPIKeyRepositoryMockTouch) ExpectIdParam2(id int64) *mAPIKeyRepositoryMockTouch {
+	if mmTouch.mock.funcTouch != nil {
+		mmTouch.mock.t.Fatalf("APIKeyRepositoryMock.Touch mock is already set by Set")
+	}
+
+	if mmTouch.defaultExpectation == nil {
+		mmTouch.defaultExpectation = &APIKeyRepositoryMockTouchExpectation{}
+	}
+
+	if mmTouch.defaultExpectation.params != nil {
+		mmTouch.mock.t.Fatalf("APIKeyRepositoryMock.Touch mock is already set by Expect")
+	}
+
+	if mmTouch.defaultExpectation.paramPtrs == nil {
+		mmTouch.defaultExpectation.paramPtrs = &APIKeyRepositoryMockTouchParamPtrs{}
+	}
+	mmTouch.defaultExpectation.paramPtrs.id = &id
+
+	return mmTouch
+}
+
+// Inspect accepts an inspector function that has same arguments as the APIKeyRepository.Touch
+func (mmTouch *mAPIKeyRepositoryMockTouch) Inspect(f func(ctx context.Context, id int64)) *mAPIKeyRepositoryMockTouch {
+	if mmTouch.mock.inspectFuncTouch != nil {
+		mmTouch.mock.t.Fatalf("Inspect function is already set for APIKeyRepositoryMock.Touch")
+	}
+
+	mmTouch.mock.inspectFuncTouch = f
+
+	return mmTouch
+}
+
+// Return sets up results that will be returned by APIKeyRepository.Touch
+func (mmTouch *mAPIKeyRepositoryMockTouch) Return(err error) *APIKeyRepositoryMock {
+	if mmTouch.mock.funcTouch != nil {
+		mmTouch.mock.t.Fatalf("APIKeyRepositoryMock.Touch mock is already set by Set")
+	}
+
+	if mmTouch.defaultExpectation == nil {
+		mmTouch.defaultExpectation = &APIKeyRepositoryMockTouchExpectation{mock: mmTouch.mock}
+	}
+	mmTouch.defaultExpectation.results = &APIKeyRepositoryMockTouchResults{err}
+	return mmTouch.mock
+}
+
+// Set uses given function f to mock the APIKeyRepository.Touch method
+func (mmTouch *mAPIKeyRepositoryMockTouch) Set(f func(ctx context.Context, id int64) (err error)) *APIKeyRepositoryMock {
+	if mmTouch.defaultExpectation != nil {
+		mmTouch.mock.t.Fatalf("Default expectation is already set for the APIKeyRepository.Touch method")
+	}
+
+	if len(mmTouch.expectations) > 0 {
+		mmTouch.mock.t.Fatalf("Some expectations are already set for the APIKeyRepository.Touch method")
+	}
+
+	mmTouch.mock.funcTouch = f
+	return mmTouch.mock
+}
+
+// When sets expectation for the APIKeyRepository.Touch which will trigger the result defined by the following
+// Then helper
+func (mmTouch *mAPIKeyRepositoryMockTouch) When(ctx context.Context, id int64) *APIKeyRepositoryMockTouchExpectation {
+	if mmTouch.mock.funcTouch != nil {
+		mmTouch.mock.t.Fatalf("APIKeyRepositoryMock.Touch mock is already set by Set")
+	}
+
+	expectation := &APIKeyRepositoryMockTouchExpectation{
+		mock:   mmTouch.mock,
+		params: &APIKeyRepositoryMockTouchParams{ctx, id},
+	}
+	mmTouch.expectations = append(mmTouch.expectations, expectation)
+	return expectation
+}
+
+// Then sets up APIKeyRepository.Touch return parameters for the expectation previously defined by the When method
+func (e *APIKeyRepositoryMockTouchExpectation) Then(err error) *APIKeyRepositoryMock {
+	e.results = &APIKeyRepositoryMockTouchResults{err}
+	return e.mock
+}
+
+// Times sets number of times APIKeyRepository.Touch should be invoked
+func (mmTouch *mAPIKeyRepositoryMockTouch) Times(n uint64) *mAPIKeyRepositoryMockTouch {
+	if n == 0 {
+		mmTouch.mock.t.Fatalf("Times of APIKeyRepositoryMock.Touch mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmTouch.expectedInvocations, n)
+	return mmTouch
+}
+
+func (mmTouch *mAPIKeyRepositoryMockTouch) invocationsDone() bool {
+	if len(mmTouch.expectations) == 0 && mmTouch.defaultExpectation == nil && mmTouch.mock.funcTouch == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmTouch.mock.afterTouchCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmTouch.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Touch implements repository.APIKeyRepository
+func (mmTouch *APIKeyRepositoryMock) Touch(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmTouch.beforeTouchCounter, 1)
+	defer mm_atomic.AddUint64(&mmTouch.afterTouchCounter, 1)
+
+	if mmTouch.inspectFuncTouch != nil {
+		mmTouch.inspectFuncTouch(ctx, id)
+	}
+
+	mm_params := APIKeyRepositoryMockTouchParams{ctx, id}
+
+	// Record call args
+	mmTouch.TouchMock.mutex.Lock()
+	mmTouch.TouchMock.callArgs = append(mmTouch.TouchMock.callArgs, &mm_params)
+	mmTouch.TouchMock.mutex.Unlock()
+
+	for _, e := range mmTouch.TouchMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmTouch.TouchMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmTouch.TouchMock.defaultExpectation.Counter, 1)
+		mm_want := mmTouch.TouchMock.defaultExpectation.params
+		mm_want_ptrs := mmTouch.TouchMock.defaultExpectation.paramPtrs
+
+		mm_got := APIKeyRepositoryMockTouchParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmTouch.t.Errorf("APIKeyRepositoryMock.Touch got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmTouch.t.Errorf("APIKeyRepositoryMock.Touch got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmTouch.t.Errorf("APIKeyRepositoryMock.Touch got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmTouch.TouchMock.defaultExpectation.results
+		if mm_results == nil {
+			mmTouch.t.Fatal("No results are set for the APIKeyRepositoryMock.Touch")
+		}
+		return (*mm_results).err
+	}
+	if mmTouch.funcTouch != nil {
+		return mmTouch.funcTouch(ctx, id)
+	}
+	mmTouch.t.Fatalf("Unexpected call to APIKeyRepositoryMock.Touch. %v %v", ctx, id)
+	return
+}
+
+// TouchAfterCounter returns a count of finished APIKeyRepositoryMock.Touch invocations
+func (mmTouch *APIKeyRepositoryMock) TouchAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTouch.afterTouchCounter)
+}
+
+// TouchBeforeCounter returns a count of APIKeyRepositoryMock.Touch invocations
+func (mmTouch *APIKeyRepositoryMock) TouchBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTouch.beforeTouchCounter)
+}
+
+// Calls returns a list of arguments used in each call to APIKeyRepositoryMock.Touch.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmTouch *mAPIKeyRepositoryMockTouch) Calls() []*APIKeyRepositoryMockTouchParams {
+	mmTouch.mutex.RLock()
+
+	argCopy := make([]*APIKeyRepositoryMockTouchParams, len(mmTouch.callArgs))
+	copy(argCopy, mmTouch.callArgs)
+
+	mmTouch.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockTouchDone returns true if the count of the Touch invocations corresponds
+// the number of defined expectations
+func (m *APIKeyRepositoryMock) MinimockTouchDone() bool {
+	if m.TouchMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.TouchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.TouchMock.invocationsDone()
+}
+
+// MinimockTouchInspect logs each unmet expectation
+func (m *APIKeyRepositoryMock) MinimockTouchInspect() {
+	for _, e := range m.TouchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to APIKeyRepositoryMock.Touch with params: %#v", *e.params)
+		}
+	}
+
+	afterTouchCounter := mm_atomic.LoadUint64(&m.afterTouchCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.TouchMock.defaultExpectation != nil && afterTouchCounter < 1 {
+		if m.TouchMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to APIKeyRepositoryMock.Touch")
+		} else {
+			m.t.Errorf("Expected call to APIKeyRepositoryMock.Touch with params: %#v", *m.TouchMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcTouch != nil && afterTouchCounter < 1 {
+		m.t.Error("Expected call to APIKeyRepositoryMock.Touch")
+	}
+
+	if !m.TouchMock.invocationsDone() && afterTouchCounter > 0 {
+		m.t.Errorf("Expected %d calls to APIKeyRepositoryMock.Touch but found %d calls",
+			mm_atomic.LoadUint64(&m.TouchMock.expectedInvocations), afterTouchCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *APIKeyRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateInspect()
+
+			m.MinimockGetInspect()
+
+			m.MinimockGetByHashInspect()
+
+			m.MinimockListActiveInspect()
+
+			m.MinimockRevokeInspect()
+
+			m.MinimockTouchInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *APIKeyRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *APIKeyRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateDone() &&
+		m.MinimockGetDone() &&
+		m.MinimockGetByHashDone() &&
+		m.MinimockListActiveDone() &&
+		m.MinimockRevokeDone() &&
+		m.MinimockTouchDone()
+}
@@ -0,0 +1,1337 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.13). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i di_container/internal/repository.AuditEventRepository -o audit_event_repository_minimock.go -n AuditEventRepositoryMock -p mocks
+
+import (
+	"context"
+	"di_container/internal/model"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// AuditEventRepositoryMock implements repository.AuditEventRepository
+type AuditEventRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreate          func(ctx context.Context, event *model.AuditEvent) (i1 int64, err error)
+	inspectFuncCreate   func(ctx context.Context, event *model.AuditEvent)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mAuditEventRepositoryMockCreate
+
+	funcLastHash          func(ctx context.Context) (s1 string, err error)
+	inspectFuncLastHash   func(ctx context.Context)
+	afterLastHashCounter  uint64
+	beforeLastHashCounter uint64
+	LastHashMock          mAuditEventRepositoryMockLastHash
+
+	funcList          func(ctx context.Context, filter *model.AuditFilter) (apa1 []*model.AuditEvent, err error)
+	inspectFuncList   func(ctx context.Context, filter *model.AuditFilter)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mAuditEventRepositoryMockList
+
+	funcLockChain          func(ctx context.Context) (err error)
+	inspectFuncLockChain   func(ctx context.Context)
+	afterLockChainCounter  uint64
+	beforeLockChainCounter uint64
+	LockChainMock          mAuditEventRepositoryMockLockChain
+}
+
+// NewAuditEventRepositoryMock returns a mock for repository.AuditEventRepository
+func NewAuditEventRepositoryMock(t minimock.Tester) *AuditEventRepositoryMock {
+	m := &AuditEventRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateMock = mAuditEventRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*AuditEventRepositoryMockCreateParams{}
+
+	m.LastHashMock = mAuditEventRepositoryMockLastHash{mock: m}
+	m.LastHashMock.callArgs = []*AuditEventRepositoryMockLastHashParams{}
+
+	m.ListMock = mAuditEventRepositoryMockList{mock: m}
+	m.ListMock.callArgs = []*AuditEventRepositoryMockListParams{}
+
+	m.LockChainMock = mAuditEventRepositoryMockLockChain{mock: m}
+	m.LockChainMock.callArgs = []*AuditEventRepositoryMockLockChainParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAuditEventRepositoryMockCreate struct {
+	optional           bool
+	mock               *AuditEventRepositoryMock
+	defaultExpectation *AuditEventRepositoryMockCreateExpectation
+	expectations       []*AuditEventRepositoryMockCreateExpectation
+
+	callArgs []*AuditEventRepositoryMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AuditEventRepositoryMockCreateExpectation specifies expectation struct of the AuditEventRepository.Create
+type AuditEventRepositoryMockCreateExpectation struct {
+	mock      *AuditEventRepositoryMock
+	params    *AuditEventRepositoryMockCreateParams
+	paramPtrs *AuditEventRepositoryMockCreateParamPtrs
+	results   *AuditEventRepositoryMockCreateResults
+	Counter   uint64
+}
+
+// AuditEventRepositoryMockCreateParams contains parameters of the AuditEventRepository.Create
+type AuditEventRepositoryMockCreateParams struct {
+	ctx   context.Context
+	event *model.AuditEvent
+}
+
+// AuditEventRepositoryMockCreateParamPtrs contains pointers to parameters of the AuditEventRepository.Create
+type AuditEventRepositoryMockCreateParamPtrs struct {
+	ctx   *context.Context
+	event **model.AuditEvent
+}
+
+// AuditEventRepositoryMockCreateResults contains results of the AuditEventRepository.Create
+type AuditEventRepositoryMockCreateResults struct {
+	i1  int64
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mAuditEventRepositoryMockCreate) Optional() *mAuditEventRepositoryMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for AuditEventRepository.Create
+func (mmCreate *mAuditEventRepositoryMockCreate) Expect(ctx context.Context, event *model.AuditEvent) *mAuditEventRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("AuditEventRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &AuditEventRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("AuditEventRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &AuditEventRepositoryMockCreateParams{ctx, event}
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditEventRepository.Create
+func (mmCreate *mAuditEventRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mAuditEventRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("AuditEventRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &AuditEventRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("AuditEventRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &AuditEventRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreate
+}
+
+// ExpectEventParam2 sets up expected param event for AuditEventRepository.Create
+func (mmCreate *mAuditEventRepositoryMockCreate) ExpectEventParam2(event *model.AuditEvent) *mAuditEventRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("AuditEventRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &AuditEventRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("AuditEventRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &AuditEventRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.event = &event
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditEventRepository.Create
+func (mmCreate *mAuditEventRepositoryMockCreate) Inspect(f func(ctx context.Context, event *model.AuditEvent)) *mAuditEventRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for AuditEventRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by AuditEventRepository.Create
+func (mmCreate *mAuditEventRepositoryMockCreate) Return(i1 int64, err error) *AuditEventRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("AuditEventRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &AuditEventRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &AuditEventRepositoryMockCreateResults{i1, err}
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the AuditEventRepository.Create method
+func (mmCreate *mAuditEventRepositoryMockCreate) Set(f func(ctx context.Context, event *model.AuditEvent) (i1 int64, err error)) *AuditEventRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the AuditEventRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the AuditEventRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	return mmCreate.mock
+}
+
+// When sets expectation for the AuditEventRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mAuditEventRepositoryMockCreate) When(ctx context.Context, event *model.AuditEvent) *AuditEventRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("AuditEventRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &AuditEventRepositoryMockCreateExpectation{
+		mock:   mmCreate.mock,
+		params: &AuditEventRepositoryMockCreateParams{ctx, event},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditEventRepository.Create return parameters for the expectation previously defined by the When method
+func (e *AuditEventRepositoryMockCreateExpectation) Then(i1 int64, err error) *AuditEventRepositoryMock {
+	e.results = &AuditEventRepositoryMockCreateResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times AuditEventRepository.Create should be invoked
+func (mmCreate *mAuditEventRepositoryMockCreate) Times(n uint64) *mAuditEventRepositoryMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of AuditEventRepositoryMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	return mmCreate
+}
+
+func (mmCreate *mAuditEventRepositoryMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements repository.AuditEventRepository
+func (mmCreate *AuditEventRepositoryMock) Create(ctx context.Context, event *model.AuditEvent) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, event)
+	}
+
+	mm_params := AuditEventRepositoryMockCreateParams{ctx, event}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditEventRepositoryMockCreateParams{ctx, event}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("AuditEventRepositoryMock.Create got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.event != nil && !minimock.Equal(*mm_want_ptrs.event, mm_got.event) {
+				mmCreate.t.Errorf("AuditEventRepositoryMock.Create got unexpected parameter event, want: %#v, got: %#v%s\n", *mm_want_ptrs.event, mm_got.event, minimock.Diff(*mm_want_ptrs.event, mm_got.event))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("AuditEventRepositoryMock.Create got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the AuditEventRepositoryMock.Create")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, event)
+	}
+	mmCreate.t.Fatalf("Unexpected call to AuditEventRepositoryMock.Create. %v %v", ctx, event)
+	return
+}
+
+// CreateAfterCounter returns a count of finished AuditEventRepositoryMock.Create invocations
+func (mmCreate *AuditEventRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of AuditEventRepositoryMock.Create invocations
+func (mmCreate *AuditEventRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditEventRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mAuditEventRepositoryMockCreate) Calls() []*AuditEventRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*AuditEventRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *AuditEventRepositoryMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *AuditEventRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditEventRepositoryMock.Create with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AuditEventRepositoryMock.Create")
+		} else {
+			m.t.Errorf("Expected call to AuditEventRepositoryMock.Create with params: %#v", *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Error("Expected call to AuditEventRepositoryMock.Create")
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditEventRepositoryMock.Create but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), afterCreateCounter)
+	}
+}
+
+type mAuditEventRepositoryMockLastHash struct {
+	optional           bool
+	mock               *AuditEventRepositoryMock
+	defaultExpectation *AuditEventRepositoryMockLastHashExpectation
+	expectations       []*AuditEventRepositoryMockLastHashExpectation
+
+	callArgs []*AuditEventRepositoryMockLastHashParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AuditEventRepositoryMockLastHashExpectation specifies expectation struct of the AuditEventRepository.LastHash
+type AuditEventRepositoryMockLastHashExpectation struct {
+	mock      *AuditEventRepositoryMock
+	params    *AuditEventRepositoryMockLastHashParams
+	paramPtrs *AuditEventRepositoryMockLastHashParamPtrs
+	results   *AuditEventRepositoryMockLastHashResults
+	Counter   uint64
+}
+
+// AuditEventRepositoryMockLastHashParams contains parameters of the AuditEventRepository.LastHash
+type AuditEventRepositoryMockLastHashParams struct {
+	ctx context.Context
+}
+
+// AuditEventRepositoryMockLastHashParamPtrs contains pointers to parameters of the AuditEventRepository.LastHash
+type AuditEventRepositoryMockLastHashParamPtrs struct {
+	ctx *context.Context
+}
+
+// AuditEventRepositoryMockLastHashResults contains results of the AuditEventRepository.LastHash
+type AuditEventRepositoryMockLastHashResults struct {
+	s1  string
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLastHash *mAuditEventRepositoryMockLastHash) Optional() *mAuditEventRepositoryMockLastHash {
+	mmLastHash.optional = true
+	return mmLastHash
+}
+
+// Expect sets up expected params for AuditEventRepository.LastHash
+func (mmLastHash *mAuditEventRepositoryMockLastHash) Expect(ctx context.Context) *mAuditEventRepositoryMockLastHash {
+	if mmLastHash.mock.funcLastHash != nil {
+		mmLastHash.mock.t.Fatalf("AuditEventRepositoryMock.LastHash mock is already set by Set")
+	}
+
+	if mmLastHash.defaultExpectation == nil {
+		mmLastHash.defaultExpectation = &AuditEventRepositoryMockLastHashExpectation{}
+	}
+
+	if mmLastHash.defaultExpectation.paramPtrs != nil {
+		mmLastHash.mock.t.Fatalf("AuditEventRepositoryMock.LastHash mock is already set by ExpectParams functions")
+	}
+
+	mmLastHash.defaultExpectation.params = &AuditEventRepositoryMockLastHashParams{ctx}
+	for _, e := range mmLastHash.expectations {
+		if minimock.Equal(e.params, mmLastHash.defaultExpectation.params) {
+			mmLastHash.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLastHash.defaultExpectation.params)
+		}
+	}
+
+	return mmLastHash
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditEventRepository.LastHash
+func (mmLastHash *mAuditEventRepositoryMockLastHash) ExpectCtxParam1(ctx context.Context) *mAuditEventRepositoryMockLastHash {
+	if mmLastHash.mock.funcLastHash != nil {
+		mmLastHash.mock.t.Fatalf("AuditEventRepositoryMock.LastHash mock is already set by Set")
+	}
+
+	if mmLastHash.defaultExpectation == nil {
+		mmLastHash.defaultExpectation = &AuditEventRepositoryMockLastHashExpectation{}
+	}
+
+	if mmLastHash.defaultExpectation.params != nil {
+		mmLastHash.mock.t.Fatalf("AuditEventRepositoryMock.LastHash mock is already set by Expect")
+	}
+
+	if mmLastHash.defaultExpectation.paramPtrs == nil {
+		mmLastHash.defaultExpectation.paramPtrs = &AuditEventRepositoryMockLastHashParamPtrs{}
+	}
+	mmLastHash.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmLastHash
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditEventRepository.LastHash
+func (mmLastHash *mAuditEventRepositoryMockLastHash) Inspect(f func(ctx context.Context)) *mAuditEventRepositoryMockLastHash {
+	if mmLastHash.mock.inspectFuncLastHash != nil {
+		mmLastHash.mock.t.Fatalf("Inspect function is already set for AuditEventRepositoryMock.LastHash")
+	}
+
+	mmLastHash.mock.inspectFuncLastHash = f
+
+	return mmLastHash
+}
+
+// Return sets up results that will be returned by AuditEventRepository.LastHash
+func (mmLastHash *mAuditEventRepositoryMockLastHash) Return(s1 string, err error) *AuditEventRepositoryMock {
+	if mmLastHash.mock.funcLastHash != nil {
+		mmLastHash.mock.t.Fatalf("AuditEventRepositoryMock.LastHash mock is already set by Set")
+	}
+
+	if mmLastHash.defaultExpectation == nil {
+		mmLastHash.defaultExpectation = &AuditEventRepositoryMockLastHashExpectation{mock: mmLastHash.mock}
+	}
+	mmLastHash.defaultExpectation.results = &AuditEventRepositoryMockLastHashResults{s1, err}
+	return mmLastHash.mock
+}
+
+// Set uses given function f to mock the AuditEventRepository.LastHash method
+func (mmLastHash *mAuditEventRepositoryMockLastHash) Set(f func(ctx context.Context) (s1 string, err error)) *AuditEventRepositoryMock {
+	if mmLastHash.defaultExpectation != nil {
+		mmLastHash.mock.t.Fatalf("Default expectation is already set for the AuditEventRepository.LastHash method")
+	}
+
+	if len(mmLastHash.expectations) > 0 {
+		mmLastHash.mock.t.Fatalf("Some expectations are already set for the AuditEventRepository.LastHash method")
+	}
+
+	mmLastHash.mock.funcLastHash = f
+	return mmLastHash.mock
+}
+
+// When sets expectation for the AuditEventRepository.LastHash which will trigger the result defined by the following
+// Then helper
+func (mmLastHash *mAuditEventRepositoryMockLastHash) When(ctx context.Context) *AuditEventRepositoryMockLastHashExpectation {
+	if mmLastHash.mock.funcLastHash != nil {
+		mmLastHash.mock.t.Fatalf("AuditEventRepositoryMock.LastHash mock is already set by Set")
+	}
+
+	expectation := &AuditEventRepositoryMockLastHashExpectation{
+		mock:   mmLastHash.mock,
+		params: &AuditEventRepositoryMockLastHashParams{ctx},
+	}
+	mmLastHash.expectations = append(mmLastHash.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditEventRepository.LastHash return parameters for the expectation previously defined by the When method
+func (e *AuditEventRepositoryMockLastHashExpectation) Then(s1 string, err error) *AuditEventRepositoryMock {
+	e.results = &AuditEventRepositoryMockLastHashResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times AuditEventRepository.LastHash should be invoked
+func (mmLastHash *mAuditEventRepositoryMockLastHash) Times(n uint64) *mAuditEventRepositoryMockLastHash {
+	if n == 0 {
+		mmLastHash.mock.t.Fatalf("Times of AuditEventRepositoryMock.LastHash mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLastHash.expectedInvocations, n)
+	return mmLastHash
+}
+
+func (mmLastHash *mAuditEventRepositoryMockLastHash) invocationsDone() bool {
+	if len(mmLastHash.expectations) == 0 && mmLastHash.defaultExpectation == nil && mmLastHash.mock.funcLastHash == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLastHash.mock.afterLastHashCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLastHash.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// LastHash implements repository.AuditEventRepository
+func (mmLastHash *AuditEventRepositoryMock) LastHash(ctx context.Context) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmLastHash.beforeLastHashCounter, 1)
+	defer mm_atomic.AddUint64(&mmLastHash.afterLastHashCounter, 1)
+
+	if mmLastHash.inspectFuncLastHash != nil {
+		mmLastHash.inspectFuncLastHash(ctx)
+	}
+
+	mm_params := AuditEventRepositoryMockLastHashParams{ctx}
+
+	// Record call args
+	mmLastHash.LastHashMock.mutex.Lock()
+	mmLastHash.LastHashMock.callArgs = append(mmLastHash.LastHashMock.callArgs, &mm_params)
+	mmLastHash.LastHashMock.mutex.Unlock()
+
+	for _, e := range mmLastHash.LastHashMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmLastHash.LastHashMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLastHash.LastHashMock.defaultExpectation.Counter, 1)
+		mm_want := mmLastHash.LastHashMock.defaultExpectation.params
+		mm_want_ptrs := mmLastHash.LastHashMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditEventRepositoryMockLastHashParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLastHash.t.Errorf("AuditEventRepositoryMock.LastHash got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLastHash.t.Errorf("AuditEventRepositoryMock.LastHash got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLastHash.LastHashMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLastHash.t.Fatal("No results are set for the AuditEventRepositoryMock.LastHash")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmLastHash.funcLastHash != nil {
+		return mmLastHash.funcLastHash(ctx)
+	}
+	mmLastHash.t.Fatalf("Unexpected call to AuditEventRepositoryMock.LastHash. %v", ctx)
+	return
+}
+
+// LastHashAfterCounter returns a count of finished AuditEventRepositoryMock.LastHash invocations
+func (mmLastHash *AuditEventRepositoryMock) LastHashAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLastHash.afterLastHashCounter)
+}
+
+// LastHashBeforeCounter returns a count of AuditEventRepositoryMock.LastHash invocations
+func (mmLastHash *AuditEventRepositoryMock) LastHashBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLastHash.beforeLastHashCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditEventRepositoryMock.LastHash.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLastHash *mAuditEventRepositoryMockLastHash) Calls() []*AuditEventRepositoryMockLastHashParams {
+	mmLastHash.mutex.RLock()
+
+	argCopy := make([]*AuditEventRepositoryMockLastHashParams, len(mmLastHash.callArgs))
+	copy(argCopy, mmLastHash.callArgs)
+
+	mmLastHash.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLastHashDone returns true if the count of the LastHash invocations corresponds
+// the number of defined expectations
+func (m *AuditEventRepositoryMock) MinimockLastHashDone() bool {
+	if m.LastHashMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LastHashMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LastHashMock.invocationsDone()
+}
+
+// MinimockLastHashInspect logs each unmet expectation
+func (m *AuditEventRepositoryMock) MinimockLastHashInspect() {
+	for _, e := range m.LastHashMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditEventRepositoryMock.LastHash with params: %#v", *e.params)
+		}
+	}
+
+	afterLastHashCounter := mm_atomic.LoadUint64(&m.afterLastHashCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LastHashMock.defaultExpectation != nil && afterLastHashCounter < 1 {
+		if m.LastHashMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AuditEventRepositoryMock.LastHash")
+		} else {
+			m.t.Errorf("Expected call to AuditEventRepositoryMock.LastHash with params: %#v", *m.LastHashMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLastHash != nil && afterLastHashCounter < 1 {
+		m.t.Error("Expected call to AuditEventRepositoryMock.LastHash")
+	}
+
+	if !m.LastHashMock.invocationsDone() && afterLastHashCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditEventRepositoryMock.LastHash but found %d calls",
+			mm_atomic.LoadUint64(&m.LastHashMock.expectedInvocations), afterLastHashCounter)
+	}
+}
+
+type mAuditEventRepositoryMockList struct {
+	optional           bool
+	mock               *AuditEventRepositoryMock
+	defaultExpectation *AuditEventRepositoryMockListExpectation
+	expectations       []*AuditEventRepositoryMockListExpectation
+
+	callArgs []*AuditEventRepositoryMockListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AuditEventRepositoryMockListExpectation specifies expectation struct of the AuditEventRepository.List
+type AuditEventRepositoryMockListExpectation struct {
+	mock      *AuditEventRepositoryMock
+	params    *AuditEventRepositoryMockListParams
+	paramPtrs *AuditEventRepositoryMockListParamPtrs
+	results   *AuditEventRepositoryMockListResults
+	Counter   uint64
+}
+
+// AuditEventRepositoryMockListParams contains parameters of the AuditEventRepository.List
+type AuditEventRepositoryMockListParams struct {
+	ctx    context.Context
+	filter *model.AuditFilter
+}
+
+// AuditEventRepositoryMockListParamPtrs contains pointers to parameters of the AuditEventRepository.List
+type AuditEventRepositoryMockListParamPtrs struct {
+	ctx    *context.Context
+	filter **model.AuditFilter
+}
+
+// AuditEventRepositoryMockListResults contains results of the AuditEventRepository.List
+type AuditEventRepositoryMockListResults struct {
+	apa1 []*model.AuditEvent
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmList *mAuditEventRepositoryMockList) Optional() *mAuditEventRepositoryMockList {
+	mmList.optional = true
+	return mmList
+}
+
+// Expect sets up expected params for AuditEventRepository.List
+func (mmList *mAuditEventRepositoryMockList) Expect(ctx context.Context, filter *model.AuditFilter) *mAuditEventRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("AuditEventRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &AuditEventRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("AuditEventRepositoryMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &AuditEventRepositoryMockListParams{ctx, filter}
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditEventRepository.List
+func (mmList *mAuditEventRepositoryMockList) ExpectCtxParam1(ctx context.Context) *mAuditEventRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("AuditEventRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &AuditEventRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("AuditEventRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &AuditEventRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmList
+}
+
+// ExpectFilterParam2 sets up expected param filter for AuditEventRepository.List
+func (mmList *mAuditEventRepositoryMockList) ExpectFilterParam2(filter *model.AuditFilter) *mAuditEventRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("AuditEventRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &AuditEventRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("AuditEventRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &AuditEventRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.filter = &filter
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditEventRepository.List
+func (mmList *mAuditEventRepositoryMockList) Inspect(f func(ctx context.Context, filter *model.AuditFilter)) *mAuditEventRepositoryMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for AuditEventRepositoryMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by AuditEventRepository.List
+func (mmList *mAuditEventRepositoryMockList) Return(apa1 []*model.AuditEvent, err error) *AuditEventRepositoryMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("AuditEventRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &AuditEventRepositoryMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &AuditEventRepositoryMockListResults{apa1, err}
+	return mmList.mock
+}
+
+// Set uses given function f to mock the AuditEventRepository.List method
+func (mmList *mAuditEventRepositoryMockList) Set(f func(ctx context.Context, filter *model.AuditFilter) (apa1 []*model.AuditEvent, err error)) *AuditEventRepositoryMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the AuditEventRepository.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the AuditEventRepository.List method")
+	}
+
+	mmList.mock.funcList = f
+	return mmList.mock
+}
+
+// When sets expectation for the AuditEventRepository.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mAuditEventRepositoryMockList) When(ctx context.Context, filter *model.AuditFilter) *AuditEventRepositoryMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("AuditEventRepositoryMock.List mock is already set by Set")
+	}
+
+	expectation := &AuditEventRepositoryMockListExpectation{
+		mock:   mmList.mock,
+		params: &AuditEventRepositoryMockListParams{ctx, filter},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditEventRepository.List return parameters for the expectation previously defined by the When method
+func (e *AuditEventRepositoryMockListExpectation) Then(apa1 []*model.AuditEvent, err error) *AuditEventRepositoryMock {
+	e.results = &AuditEventRepositoryMockListResults{apa1, err}
+	return e.mock
+}
+
+// Times sets number of times AuditEventRepository.List should be invoked
+func (mmList *mAuditEventRepositoryMockList) Times(n uint64) *mAuditEventRepositoryMockList {
+	if n == 0 {
+		mmList.mock.t.Fatalf("Times of AuditEventRepositoryMock.List mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
+	return mmList
+}
+
+func (mmList *mAuditEventRepositoryMockList) invocationsDone() bool {
+	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// List implements repository.AuditEventRepository
+func (mmList *AuditEventRepositoryMock) List(ctx context.Context, filter *model.AuditFilter) (apa1 []*model.AuditEvent, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx, filter)
+	}
+
+	mm_params := AuditEventRepositoryMockListParams{ctx, filter}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.apa1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditEventRepositoryMockListParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmList.t.Errorf("AuditEventRepositoryMock.List got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmList.t.Errorf("AuditEventRepositoryMock.List got unexpected parameter filter, want: %#v, got: %#v%s\n", *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("AuditEventRepositoryMock.List got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the AuditEventRepositoryMock.List")
+		}
+		return (*mm_results).apa1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx, filter)
+	}
+	mmList.t.Fatalf("Unexpected call to AuditEventRepositoryMock.List. %v %v", ctx, filter)
+	return
+}
+
+// ListAfterCounter returns a count of finished AuditEventRepositoryMock.List invocations
+func (mmList *AuditEventRepositoryMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of AuditEventRepositoryMock.List invocations
+func (mmList *AuditEventRepositoryMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditEventRepositoryMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mAuditEventRepositoryMockList) Calls() []*AuditEventRepositoryMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*AuditEventRepositoryMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *AuditEventRepositoryMock) MinimockListDone() bool {
+	if m.ListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMock.invocationsDone()
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *AuditEventRepositoryMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditEventRepositoryMock.List with params: %#v", *e.params)
+		}
+	}
+
+	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AuditEventRepositoryMock.List")
+		} else {
+			m.t.Errorf("Expected call to AuditEventRepositoryMock.List with params: %#v", *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && afterListCounter < 1 {
+		m.t.Error("Expected call to AuditEventRepositoryMock.List")
+	}
+
+	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditEventRepositoryMock.List but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), afterListCounter)
+	}
+}
+
+type mAuditEventRepositoryMockLockChain struct {
+	optional           bool
+	mock               *AuditEventRepositoryMock
+	defaultExpectation *AuditEventRepositoryMockLockChainExpectation
+	expectations       []*AuditEventRepositoryMockLockChainExpectation
+
+	callArgs []*AuditEventRepositoryMockLockChainParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AuditEventRepositoryMockLockChainExpectation specifies expectation struct of the AuditEventRepository.LockChain
+type AuditEventRepositoryMockLockChainExpectation struct {
+	mock      *AuditEventRepositoryMock
+	params    *AuditEventRepositoryMockLockChainParams
+	paramPtrs *AuditEventRepositoryMockLockChainParamPtrs
+	results   *AuditEventRepositoryMockLockChainResults
+	Counter   uint64
+}
+
+// AuditEventRepositoryMockLockChainParams contains parameters of the AuditEventRepository.LockChain
+type AuditEventRepositoryMockLockChainParams struct {
+	ctx context.Context
+}
+
+// AuditEventRepositoryMockLockChainParamPtrs contains pointers to parameters of the AuditEventRepository.LockChain
+type AuditEventRepositoryMockLockChainParamPtrs struct {
+	ctx *context.Context
+}
+
+// AuditEventRepositoryMockLockChainResults contains results of the AuditEventRepository.LockChain
+type AuditEventRepositoryMockLockChainResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLockChain *mAuditEventRepositoryMockLockChain) Optional() *mAuditEventRepositoryMockLockChain {
+	mmLockChain.optional = true
+	return mmLockChain
+}
+
+// Expect sets up expected params for AuditEventRepository.LockChain
+func (mmLockChain *mAuditEventRepositoryMockLockChain) Expect(ctx context.Context) *mAuditEventRepositoryMockLockChain {
+	if mmLockChain.mock.funcLockChain != nil {
+		mmLockChain.mock.t.Fatalf("AuditEventRepositoryMock.LockChain mock is already set by Set")
+	}
+
+	if mmLockChain.defaultExpectation == nil {
+		mmLockChain.defaultExpectation = &AuditEventRepositoryMockLockChainExpectation{}
+	}
+
+	if mmLockChain.defaultExpectation.paramPtrs != nil {
+		mmLockChain.mock.t.Fatalf("AuditEventRepositoryMock.LockChain mock is already set by ExpectParams functions")
+	}
+
+	mmLockChain.defaultExpectation.params = &AuditEventRepositoryMockLockChainParams{ctx}
+	for _, e := range mmLockChain.expectations {
+		if minimock.Equal(e.params, mmLockChain.defaultExpectation.params) {
+			mmLockChain.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLockChain.defaultExpectation.params)
+		}
+	}
+
+	return mmLockChain
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditEventRepository.LockChain
+func (mmLockChain *mAuditEventRepositoryMockLockChain) ExpectCtxParam1(ctx context.Context) *mAuditEventRepositoryMockLockChain {
+	if mmLockChain.mock.funcLockChain != nil {
+		mmLockChain.mock.t.Fatalf("AuditEventRepositoryMock.LockChain mock is already set by Set")
+	}
+
+	if mmLockChain.defaultExpectation == nil {
+		mmLockChain.defaultExpectation = &AuditEventRepositoryMockLockChainExpectation{}
+	}
+
+	if mmLockChain.defaultExpectation.params != nil {
+		mmLockChain.mock.t.Fatalf("AuditEventRepositoryMock.LockChain mock is already set by Expect")
+	}
+
+	if mmLockChain.defaultExpectation.paramPtrs == nil {
+		mmLockChain.defaultExpectation.paramPtrs = &AuditEventRepositoryMockLockChainParamPtrs{}
+	}
+	mmLockChain.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmLockChain
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditEventRepository.LockChain
+func (mmLockChain *mAuditEventRepositoryMockLockChain) Inspect(f func(ctx context.Context)) *mAuditEventRepositoryMockLockChain {
+	if mmLockChain.mock.inspectFuncLockChain != nil {
+		mmLockChain.mock.t.Fatalf("Inspect function is already set for AuditEventRepositoryMock.LockChain")
+	}
+
+	mmLockChain.mock.inspectFuncLockChain = f
+
+	return mmLockChain
+}
+
+// Return sets up results that will be returned by AuditEventRepository.LockChain
+func (mmLockChain *mAuditEventRepositoryMockLockChain) Return(err error) *AuditEventRepositoryMock {
+	if mmLockChain.mock.funcLockChain != nil {
+		mmLockChain.mock.t.Fatalf("AuditEventRepositoryMock.LockChain mock is already set by Set")
+	}
+
+	if mmLockChain.defaultExpectation == nil {
+		mmLockChain.defaultExpectation = &AuditEventRepositoryMockLockChainExpectation{mock: mmLockChain.mock}
+	}
+	mmLockChain.defaultExpectation.results = &AuditEventRepositoryMockLockChainResults{err}
+	return mmLockChain.mock
+}
+
+// Set uses given function f to mock the AuditEventRepository.LockChain method
+func (mmLockChain *mAuditEventRepositoryMockLockChain) Set(f func(ctx context.Context) (err error)) *AuditEventRepositoryMock {
+	if mmLockChain.defaultExpectation != nil {
+		mmLockChain.mock.t.Fatalf("Default expectation is already set for the AuditEventRepository.LockChain method")
+	}
+
+	if len(mmLockChain.expectations) > 0 {
+		mmLockChain.mock.t.Fatalf("Some expectations are already set for the AuditEventRepository.LockChain method")
+	}
+
+	mmLockChain.mock.funcLockChain = f
+	return mmLockChain.mock
+}
+
+// When sets expectation for the AuditEventRepository.LockChain which will trigger the result defined by the following
+// Then helper
+func (mmLockChain *mAuditEventRepositoryMockLockChain) When(ctx context.Context) *AuditEventRepositoryMockLockChainExpectation {
+	if mmLockChain.mock.funcLockChain != nil {
+		mmLockChain.mock.t.Fatalf("AuditEventRepositoryMock.LockChain mock is already set by Set")
+	}
+
+	expectation := &AuditEventRepositoryMockLockChainExpectation{
+		mock:   mmLockChain.mock,
+		params: &AuditEventRepositoryMockLockChainParams{ctx},
+	}
+	mmLockChain.expectations = append(mmLockChain.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditEventRepository.LockChain return parameters for the expectation previously defined by the When method
+func (e *AuditEventRepositoryMockLockChainExpectation) Then(err error) *AuditEventRepositoryMock {
+	e.results = &AuditEventRepositoryMockLockChainResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuditEventRepository.LockChain should be invoked
+func (mmLockChain *mAuditEventRepositoryMockLockChain) Times(n uint64) *mAuditEventRepositoryMockLockChain {
+	if n == 0 {
+		mmLockChain.mock.t.Fatalf("Times of AuditEventRepositoryMock.LockChain mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLockChain.expectedInvocations, n)
+	return mmLockChain
+}
+
+func (mmLockChain *mAuditEventRepositoryMockLockChain) invocationsDone() bool {
+	if len(mmLockChain.expectations) == 0 && mmLockChain.defaultExpectation == nil && mmLockChain.mock.funcLockChain == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLockChain.mock.afterLockChainCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLockChain.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// LockChain implements repository.AuditEventRepository
+func (mmLockChain *AuditEventRepositoryMock) LockChain(ctx context.Context) (err error) {
+	mm_atomic.AddUint64(&mmLockChain.beforeLockChainCounter, 1)
+	defer mm_atomic.AddUint64(&mmLockChain.afterLockChainCounter, 1)
+
+	if mmLockChain.inspectFuncLockChain != nil {
+		mmLockChain.inspectFuncLockChain(ctx)
+	}
+
+	mm_params := AuditEventRepositoryMockLockChainParams{ctx}
+
+	// Record call args
+	mmLockChain.LockChainMock.mutex.Lock()
+	mmLockChain.LockChainMock.callArgs = append(mmLockChain.LockChainMock.callArgs, &mm_params)
+	mmLockChain.LockChainMock.mutex.Unlock()
+
+	for _, e := range mmLockChain.LockChainMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmLockChain.LockChainMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLockChain.LockChainMock.defaultExpectation.Counter, 1)
+		mm_want := mmLockChain.LockChainMock.defaultExpectation.params
+		mm_want_ptrs := mmLockChain.LockChainMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditEventRepositoryMockLockChainParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLockChain.t.Errorf("AuditEventRepositoryMock.LockChain got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLockChain.t.Errorf("AuditEventRepositoryMock.LockChain got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLockChain.LockChainMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLockChain.t.Fatal("No results are set for the AuditEventRepositoryMock.LockChain")
+		}
+		return (*mm_results).err
+	}
+	if mmLockChain.funcLockChain != nil {
+		return mmLockChain.funcLockChain(ctx)
+	}
+	mmLockChain.t.Fatalf("Unexpected call to AuditEventRepositoryMock.LockChain. %v", ctx)
+	return
+}
+
+// LockChainAfterCounter returns a count of finished AuditEventRepositoryMock.LockChain invocations
+func (mmLockChain *AuditEventRepositoryMock) LockChainAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLockChain.afterLockChainCounter)
+}
+
+// LockChainBeforeCounter returns a count of AuditEventRepositoryMock.LockChain invocations
+func (mmLockChain *AuditEventRepositoryMock) LockChainBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLockChain.beforeLockChainCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditEventRepositoryMock.LockChain.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLockChain *mAuditEventRepositoryMockLockChain) Calls() []*AuditEventRepositoryMockLockChainParams {
+	mmLockChain.mutex.RLock()
+
+	argCopy := make([]*AuditEventRepositoryMockLockChainParams, len(mmLockChain.callArgs))
+	copy(argCopy, mmLockChain.callArgs)
+
+	mmLockChain.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLockChainDone returns true if the count of the LockChain invocations corresponds
+// the number of defined expectations
+func (m *AuditEventRepositoryMock) MinimockLockChainDone() bool {
+	if m.LockChainMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LockChainMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LockChainMock.invocationsDone()
+}
+
+// MinimockLockChainInspect logs each unmet expectation
+func (m *AuditEventRepositoryMock) MinimockLockChainInspect() {
+	for _, e := range m.LockChainMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditEventRepositoryMock.LockChain with params: %#v", *e.params)
+		}
+	}
+
+	afterLockChainCounter := mm_atomic.LoadUint64(&m.afterLockChainCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LockChainMock.defaultExpectation != nil && afterLockChainCounter < 1 {
+		if m.LockChainMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AuditEventRepositoryMock.LockChain")
+		} else {
+			m.t.Errorf("Expected call to AuditEventRepositoryMock.LockChain with params: %#v", *m.LockChainMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLockChain != nil && afterLockChainCounter < 1 {
+		m.t.Error("Expected call to AuditEventRepositoryMock.LockChain")
+	}
+
+	if !m.LockChainMock.invocationsDone() && afterLockChainCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditEventRepositoryMock.LockChain but found %d calls",
+			mm_atomic.LoadUint64(&m.LockChainMock.expectedInvocations), afterLockChainCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AuditEventRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateInspect()
+
+			m.MinimockLastHashInspect()
+
+			m.MinimockListInspect()
+
+			m.MinimockLockChainInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AuditEventRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AuditEventRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateDone() &&
+		m.MinimockLastHashDone() &&
+		m.MinimockListDone() &&
+		m.MinimockLockChainDone()
+}
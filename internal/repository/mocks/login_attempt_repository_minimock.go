@@ -0,0 +1,1448 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.13). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i di_container/internal/repository.LoginAttemptRepository -o login_attempt_repository_minimock.go -n LoginAttemptRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// LoginAttemptRepositoryMock implements repository.LoginAttemptRepository
+type LoginAttemptRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGetLockedUntil          func(ctx context.Context, keys ...string) (t1 time.Time, err error)
+	inspectFuncGetLockedUntil   func(ctx context.Context, keys ...string)
+	afterGetLockedUntilCounter  uint64
+	beforeGetLockedUntilCounter uint64
+	GetLockedUntilMock          mLoginAttemptRepositoryMockGetLockedUntil
+
+	funcLock          func(ctx context.Context, key string, until time.Time) (err error)
+	inspectFuncLock   func(ctx context.Context, key string, until time.Time)
+	afterLockCounter  uint64
+	beforeLockCounter uint64
+	LockMock          mLoginAttemptRepositoryMockLock
+
+	funcRegisterFailure          func(ctx context.Context, key string, window time.Duration) (i1 int64, err error)
+	inspectFuncRegisterFailure   func(ctx context.Context, key string, window time.Duration)
+	afterRegisterFailureCounter  uint64
+	beforeRegisterFailureCounter uint64
+	RegisterFailureMock          mLoginAttemptRepositoryMockRegisterFailure
+
+	funcReset          func(ctx context.Context, key string) (err error)
+	inspectFuncReset   func(ctx context.Context, key string)
+	afterResetCounter  uint64
+	beforeResetCounter uint64
+	ResetMock          mLoginAttemptRepositoryMockReset
+}
+
+// NewLoginAttemptRepositoryMock returns a mock for repository.LoginAttemptRepository
+func NewLoginAttemptRepositoryMock(t minimock.Tester) *LoginAttemptRepositoryMock {
+	m := &LoginAttemptRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetLockedUntilMock = mLoginAttemptRepositoryMockGetLockedUntil{mock: m}
+	m.GetLockedUntilMock.callArgs = []*LoginAttemptRepositoryMockGetLockedUntilParams{}
+
+	m.LockMock = mLoginAttemptRepositoryMockLock{mock: m}
+	m.LockMock.callArgs = []*LoginAttemptRepositoryMockLockParams{}
+
+	m.RegisterFailureMock = mLoginAttemptRepositoryMockRegisterFailure{mock: m}
+	m.RegisterFailureMock.callArgs = []*LoginAttemptRepositoryMockRegisterFailureParams{}
+
+	m.ResetMock = mLoginAttemptRepositoryMockReset{mock: m}
+	m.ResetMock.callArgs = []*LoginAttemptRepositoryMockResetParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mLoginAttemptRepositoryMockGetLockedUntil struct {
+	optional           bool
+	mock               *LoginAttemptRepositoryMock
+	defaultExpectation *LoginAttemptRepositoryMockGetLockedUntilExpectation
+	expectations       []*LoginAttemptRepositoryMockGetLockedUntilExpectation
+
+	callArgs []*LoginAttemptRepositoryMockGetLockedUntilParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LoginAttemptRepositoryMockGetLockedUntilExpectation specifies expectation struct of the LoginAttemptRepository.GetLockedUntil
+type LoginAttemptRepositoryMockGetLockedUntilExpectation struct {
+	mock      *LoginAttemptRepositoryMock
+	params    *LoginAttemptRepositoryMockGetLockedUntilParams
+	paramPtrs *LoginAttemptRepositoryMockGetLockedUntilParamPtrs
+	results   *LoginAttemptRepositoryMockGetLockedUntilResults
+	Counter   uint64
+}
+
+// LoginAttemptRepositoryMockGetLockedUntilParams contains parameters of the LoginAttemptRepository.GetLockedUntil
+type LoginAttemptRepositoryMockGetLockedUntilParams struct {
+	ctx  context.Context
+	keys []string
+}
+
+// LoginAttemptRepositoryMockGetLockedUntilParamPtrs contains pointers to parameters of the LoginAttemptRepository.GetLockedUntil
+type LoginAttemptRepositoryMockGetLockedUntilParamPtrs struct {
+	ctx  *context.Context
+	keys *[]string
+}
+
+// LoginAttemptRepositoryMockGetLockedUntilResults contains results of the LoginAttemptRepository.GetLockedUntil
+type LoginAttemptRepositoryMockGetLockedUntilResults struct {
+	t1  time.Time
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetLockedUntil *mLoginAttemptRepositoryMockGetLockedUntil) Optional() *mLoginAttemptRepositoryMockGetLockedUntil {
+	mmGetLockedUntil.optional = true
+	return mmGetLockedUntil
+}
+
+// Expect sets up expected params for LoginAttemptRepository.GetLockedUntil
+func (mmGetLockedUntil *mLoginAttemptRepositoryMockGetLockedUntil) Expect(ctx context.Context, keys ...string) *mLoginAttemptRepositoryMockGetLockedUntil {
+	if mmGetLockedUntil.mock.funcGetLockedUntil != nil {
+		mmGetLockedUntil.mock.t.Fatalf("LoginAttemptRepositoryMock.GetLockedUntil mock is already set by Set")
+	}
+
+	if mmGetLockedUntil.defaultExpectation == nil {
+		mmGetLockedUntil.defaultExpectation = &LoginAttemptRepositoryMockGetLockedUntilExpectation{}
+	}
+
+	if mmGetLockedUntil.defaultExpectation.paramPtrs != nil {
+		mmGetLockedUntil.mock.t.Fatalf("LoginAttemptRepositoryMock.GetLockedUntil mock is already set by ExpectParams functions")
+	}
+
+	mmGetLockedUntil.defaultExpectation.params = &LoginAttemptRepositoryMockGetLockedUntilParams{ctx, keys}
+	for _, e := range mmGetLockedUntil.expectations {
+		if minimock.Equal(e.params, mmGetLockedUntil.defaultExpectation.params) {
+			mmGetLockedUntil.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetLockedUntil.defaultExpectation.params)
+		}
+	}
+
+	return mmGetLockedUntil
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LoginAttemptRepository.GetLockedUntil
+func (mmGetLockedUntil *mLoginAttemptRepositoryMockGetLockedUntil) ExpectCtxParam1(ctx context.Context) *mLoginAttemptRepositoryMockGetLockedUntil {
+	if mmGetLockedUntil.mock.funcGetLockedUntil != nil {
+		mmGetLockedUntil.mock.t.Fatalf("LoginAttemptRepositoryMock.GetLockedUntil mock is already set by Set")
+	}
+
+	if mmGetLockedUntil.defaultExpectation == nil {
+		mmGetLockedUntil.defaultExpectation = &LoginAttemptRepositoryMockGetLockedUntilExpectation{}
+	}
+
+	if mmGetLockedUntil.defaultExpectation.params != nil {
+		mmGetLockedUntil.mock.t.Fatalf("LoginAttemptRepositoryMock.GetLockedUntil mock is already set by Expect")
+	}
+
+	if mmGetLockedUntil.defaultExpectation.paramPtrs == nil {
+		mmGetLockedUntil.defaultExpectation.paramPtrs = &LoginAttemptRepositoryMockGetLockedUntilParamPtrs{}
+	}
+	mmGetLockedUntil.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetLockedUntil
+}
+
+// ExpectKeysParam2 sets up expected param keys for LoginAttemptRepository.GetLockedUntil
+func (mmGetLockedUntil *mLoginAttemptRepositoryMockGetLockedUntil) ExpectKeysParam2(keys ...string) *mLoginAttemptRepositoryMockGetLockedUntil {
+	if mmGetLockedUntil.mock.funcGetLockedUntil != nil {
+		mmGetLockedUntil.mock.t.Fatalf("LoginAttemptRepositoryMock.GetLockedUntil mock is already set by Set")
+	}
+
+	if mmGetLockedUntil.defaultExpectation == nil {
+		mmGetLockedUntil.defaultExpectation = &LoginAttemptRepositoryMockGetLockedUntilExpectation{}
+	}
+
+	if mmGetLockedUntil.defaultExpectation.params != nil {
+		mmGetLockedUntil.mock.t.Fatalf("LoginAttemptRepositoryMock.GetLockedUntil mock is already set by Expect")
+	}
+
+	if mmGetLockedUntil.defaultExpectation.paramPtrs == nil {
+		mmGetLockedUntil.defaultExpectation.paramPtrs = &LoginAttemptRepositoryMockGetLockedUntilParamPtrs{}
+	}
+	mmGetLockedUntil.defaultExpectation.paramPtrs.keys = &keys
+
+	return mmGetLockedUntil
+}
+
+// Inspect accepts an inspector function that has same arguments as the LoginAttemptRepository.GetLockedUntil
+func (mmGetLockedUntil *mLoginAttemptRepositoryMockGetLockedUntil) Inspect(f func(ctx context.Context, keys ...string)) *mLoginAttemptRepositoryMockGetLockedUntil {
+	if mmGetLockedUntil.mock.inspectFuncGetLockedUntil != nil {
+		mmGetLockedUntil.mock.t.Fatalf("Inspect function is already set for LoginAttemptRepositoryMock.GetLockedUntil")
+	}
+
+	mmGetLockedUntil.mock.inspectFuncGetLockedUntil = f
+
+	return mmGetLockedUntil
+}
+
+// Return sets up results that will be returned by LoginAttemptRepository.GetLockedUntil
+func (mmGetLockedUntil *mLoginAttemptRepositoryMockGetLockedUntil) Return(t1 time.Time, err error) *LoginAttemptRepositoryMock {
+	if mmGetLockedUntil.mock.funcGetLockedUntil != nil {
+		mmGetLockedUntil.mock.t.Fatalf("LoginAttemptRepositoryMock.GetLockedUntil mock is already set by Set")
+	}
+
+	if mmGetLockedUntil.defaultExpectation == nil {
+		mmGetLockedUntil.defaultExpectation = &LoginAttemptRepositoryMockGetLockedUntilExpectation{mock: mmGetLockedUntil.mock}
+	}
+	mmGetLockedUntil.defaultExpectation.results = &LoginAttemptRepositoryMockGetLockedUntilResults{t1, err}
+	return mmGetLockedUntil.mock
+}
+
+// Set uses given function f to mock the LoginAttemptRepository.GetLockedUntil method
+func (mmGetLockedUntil *mLoginAttemptRepositoryMockGetLockedUntil) Set(f func(ctx context.Context, keys ...string) (t1 time.Time, err error)) *LoginAttemptRepositoryMock {
+	if mmGetLockedUntil.defaultExpectation != nil {
+		mmGetLockedUntil.mock.t.Fatalf("Default expectation is already set for the LoginAttemptRepository.GetLockedUntil method")
+	}
+
+	if len(mmGetLockedUntil.expectations) > 0 {
+		mmGetLockedUntil.mock.t.Fatalf("Some expectations are already set for the LoginAttemptRepository.GetLockedUntil method")
+	}
+
+	mmGetLockedUntil.mock.funcGetLockedUntil = f
+	return mmGetLockedUntil.mock
+}
+
+// When sets expectation for the LoginAttemptRepository.GetLockedUntil which will trigger the result defined by the following
+// Then helper
+func (mmGetLockedUntil *mLoginAttemptRepositoryMockGetLockedUntil) When(ctx context.Context, keys ...string) *LoginAttemptRepositoryMockGetLockedUntilExpectation {
+	if mmGetLockedUntil.mock.funcGetLockedUntil != nil {
+		mmGetLockedUntil.mock.t.Fatalf("LoginAttemptRepositoryMock.GetLockedUntil mock is already set by Set")
+	}
+
+	expectation := &LoginAttemptRepositoryMockGetLockedUntilExpectation{
+		mock:   mmGetLockedUntil.mock,
+		params: &LoginAttemptRepositoryMockGetLockedUntilParams{ctx, keys},
+	}
+	mmGetLockedUntil.expectations = append(mmGetLockedUntil.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LoginAttemptRepository.GetLockedUntil return parameters for the expectation previously defined by the When method
+func (e *LoginAttemptRepositoryMockGetLockedUntilExpectation) Then(t1 time.Time, err error) *LoginAttemptRepositoryMock {
+	e.results = &LoginAttemptRepositoryMockGetLockedUntilResults{t1, err}
+	return e.mock
+}
+
+// Times sets number of times LoginAttemptRepository.GetLockedUntil should be invoked
+func (mmGetLockedUntil *mLoginAttemptRepositoryMockGetLockedUntil) Times(n uint64) *mLoginAttemptRepositoryMockGetLockedUntil {
+	if n == 0 {
+		mmGetLockedUntil.mock.t.Fatalf("Times of LoginAttemptRepositoryMock.GetLockedUntil mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetLockedUntil.expectedInvocations, n)
+	return mmGetLockedUntil
+}
+
+func (mmGetLockedUntil *mLoginAttemptRepositoryMockGetLockedUntil) invocationsDone() bool {
+	if len(mmGetLockedUntil.expectations) == 0 && mmGetLockedUntil.defaultExpectation == nil && mmGetLockedUntil.mock.funcGetLockedUntil == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetLockedUntil.mock.afterGetLockedUntilCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetLockedUntil.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetLockedUntil implements repository.LoginAttemptRepository
+func (mmGetLockedUntil *LoginAttemptRepositoryMock) GetLockedUntil(ctx context.Context, keys ...string) (t1 time.Time, err error) {
+	mm_atomic.AddUint64(&mmGetLockedUntil.beforeGetLockedUntilCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetLockedUntil.afterGetLockedUntilCounter, 1)
+
+	if mmGetLockedUntil.inspectFuncGetLockedUntil != nil {
+		mmGetLockedUntil.inspectFuncGetLockedUntil(ctx, keys...)
+	}
+
+	mm_params := LoginAttemptRepositoryMockGetLockedUntilParams{ctx, keys}
+
+	// Record call args
+	mmGetLockedUntil.GetLockedUntilMock.mutex.Lock()
+	mmGetLockedUntil.GetLockedUntilMock.callArgs = append(mmGetLockedUntil.GetLockedUntilMock.callArgs, &mm_params)
+	mmGetLockedUntil.GetLockedUntilMock.mutex.Unlock()
+
+	for _, e := range mmGetLockedUntil.GetLockedUntilMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.t1, e.results.err
+		}
+	}
+
+	if mmGetLockedUntil.GetLockedUntilMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetLockedUntil.GetLockedUntilMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetLockedUntil.GetLockedUntilMock.defaultExpectation.params
+		mm_want_ptrs := mmGetLockedUntil.GetLockedUntilMock.defaultExpectation.paramPtrs
+
+		mm_got := LoginAttemptRepositoryMockGetLockedUntilParams{ctx, keys}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetLockedUntil.t.Errorf("LoginAttemptRepositoryMock.GetLockedUntil got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.keys != nil && !minimock.Equal(*mm_want_ptrs.keys, mm_got.keys) {
+				mmGetLockedUntil.t.Errorf("LoginAttemptRepositoryMock.GetLockedUntil got unexpected parameter keys, want: %#v, got: %#v%s\n", *mm_want_ptrs.keys, mm_got.keys, minimock.Diff(*mm_want_ptrs.keys, mm_got.keys))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetLockedUntil.t.Errorf("LoginAttemptRepositoryMock.GetLockedUntil got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetLockedUntil.GetLockedUntilMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetLockedUntil.t.Fatal("No results are set for the LoginAttemptRepositoryMock.GetLockedUntil")
+		}
+		return (*mm_results).t1, (*mm_results).err
+	}
+	if mmGetLockedUntil.funcGetLockedUntil != nil {
+		return mmGetLockedUntil.funcGetLockedUntil(ctx, keys...)
+	}
+	mmGetLockedUntil.t.Fatalf("Unexpected call to LoginAttemptRepositoryMock.GetLockedUntil. %v %v", ctx, keys)
+	return
+}
+
+// GetLockedUntilAfterCounter returns a count of finished LoginAttemptRepositoryMock.GetLockedUntil invocations
+func (mmGetLockedUntil *LoginAttemptRepositoryMock) GetLockedUntilAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetLockedUntil.afterGetLockedUntilCounter)
+}
+
+// GetLockedUntilBeforeCounter returns a count of LoginAttemptRepositoryMock.GetLockedUntil invocations
+func (mmGetLockedUntil *LoginAttemptRepositoryMock) GetLockedUntilBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetLockedUntil.beforeGetLockedUntilCounter)
+}
+
+// Calls returns a list of arguments used in each call to LoginAttemptRepositoryMock.GetLockedUntil.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetLockedUntil *mLoginAttemptRepositoryMockGetLockedUntil) Calls() []*LoginAttemptRepositoryMockGetLockedUntilParams {
+	mmGetLockedUntil.mutex.RLock()
+
+	argCopy := make([]*LoginAttemptRepositoryMockGetLockedUntilParams, len(mmGetLockedUntil.callArgs))
+	copy(argCopy, mmGetLockedUntil.callArgs)
+
+	mmGetLockedUntil.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetLockedUntilDone returns true if the count of the GetLockedUntil invocations corresponds
+// the number of defined expectations
+func (m *LoginAttemptRepositoryMock) MinimockGetLockedUntilDone() bool {
+	if m.GetLockedUntilMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetLockedUntilMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetLockedUntilMock.invocationsDone()
+}
+
+// MinimockGetLockedUntilInspect logs each unmet expectation
+func (m *LoginAttemptRepositoryMock) MinimockGetLockedUntilInspect() {
+	for _, e := range m.GetLockedUntilMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LoginAttemptRepositoryMock.GetLockedUntil with params: %#v", *e.params)
+		}
+	}
+
+	afterGetLockedUntilCounter := mm_atomic.LoadUint64(&m.afterGetLockedUntilCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetLockedUntilMock.defaultExpectation != nil && afterGetLockedUntilCounter < 1 {
+		if m.GetLockedUntilMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LoginAttemptRepositoryMock.GetLockedUntil")
+		} else {
+			m.t.Errorf("Expected call to LoginAttemptRepositoryMock.GetLockedUntil with params: %#v", *m.GetLockedUntilMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetLockedUntil != nil && afterGetLockedUntilCounter < 1 {
+		m.t.Error("Expected call to LoginAttemptRepositoryMock.GetLockedUntil")
+	}
+
+	if !m.GetLockedUntilMock.invocationsDone() && afterGetLockedUntilCounter > 0 {
+		m.t.Errorf("Expected %d calls to LoginAttemptRepositoryMock.GetLockedUntil but found %d calls",
+			mm_atomic.LoadUint64(&m.GetLockedUntilMock.expectedInvocations), afterGetLockedUntilCounter)
+	}
+}
+
+type mLoginAttemptRepositoryMockLock struct {
+	optional           bool
+	mock               *LoginAttemptRepositoryMock
+	defaultExpectation *LoginAttemptRepositoryMockLockExpectation
+	expectations       []*LoginAttemptRepositoryMockLockExpectation
+
+	callArgs []*LoginAttemptRepositoryMockLockParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LoginAttemptRepositoryMockLockExpectation specifies expectation struct of the LoginAttemptRepository.Lock
+type LoginAttemptRepositoryMockLockExpectation struct {
+	mock      *LoginAttemptRepositoryMock
+	params    *LoginAttemptRepositoryMockLockParams
+	paramPtrs *LoginAttemptRepositoryMockLockParamPtrs
+	results   *LoginAttemptRepositoryMockLockResults
+	Counter   uint64
+}
+
+// LoginAttemptRepositoryMockLockParams contains parameters of the LoginAttemptRepository.Lock
+type LoginAttemptRepositoryMockLockParams struct {
+	ctx   context.Context
+	key   string
+	until time.Time
+}
+
+// LoginAttemptRepositoryMockLockParamPtrs contains pointers to parameters of the LoginAttemptRepository.Lock
+type LoginAttemptRepositoryMockLockParamPtrs struct {
+	ctx   *context.Context
+	key   *string
+	until *time.Time
+}
+
+// LoginAttemptRepositoryMockLockResults contains results of the LoginAttemptRepository.Lock
+type LoginAttemptRepositoryMockLockResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLock *mLoginAttemptRepositoryMockLock) Optional() *mLoginAttemptRepositoryMockLock {
+	mmLock.optional = true
+	return mmLock
+}
+
+// Expect sets up expected params for LoginAttemptRepository.Lock
+func (mmLock *mLoginAttemptRepositoryMockLock) Expect(ctx context.Context, key string, until time.Time) *mLoginAttemptRepositoryMockLock {
+	if mmLock.mock.funcLock != nil {
+		mmLock.mock.t.Fatalf("LoginAttemptRepositoryMock.Lock mock is already set by Set")
+	}
+
+	if mmLock.defaultExpectation == nil {
+		mmLock.defaultExpectation = &LoginAttemptRepositoryMockLockExpectation{}
+	}
+
+	if mmLock.defaultExpectation.paramPtrs != nil {
+		mmLock.mock.t.Fatalf("LoginAttemptRepositoryMock.Lock mock is already set by ExpectParams functions")
+	}
+
+	mmLock.defaultExpectation.params = &LoginAttemptRepositoryMockLockParams{ctx, key, until}
+	for _, e := range mmLock.expectations {
+		if minimock.Equal(e.params, mmLock.defaultExpectation.params) {
+			mmLock.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLock.defaultExpectation.params)
+		}
+	}
+
+	return mmLock
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LoginAttemptRepository.Lock
+func (mmLock *mLoginAttemptRepositoryMockLock) ExpectCtxParam1(ctx context.Context) *mLoginAttemptRepositoryMockLock {
+	if mmLock.mock.funcLock != nil {
+		mmLock.mock.t.Fatalf("LoginAttemptRepositoryMock.Lock mock is already set by Set")
+	}
+
+	if mmLock.defaultExpectation == nil {
+		mmLock.defaultExpectation = &LoginAttemptRepositoryMockLockExpectation{}
+	}
+
+	if mmLock.defaultExpectation.params != nil {
+		mmLock.mock.t.Fatalf("LoginAttemptRepositoryMock.Lock mock is already set by Expect")
+	}
+
+	if mmLock.defaultExpectation.paramPtrs == nil {
+		mmLock.defaultExpectation.paramPtrs = &LoginAttemptRepositoryMockLockParamPtrs{}
+	}
+	mmLock.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmLock
+}
+
+// ExpectKeyParam2 sets up expected param key for LoginAttemptRepository.Lock
+func (mmLock *mLoginAttemptRepositoryMockLock) ExpectKeyParam2(key string) *mLoginAttemptRepositoryMockLock {
+	if mmLock.mock.funcLock != nil {
+		mmLock.mock.t.Fatalf("LoginAttemptRepositoryMock.Lock mock is already set by Set")
+	}
+
+	if mmLock.defaultExpectation == nil {
+		mmLock.defaultExpectation = &LoginAttemptRepositoryMockLockExpectation{}
+	}
+
+	if mmLock.defaultExpectation.params != nil {
+		mmLock.mock.t.Fatalf("LoginAttemptRepositoryMock.Lock mock is already set by Expect")
+	}
+
+	if mmLock.defaultExpectation.paramPtrs == nil {
+		mmLock.defaultExpectation.paramPtrs = &LoginAttemptRepositoryMockLockParamPtrs{}
+	}
+	mmLock.defaultExpectation.paramPtrs.key = &key
+
+	return mmLock
+}
+
+// ExpectUntilParam3 sets up expected param until for LoginAttemptRepository.Lock
+func (mmLock *mLoginAttemptRepositoryMockLock) ExpectUntilParam3(until time.Time) *mLoginAttemptRepositoryMockLock {
+	if mmLock.mock.funcLock != nil {
+		mmLock.mock.t.Fatalf("LoginAttemptRepositoryMock.Lock mock is already set by Set")
+	}
+
+	if mmLock.defaultExpectation == nil {
+		mmLock.defaultExpectation = &LoginAttemptRepositoryMockLockExpectation{}
+	}
+
+	if mmLock.defaultExpectation.params != nil {
+		mmLock.mock.t.Fatalf("LoginAttemptRepositoryMock.Lock mock is already set by Expect")
+	}
+
+	if mmLock.defaultExpectation.paramPtrs == nil {
+		mmLock.defaultExpectation.paramPtrs = &LoginAttemptRepositoryMockLockParamPtrs{}
+	}
+	mmLock.defaultExpectation.paramPtrs.until = &until
+
+	return mmLock
+}
+
+// Inspect accepts an inspector function that has same arguments as the LoginAttemptRepository.Lock
+func (mmLock *mLoginAttemptRepositoryMockLock) Inspect(f func(ctx context.Context, key string, until time.Time)) *mLoginAttemptRepositoryMockLock {
+	if mmLock.mock.inspectFuncLock != nil {
+		mmLock.mock.t.Fatalf("Inspect function is already set for LoginAttemptRepositoryMock.Lock")
+	}
+
+	mmLock.mock.inspectFuncLock = f
+
+	return mmLock
+}
+
+// Return sets up results that will be returned by LoginAttemptRepository.Lock
+func (mmLock *mLoginAttemptRepositoryMockLock) Return(err error) *LoginAttemptRepositoryMock {
+	if mmLock.mock.funcLock != nil {
+		mmLock.mock.t.Fatalf("LoginAttemptRepositoryMock.Lock mock is already set by Set")
+	}
+
+	if mmLock.defaultExpectation == nil {
+		mmLock.defaultExpectation = &LoginAttemptRepositoryMockLockExpectation{mock: mmLock.mock}
+	}
+	mmLock.defaultExpectation.results = &LoginAttemptRepositoryMockLockResults{err}
+	return mmLock.mock
+}
+
+// Set uses given function f to mock the LoginAttemptRepository.Lock method
+func (mmLock *mLoginAttemptRepositoryMockLock) Set(f func(ctx context.Context, key string, until time.Time) (err error)) *LoginAttemptRepositoryMock {
+	if mmLock.defaultExpectation != nil {
+		mmLock.mock.t.Fatalf("Default expectation is already set for the LoginAttemptRepository.Lock method")
+	}
+
+	if len(mmLock.expectations) > 0 {
+		mmLock.mock.t.Fatalf("Some expectations are already set for the LoginAttemptRepository.Lock method")
+	}
+
+	mmLock.mock.funcLock = f
+	return mmLock.mock
+}
+
+// When sets expectation for the LoginAttemptRepository.Lock which will trigger the result defined by the following
+// Then helper
+func (mmLock *mLoginAttemptRepositoryMockLock) When(ctx context.Context, key string, until time.Time) *LoginAttemptRepositoryMockLockExpectation {
+	if mmLock.mock.funcLock != nil {
+		mmLock.mock.t.Fatalf("LoginAttemptRepositoryMock.Lock mock is already set by Set")
+	}
+
+	expectation := &LoginAttemptRepositoryMockLockExpectation{
+		mock:   mmLock.mock,
+		params: &LoginAttemptRepositoryMockLockParams{ctx, key, until},
+	}
+	mmLock.expectations = append(mmLock.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LoginAttemptRepository.Lock return parameters for the expectation previously defined by the When method
+func (e *LoginAttemptRepositoryMockLockExpectation) Then(err error) *LoginAttemptRepositoryMock {
+	e.results = &LoginAttemptRepositoryMockLockResults{err}
+	return e.mock
+}
+
+// Times sets number of times LoginAttemptRepository.Lock should be invoked
+func (mmLock *mLoginAttemptRepositoryMockLock) Times(n uint64) *mLoginAttemptRepositoryMockLock {
+	if n == 0 {
+		mmLock.mock.t.Fatalf("Times of LoginAttemptRepositoryMock.Lock mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLock.expectedInvocations, n)
+	return mmLock
+}
+
+func (mmLock *mLoginAttemptRepositoryMockLock) invocationsDone() bool {
+	if len(mmLock.expectations) == 0 && mmLock.defaultExpectation == nil && mmLock.mock.funcLock == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLock.mock.afterLockCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLock.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Lock implements repository.LoginAttemptRepository
+func (mmLock *LoginAttemptRepositoryMock) Lock(ctx context.Context, key string, until time.Time) (err error) {
+	mm_atomic.AddUint64(&mmLock.beforeLockCounter, 1)
+	defer mm_atomic.AddUint64(&mmLock.afterLockCounter, 1)
+
+	if mmLock.inspectFuncLock != nil {
+		mmLock.inspectFuncLock(ctx, key, until)
+	}
+
+	mm_params := LoginAttemptRepositoryMockLockParams{ctx, key, until}
+
+	// Record call args
+	mmLock.LockMock.mutex.Lock()
+	mmLock.LockMock.callArgs = append(mmLock.LockMock.callArgs, &mm_params)
+	mmLock.LockMock.mutex.Unlock()
+
+	for _, e := range mmLock.LockMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmLock.LockMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLock.LockMock.defaultExpectation.Counter, 1)
+		mm_want := mmLock.LockMock.defaultExpectation.params
+		mm_want_ptrs := mmLock.LockMock.defaultExpectation.paramPtrs
+
+		mm_got := LoginAttemptRepositoryMockLockParams{ctx, key, until}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLock.t.Errorf("LoginAttemptRepositoryMock.Lock got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmLock.t.Errorf("LoginAttemptRepositoryMock.Lock got unexpected parameter key, want: %#v, got: %#v%s\n", *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.until != nil && !minimock.Equal(*mm_want_ptrs.until, mm_got.until) {
+				mmLock.t.Errorf("LoginAttemptRepositoryMock.Lock got unexpected parameter until, want: %#v, got: %#v%s\n", *mm_want_ptrs.until, mm_got.until, minimock.Diff(*mm_want_ptrs.until, mm_got.until))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLock.t.Errorf("LoginAttemptRepositoryMock.Lock got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLock.LockMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLock.t.Fatal("No results are set for the LoginAttemptRepositoryMock.Lock")
+		}
+		return (*mm_results).err
+	}
+	if mmLock.funcLock != nil {
+		return mmLock.funcLock(ctx, key, until)
+	}
+	mmLock.t.Fatalf("Unexpected call to LoginAttemptRepositoryMock.Lock. %v %v %v", ctx, key, until)
+	return
+}
+
+// LockAfterCounter returns a count of finished LoginAttemptRepositoryMock.Lock invocations
+func (mmLock *LoginAttemptRepositoryMock) LockAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLock.afterLockCounter)
+}
+
+// LockBeforeCounter returns a count of LoginAttemptRepositoryMock.Lock invocations
+func (mmLock *LoginAttemptRepositoryMock) LockBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLock.beforeLockCounter)
+}
+
+// Calls returns a list of arguments used in each call to LoginAttemptRepositoryMock.Lock.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLock *mLoginAttemptRepositoryMockLock) Calls() []*LoginAttemptRepositoryMockLockParams {
+	mmLock.mutex.RLock()
+
+	argCopy := make([]*LoginAttemptRepositoryMockLockParams, len(mmLock.callArgs))
+	copy(argCopy, mmLock.callArgs)
+
+	mmLock.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLockDone returns true if the count of the Lock invocations corresponds
+// the number of defined expectations
+func (m *LoginAttemptRepositoryMock) MinimockLockDone() bool {
+	if m.LockMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LockMock.invocationsDone()
+}
+
+// MinimockLockInspect logs each unmet expectation
+func (m *LoginAttemptRepositoryMock) MinimockLockInspect() {
+	for _, e := range m.LockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LoginAttemptRepositoryMock.Lock with params: %#v", *e.params)
+		}
+	}
+
+	afterLockCounter := mm_atomic.LoadUint64(&m.afterLockCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LockMock.defaultExpectation != nil && afterLockCounter < 1 {
+		if m.LockMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LoginAttemptRepositoryMock.Lock")
+		} else {
+			m.t.Errorf("Expected call to LoginAttemptRepositoryMock.Lock with params: %#v", *m.LockMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLock != nil && afterLockCounter < 1 {
+		m.t.Error("Expected call to LoginAttemptRepositoryMock.Lock")
+	}
+
+	if !m.LockMock.invocationsDone() && afterLockCounter > 0 {
+		m.t.Errorf("Expected %d calls to LoginAttemptRepositoryMock.Lock but found %d calls",
+			mm_atomic.LoadUint64(&m.LockMock.expectedInvocations), afterLockCounter)
+	}
+}
+
+type mLoginAttemptRepositoryMockRegisterFailure struct {
+	optional           bool
+	mock               *LoginAttemptRepositoryMock
+	defaultExpectation *LoginAttemptRepositoryMockRegisterFailureExpectation
+	expectations       []*LoginAttemptRepositoryMockRegisterFailureExpectation
+
+	callArgs []*LoginAttemptRepositoryMockRegisterFailureParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LoginAttemptRepositoryMockRegisterFailureExpectation specifies expectation struct of the LoginAttemptRepository.RegisterFailure
+type LoginAttemptRepositoryMockRegisterFailureExpectation struct {
+	mock      *LoginAttemptRepositoryMock
+	params    *LoginAttemptRepositoryMockRegisterFailureParams
+	paramPtrs *LoginAttemptRepositoryMockRegisterFailureParamPtrs
+	results   *LoginAttemptRepositoryMockRegisterFailureResults
+	Counter   uint64
+}
+
+// LoginAttemptRepositoryMockRegisterFailureParams contains parameters of the LoginAttemptRepository.RegisterFailure
+type LoginAttemptRepositoryMockRegisterFailureParams struct {
+	ctx    context.Context
+	key    string
+	window time.Duration
+}
+
+// LoginAttemptRepositoryMockRegisterFailureParamPtrs contains pointers to parameters of the LoginAttemptRepository.RegisterFailure
+type LoginAttemptRepositoryMockRegisterFailureParamPtrs struct {
+	ctx    *context.Context
+	key    *string
+	window *time.Duration
+}
+
+// LoginAttemptRepositoryMockRegisterFailureResults contains results of the LoginAttemptRepository.RegisterFailure
+type LoginAttemptRepositoryMockRegisterFailureResults struct {
+	i1  int64
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRegisterFailure *mLoginAttemptRepositoryMockRegisterFailure) Optional() *mLoginAttemptRepositoryMockRegisterFailure {
+	mmRegisterFailure.optional = true
+	return mmRegisterFailure
+}
+
+// Expect sets up expected params for LoginAttemptRepository.RegisterFailure
+func (mmRegisterFailure *mLoginAttemptRepositoryMockRegisterFailure) Expect(ctx context.Context, key string, window time.Duration) *mLoginAttemptRepositoryMockRegisterFailure {
+	if mmRegisterFailure.mock.funcRegisterFailure != nil {
+		mmRegisterFailure.mock.t.Fatalf("LoginAttemptRepositoryMock.RegisterFailure mock is already set by Set")
+	}
+
+	if mmRegisterFailure.defaultExpectation == nil {
+		mmRegisterFailure.defaultExpectation = &LoginAttemptRepositoryMockRegisterFailureExpectation{}
+	}
+
+	if mmRegisterFailure.defaultExpectation.paramPtrs != nil {
+		mmRegisterFailure.mock.t.Fatalf("LoginAttemptRepositoryMock.RegisterFailure mock is already set by ExpectParams functions")
+	}
+
+	mmRegisterFailure.defaultExpectation.params = &LoginAttemptRepositoryMockRegisterFailureParams{ctx, key, window}
+	for _, e := range mmRegisterFailure.expectations {
+		if minimock.Equal(e.params, mmRegisterFailure.defaultExpectation.params) {
+			mmRegisterFailure.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRegisterFailure.defaultExpectation.params)
+		}
+	}
+
+	return mmRegisterFailure
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LoginAttemptRepository.RegisterFailure
+func (mmRegisterFailure *mLoginAttemptRepositoryMockRegisterFailure) ExpectCtxParam1(ctx context.Context) *mLoginAttemptRepositoryMockRegisterFailure {
+	if mmRegisterFailure.mock.funcRegisterFailure != nil {
+		mmRegisterFailure.mock.t.Fatalf("LoginAttemptRepositoryMock.RegisterFailure mock is already set by Set")
+	}
+
+	if mmRegisterFailure.defaultExpectation == nil {
+		mmRegisterFailure.defaultExpectation = &LoginAttemptRepositoryMockRegisterFailureExpectation{}
+	}
+
+	if mmRegisterFailure.defaultExpectation.params != nil {
+		mmRegisterFailure.mock.t.Fatalf("LoginAttemptRepositoryMock.RegisterFailure mock is already set by Expect")
+	}
+
+	if mmRegisterFailure.defaultExpectation.paramPtrs == nil {
+		mmRegisterFailure.defaultExpectation.paramPtrs = &LoginAttemptRepositoryMockRegisterFailureParamPtrs{}
+	}
+	mmRegisterFailure.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRegisterFailure
+}
+
+// ExpectKeyParam2 sets up expected param key for LoginAttemptRepository.RegisterFailure
+func (mmRegisterFailure *mLoginAttemptRepositoryMockRegisterFailure) ExpectKeyParam2(key string) *mLoginAttemptRepositoryMockRegisterFailure {
+	if mmRegisterFailure.mock.funcRegisterFailure != nil {
+		mmRegisterFailure.mock.t.Fatalf("LoginAttemptRepositoryMock.RegisterFailure mock is already set by Set")
+	}
+
+	if mmRegisterFailure.defaultExpectation == nil {
+		mmRegisterFailure.defaultExpectation = &LoginAttemptRepositoryMockRegisterFailureExpectation{}
+	}
+
+	if mmRegisterFailure.defaultExpectation.params != nil {
+		mmRegisterFailure.mock.t.Fatalf("LoginAttemptRepositoryMock.RegisterFailure mock is already set by Expect")
+	}
+
+	if mmRegisterFailure.defaultExpectation.paramPtrs == nil {
+		mmRegisterFailure.defaultExpectation.paramPtrs = &LoginAttemptRepositoryMockRegisterFailureParamPtrs{}
+	}
+	mmRegisterFailure.defaultExpectation.paramPtrs.key = &key
+
+	return mmRegisterFailure
+}
+
+// ExpectWindowParam3 sets up expected param window for LoginAttemptRepository.RegisterFailure
+func (mmRegisterFailure *mLoginAttemptRepositoryMockRegisterFailure) ExpectWindowParam3(window time.Duration) *mLoginAttemptRepositoryMockRegisterFailure {
+	if mmRegisterFailure.mock.funcRegisterFailure != nil {
+		mmRegisterFailure.mock.t.Fatalf("LoginAttemptRepositoryMock.RegisterFailure mock is already set by Set")
+	}
+
+	if mmRegisterFailure.defaultExpectation == nil {
+		mmRegisterFailure.defaultExpectation = &LoginAttemptRepositoryMockRegisterFailureExpectation{}
+	}
+
+	if mmRegisterFailure.defaultExpectation.params != nil {
+		mmRegisterFailure.mock.t.Fatalf("LoginAttemptRepositoryMock.RegisterFailure mock is already set by Expect")
+	}
+
+	if mmRegisterFailure.defaultExpectation.paramPtrs == nil {
+		mmRegisterFailure.defaultExpectation.paramPtrs = &LoginAttemptRepositoryMockRegisterFailureParamPtrs{}
+	}
+	mmRegisterFailure.defaultExpectation.paramPtrs.window = &window
+
+	return mmRegisterFailure
+}
+
+// Inspect accepts an inspector function that has same arguments as the LoginAttemptRepository.RegisterFailure
+func (mmRegisterFailure *mLoginAttemptRepositoryMockRegisterFailure) Inspect(f func(ctx context.Context, key string, window time.Duration)) *mLoginAttemptRepositoryMockRegisterFailure {
+	if mmRegisterFailure.mock.inspectFuncRegisterFailure != nil {
+		mmRegisterFailure.mock.t.Fatalf("Inspect function is already set for LoginAttemptRepositoryMock.RegisterFailure")
+	}
+
+	mmRegisterFailure.mock.inspectFuncRegisterFailure = f
+
+	return mmRegisterFailure
+}
+
+// Return sets up results that will be returned by LoginAttemptRepository.RegisterFailure
+func (mmRegisterFailure *mLoginAttemptRepositoryMockRegisterFailure) Return(i1 int64, err error) *LoginAttemptRepositoryMock {
+	if mmRegisterFailure.mock.funcRegisterFailure != nil {
+		mmRegisterFailure.mock.t.Fatalf("LoginAttemptRepositoryMock.RegisterFailure mock is already set by Set")
+	}
+
+	if mmRegisterFailure.defaultExpectation == nil {
+		mmRegisterFailure.defaultExpectation = &LoginAttemptRepositoryMockRegisterFailureExpectation{mock: mmRegisterFailure.mock}
+	}
+	mmRegisterFailure.defaultExpectation.results = &LoginAttemptRepositoryMockRegisterFailureResults{i1, err}
+	return mmRegisterFailure.mock
+}
+
+// Set uses given function f to mock the LoginAttemptRepository.RegisterFailure method
+func (mmRegisterFailure *mLoginAttemptRepositoryMockRegisterFailure) Set(f func(ctx context.Context, key string, window time.Duration) (i1 int64, err error)) *LoginAttemptRepositoryMock {
+	if mmRegisterFailure.defaultExpectation != nil {
+		mmRegisterFailure.mock.t.Fatalf("Default expectation is already set for the LoginAttemptRepository.RegisterFailure method")
+	}
+
+	if len(mmRegisterFailure.expectations) > 0 {
+		mmRegisterFailure.mock.t.Fatalf("Some expectations are already set for the LoginAttemptRepository.RegisterFailure method")
+	}
+
+	mmRegisterFailure.mock.funcRegisterFailure = f
+	return mmRegisterFailure.mock
+}
+
+// When sets expectation for the LoginAttemptRepository.RegisterFailure which will trigger the result defined by the following
+// Then helper
+func (mmRegisterFailure *mLoginAttemptRepositoryMockRegisterFailure) When(ctx context.Context, key string, window time.Duration) *LoginAttemptRepositoryMockRegisterFailureExpectation {
+	if mmRegisterFailure.mock.funcRegisterFailure != nil {
+		mmRegisterFailure.mock.t.Fatalf("LoginAttemptRepositoryMock.RegisterFailure mock is already set by Set")
+	}
+
+	expectation := &LoginAttemptRepositoryMockRegisterFailureExpectation{
+		mock:   mmRegisterFailure.mock,
+		params: &LoginAttemptRepositoryMockRegisterFailureParams{ctx, key, window},
+	}
+	mmRegisterFailure.expectations = append(mmRegisterFailure.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LoginAttemptRepository.RegisterFailure return parameters for the expectation previously defined by the When method
+func (e *LoginAttemptRepositoryMockRegisterFailureExpectation) Then(i1 int64, err error) *LoginAttemptRepositoryMock {
+	e.results = &LoginAttemptRepositoryMockRegisterFailureResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times LoginAttemptRepository.RegisterFailure should be invoked
+func (mmRegisterFailure *mLoginAttemptRepositoryMockRegisterFailure) Times(n uint64) *mLoginAttemptRepositoryMockRegisterFailure {
+	if n == 0 {
+		mmRegisterFailure.mock.t.Fatalf("Times of LoginAttemptRepositoryMock.RegisterFailure mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRegisterFailure.expectedInvocations, n)
+	return mmRegisterFailure
+}
+
+func (mmRegisterFailure *mLoginAttemptRepositoryMockRegisterFailure) invocationsDone() bool {
+	if len(mmRegisterFailure.expectations) == 0 && mmRegisterFailure.defaultExpectation == nil && mmRegisterFailure.mock.funcRegisterFailure == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRegisterFailure.mock.afterRegisterFailureCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRegisterFailure.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RegisterFailure implements repository.LoginAttemptRepository
+func (mmRegisterFailure *LoginAttemptRepositoryMock) RegisterFailure(ctx context.Context, key string, window time.Duration) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmRegisterFailure.beforeRegisterFailureCounter, 1)
+	defer mm_atomic.AddUint64(&mmRegisterFailure.afterRegisterFailureCounter, 1)
+
+	if mmRegisterFailure.inspectFuncRegisterFailure != nil {
+		mmRegisterFailure.inspectFuncRegisterFailure(ctx, key, window)
+	}
+
+	mm_params := LoginAttemptRepositoryMockRegisterFailureParams{ctx, key, window}
+
+	// Record call args
+	mmRegisterFailure.RegisterFailureMock.mutex.Lock()
+	mmRegisterFailure.RegisterFailureMock.callArgs = append(mmRegisterFailure.RegisterFailureMock.callArgs, &mm_params)
+	mmRegisterFailure.RegisterFailureMock.mutex.Unlock()
+
+	for _, e := range mmRegisterFailure.RegisterFailureMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmRegisterFailure.RegisterFailureMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRegisterFailure.RegisterFailureMock.defaultExpectation.Counter, 1)
+		mm_want := mmRegisterFailure.RegisterFailureMock.defaultExpectation.params
+		mm_want_ptrs := mmRegisterFailure.RegisterFailureMock.defaultExpectation.paramPtrs
+
+		mm_got := LoginAttemptRepositoryMockRegisterFailureParams{ctx, key, window}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRegisterFailure.t.Errorf("LoginAttemptRepositoryMock.RegisterFailure got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmRegisterFailure.t.Errorf("LoginAttemptRepositoryMock.RegisterFailure got unexpected parameter key, want: %#v, got: %#v%s\n", *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.window != nil && !minimock.Equal(*mm_want_ptrs.window, mm_got.window) {
+				mmRegisterFailure.t.Errorf("LoginAttemptRepositoryMock.RegisterFailure got unexpected parameter window, want: %#v, got: %#v%s\n", *mm_want_ptrs.window, mm_got.window, minimock.Diff(*mm_want_ptrs.window, mm_got.window))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRegisterFailure.t.Errorf("LoginAttemptRepositoryMock.RegisterFailure got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRegisterFailure.RegisterFailureMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRegisterFailure.t.Fatal("No results are set for the LoginAttemptRepositoryMock.RegisterFailure")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmRegisterFailure.funcRegisterFailure != nil {
+		return mmRegisterFailure.funcRegisterFailure(ctx, key, window)
+	}
+	mmRegisterFailure.t.Fatalf("Unexpected call to LoginAttemptRepositoryMock.RegisterFailure. %v %v %v", ctx, key, window)
+	return
+}
+
+// RegisterFailureAfterCounter returns a count of finished LoginAttemptRepositoryMock.RegisterFailure invocations
+func (mmRegisterFailure *LoginAttemptRepositoryMock) RegisterFailureAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRegisterFailure.afterRegisterFailureCounter)
+}
+
+// RegisterFailureBeforeCounter returns a count of LoginAttemptRepositoryMock.RegisterFailure invocations
+func (mmRegisterFailure *LoginAttemptRepositoryMock) RegisterFailureBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRegisterFailure.beforeRegisterFailureCounter)
+}
+
+// Calls returns a list of arguments used in each call to LoginAttemptRepositoryMock.RegisterFailure.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRegisterFailure *mLoginAttemptRepositoryMockRegisterFailure) Calls() []*LoginAttemptRepositoryMockRegisterFailureParams {
+	mmRegisterFailure.mutex.RLock()
+
+	argCopy := make([]*LoginAttemptRepositoryMockRegisterFailureParams, len(mmRegisterFailure.callArgs))
+	copy(argCopy, mmRegisterFailure.callArgs)
+
+	mmRegisterFailure.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRegisterFailureDone returns true if the count of the RegisterFailure invocations corresponds
+// the number of defined expectations
+func (m *LoginAttemptRepositoryMock) MinimockRegisterFailureDone() bool {
+	if m.RegisterFailureMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RegisterFailureMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RegisterFailureMock.invocationsDone()
+}
+
+// MinimockRegisterFailureInspect logs each unmet expectation
+func (m *LoginAttemptRepositoryMock) MinimockRegisterFailureInspect() {
+	for _, e := range m.RegisterFailureMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LoginAttemptRepositoryMock.RegisterFailure with params: %#v", *e.params)
+		}
+	}
+
+	afterRegisterFailureCounter := mm_atomic.LoadUint64(&m.afterRegisterFailureCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RegisterFailureMock.defaultExpectation != nil && afterRegisterFailureCounter < 1 {
+		if m.RegisterFailureMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LoginAttemptRepositoryMock.RegisterFailure")
+		} else {
+			m.t.Errorf("Expected call to LoginAttemptRepositoryMock.RegisterFailure with params: %#v", *m.RegisterFailureMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRegisterFailure != nil && afterRegisterFailureCounter < 1 {
+		m.t.Error("Expected call to LoginAttemptRepositoryMock.RegisterFailure")
+	}
+
+	if !m.RegisterFailureMock.invocationsDone() && afterRegisterFailureCounter > 0 {
+		m.t.Errorf("Expected %d calls to LoginAttemptRepositoryMock.RegisterFailure but found %d calls",
+			mm_atomic.LoadUint64(&m.RegisterFailureMock.expectedInvocations), afterRegisterFailureCounter)
+	}
+}
+
+type mLoginAttemptRepositoryMockReset struct {
+	optional           bool
+	mock               *LoginAttemptRepositoryMock
+	defaultExpectation *LoginAttemptRepositoryMockResetExpectation
+	expectations       []*LoginAttemptRepositoryMockResetExpectation
+
+	callArgs []*LoginAttemptRepositoryMockResetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LoginAttemptRepositoryMockResetExpectation specifies expectation struct of the LoginAttemptRepository.Reset
+type LoginAttemptRepositoryMockResetExpectation struct {
+	mock      *LoginAttemptRepositoryMock
+	params    *LoginAttemptRepositoryMockResetParams
+	paramPtrs *LoginAttemptRepositoryMockResetParamPtrs
+	results   *LoginAttemptRepositoryMockResetResults
+	Counter   uint64
+}
+
+// LoginAttemptRepositoryMockResetParams contains parameters of the LoginAttemptRepository.Reset
+type LoginAttemptRepositoryMockResetParams struct {
+	ctx context.Context
+	key string
+}
+
+// LoginAttemptRepositoryMockResetParamPtrs contains pointers to parameters of the LoginAttemptRepository.Reset
+type LoginAttemptRepositoryMockResetParamPtrs struct {
+	ctx *context.Context
+	key *string
+}
+
+// LoginAttemptRepositoryMockResetResults contains results of the LoginAttemptRepository.Reset
+type LoginAttemptRepositoryMockResetResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReset *mLoginAttemptRepositoryMockReset) Optional() *mLoginAttemptRepositoryMockReset {
+	mmReset.optional = true
+	return mmReset
+}
+
+// Expect sets up expected params for LoginAttemptRepository.Reset
+func (mmReset *mLoginAttemptRepositoryMockReset) Expect(ctx context.Context, key string) *mLoginAttemptRepositoryMockReset {
+	if mmReset.mock.funcReset != nil {
+		mmReset.mock.t.Fatalf("LoginAttemptRepositoryMock.Reset mock is already set by Set")
+	}
+
+	if mmReset.defaultExpectation == nil {
+		mmReset.defaultExpectation = &LoginAttemptRepositoryMockResetExpectation{}
+	}
+
+	if mmReset.defaultExpectation.paramPtrs != nil {
+		mmReset.mock.t.Fatalf("LoginAttemptRepositoryMock.Reset mock is already set by ExpectParams functions")
+	}
+
+	mmReset.defaultExpectation.params = &LoginAttemptRepositoryMockResetParams{ctx, key}
+	for _, e := range mmReset.expectations {
+		if minimock.Equal(e.params, mmReset.defaultExpectation.params) {
+			mmReset.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReset.defaultExpectation.params)
+		}
+	}
+
+	return mmReset
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LoginAttemptRepository.Reset
+func (mmReset *mLoginAttemptRepositoryMockReset) ExpectCtxParam1(ctx context.Context) *mLoginAttemptRepositoryMockReset {
+	if mmReset.mock.funcReset != nil {
+		mmReset.mock.t.Fatalf("LoginAttemptRepositoryMock.Reset mock is already set by Set")
+	}
+
+	if mmReset.defaultExpectation == nil {
+		mmReset.defaultExpectation = &LoginAttemptRepositoryMockResetExpectation{}
+	}
+
+	if mmReset.defaultExpectation.params != nil {
+		mmReset.mock.t.Fatalf("LoginAttemptRepositoryMock.Reset mock is already set by Expect")
+	}
+
+	if mmReset.defaultExpectation.paramPtrs == nil {
+		mmReset.defaultExpectation.paramPtrs = &LoginAttemptRepositoryMockResetParamPtrs{}
+	}
+	mmReset.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmReset
+}
+
+// ExpectKeyParam2 sets up expected param key for LoginAttemptRepository.Reset
+func (mmReset *mLoginAttemptRepositoryMockReset) ExpectKeyParam2(key string) *mLoginAttemptRepositoryMockReset {
+	if mmReset.mock.funcReset != nil {
+		mmReset.mock.t.Fatalf("LoginAttemptRepositoryMock.Reset mock is already set by Set")
+	}
+
+	if mmReset.defaultExpectation == nil {
+		mmReset.defaultExpectation = &LoginAttemptRepositoryMockResetExpectation{}
+	}
+
+	if mmReset.defaultExpectation.params != nil {
+		mmReset.mock.t.Fatalf("LoginAttemptRepositoryMock.Reset mock is already set by Expect")
+	}
+
+	if mmReset.defaultExpectation.paramPtrs == nil {
+		mmReset.defaultExpectation.paramPtrs = &LoginAttemptRepositoryMockResetParamPtrs{}
+	}
+	mmReset.defaultExpectation.paramPtrs.key = &key
+
+	return mmReset
+}
+
+// Inspect accepts an inspector function that has same arguments as the LoginAttemptRepository.Reset
+func (mmReset *mLoginAttemptRepositoryMockReset) Inspect(f func(ctx context.Context, key string)) *mLoginAttemptRepositoryMockReset {
+	if mmReset.mock.inspectFuncReset != nil {
+		mmReset.mock.t.Fatalf("Inspect function is already set for LoginAttemptRepositoryMock.Reset")
+	}
+
+	mmReset.mock.inspectFuncReset = f
+
+	return mmReset
+}
+
+// Return sets up results that will be returned by LoginAttemptRepository.Reset
+func (mmReset *mLoginAttemptRepositoryMockReset) Return(err error) *LoginAttemptRepositoryMock {
+	if mmReset.mock.funcReset != nil {
+		mmReset.mock.t.Fatalf("LoginAttemptRepositoryMock.Reset mock is already set by Set")
+	}
+
+	if mmReset.defaultExpectation == nil {
+		mmReset.defaultExpectation = &LoginAttemptRepositoryMockResetExpectation{mock: mmReset.mock}
+	}
+	mmReset.defaultExpectation.results = &LoginAttemptRepositoryMockResetResults{err}
+	return mmReset.mock
+}
+
+// Set uses given function f to mock the LoginAttemptRepository.Reset method
+func (mmReset *mLoginAttemptRepositoryMockReset) Set(f func(ctx context.Context, key string) (err error)) *LoginAttemptRepositoryMock {
+	if mmReset.defaultExpectation != nil {
+		mmReset.mock.t.Fatalf("Default expectation is already set for the LoginAttemptRepository.Reset method")
+	}
+
+	if len(mmReset.expectations) > 0 {
+		mmReset.mock.t.Fatalf("Some expectations are already set for the LoginAttemptRepository.Reset method")
+	}
+
+	mmReset.mock.funcReset = f
+	return mmReset.mock
+}
+
+// When sets expectation for the LoginAttemptRepository.Reset which will trigger the result defined by the following
+// Then helper
+func (mmReset *mLoginAttemptRepositoryMockReset) When(ctx context.Context, key string) *LoginAttemptRepositoryMockResetExpectation {
+	if mmReset.mock.funcReset != nil {
+		mmReset.mock.t.Fatalf("LoginAttemptRepositoryMock.Reset mock is already set by Set")
+	}
+
+	expectation := &LoginAttemptRepositoryMockResetExpectation{
+		mock:   mmReset.mock,
+		params: &LoginAttemptRepositoryMockResetParams{ctx, key},
+	}
+	mmReset.expectations = append(mmReset.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LoginAttemptRepository.Reset return parameters for the expectation previously defined by the When method
+func (e *LoginAttemptRepositoryMockResetExpectation) Then(err error) *LoginAttemptRepositoryMock {
+	e.results = &LoginAttemptRepositoryMockResetResults{err}
+	return e.mock
+}
+
+// Times sets number of times LoginAttemptRepository.Reset should be invoked
+func (mmReset *mLoginAttemptRepositoryMockReset) Times(n uint64) *mLoginAttemptRepositoryMockReset {
+	if n == 0 {
+		mmReset.mock.t.Fatalf("Times of LoginAttemptRepositoryMock.Reset mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReset.expectedInvocations, n)
+	return mmReset
+}
+
+func (mmReset *mLoginAttemptRepositoryMockReset) invocationsDone() bool {
+	if len(mmReset.expectations) == 0 && mmReset.defaultExpectation == nil && mmReset.mock.funcReset == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReset.mock.afterResetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReset.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Reset implements repository.LoginAttemptRepository
+func (mmReset *LoginAttemptRepositoryMock) Reset(ctx context.Context, key string) (err error) {
+	mm_atomic.AddUint64(&mmReset.beforeResetCounter, 1)
+	defer mm_atomic.AddUint64(&mmReset.afterResetCounter, 1)
+
+	if mmReset.inspectFuncReset != nil {
+		mmReset.inspectFuncReset(ctx, key)
+	}
+
+	mm_params := LoginAttemptRepositoryMockResetParams{ctx, key}
+
+	// Record call args
+	mmReset.ResetMock.mutex.Lock()
+	mmReset.ResetMock.callArgs = append(mmReset.ResetMock.callArgs, &mm_params)
+	mmReset.ResetMock.mutex.Unlock()
+
+	for _, e := range mmReset.ResetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmReset.ResetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReset.ResetMock.defaultExpectation.Counter, 1)
+		mm_want := mmReset.ResetMock.defaultExpectation.params
+		mm_want_ptrs := mmReset.ResetMock.defaultExpectation.paramPtrs
+
+		mm_got := LoginAttemptRepositoryMockResetParams{ctx, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReset.t.Errorf("LoginAttemptRepositoryMock.Reset got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmReset.t.Errorf("LoginAttemptRepositoryMock.Reset got unexpected parameter key, want: %#v, got: %#v%s\n", *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReset.t.Errorf("LoginAttemptRepositoryMock.Reset got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReset.ResetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReset.t.Fatal("No results are set for the LoginAttemptRepositoryMock.Reset")
+		}
+		return (*mm_results).err
+	}
+	if mmReset.funcReset != nil {
+		return mmReset.funcReset(ctx, key)
+	}
+	mmReset.t.Fatalf("Unexpected call to LoginAttemptRepositoryMock.Reset. %v %v", ctx, key)
+	return
+}
+
+// ResetAfterCounter returns a count of finished LoginAttemptRepositoryMock.Reset invocations
+func (mmReset *LoginAttemptRepositoryMock) ResetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReset.afterResetCounter)
+}
+
+// ResetBeforeCounter returns a count of LoginAttemptRepositoryMock.Reset invocations
+func (mmReset *LoginAttemptRepositoryMock) ResetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReset.beforeResetCounter)
+}
+
+// Calls returns a list of arguments used in each call to LoginAttemptRepositoryMock.Reset.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReset *mLoginAttemptRepositoryMockReset) Calls() []*LoginAttemptRepositoryMockResetParams {
+	mmReset.mutex.RLock()
+
+	argCopy := make([]*LoginAttemptRepositoryMockResetParams, len(mmReset.callArgs))
+	copy(argCopy, mmReset.callArgs)
+
+	mmReset.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockResetDone returns true if the count of the Reset invocations corresponds
+// the number of defined expectations
+func (m *LoginAttemptRepositoryMock) MinimockResetDone() bool {
+	if m.ResetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ResetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ResetMock.invocationsDone()
+}
+
+// MinimockResetInspect logs each unmet expectation
+func (m *LoginAttemptRepositoryMock) MinimockResetInspect() {
+	for _, e := range m.ResetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LoginAttemptRepositoryMock.Reset with params: %#v", *e.params)
+		}
+	}
+
+	afterResetCounter := mm_atomic.LoadUint64(&m.afterResetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ResetMock.defaultExpectation != nil && afterResetCounter < 1 {
+		if m.ResetMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LoginAttemptRepositoryMock.Reset")
+		} else {
+			m.t.Errorf("Expected call to LoginAttemptRepositoryMock.Reset with params: %#v", *m.ResetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReset != nil && afterResetCounter < 1 {
+		m.t.Error("Expected call to LoginAttemptRepositoryMock.Reset")
+	}
+
+	if !m.ResetMock.invocationsDone() && afterResetCounter > 0 {
+		m.t.Errorf("Expected %d calls to LoginAttemptRepositoryMock.Reset but found %d calls",
+			mm_atomic.LoadUint64(&m.ResetMock.expectedInvocations), afterResetCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *LoginAttemptRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetLockedUntilInspect()
+
+			m.MinimockLockInspect()
+
+			m.MinimockRegisterFailureInspect()
+
+			m.MinimockResetInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *LoginAttemptRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *LoginAttemptRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetLockedUntilDone() &&
+		m.MinimockLockDone() &&
+		m.MinimockRegisterFailureDone() &&
+		m.MinimockResetDone()
+}
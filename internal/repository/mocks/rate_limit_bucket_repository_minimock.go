@@ -0,0 +1,1200 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.13). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i di_container/internal/repository.RateLimitBucketRepository -o rate_limit_bucket_repository_minimock.go -n RateLimitBucketRepositoryMock -p mocks
+
+import (
+	"context"
+	"di_container/internal/model"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// RateLimitBucketRepositoryMock implements repository.RateLimitBucketRepository
+type RateLimitBucketRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcDeleteIdle          func(ctx context.Context, idleTimeout time.Duration) (err error)
+	inspectFuncDeleteIdle   func(ctx context.Context, idleTimeout time.Duration)
+	afterDeleteIdleCounter  uint64
+	beforeDeleteIdleCounter uint64
+	DeleteIdleMock          mRateLimitBucketRepositoryMockDeleteIdle
+
+	funcLock          func(ctx context.Context, key string, method string, burst float64) (rp1 *model.RateLimitBucket, err error)
+	inspectFuncLock   func(ctx context.Context, key string, method string, burst float64)
+	afterLockCounter  uint64
+	beforeLockCounter uint64
+	LockMock          mRateLimitBucketRepositoryMockLock
+
+	funcUpdate          func(ctx context.Context, key string, method string, tokens float64, updatedAt time.Time) (err error)
+	inspectFuncUpdate   func(ctx context.Context, key string, method string, tokens float64, updatedAt time.Time)
+	afterUpdateCounter  uint64
+	beforeUpdateCounter uint64
+	UpdateMock          mRateLimitBucketRepositoryMockUpdate
+}
+
+// NewRateLimitBucketRepositoryMock returns a mock for repository.RateLimitBucketRepository
+func NewRateLimitBucketRepositoryMock(t minimock.Tester) *RateLimitBucketRepositoryMock {
+	m := &RateLimitBucketRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.DeleteIdleMock = mRateLimitBucketRepositoryMockDeleteIdle{mock: m}
+	m.DeleteIdleMock.callArgs = []*RateLimitBucketRepositoryMockDeleteIdleParams{}
+
+	m.LockMock = mRateLimitBucketRepositoryMockLock{mock: m}
+	m.LockMock.callArgs = []*RateLimitBucketRepositoryMockLockParams{}
+
+	m.UpdateMock = mRateLimitBucketRepositoryMockUpdate{mock: m}
+	m.UpdateMock.callArgs = []*RateLimitBucketRepositoryMockUpdateParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRateLimitBucketRepositoryMockDeleteIdle struct {
+	optional           bool
+	mock               *RateLimitBucketRepositoryMock
+	defaultExpectation *RateLimitBucketRepositoryMockDeleteIdleExpectation
+	expectations       []*RateLimitBucketRepositoryMockDeleteIdleExpectation
+
+	callArgs []*RateLimitBucketRepositoryMockDeleteIdleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RateLimitBucketRepositoryMockDeleteIdleExpectation specifies expectation struct of the RateLimitBucketRepository.DeleteIdle
+type RateLimitBucketRepositoryMockDeleteIdleExpectation struct {
+	mock      *RateLimitBucketRepositoryMock
+	params    *RateLimitBucketRepositoryMockDeleteIdleParams
+	paramPtrs *RateLimitBucketRepositoryMockDeleteIdleParamPtrs
+	results   *RateLimitBucketRepositoryMockDeleteIdleResults
+	Counter   uint64
+}
+
+// RateLimitBucketRepositoryMockDeleteIdleParams contains parameters of the RateLimitBucketRepository.DeleteIdle
+type RateLimitBucketRepositoryMockDeleteIdleParams struct {
+	ctx         context.Context
+	idleTimeout time.Duration
+}
+
+// RateLimitBucketRepositoryMockDeleteIdleParamPtrs contains pointers to parameters of the RateLimitBucketRepository.DeleteIdle
+type RateLimitBucketRepositoryMockDeleteIdleParamPtrs struct {
+	ctx         *context.Context
+	idleTimeout *time.Duration
+}
+
+// RateLimitBucketRepositoryMockDeleteIdleResults contains results of the RateLimitBucketRepository.DeleteIdle
+type RateLimitBucketRepositoryMockDeleteIdleResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteIdle *mRateLimitBucketRepositoryMockDeleteIdle) Optional() *mRateLimitBucketRepositoryMockDeleteIdle {
+	mmDeleteIdle.optional = true
+	return mmDeleteIdle
+}
+
+// Expect sets up expected params for RateLimitBucketRepository.DeleteIdle
+func (mmDeleteIdle *mRateLimitBucketRepositoryMockDeleteIdle) Expect(ctx context.Context, idleTimeout time.Duration) *mRateLimitBucketRepositoryMockDeleteIdle {
+	if mmDeleteIdle.mock.funcDeleteIdle != nil {
+		mmDeleteIdle.mock.t.Fatalf("RateLimitBucketRepositoryMock.DeleteIdle mock is already set by Set")
+	}
+
+	if mmDeleteIdle.defaultExpectation == nil {
+		mmDeleteIdle.defaultExpectation = &RateLimitBucketRepositoryMockDeleteIdleExpectation{}
+	}
+
+	if mmDeleteIdle.defaultExpectation.paramPtrs != nil {
+		mmDeleteIdle.mock.t.Fatalf("RateLimitBucketRepositoryMock.DeleteIdle mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteIdle.defaultExpectation.params = &RateLimitBucketRepositoryMockDeleteIdleParams{ctx, idleTimeout}
+	for _, e := range mmDeleteIdle.expectations {
+		if minimock.Equal(e.params, mmDeleteIdle.defaultExpectation.params) {
+			mmDeleteIdle.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteIdle.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteIdle
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RateLimitBucketRepository.DeleteIdle
+func (mmDeleteIdle *mRateLimitBucketRepositoryMockDeleteIdle) ExpectCtxParam1(ctx context.Context) *mRateLimitBucketRepositoryMockDeleteIdle {
+	if mmDeleteIdle.mock.funcDeleteIdle != nil {
+		mmDeleteIdle.mock.t.Fatalf("RateLimitBucketRepositoryMock.DeleteIdle mock is already set by Set")
+	}
+
+	if mmDeleteIdle.defaultExpectation == nil {
+		mmDeleteIdle.defaultExpectation = &RateLimitBucketRepositoryMockDeleteIdleExpectation{}
+	}
+
+	if mmDeleteIdle.defaultExpectation.params != nil {
+		mmDeleteIdle.mock.t.Fatalf("RateLimitBucketRepositoryMock.DeleteIdle mock is already set by Expect")
+	}
+
+	if mmDeleteIdle.defaultExpectation.paramPtrs == nil {
+		mmDeleteIdle.defaultExpectation.paramPtrs = &RateLimitBucketRepositoryMockDeleteIdleParamPtrs{}
+	}
+	mmDeleteIdle.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDeleteIdle
+}
+
+// ExpectIdleTimeoutParam2 sets up expected param idleTimeout for RateLimitBucketRepository.DeleteIdle
+func (mmDeleteIdle *mRateLimitBucketRepositoryMockDeleteIdle) ExpectIdleTimeoutParam2(idleTimeout time.Duration) *mRateLimitBucketRepositoryMockDeleteIdle {
+	if mmDeleteIdle.mock.funcDeleteIdle != nil {
+		mmDeleteIdle.mock.t.Fatalf("RateLimitBucketRepositoryMock.DeleteIdle mock is already set by Set")
+	}
+
+	if mmDeleteIdle.defaultExpectation == nil {
+		mmDeleteIdle.defaultExpectation = &RateLimitBucketRepositoryMockDeleteIdleExpectation{}
+	}
+
+	if mmDeleteIdle.defaultExpectation.params != nil {
+		mmDeleteIdle.mock.t.Fatalf("RateLimitBucketRepositoryMock.DeleteIdle mock is already set by Expect")
+	}
+
+	if mmDeleteIdle.defaultExpectation.paramPtrs == nil {
+		mmDeleteIdle.defaultExpectation.paramPtrs = &RateLimitBucketRepositoryMockDeleteIdleParamPtrs{}
+	}
+	mmDeleteIdle.defaultExpectation.paramPtrs.idleTimeout = &idleTimeout
+
+	return mmDeleteIdle
+}
+
+// Inspect accepts an inspector function that has same arguments as the RateLimitBucketRepository.DeleteIdle
+func (mmDeleteIdle *mRateLimitBucketRepositoryMockDeleteIdle) Inspect(f func(ctx context.Context, idleTimeout time.Duration)) *mRateLimitBucketRepositoryMockDeleteIdle {
+	if mmDeleteIdle.mock.inspectFuncDeleteIdle != nil {
+		mmDeleteIdle.mock.t.Fatalf("Inspect function is already set for RateLimitBucketRepositoryMock.DeleteIdle")
+	}
+
+	mmDeleteIdle.mock.inspectFuncDeleteIdle = f
+
+	return mmDeleteIdle
+}
+
+// Return sets up results that will be returned by RateLimitBucketRepository.DeleteIdle
+func (mmDeleteIdle *mRateLimitBucketRepositoryMockDeleteIdle) Return(err error) *RateLimitBucketRepositoryMock {
+	if mmDeleteIdle.mock.funcDeleteIdle != nil {
+		mmDeleteIdle.mock.t.Fatalf("RateLimitBucketRepositoryMock.DeleteIdle mock is already set by Set")
+	}
+
+	if mmDeleteIdle.defaultExpectation == nil {
+		mmDeleteIdle.defaultExpectation = &RateLimitBucketRepositoryMockDeleteIdleExpectation{mock: mmDeleteIdle.mock}
+	}
+	mmDeleteIdle.defaultExpectation.results = &RateLimitBucketRepositoryMockDeleteIdleResults{err}
+	return mmDeleteIdle.mock
+}
+
+// Set uses given function f to mock the RateLimitBucketRepository.DeleteIdle method
+func (mmDeleteIdle *mRateLimitBucketRepositoryMockDeleteIdle) Set(f func(ctx context.Context, idleTimeout time.Duration) (err error)) *RateLimitBucketRepositoryMock {
+	if mmDeleteIdle.defaultExpectation != nil {
+		mmDeleteIdle.mock.t.Fatalf("Default expectation is already set for the RateLimitBucketRepository.DeleteIdle method")
+	}
+
+	if len(mmDeleteIdle.expectations) > 0 {
+		mmDeleteIdle.mock.t.Fatalf("Some expectations are already set for the RateLimitBucketRepository.DeleteIdle method")
+	}
+
+	mmDeleteIdle.mock.funcDeleteIdle = f
+	return mmDeleteIdle.mock
+}
+
+// When sets expectation for the RateLimitBucketRepository.DeleteIdle which will trigger the result defined by the following
+// Then helper
+func (mmDeleteIdle *mRateLimitBucketRepositoryMockDeleteIdle) When(ctx context.Context, idleTimeout time.Duration) *RateLimitBucketRepositoryMockDeleteIdleExpectation {
+	if mmDeleteIdle.mock.funcDeleteIdle != nil {
+		mmDeleteIdle.mock.t.Fatalf("RateLimitBucketRepositoryMock.DeleteIdle mock is already set by Set")
+	}
+
+	expectation := &RateLimitBucketRepositoryMockDeleteIdleExpectation{
+		mock:   mmDeleteIdle.mock,
+		params: &RateLimitBucketRepositoryMockDeleteIdleParams{ctx, idleTimeout},
+	}
+	mmDeleteIdle.expectations = append(mmDeleteIdle.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RateLimitBucketRepository.DeleteIdle return parameters for the expectation previously defined by the When method
+func (e *RateLimitBucketRepositoryMockDeleteIdleExpectation) Then(err error) *RateLimitBucketRepositoryMock {
+	e.results = &RateLimitBucketRepositoryMockDeleteIdleResults{err}
+	return e.mock
+}
+
+// Times sets number of times RateLimitBucketRepository.DeleteIdle should be invoked
+func (mmDeleteIdle *mRateLimitBucketRepositoryMockDeleteIdle) Times(n uint64) *mRateLimitBucketRepositoryMockDeleteIdle {
+	if n == 0 {
+		mmDeleteIdle.mock.t.Fatalf("Times of RateLimitBucketRepositoryMock.DeleteIdle mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteIdle.expectedInvocations, n)
+	return mmDeleteIdle
+}
+
+func (mmDeleteIdle *mRateLimitBucketRepositoryMockDeleteIdle) invocationsDone() bool {
+	if len(mmDeleteIdle.expectations) == 0 && mmDeleteIdle.defaultExpectation == nil && mmDeleteIdle.mock.funcDeleteIdle == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteIdle.mock.afterDeleteIdleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteIdle.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteIdle implements repository.RateLimitBucketRepository
+func (mmDeleteIdle *RateLimitBucketRepositoryMock) DeleteIdle(ctx context.Context, idleTimeout time.Duration) (err error) {
+	mm_atomic.AddUint64(&mmDeleteIdle.beforeDeleteIdleCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteIdle.afterDeleteIdleCounter, 1)
+
+	if mmDeleteIdle.inspectFuncDeleteIdle != nil {
+		mmDeleteIdle.inspectFuncDeleteIdle(ctx, idleTimeout)
+	}
+
+	mm_params := RateLimitBucketRepositoryMockDeleteIdleParams{ctx, idleTimeout}
+
+	// Record call args
+	mmDeleteIdle.DeleteIdleMock.mutex.Lock()
+	mmDeleteIdle.DeleteIdleMock.callArgs = append(mmDeleteIdle.DeleteIdleMock.callArgs, &mm_params)
+	mmDeleteIdle.DeleteIdleMock.mutex.Unlock()
+
+	for _, e := range mmDeleteIdle.DeleteIdleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteIdle.DeleteIdleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteIdle.DeleteIdleMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteIdle.DeleteIdleMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteIdle.DeleteIdleMock.defaultExpectation.paramPtrs
+
+		mm_got := RateLimitBucketRepositoryMockDeleteIdleParams{ctx, idleTimeout}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteIdle.t.Errorf("RateLimitBucketRepositoryMock.DeleteIdle got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.idleTimeout != nil && !minimock.Equal(*mm_want_ptrs.idleTimeout, mm_got.idleTimeout) {
+				mmDeleteIdle.t.Errorf("RateLimitBucketRepositoryMock.DeleteIdle got unexpected parameter idleTimeout, want: %#v, got: %#v%s\n", *mm_want_ptrs.idleTimeout, mm_got.idleTimeout, minimock.Diff(*mm_want_ptrs.idleTimeout, mm_got.idleTimeout))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteIdle.t.Errorf("RateLimitBucketRepositoryMock.DeleteIdle got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteIdle.DeleteIdleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteIdle.t.Fatal("No results are set for the RateLimitBucketRepositoryMock.DeleteIdle")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteIdle.funcDeleteIdle != nil {
+		return mmDeleteIdle.funcDeleteIdle(ctx, idleTimeout)
+	}
+	mmDeleteIdle.t.Fatalf("Unexpected call to RateLimitBucketRepositoryMock.DeleteIdle. %v %v", ctx, idleTimeout)
+	return
+}
+
+// DeleteIdleAfterCounter returns a count of finished RateLimitBucketRepositoryMock.DeleteIdle invocations
+func (mmDeleteIdle *RateLimitBucketRepositoryMock) DeleteIdleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteIdle.afterDeleteIdleCounter)
+}
+
+// DeleteIdleBeforeCounter returns a count of RateLimitBucketRepositoryMock.DeleteIdle invocations
+func (mmDeleteIdle *RateLimitBucketRepositoryMock) DeleteIdleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteIdle.beforeDeleteIdleCounter)
+}
+
+// Calls returns a list of arguments used in each call to RateLimitBucketRepositoryMock.DeleteIdle.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteIdle *mRateLimitBucketRepositoryMockDeleteIdle) Calls() []*RateLimitBucketRepositoryMockDeleteIdleParams {
+	mmDeleteIdle.mutex.RLock()
+
+	argCopy := make([]*RateLimitBucketRepositoryMockDeleteIdleParams, len(mmDeleteIdle.callArgs))
+	copy(argCopy, mmDeleteIdle.callArgs)
+
+	mmDeleteIdle.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteIdleDone returns true if the count of the DeleteIdle invocations corresponds
+// the number of defined expectations
+func (m *RateLimitBucketRepositoryMock) MinimockDeleteIdleDone() bool {
+	if m.DeleteIdleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteIdleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteIdleMock.invocationsDone()
+}
+
+// MinimockDeleteIdleInspect logs each unmet expectation
+func (m *RateLimitBucketRepositoryMock) MinimockDeleteIdleInspect() {
+	for _, e := range m.DeleteIdleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RateLimitBucketRepositoryMock.DeleteIdle with params: %#v", *e.params)
+		}
+	}
+
+	afterDeleteIdleCounter := mm_atomic.LoadUint64(&m.afterDeleteIdleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteIdleMock.defaultExpectation != nil && afterDeleteIdleCounter < 1 {
+		if m.DeleteIdleMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RateLimitBucketRepositoryMock.DeleteIdle")
+		} else {
+			m.t.Errorf("Expected call to RateLimitBucketRepositoryMock.DeleteIdle with params: %#v", *m.DeleteIdleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteIdle != nil && afterDeleteIdleCounter < 1 {
+		m.t.Error("Expected call to RateLimitBucketRepositoryMock.DeleteIdle")
+	}
+
+	if !m.DeleteIdleMock.invocationsDone() && afterDeleteIdleCounter > 0 {
+		m.t.Errorf("Expected %d calls to RateLimitBucketRepositoryMock.DeleteIdle but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteIdleMock.expectedInvocations), afterDeleteIdleCounter)
+	}
+}
+
+type mRateLimitBucketRepositoryMockLock struct {
+	optional           bool
+	mock               *RateLimitBucketRepositoryMock
+	defaultExpectation *RateLimitBucketRepositoryMockLockExpectation
+	expectations       []*RateLimitBucketRepositoryMockLockExpectation
+
+	callArgs []*RateLimitBucketRepositoryMockLockParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RateLimitBucketRepositoryMockLockExpectation specifies expectation struct of the RateLimitBucketRepository.Lock
+type RateLimitBucketRepositoryMockLockExpectation struct {
+	mock      *RateLimitBucketRepositoryMock
+	params    *RateLimitBucketRepositoryMockLockParams
+	paramPtrs *RateLimitBucketRepositoryMockLockParamPtrs
+	results   *RateLimitBucketRepositoryMockLockResults
+	Counter   uint64
+}
+
+// RateLimitBucketRepositoryMockLockParams contains parameters of the RateLimitBucketRepository.Lock
+type RateLimitBucketRepositoryMockLockParams struct {
+	ctx    context.Context
+	key    string
+	method string
+	burst  float64
+}
+
+// RateLimitBucketRepositoryMockLockParamPtrs contains pointers to parameters of the RateLimitBucketRepository.Lock
+type RateLimitBucketRepositoryMockLockParamPtrs struct {
+	ctx    *context.Context
+	key    *string
+	method *string
+	burst  *float64
+}
+
+// RateLimitBucketRepositoryMockLockResults contains results of the RateLimitBucketRepository.Lock
+type RateLimitBucketRepositoryMockLockResults struct {
+	rp1 *model.RateLimitBucket
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLock *mRateLimitBucketRepositoryMockLock) Optional() *mRateLimitBucketRepositoryMockLock {
+	mmLock.optional = true
+	return mmLock
+}
+
+// Expect sets up expected params for RateLimitBucketRepository.Lock
+func (mmLock *mRateLimitBucketRepositoryMockLock) Expect(ctx context.Context, key string, method string, burst float64) *mRateLimitBucketRepositoryMockLock {
+	if mmLock.mock.funcLock != nil {
+		mmLock.mock.t.Fatalf("RateLimitBucketRepositoryMock.Lock mock is already set by Set")
+	}
+
+	if mmLock.defaultExpectation == nil {
+		mmLock.defaultExpectation = &RateLimitBucketRepositoryMockLockExpectation{}
+	}
+
+	if mmLock.defaultExpectation.paramPtrs != nil {
+		mmLock.mock.t.Fatalf("RateLimitBucketRepositoryMock.Lock mock is already set by ExpectParams functions")
+	}
+
+	mmLock.defaultExpectation.params = &RateLimitBucketRepositoryMockLockParams{ctx, key, method, burst}
+	for _, e := range mmLock.expectations {
+		if minimock.Equal(e.params, mmLock.defaultExpectation.params) {
+			mmLock.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLock.defaultExpectation.params)
+		}
+	}
+
+	return mmLock
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RateLimitBucketRepository.Lock
+func (mmLock *mRateLimitBucketRepositoryMockLock) ExpectCtxParam1(ctx context.Context) *mRateLimitBucketRepositoryMockLock {
+	if mmLock.mock.funcLock != nil {
+		mmLock.mock.t.Fatalf("RateLimitBucketRepositoryMock.Lock mock is already set by Set")
+	}
+
+	if mmLock.defaultExpectation == nil {
+		mmLock.defaultExpectation = &RateLimitBucketRepositoryMockLockExpectation{}
+	}
+
+	if mmLock.defaultExpectation.params != nil {
+		mmLock.mock.t.Fatalf("RateLimitBucketRepositoryMock.Lock mock is already set by Expect")
+	}
+
+	if mmLock.defaultExpectation.paramPtrs == nil {
+		mmLock.defaultExpectation.paramPtrs = &RateLimitBucketRepositoryMockLockParamPtrs{}
+	}
+	mmLock.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmLock
+}
+
+// ExpectKeyParam2 sets up expected param key for RateLimitBucketRepository.Lock
+func (mmLock *mRateLimitBucketRepositoryMockLock) ExpectKeyParam2(key string) *mRateLimitBucketRepositoryMockLock {
+	if mmLock.mock.funcLock != nil {
+		mmLock.mock.t.Fatalf("RateLimitBucketRepositoryMock.Lock mock is already set by Set")
+	}
+
+	if mmLock.defaultExpectation == nil {
+		mmLock.defaultExpectation = &RateLimitBucketRepositoryMockLockExpectation{}
+	}
+
+	if mmLock.defaultExpectation.params != nil {
+		mmLock.mock.t.Fatalf("RateLimitBucketRepositoryMock.Lock mock is already set by Expect")
+	}
+
+	if mmLock.defaultExpectation.paramPtrs == nil {
+		mmLock.defaultExpectation.paramPtrs = &RateLimitBucketRepositoryMockLockParamPtrs{}
+	}
+	mmLock.defaultExpectation.paramPtrs.key = &key
+
+	return mmLock
+}
+
+// ExpectMethodParam3 sets up expected param method for RateLimitBucketRepository.Lock
+func (mmLock *mRateLimitBucketRepositoryMockLock) ExpectMethodParam3(method string) *mRateLimitBucketRepositoryMockLock {
+	if mmLock.mock.funcLock != nil {
+		mmLock.mock.t.Fatalf("RateLimitBucketRepositoryMock.Lock mock is already set by Set")
+	}
+
+	if mmLock.defaultExpectation == nil {
+		mmLock.defaultExpectation = &RateLimitBucketRepositoryMockLockExpectation{}
+	}
+
+	if mmLock.defaultExpectation.params != nil {
+		mmLock.mock.t.Fatalf("RateLimitBucketRepositoryMock.Lock mock is already set by Expect")
+	}
+
+	if mmLock.defaultExpectation.paramPtrs == nil {
+		mmLock.defaultExpectation.paramPtrs = &RateLimitBucketRepositoryMockLockParamPtrs{}
+	}
+	mmLock.defaultExpectation.paramPtrs.method = &method
+
+	return mmLock
+}
+
+// ExpectBurstParam4 sets up expected param burst for RateLimitBucketRepository.Lock
+func (mmLock *mRateLimitBucketRepositoryMockLock) ExpectBurstParam4(burst float64) *mRateLimitBucketRepositoryMockLock {
+	if mmLock.mock.funcLock != nil {
+		mmLock.mock.t.Fatalf("RateLimitBucketRepositoryMock.Lock mock is already set by Set")
+	}
+
+	if mmLock.defaultExpectation == nil {
+		mmLock.defaultExpectation = &RateLimitBucketRepositoryMockLockExpectation{}
+	}
+
+	if mmLock.defaultExpectation.params != nil {
+		mmLock.mock.t.Fatalf("RateLimitBucketRepositoryMock.Lock mock is already set by Expect")
+	}
+
+	if mmLock.defaultExpectation.paramPtrs == nil {
+		mmLock.defaultExpectation.paramPtrs = &RateLimitBucketRepositoryMockLockParamPtrs{}
+	}
+	mmLock.defaultExpectation.paramPtrs.burst = &burst
+
+	return mmLock
+}
+
+// Inspect accepts an inspector function that has same arguments as the RateLimitBucketRepository.Lock
+func (mmLock *mRateLimitBucketRepositoryMockLock) Inspect(f func(ctx context.Context, key string, method string, burst float64)) *mRateLimitBucketRepositoryMockLock {
+	if mmLock.mock.inspectFuncLock != nil {
+		mmLock.mock.t.Fatalf("Inspect function is already set for RateLimitBucketRepositoryMock.Lock")
+	}
+
+	mmLock.mock.inspectFuncLock = f
+
+	return mmLock
+}
+
+// Return sets up results that will be returned by RateLimitBucketRepository.Lock
+func (mmLock *mRateLimitBucketRepositoryMockLock) Return(rp1 *model.RateLimitBucket, err error) *RateLimitBucketRepositoryMock {
+	if mmLock.mock.funcLock != nil {
+		mmLock.mock.t.Fatalf("RateLimitBucketRepositoryMock.Lock mock is already set by Set")
+	}
+
+	if mmLock.defaultExpectation == nil {
+		mmLock.defaultExpectation = &RateLimitBucketRepositoryMockLockExpectation{mock: mmLock.mock}
+	}
+	mmLock.defaultExpectation.results = &RateLimitBucketRepositoryMockLockResults{rp1, err}
+	return mmLock.mock
+}
+
+// Set uses given function f to mock the RateLimitBucketRepository.Lock method
+func (mmLock *mRateLimitBucketRepositoryMockLock) Set(f func(ctx context.Context, key string, method string, burst float64) (rp1 *model.RateLimitBucket, err error)) *RateLimitBucketRepositoryMock {
+	if mmLock.defaultExpectation != nil {
+		mmLock.mock.t.Fatalf("Default expectation is already set for the RateLimitBucketRepository.Lock method")
+	}
+
+	if len(mmLock.expectations) > 0 {
+		mmLock.mock.t.Fatalf("Some expectations are already set for the RateLimitBucketRepository.Lock method")
+	}
+
+	mmLock.mock.funcLock = f
+	return mmLock.mock
+}
+
+// When sets expectation for the RateLimitBucketRepository.Lock which will trigger the result defined by the following
+// Then helper
+func (mmLock *mRateLimitBucketRepositoryMockLock) When(ctx context.Context, key string, method string, burst float64) *RateLimitBucketRepositoryMockLockExpectation {
+	if mmLock.mock.funcLock != nil {
+		mmLock.mock.t.Fatalf("RateLimitBucketRepositoryMock.Lock mock is already set by Set")
+	}
+
+	expectation := &RateLimitBucketRepositoryMockLockExpectation{
+		mock:   mmLock.mock,
+		params: &RateLimitBucketRepositoryMockLockParams{ctx, key, method, burst},
+	}
+	mmLock.expectations = append(mmLock.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RateLimitBucketRepository.Lock return parameters for the expectation previously defined by the When method
+func (e *RateLimitBucketRepositoryMockLockExpectation) Then(rp1 *model.RateLimitBucket, err error) *RateLimitBucketRepositoryMock {
+	e.results = &RateLimitBucketRepositoryMockLockResults{rp1, err}
+	return e.mock
+}
+
+// Times sets number of times RateLimitBucketRepository.Lock should be invoked
+func (mmLock *mRateLimitBucketRepositoryMockLock) Times(n uint64) *mRateLimitBucketRepositoryMockLock {
+	if n == 0 {
+		mmLock.mock.t.Fatalf("Times of RateLimitBucketRepositoryMock.Lock mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLock.expectedInvocations, n)
+	return mmLock
+}
+
+func (mmLock *mRateLimitBucketRepositoryMockLock) invocationsDone() bool {
+	if len(mmLock.expectations) == 0 && mmLock.defaultExpectation == nil && mmLock.mock.funcLock == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLock.mock.afterLockCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLock.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Lock implements repository.RateLimitBucketRepository
+func (mmLock *RateLimitBucketRepositoryMock) Lock(ctx context.Context, key string, method string, burst float64) (rp1 *model.RateLimitBucket, err error) {
+	mm_atomic.AddUint64(&mmLock.beforeLockCounter, 1)
+	defer mm_atomic.AddUint64(&mmLock.afterLockCounter, 1)
+
+	if mmLock.inspectFuncLock != nil {
+		mmLock.inspectFuncLock(ctx, key, method, burst)
+	}
+
+	mm_params := RateLimitBucketRepositoryMockLockParams{ctx, key, method, burst}
+
+	// Record call args
+	mmLock.LockMock.mutex.Lock()
+	mmLock.LockMock.callArgs = append(mmLock.LockMock.callArgs, &mm_params)
+	mmLock.LockMock.mutex.Unlock()
+
+	for _, e := range mmLock.LockMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rp1, e.results.err
+		}
+	}
+
+	if mmLock.LockMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLock.LockMock.defaultExpectation.Counter, 1)
+		mm_want := mmLock.LockMock.defaultExpectation.params
+		mm_want_ptrs := mmLock.LockMock.defaultExpectation.paramPtrs
+
+		mm_got := RateLimitBucketRepositoryMockLockParams{ctx, key, method, burst}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLock.t.Errorf("RateLimitBucketRepositoryMock.Lock got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmLock.t.Errorf("RateLimitBucketRepositoryMock.Lock got unexpected parameter key, want: %#v, got: %#v%s\n", *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.method != nil && !minimock.Equal(*mm_want_ptrs.method, mm_got.method) {
+				mmLock.t.Errorf("RateLimitBucketRepositoryMock.Lock got unexpected parameter method, want: %#v, got: %#v%s\n", *mm_want_ptrs.method, mm_got.method, minimock.Diff(*mm_want_ptrs.method, mm_got.method))
+			}
+
+			if mm_want_ptrs.burst != nil && !minimock.Equal(*mm_want_ptrs.burst, mm_got.burst) {
+				mmLock.t.Errorf("RateLimitBucketRepositoryMock.Lock got unexpected parameter burst, want: %#v, got: %#v%s\n", *mm_want_ptrs.burst, mm_got.burst, minimock.Diff(*mm_want_ptrs.burst, mm_got.burst))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLock.t.Errorf("RateLimitBucketRepositoryMock.Lock got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLock.LockMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLock.t.Fatal("No results are set for the RateLimitBucketRepositoryMock.Lock")
+		}
+		return (*mm_results).rp1, (*mm_results).err
+	}
+	if mmLock.funcLock != nil {
+		return mmLock.funcLock(ctx, key, method, burst)
+	}
+	mmLock.t.Fatalf("Unexpected call to RateLimitBucketRepositoryMock.Lock. %v %v %v %v", ctx, key, method, burst)
+	return
+}
+
+// LockAfterCounter returns a count of finished RateLimitBucketRepositoryMock.Lock invocations
+func (mmLock *RateLimitBucketRepositoryMock) LockAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLock.afterLockCounter)
+}
+
+// LockBeforeCounter returns a count of RateLimitBucketRepositoryMock.Lock invocations
+func (mmLock *RateLimitBucketRepositoryMock) LockBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLock.beforeLockCounter)
+}
+
+// Calls returns a list of arguments used in each call to RateLimitBucketRepositoryMock.Lock.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLock *mRateLimitBucketRepositoryMockLock) Calls() []*RateLimitBucketRepositoryMockLockParams {
+	mmLock.mutex.RLock()
+
+	argCopy := make([]*RateLimitBucketRepositoryMockLockParams, len(mmLock.callArgs))
+	copy(argCopy, mmLock.callArgs)
+
+	mmLock.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLockDone returns true if the count of the Lock invocations corresponds
+// the number of defined expectations
+func (m *RateLimitBucketRepositoryMock) MinimockLockDone() bool {
+	if m.LockMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LockMock.invocationsDone()
+}
+
+// MinimockLockInspect logs each unmet expectation
+func (m *RateLimitBucketRepositoryMock) MinimockLockInspect() {
+	for _, e := range m.LockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RateLimitBucketRepositoryMock.Lock with params: %#v", *e.params)
+		}
+	}
+
+	afterLockCounter := mm_atomic.LoadUint64(&m.afterLockCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LockMock.defaultExpectation != nil && afterLockCounter < 1 {
+		if m.LockMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RateLimitBucketRepositoryMock.Lock")
+		} else {
+			m.t.Errorf("Expected call to RateLimitBucketRepositoryMock.Lock with params: %#v", *m.LockMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLock != nil && afterLockCounter < 1 {
+		m.t.Error("Expected call to RateLimitBucketRepositoryMock.Lock")
+	}
+
+	if !m.LockMock.invocationsDone() && afterLockCounter > 0 {
+		m.t.Errorf("Expected %d calls to RateLimitBucketRepositoryMock.Lock but found %d calls",
+			mm_atomic.LoadUint64(&m.LockMock.expectedInvocations), afterLockCounter)
+	}
+}
+
+type mRateLimitBucketRepositoryMockUpdate struct {
+	optional           bool
+	mock               *RateLimitBucketRepositoryMock
+	defaultExpectation *RateLimitBucketRepositoryMockUpdateExpectation
+	expectations       []*RateLimitBucketRepositoryMockUpdateExpectation
+
+	callArgs []*RateLimitBucketRepositoryMockUpdateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RateLimitBucketRepositoryMockUpdateExpectation specifies expectation struct of the RateLimitBucketRepository.Update
+type RateLimitBucketRepositoryMockUpdateExpectation struct {
+	mock      *RateLimitBucketRepositoryMock
+	params    *RateLimitBucketRepositoryMockUpdateParams
+	paramPtrs *RateLimitBucketRepositoryMockUpdateParamPtrs
+	results   *RateLimitBucketRepositoryMockUpdateResults
+	Counter   uint64
+}
+
+// RateLimitBucketRepositoryMockUpdateParams contains parameters of the RateLimitBucketRepository.Update
+type RateLimitBucketRepositoryMockUpdateParams struct {
+	ctx       context.Context
+	key       string
+	method    string
+	tokens    float64
+	updatedAt time.Time
+}
+
+// RateLimitBucketRepositoryMockUpdateParamPtrs contains pointers to parameters of the RateLimitBucketRepository.Update
+type RateLimitBucketRepositoryMockUpdateParamPtrs struct {
+	ctx       *context.Context
+	key       *string
+	method    *string
+	tokens    *float64
+	updatedAt *time.Time
+}
+
+// RateLimitBucketRepositoryMockUpdateResults contains results of the RateLimitBucketRepository.Update
+type RateLimitBucketRepositoryMockUpdateResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdate *mRateLimitBucketRepositoryMockUpdate) Optional() *mRateLimitBucketRepositoryMockUpdate {
+	mmUpdate.optional = true
+	return mmUpdate
+}
+
+// Expect sets up expected params for RateLimitBucketRepository.Update
+func (mmUpdate *mRateLimitBucketRepositoryMockUpdate) Expect(ctx context.Context, key string, method string, tokens float64, updatedAt time.Time) *mRateLimitBucketRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("RateLimitBucketRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &RateLimitBucketRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs != nil {
+		mmUpdate.mock.t.Fatalf("RateLimitBucketRepositoryMock.Update mock is already set by ExpectParams functions")
+	}
+
+	mmUpdate.defaultExpectation.params = &RateLimitBucketRepositoryMockUpdateParams{ctx, key, method, tokens, updatedAt}
+	for _, e := range mmUpdate.expectations {
+		if minimock.Equal(e.params, mmUpdate.defaultExpectation.params) {
+			mmUpdate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdate.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RateLimitBucketRepository.Update
+func (mmUpdate *mRateLimitBucketRepositoryMockUpdate) ExpectCtxParam1(ctx context.Context) *mRateLimitBucketRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("RateLimitBucketRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &RateLimitBucketRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("RateLimitBucketRepositoryMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &RateLimitBucketRepositoryMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmUpdate
+}
+
+// ExpectKeyParam2 sets up expected param key for RateLimitBucketRepository.Update
+func (mmUpdate *mRateLimitBucketRepositoryMockUpdate) ExpectKeyParam2(key string) *mRateLimitBucketRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("RateLimitBucketRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &RateLimitBucketRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("RateLimitBucketRepositoryMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &RateLimitBucketRepositoryMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.key = &key
+
+	return mmUpdate
+}
+
+// ExpectMethodParam3 sets up expected param method for RateLimitBucketRepository.Update
+func (mmUpdate *mRateLimitBucketRepositoryMockUpdate) ExpectMethodParam3(method string) *mRateLimitBucketRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("RateLimitBucketRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &RateLimitBucketRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("RateLimitBucketRepositoryMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &RateLimitBucketRepositoryMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.method = &method
+
+	return mmUpdate
+}
+
+// ExpectTokensParam4 sets up expected param tokens for RateLimitBucketRepository.Update
+func (mmUpdate *mRateLimitBucketRepositoryMockUpdate) ExpectTokensParam4(tokens float64) *mRateLimitBucketRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("RateLimitBucketRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &RateLimitBucketRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("RateLimitBucketRepositoryMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &RateLimitBucketRepositoryMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.tokens = &tokens
+
+	return mmUpdate
+}
+
+// ExpectUpdatedAtParam5 sets up expected param updatedAt for RateLimitBucketRepository.Update
+func (mmUpdate *mRateLimitBucketRepositoryMockUpdate) ExpectUpdatedAtParam5(updatedAt time.Time) *mRateLimitBucketRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("RateLimitBucketRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &RateLimitBucketRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("RateLimitBucketRepositoryMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &RateLimitBucketRepositoryMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.updatedAt = &updatedAt
+
+	return mmUpdate
+}
+
+// Inspect accepts an inspector function that has same arguments as the RateLimitBucketRepository.Update
+func (mmUpdate *mRateLimitBucketRepositoryMockUpdate) Inspect(f func(ctx context.Context, key string, method string, tokens float64, updatedAt time.Time)) *mRateLimitBucketRepositoryMockUpdate {
+	if mmUpdate.mock.inspectFuncUpdate != nil {
+		mmUpdate.mock.t.Fatalf("Inspect function is already set for RateLimitBucketRepositoryMock.Update")
+	}
+
+	mmUpdate.mock.inspectFuncUpdate = f
+
+	return mmUpdate
+}
+
+// Return sets up results that will be returned by RateLimitBucketRepository.Update
+func (mmUpdate *mRateLimitBucketRepositoryMockUpdate) Return(err error) *RateLimitBucketRepositoryMock {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("RateLimitBucketRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &RateLimitBucketRepositoryMockUpdateExpectation{mock: mmUpdate.mock}
+	}
+	mmUpdate.defaultExpectation.results = &RateLimitBucketRepositoryMockUpdateResults{err}
+	return mmUpdate.mock
+}
+
+// Set uses given function f to mock the RateLimitBucketRepository.Update method
+func (mmUpdate *mRateLimitBucketRepositoryMockUpdate) Set(f func(ctx context.Context, key string, method string, tokens float64, updatedAt time.Time) (err error)) *RateLimitBucketRepositoryMock {
+	if mmUpdate.defaultExpectation != nil {
+		mmUpdate.mock.t.Fatalf("Default expectation is already set for the RateLimitBucketRepository.Update method")
+	}
+
+	if len(mmUpdate.expectations) > 0 {
+		mmUpdate.mock.t.Fatalf("Some expectations are already set for the RateLimitBucketRepository.Update method")
+	}
+
+	mmUpdate.mock.funcUpdate = f
+	return mmUpdate.mock
+}
+
+// When sets expectation for the RateLimitBucketRepository.Update which will trigger the result defined by the following
+// Then helper
+func (mmUpdate *mRateLimitBucketRepositoryMockUpdate) When(ctx context.Context, key string, method string, tokens float64, updatedAt time.Time) *RateLimitBucketRepositoryMockUpdateExpectation {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("RateLimitBucketRepositoryMock.Update mock is already set by Set")
+	}
+
+	expectation := &RateLimitBucketRepositoryMockUpdateExpectation{
+		mock:   mmUpdate.mock,
+		params: &RateLimitBucketRepositoryMockUpdateParams{ctx, key, method, tokens, updatedAt},
+	}
+	mmUpdate.expectations = append(mmUpdate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RateLimitBucketRepository.Update return parameters for the expectation previously defined by the When method
+func (e *RateLimitBucketRepositoryMockUpdateExpectation) Then(err error) *RateLimitBucketRepositoryMock {
+	e.results = &RateLimitBucketRepositoryMockUpdateResults{err}
+	return e.mock
+}
+
+// Times sets number of times RateLimitBucketRepository.Update should be invoked
+func (mmUpdate *mRateLimitBucketRepositoryMockUpdate) Times(n uint64) *mRateLimitBucketRepositoryMockUpdate {
+	if n == 0 {
+		mmUpdate.mock.t.Fatalf("Times of RateLimitBucketRepositoryMock.Update mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdate.expectedInvocations, n)
+	return mmUpdate
+}
+
+func (mmUpdate *mRateLimitBucketRepositoryMockUpdate) invocationsDone() bool {
+	if len(mmUpdate.expectations) == 0 && mmUpdate.defaultExpectation == nil && mmUpdate.mock.funcUpdate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdate.mock.afterUpdateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Update implements repository.RateLimitBucketRepository
+func (mmUpdate *RateLimitBucketRepositoryMock) Update(ctx context.Context, key string, method string, tokens float64, updatedAt time.Time) (err error) {
+	mm_atomic.AddUint64(&mmUpdate.beforeUpdateCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdate.afterUpdateCounter, 1)
+
+	if mmUpdate.inspectFuncUpdate != nil {
+		mmUpdate.inspectFuncUpdate(ctx, key, method, tokens, updatedAt)
+	}
+
+	mm_params := RateLimitBucketRepositoryMockUpdateParams{ctx, key, method, tokens, updatedAt}
+
+	// Record call args
+	mmUpdate.UpdateMock.mutex.Lock()
+	mmUpdate.UpdateMock.callArgs = append(mmUpdate.UpdateMock.callArgs, &mm_params)
+	mmUpdate.UpdateMock.mutex.Unlock()
+
+	for _, e := range mmUpdate.UpdateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdate.UpdateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdate.UpdateMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdate.UpdateMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdate.UpdateMock.defaultExpectation.paramPtrs
+
+		mm_got := RateLimitBucketRepositoryMockUpdateParams{ctx, key, method, tokens, updatedAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdate.t.Errorf("RateLimitBucketRepositoryMock.Update got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmUpdate.t.Errorf("RateLimitBucketRepositoryMock.Update got unexpected parameter key, want: %#v, got: %#v%s\n", *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.method != nil && !minimock.Equal(*mm_want_ptrs.method, mm_got.method) {
+				mmUpdate.t.Errorf("RateLimitBucketRepositoryMock.Update got unexpected parameter method, want: %#v, got: %#v%s\n", *mm_want_ptrs.method, mm_got.method, minimock.Diff(*mm_want_ptrs.method, mm_got.method))
+			}
+
+			if mm_want_ptrs.tokens != nil && !minimock.Equal(*mm_want_ptrs.tokens, mm_got.tokens) {
+				mmUpdate.t.Errorf("RateLimitBucketRepositoryMock.Update got unexpected parameter tokens, want: %#v, got: %#v%s\n", *mm_want_ptrs.tokens, mm_got.tokens, minimock.Diff(*mm_want_ptrs.tokens, mm_got.tokens))
+			}
+
+			if mm_want_ptrs.updatedAt != nil && !minimock.Equal(*mm_want_ptrs.updatedAt, mm_got.updatedAt) {
+				mmUpdate.t.Errorf("RateLimitBucketRepositoryMock.Update got unexpected parameter updatedAt, want: %#v, got: %#v%s\n", *mm_want_ptrs.updatedAt, mm_got.updatedAt, minimock.Diff(*mm_want_ptrs.updatedAt, mm_got.updatedAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdate.t.Errorf("RateLimitBucketRepositoryMock.Update got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdate.UpdateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdate.t.Fatal("No results are set for the RateLimitBucketRepositoryMock.Update")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdate.funcUpdate != nil {
+		return mmUpdate.funcUpdate(ctx, key, method, tokens, updatedAt)
+	}
+	mmUpdate.t.Fatalf("Unexpected call to RateLimitBucketRepositoryMock.Update. %v %v %v %v %v", ctx, key, method, tokens, updatedAt)
+	return
+}
+
+// UpdateAfterCounter returns a count of finished RateLimitBucketRepositoryMock.Update invocations
+func (mmUpdate *RateLimitBucketRepositoryMock) UpdateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.afterUpdateCounter)
+}
+
+// UpdateBeforeCounter returns a count of RateLimitBucketRepositoryMock.Update invocations
+func (mmUpdate *RateLimitBucketRepositoryMock) UpdateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.beforeUpdateCounter)
+}
+
+// Calls returns a list of arguments used in each call to RateLimitBucketRepositoryMock.Update.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdate *mRateLimitBucketRepositoryMockUpdate) Calls() []*RateLimitBucketRepositoryMockUpdateParams {
+	mmUpdate.mutex.RLock()
+
+	argCopy := make([]*RateLimitBucketRepositoryMockUpdateParams, len(mmUpdate.callArgs))
+	copy(argCopy, mmUpdate.callArgs)
+
+	mmUpdate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateDone returns true if the count of the Update invocations corresponds
+// the number of defined expectations
+func (m *RateLimitBucketRepositoryMock) MinimockUpdateDone() bool {
+	if m.UpdateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateMock.invocationsDone()
+}
+
+// MinimockUpdateInspect logs each unmet expectation
+func (m *RateLimitBucketRepositoryMock) MinimockUpdateInspect() {
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RateLimitBucketRepositoryMock.Update with params: %#v", *e.params)
+		}
+	}
+
+	afterUpdateCounter := mm_atomic.LoadUint64(&m.afterUpdateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateMock.defaultExpectation != nil && afterUpdateCounter < 1 {
+		if m.UpdateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RateLimitBucketRepositoryMock.Update")
+		} else {
+			m.t.Errorf("Expected call to RateLimitBucketRepositoryMock.Update with params: %#v", *m.UpdateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdate != nil && afterUpdateCounter < 1 {
+		m.t.Error("Expected call to RateLimitBucketRepositoryMock.Update")
+	}
+
+	if !m.UpdateMock.invocationsDone() && afterUpdateCounter > 0 {
+		m.t.Errorf("Expected %d calls to RateLimitBucketRepositoryMock.Update but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateMock.expectedInvocations), afterUpdateCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RateLimitBucketRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockDeleteIdleInspect()
+
+			m.MinimockLockInspect()
+
+			m.MinimockUpdateInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RateLimitBucketRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RateLimitBucketRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockDeleteIdleDone() &&
+		m.MinimockLockDone() &&
+		m.MinimockUpdateDone()
+}
@@ -0,0 +1,782 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.13). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i di_container/internal/repository.RecoveryCodeRepository -o recovery_code_repository_minimock.go -n RecoveryCodeRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// RecoveryCodeRepositoryMock implements repository.RecoveryCodeRepository
+type RecoveryCodeRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcReplace          func(ctx context.Context, userID int64, codeHashes []string) (err error)
+	inspectFuncReplace   func(ctx context.Context, userID int64, codeHashes []string)
+	afterReplaceCounter  uint64
+	beforeReplaceCounter uint64
+	ReplaceMock          mRecoveryCodeRepositoryMockReplace
+
+	funcUse          func(ctx context.Context, userID int64, codeHash string) (b1 bool, err error)
+	inspectFuncUse   func(ctx context.Context, userID int64, codeHash string)
+	afterUseCounter  uint64
+	beforeUseCounter uint64
+	UseMock          mRecoveryCodeRepositoryMockUse
+}
+
+// NewRecoveryCodeRepositoryMock returns a mock for repository.RecoveryCodeRepository
+func NewRecoveryCodeRepositoryMock(t minimock.Tester) *RecoveryCodeRepositoryMock {
+	m := &RecoveryCodeRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ReplaceMock = mRecoveryCodeRepositoryMockReplace{mock: m}
+	m.ReplaceMock.callArgs = []*RecoveryCodeRepositoryMockReplaceParams{}
+
+	m.UseMock = mRecoveryCodeRepositoryMockUse{mock: m}
+	m.UseMock.callArgs = []*RecoveryCodeRepositoryMockUseParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRecoveryCodeRepositoryMockReplace struct {
+	optional           bool
+	mock               *RecoveryCodeRepositoryMock
+	defaultExpectation *RecoveryCodeRepositoryMockReplaceExpectation
+	expectations       []*RecoveryCodeRepositoryMockReplaceExpectation
+
+	callArgs []*RecoveryCodeRepositoryMockReplaceParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RecoveryCodeRepositoryMockReplaceExpectation specifies expectation struct of the RecoveryCodeRepository.Replace
+type RecoveryCodeRepositoryMockReplaceExpectation struct {
+	mock      *RecoveryCodeRepositoryMock
+	params    *RecoveryCodeRepositoryMockReplaceParams
+	paramPtrs *RecoveryCodeRepositoryMockReplaceParamPtrs
+	results   *RecoveryCodeRepositoryMockReplaceResults
+	Counter   uint64
+}
+
+// RecoveryCodeRepositoryMockReplaceParams contains parameters of the RecoveryCodeRepository.Replace
+type RecoveryCodeRepositoryMockReplaceParams struct {
+	ctx        context.Context
+	userID     int64
+	codeHashes []string
+}
+
+// RecoveryCodeRepositoryMockReplaceParamPtrs contains pointers to parameters of the RecoveryCodeRepository.Replace
+type RecoveryCodeRepositoryMockReplaceParamPtrs struct {
+	ctx        *context.Context
+	userID     *int64
+	codeHashes *[]string
+}
+
+// RecoveryCodeRepositoryMockReplaceResults contains results of the RecoveryCodeRepository.Replace
+type RecoveryCodeRepositoryMockReplaceResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReplace *mRecoveryCodeRepositoryMockReplace) Optional() *mRecoveryCodeRepositoryMockReplace {
+	mmReplace.optional = true
+	return mmReplace
+}
+
+// Expect sets up expected params for RecoveryCodeRepository.Replace
+func (mmReplace *mRecoveryCodeRepositoryMockReplace) Expect(ctx context.Context, userID int64, codeHashes []string) *mRecoveryCodeRepositoryMockReplace {
+	if mmReplace.mock.funcReplace != nil {
+		mmReplace.mock.t.Fatalf("RecoveryCodeRepositoryMock.Replace mock is already set by Set")
+	}
+
+	if mmReplace.defaultExpectation == nil {
+		mmReplace.defaultExpectation = &RecoveryCodeRepositoryMockReplaceExpectation{}
+	}
+
+	if mmReplace.defaultExpectation.paramPtrs != nil {
+		mmReplace.mock.t.Fatalf("RecoveryCodeRepositoryMock.Replace mock is already set by ExpectParams functions")
+	}
+
+	mmReplace.defaultExpectation.params = &RecoveryCodeRepositoryMockReplaceParams{ctx, userID, codeHashes}
+	for _, e := range mmReplace.expectations {
+		if minimock.Equal(e.params, mmReplace.defaultExpectation.params) {
+			mmReplace.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReplace.defaultExpectation.params)
+		}
+	}
+
+	return mmReplace
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RecoveryCodeRepository.Replace
+func (mmReplace *mRecoveryCodeRepositoryMockReplace) ExpectCtxParam1(ctx context.Context) *mRecoveryCodeRepositoryMockReplace {
+	if mmReplace.mock.funcReplace != nil {
+		mmReplace.mock.t.Fatalf("RecoveryCodeRepositoryMock.Replace mock is already set by Set")
+	}
+
+	if mmReplace.defaultExpectation == nil {
+		mmReplace.defaultExpectation = &RecoveryCodeRepositoryMockReplaceExpectation{}
+	}
+
+	if mmReplace.defaultExpectation.params != nil {
+		mmReplace.mock.t.Fatalf("RecoveryCodeRepositoryMock.Replace mock is already set by Expect")
+	}
+
+	if mmReplace.defaultExpectation.paramPtrs == nil {
+		mmReplace.defaultExpectation.paramPtrs = &RecoveryCodeRepositoryMockReplaceParamPtrs{}
+	}
+	mmReplace.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmReplace
+}
+
+// ExpectUserIDParam2 sets up expected param userID for RecoveryCodeRepository.Replace
+func (mmReplace *mRecoveryCodeRepositoryMockReplace) ExpectUserIDParam2(userID int64) *mRecoveryCodeRepositoryMockReplace {
+	if mmReplace.mock.funcReplace != nil {
+		mmReplace.mock.t.Fatalf("RecoveryCodeRepositoryMock.Replace mock is already set by Set")
+	}
+
+	if mmReplace.defaultExpectation == nil {
+		mmReplace.defaultExpectation = &RecoveryCodeRepositoryMockReplaceExpectation{}
+	}
+
+	if mmReplace.defaultExpectation.params != nil {
+		mmReplace.mock.t.Fatalf("RecoveryCodeRepositoryMock.Replace mock is already set by Expect")
+	}
+
+	if mmReplace.defaultExpectation.paramPtrs == nil {
+		mmReplace.defaultExpectation.paramPtrs = &RecoveryCodeRepositoryMockReplaceParamPtrs{}
+	}
+	mmReplace.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmReplace
+}
+
+// ExpectCodeHashesParam3 sets up expected param codeHashes for RecoveryCodeRepository.Replace
+func (mmReplace *mRecoveryCodeRepositoryMockReplace) ExpectCodeHashesParam3(codeHashes []string) *mRecoveryCodeRepositoryMockReplace {
+	if mmReplace.mock.funcReplace != nil {
+		mmReplace.mock.t.Fatalf("RecoveryCodeRepositoryMock.Replace mock is already set by Set")
+	}
+
+	if mmReplace.defaultExpectation == nil {
+		mmReplace.defaultExpectation = &RecoveryCodeRepositoryMockReplaceExpectation{}
+	}
+
+	if mmReplace.defaultExpectation.params != nil {
+		mmReplace.mock.t.Fatalf("RecoveryCodeRepositoryMock.Replace mock is already set by Expect")
+	}
+
+	if mmReplace.defaultExpectation.paramPtrs == nil {
+		mmReplace.defaultExpectation.paramPtrs = &RecoveryCodeRepositoryMockReplaceParamPtrs{}
+	}
+	mmReplace.defaultExpectation.paramPtrs.codeHashes = &codeHashes
+
+	return mmReplace
+}
+
+// Inspect accepts an inspector function that has same arguments as the RecoveryCodeRepository.Replace
+func (mmReplace *mRecoveryCodeRepositoryMockReplace) Inspect(f func(ctx context.Context, userID int64, codeHashes []string)) *mRecoveryCodeRepositoryMockReplace {
+	if mmReplace.mock.inspectFuncReplace != nil {
+		mmReplace.mock.t.Fatalf("Inspect function is already set for RecoveryCodeRepositoryMock.Replace")
+	}
+
+	mmReplace.mock.inspectFuncReplace = f
+
+	return mmReplace
+}
+
+// Return sets up results that will be returned by RecoveryCodeRepository.Replace
+func (mmReplace *mRecoveryCodeRepositoryMockReplace) Return(err error) *RecoveryCodeRepositoryMock {
+	if mmReplace.mock.funcReplace != nil {
+		mmReplace.mock.t.Fatalf("RecoveryCodeRepositoryMock.Replace mock is already set by Set")
+	}
+
+	if mmReplace.defaultExpectation == nil {
+		mmReplace.defaultExpectation = &RecoveryCodeRepositoryMockReplaceExpectation{mock: mmReplace.mock}
+	}
+	mmReplace.defaultExpectation.results = &RecoveryCodeRepositoryMockReplaceResults{err}
+	return mmReplace.mock
+}
+
+// Set uses given function f to mock the RecoveryCodeRepository.Replace method
+func (mmReplace *mRecoveryCodeRepositoryMockReplace) Set(f func(ctx context.Context, userID int64, codeHashes []string) (err error)) *RecoveryCodeRepositoryMock {
+	if mmReplace.defaultExpectation != nil {
+		mmReplace.mock.t.Fatalf("Default expectation is already set for the RecoveryCodeRepository.Replace method")
+	}
+
+	if len(mmReplace.expectations) > 0 {
+		mmReplace.mock.t.Fatalf("Some expectations are already set for the RecoveryCodeRepository.Replace method")
+	}
+
+	mmReplace.mock.funcReplace = f
+	return mmReplace.mock
+}
+
+// When sets expectation for the RecoveryCodeRepository.Replace which will trigger the result defined by the following
+// Then helper
+func (mmReplace *mRecoveryCodeRepositoryMockReplace) When(ctx context.Context, userID int64, codeHashes []string) *RecoveryCodeRepositoryMockReplaceExpectation {
+	if mmReplace.mock.funcReplace != nil {
+		mmReplace.mock.t.Fatalf("RecoveryCodeRepositoryMock.Replace mock is already set by Set")
+	}
+
+	expectation := &RecoveryCodeRepositoryMockReplaceExpectation{
+		mock:   mmReplace.mock,
+		params: &RecoveryCodeRepositoryMockReplaceParams{ctx, userID, codeHashes},
+	}
+	mmReplace.expectations = append(mmReplace.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RecoveryCodeRepository.Replace return parameters for the expectation previously defined by the When method
+func (e *RecoveryCodeRepositoryMockReplaceExpectation) Then(err error) *RecoveryCodeRepositoryMock {
+	e.results = &RecoveryCodeRepositoryMockReplaceResults{err}
+	return e.mock
+}
+
+// Times sets number of times RecoveryCodeRepository.Replace should be invoked
+func (mmReplace *mRecoveryCodeRepositoryMockReplace) Times(n uint64) *mRecoveryCodeRepositoryMockReplace {
+	if n == 0 {
+		mmReplace.mock.t.Fatalf("Times of RecoveryCodeRepositoryMock.Replace mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReplace.expectedInvocations, n)
+	return mmReplace
+}
+
+func (mmReplace *mRecoveryCodeRepositoryMockReplace) invocationsDone() bool {
+	if len(mmReplace.expectations) == 0 && mmReplace.defaultExpectation == nil && mmReplace.mock.funcReplace == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReplace.mock.afterReplaceCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReplace.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Replace implements repository.RecoveryCodeRepository
+func (mmReplace *RecoveryCodeRepositoryMock) Replace(ctx context.Context, userID int64, codeHashes []string) (err error) {
+	mm_atomic.AddUint64(&mmReplace.beforeReplaceCounter, 1)
+	defer mm_atomic.AddUint64(&mmReplace.afterReplaceCounter, 1)
+
+	if mmReplace.inspectFuncReplace != nil {
+		mmReplace.inspectFuncReplace(ctx, userID, codeHashes)
+	}
+
+	mm_params := RecoveryCodeRepositoryMockReplaceParams{ctx, userID, codeHashes}
+
+	// Record call args
+	mmReplace.ReplaceMock.mutex.Lock()
+	mmReplace.ReplaceMock.callArgs = append(mmReplace.ReplaceMock.callArgs, &mm_params)
+	mmReplace.ReplaceMock.mutex.Unlock()
+
+	for _, e := range mmReplace.ReplaceMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmReplace.ReplaceMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReplace.ReplaceMock.defaultExpectation.Counter, 1)
+		mm_want := mmReplace.ReplaceMock.defaultExpectation.params
+		mm_want_ptrs := mmReplace.ReplaceMock.defaultExpectation.paramPtrs
+
+		mm_got := RecoveryCodeRepositoryMockReplaceParams{ctx, userID, codeHashes}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReplace.t.Errorf("RecoveryCodeRepositoryMock.Replace got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmReplace.t.Errorf("RecoveryCodeRepositoryMock.Replace got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.codeHashes != nil && !minimock.Equal(*mm_want_ptrs.codeHashes, mm_got.codeHashes) {
+				mmReplace.t.Errorf("RecoveryCodeRepositoryMock.Replace got unexpected parameter codeHashes, want: %#v, got: %#v%s\n", *mm_want_ptrs.codeHashes, mm_got.codeHashes, minimock.Diff(*mm_want_ptrs.codeHashes, mm_got.codeHashes))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReplace.t.Errorf("RecoveryCodeRepositoryMock.Replace got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReplace.ReplaceMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReplace.t.Fatal("No results are set for the RecoveryCodeRepositoryMock.Replace")
+		}
+		return (*mm_results).err
+	}
+	if mmReplace.funcReplace != nil {
+		return mmReplace.funcReplace(ctx, userID, codeHashes)
+	}
+	mmReplace.t.Fatalf("Unexpected call to RecoveryCodeRepositoryMock.Replace. %v %v %v", ctx, userID, codeHashes)
+	return
+}
+
+// ReplaceAfterCounter returns a count of finished RecoveryCodeRepositoryMock.Replace invocations
+func (mmReplace *RecoveryCodeRepositoryMock) ReplaceAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReplace.afterReplaceCounter)
+}
+
+// ReplaceBeforeCounter returns a count of RecoveryCodeRepositoryMock.Replace invocations
+func (mmReplace *RecoveryCodeRepositoryMock) ReplaceBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReplace.beforeReplaceCounter)
+}
+
+// Calls returns a list of arguments used in each call to RecoveryCodeRepositoryMock.Replace.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReplace *mRecoveryCodeRepositoryMockReplace) Calls() []*RecoveryCodeRepositoryMockReplaceParams {
+	mmReplace.mutex.RLock()
+
+	argCopy := make([]*RecoveryCodeRepositoryMockReplaceParams, len(mmReplace.callArgs))
+	copy(argCopy, mmReplace.callArgs)
+
+	mmReplace.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReplaceDone returns true if the count of the Replace invocations corresponds
+// the number of defined expectations
+func (m *RecoveryCodeRepositoryMock) MinimockReplaceDone() bool {
+	if m.ReplaceMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReplaceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReplaceMock.invocationsDone()
+}
+
+// MinimockReplaceInspect logs each unmet expectation
+func (m *RecoveryCodeRepositoryMock) MinimockReplaceInspect() {
+	for _, e := range m.ReplaceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RecoveryCodeRepositoryMock.Replace with params: %#v", *e.params)
+		}
+	}
+
+	afterReplaceCounter := mm_atomic.LoadUint64(&m.afterReplaceCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReplaceMock.defaultExpectation != nil && afterReplaceCounter < 1 {
+		if m.ReplaceMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RecoveryCodeRepositoryMock.Replace")
+		} else {
+			m.t.Errorf("Expected call to RecoveryCodeRepositoryMock.Replace with params: %#v", *m.ReplaceMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReplace != nil && afterReplaceCounter < 1 {
+		m.t.Error("Expected call to RecoveryCodeRepositoryMock.Replace")
+	}
+
+	if !m.ReplaceMock.invocationsDone() && afterReplaceCounter > 0 {
+		m.t.Errorf("Expected %d calls to RecoveryCodeRepositoryMock.Replace but found %d calls",
+			mm_atomic.LoadUint64(&m.ReplaceMock.expectedInvocations), afterReplaceCounter)
+	}
+}
+
+type mRecoveryCodeRepositoryMockUse struct {
+	optional           bool
+	mock               *RecoveryCodeRepositoryMock
+	defaultExpectation *RecoveryCodeRepositoryMockUseExpectation
+	expectations       []*RecoveryCodeRepositoryMockUseExpectation
+
+	callArgs []*RecoveryCodeRepositoryMockUseParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RecoveryCodeRepositoryMockUseExpectation specifies expectation struct of the RecoveryCodeRepository.Use
+type RecoveryCodeRepositoryMockUseExpectation struct {
+	mock      *RecoveryCodeRepositoryMock
+	params    *RecoveryCodeRepositoryMockUseParams
+	paramPtrs *RecoveryCodeRepositoryMockUseParamPtrs
+	results   *RecoveryCodeRepositoryMockUseResults
+	Counter   uint64
+}
+
+// RecoveryCodeRepositoryMockUseParams contains parameters of the RecoveryCodeRepository.Use
+type RecoveryCodeRepositoryMockUseParams struct {
+	ctx      context.Context
+	userID   int64
+	codeHash string
+}
+
+// RecoveryCodeRepositoryMockUseParamPtrs contains pointers to parameters of the RecoveryCodeRepository.Use
+type RecoveryCodeRepositoryMockUseParamPtrs struct {
+	ctx      *context.Context
+	userID   *int64
+	codeHash *string
+}
+
+// RecoveryCodeRepositoryMockUseResults contains results of the RecoveryCodeRepository.Use
+type RecoveryCodeRepositoryMockUseResults struct {
+	b1  bool
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUse *mRecoveryCodeRepositoryMockUse) Optional() *mRecoveryCodeRepositoryMockUse {
+	mmUse.optional = true
+	return mmUse
+}
+
+// Expect sets up expected params for RecoveryCodeRepository.Use
+func (mmUse *mRecoveryCodeRepositoryMockUse) Expect(ctx context.Context, userID int64, codeHash string) *mRecoveryCodeRepositoryMockUse {
+	if mmUse.mock.funcUse != nil {
+		mmUse.mock.t.Fatalf("RecoveryCodeRepositoryMock.Use mock is already set by Set")
+	}
+
+	if mmUse.defaultExpectation == nil {
+		mmUse.defaultExpectation = &RecoveryCodeRepositoryMockUseExpectation{}
+	}
+
+	if mmUse.defaultExpectation.paramPtrs != nil {
+		mmUse.mock.t.Fatalf("RecoveryCodeRepositoryMock.Use mock is already set by ExpectParams functions")
+	}
+
+	mmUse.defaultExpectation.params = &RecoveryCodeRepositoryMockUseParams{ctx, userID, codeHash}
+	for _, e := range mmUse.expectations {
+		if minimock.Equal(e.params, mmUse.defaultExpectation.params) {
+			mmUse.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUse.defaultExpectation.params)
+		}
+	}
+
+	return mmUse
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RecoveryCodeRepository.Use
+func (mmUse *mRecoveryCodeRepositoryMockUse) ExpectCtxParam1(ctx context.Context) *mRecoveryCodeRepositoryMockUse {
+	if mmUse.mock.funcUse != nil {
+		mmUse.mock.t.Fatalf("RecoveryCodeRepositoryMock.Use mock is already set by Set")
+	}
+
+	if mmUse.defaultExpectation == nil {
+		mmUse.defaultExpectation = &RecoveryCodeRepositoryMockUseExpectation{}
+	}
+
+	if mmUse.defaultExpectation.params != nil {
+		mmUse.mock.t.Fatalf("RecoveryCodeRepositoryMock.Use mock is already set by Expect")
+	}
+
+	if mmUse.defaultExpectation.paramPtrs == nil {
+		mmUse.defaultExpectation.paramPtrs = &RecoveryCodeRepositoryMockUseParamPtrs{}
+	}
+	mmUse.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmUse
+}
+
+// ExpectUserIDParam2 sets up expected param userID for RecoveryCodeRepository.Use
+func (mmUse *mRecoveryCodeRepositoryMockUse) ExpectUserIDParam2(userID int64) *mRecoveryCodeRepositoryMockUse {
+	if mmUse.mock.funcUse != nil {
+		mmUse.mock.t.Fatalf("RecoveryCodeRepositoryMock.Use mock is already set by Set")
+	}
+
+	if mmUse.defaultExpectation == nil {
+		mmUse.defaultExpectation = &RecoveryCodeRepositoryMockUseExpectation{}
+	}
+
+	if mmUse.defaultExpectation.params != nil {
+		mmUse.mock.t.Fatalf("RecoveryCodeRepositoryMock.Use mock is already set by Expect")
+	}
+
+	if mmUse.defaultExpectation.paramPtrs == nil {
+		mmUse.defaultExpectation.paramPtrs = &RecoveryCodeRepositoryMockUseParamPtrs{}
+	}
+	mmUse.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmUse
+}
+
+// ExpectCodeHashParam3 sets up expected param codeHash for RecoveryCodeRepository.Use
+func (mmUse *mRecoveryCodeRepositoryMockUse) ExpectCodeHashParam3(codeHash string) *mRecoveryCodeRepositoryMockUse {
+	if mmUse.mock.funcUse != nil {
+		mmUse.mock.t.Fatalf("RecoveryCodeRepositoryMock.Use mock is already set by Set")
+	}
+
+	if mmUse.defaultExpectation == nil {
+		mmUse.defaultExpectation = &RecoveryCodeRepositoryMockUseExpectation{}
+	}
+
+	if mmUse.defaultExpectation.params != nil {
+		mmUse.mock.t.Fatalf("RecoveryCodeRepositoryMock.Use mock is already set by Expect")
+	}
+
+	if mmUse.defaultExpectation.paramPtrs == nil {
+		mmUse.defaultExpectation.paramPtrs = &RecoveryCodeRepositoryMockUseParamPtrs{}
+	}
+	mmUse.defaultExpectation.paramPtrs.codeHash = &codeHash
+
+	return mmUse
+}
+
+// Inspect accepts an inspector function that has same arguments as the RecoveryCodeRepository.Use
+func (mmUse *mRecoveryCodeRepositoryMockUse) Inspect(f func(ctx context.Context, userID int64, codeHash string)) *mRecoveryCodeRepositoryMockUse {
+	if mmUse.mock.inspectFuncUse != nil {
+		mmUse.mock.t.Fatalf("Inspect function is already set for RecoveryCodeRepositoryMock.Use")
+	}
+
+	mmUse.mock.inspectFuncUse = f
+
+	return mmUse
+}
+
+// Return sets up results that will be returned by RecoveryCodeRepository.Use
+func (mmUse *mRecoveryCodeRepositoryMockUse) Return(b1 bool, err error) *RecoveryCodeRepositoryMock {
+	if mmUse.mock.funcUse != nil {
+		mmUse.mock.t.Fatalf("RecoveryCodeRepositoryMock.Use mock is already set by Set")
+	}
+
+	if mmUse.defaultExpectation == nil {
+		mmUse.defaultExpectation = &RecoveryCodeRepositoryMockUseExpectation{mock: mmUse.mock}
+	}
+	mmUse.defaultExpectation.results = &RecoveryCodeRepositoryMockUseResults{b1, err}
+	return mmUse.mock
+}
+
+// Set uses given function f to mock the RecoveryCodeRepository.Use method
+func (mmUse *mRecoveryCodeRepositoryMockUse) Set(f func(ctx context.Context, userID int64, codeHash string) (b1 bool, err error)) *RecoveryCodeRepositoryMock {
+	if mmUse.defaultExpectation != nil {
+		mmUse.mock.t.Fatalf("Default expectation is already set for the RecoveryCodeRepository.Use method")
+	}
+
+	if len(mmUse.expectations) > 0 {
+		mmUse.mock.t.Fatalf("Some expectations are already set for the RecoveryCodeRepository.Use method")
+	}
+
+	mmUse.mock.funcUse = f
+	return mmUse.mock
+}
+
+// When sets expectation for the RecoveryCodeRepository.Use which will trigger the result defined by the following
+// Then helper
+func (mmUse *mRecoveryCodeRepositoryMockUse) When(ctx context.Context, userID int64, codeHash string) *RecoveryCodeRepositoryMockUseExpectation {
+	if mmUse.mock.funcUse != nil {
+		mmUse.mock.t.Fatalf("RecoveryCodeRepositoryMock.Use mock is already set by Set")
+	}
+
+	expectation := &RecoveryCodeRepositoryMockUseExpectation{
+		mock:   mmUse.mock,
+		params: &RecoveryCodeRepositoryMockUseParams{ctx, userID, codeHash},
+	}
+	mmUse.expectations = append(mmUse.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RecoveryCodeRepository.Use return parameters for the expectation previously defined by the When method
+func (e *RecoveryCodeRepositoryMockUseExpectation) Then(b1 bool, err error) *RecoveryCodeRepositoryMock {
+	e.results = &RecoveryCodeRepositoryMockUseResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times RecoveryCodeRepository.Use should be invoked
+func (mmUse *mRecoveryCodeRepositoryMockUse) Times(n uint64) *mRecoveryCodeRepositoryMockUse {
+	if n == 0 {
+		mmUse.mock.t.Fatalf("Times of RecoveryCodeRepositoryMock.Use mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUse.expectedInvocations, n)
+	return mmUse
+}
+
+func (mmUse *mRecoveryCodeRepositoryMockUse) invocationsDone() bool {
+	if len(mmUse.expectations) == 0 && mmUse.defaultExpectation == nil && mmUse.mock.funcUse == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUse.mock.afterUseCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUse.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Use implements repository.RecoveryCodeRepository
+func (mmUse *RecoveryCodeRepositoryMock) Use(ctx context.Context, userID int64, codeHash string) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmUse.beforeUseCounter, 1)
+	defer mm_atomic.AddUint64(&mmUse.afterUseCounter, 1)
+
+	if mmUse.inspectFuncUse != nil {
+		mmUse.inspectFuncUse(ctx, userID, codeHash)
+	}
+
+	mm_params := RecoveryCodeRepositoryMockUseParams{ctx, userID, codeHash}
+
+	// Record call args
+	mmUse.UseMock.mutex.Lock()
+	mmUse.UseMock.callArgs = append(mmUse.UseMock.callArgs, &mm_params)
+	mmUse.UseMock.mutex.Unlock()
+
+	for _, e := range mmUse.UseMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmUse.UseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUse.UseMock.defaultExpectation.Counter, 1)
+		mm_want := mmUse.UseMock.defaultExpectation.params
+		mm_want_ptrs := mmUse.UseMock.defaultExpectation.paramPtrs
+
+		mm_got := RecoveryCodeRepositoryMockUseParams{ctx, userID, codeHash}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUse.t.Errorf("RecoveryCodeRepositoryMock.Use got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmUse.t.Errorf("RecoveryCodeRepositoryMock.Use got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.codeHash != nil && !minimock.Equal(*mm_want_ptrs.codeHash, mm_got.codeHash) {
+				mmUse.t.Errorf("RecoveryCodeRepositoryMock.Use got unexpected parameter codeHash, want: %#v, got: %#v%s\n", *mm_want_ptrs.codeHash, mm_got.codeHash, minimock.Diff(*mm_want_ptrs.codeHash, mm_got.codeHash))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUse.t.Errorf("RecoveryCodeRepositoryMock.Use got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUse.UseMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUse.t.Fatal("No results are set for the RecoveryCodeRepositoryMock.Use")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmUse.funcUse != nil {
+		return mmUse.funcUse(ctx, userID, codeHash)
+	}
+	mmUse.t.Fatalf("Unexpected call to RecoveryCodeRepositoryMock.Use. %v %v %v", ctx, userID, codeHash)
+	return
+}
+
+// UseAfterCounter returns a count of finished RecoveryCodeRepositoryMock.Use invocations
+func (mmUse *RecoveryCodeRepositoryMock) UseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUse.afterUseCounter)
+}
+
+// UseBeforeCounter returns a count of RecoveryCodeRepositoryMock.Use invocations
+func (mmUse *RecoveryCodeRepositoryMock) UseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUse.beforeUseCounter)
+}
+
+// Calls returns a list of arguments used in each call to RecoveryCodeRepositoryMock.Use.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUse *mRecoveryCodeRepositoryMockUse) Calls() []*RecoveryCodeRepositoryMockUseParams {
+	mmUse.mutex.RLock()
+
+	argCopy := make([]*RecoveryCodeRepositoryMockUseParams, len(mmUse.callArgs))
+	copy(argCopy, mmUse.callArgs)
+
+	mmUse.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUseDone returns true if the count of the Use invocations corresponds
+// the number of defined expectations
+func (m *RecoveryCodeRepositoryMock) MinimockUseDone() bool {
+	if m.UseMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UseMock.invocationsDone()
+}
+
+// MinimockUseInspect logs each unmet expectation
+func (m *RecoveryCodeRepositoryMock) MinimockUseInspect() {
+	for _, e := range m.UseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RecoveryCodeRepositoryMock.Use with params: %#v", *e.params)
+		}
+	}
+
+	afterUseCounter := mm_atomic.LoadUint64(&m.afterUseCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UseMock.defaultExpectation != nil && afterUseCounter < 1 {
+		if m.UseMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RecoveryCodeRepositoryMock.Use")
+		} else {
+			m.t.Errorf("Expected call to RecoveryCodeRepositoryMock.Use with params: %#v", *m.UseMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUse != nil && afterUseCounter < 1 {
+		m.t.Error("Expected call to RecoveryCodeRepositoryMock.Use")
+	}
+
+	if !m.UseMock.invocationsDone() && afterUseCounter > 0 {
+		m.t.Errorf("Expected %d calls to RecoveryCodeRepositoryMock.Use but found %d calls",
+			mm_atomic.LoadUint64(&m.UseMock.expectedInvocations), afterUseCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RecoveryCodeRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockReplaceInspect()
+
+			m.MinimockUseInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RecoveryCodeRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RecoveryCodeRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockReplaceDone() &&
+		m.MinimockUseDone()
+}
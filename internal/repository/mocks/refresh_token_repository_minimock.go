@@ -0,0 +1,1723 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.13). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i di_container/internal/repository.RefreshTokenRepository -o refresh_token_repository_minimock.go -n RefreshTokenRepositoryMock -p mocks
+
+import (
+	"context"
+	"di_container/internal/model"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// RefreshTokenRepositoryMock implements repository.RefreshTokenRepository
+type RefreshTokenRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreate          func(ctx context.Context, token *model.RefreshToken) (err error)
+	inspectFuncCreate   func(ctx context.Context, token *model.RefreshToken)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mRefreshTokenRepositoryMockCreate
+
+	funcGet          func(ctx context.Context, id string) (rp1 *model.RefreshToken, err error)
+	inspectFuncGet   func(ctx context.Context, id string)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mRefreshTokenRepositoryMockGet
+
+	funcMarkRotated          func(ctx context.Context, id string) (err error)
+	inspectFuncMarkRotated   func(ctx context.Context, id string)
+	afterMarkRotatedCounter  uint64
+	beforeMarkRotatedCounter uint64
+	MarkRotatedMock          mRefreshTokenRepositoryMockMarkRotated
+
+	funcRevokeByUsername          func(ctx context.Context, username string) (err error)
+	inspectFuncRevokeByUsername   func(ctx context.Context, username string)
+	afterRevokeByUsernameCounter  uint64
+	beforeRevokeByUsernameCounter uint64
+	RevokeByUsernameMock          mRefreshTokenRepositoryMockRevokeByUsername
+
+	funcRevokeFamily          func(ctx context.Context, familyID string) (err error)
+	inspectFuncRevokeFamily   func(ctx context.Context, familyID string)
+	afterRevokeFamilyCounter  uint64
+	beforeRevokeFamilyCounter uint64
+	RevokeFamilyMock          mRefreshTokenRepositoryMockRevokeFamily
+}
+
+// NewRefreshTokenRepositoryMock returns a mock for repository.RefreshTokenRepository
+func NewRefreshTokenRepositoryMock(t minimock.Tester) *RefreshTokenRepositoryMock {
+	m := &RefreshTokenRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateMock = mRefreshTokenRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*RefreshTokenRepositoryMockCreateParams{}
+
+	m.GetMock = mRefreshTokenRepositoryMockGet{mock: m}
+	m.GetMock.callArgs = []*RefreshTokenRepositoryMockGetParams{}
+
+	m.MarkRotatedMock = mRefreshTokenRepositoryMockMarkRotated{mock: m}
+	m.MarkRotatedMock.callArgs = []*RefreshTokenRepositoryMockMarkRotatedParams{}
+
+	m.RevokeByUsernameMock = mRefreshTokenRepositoryMockRevokeByUsername{mock: m}
+	m.RevokeByUsernameMock.callArgs = []*RefreshTokenRepositoryMockRevokeByUsernameParams{}
+
+	m.RevokeFamilyMock = mRefreshTokenRepositoryMockRevokeFamily{mock: m}
+	m.RevokeFamilyMock.callArgs = []*RefreshTokenRepositoryMockRevokeFamilyParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRefreshTokenRepositoryMockCreate struct {
+	optional           bool
+	mock               *RefreshTokenRepositoryMock
+	defaultExpectation *RefreshTokenRepositoryMockCreateExpectation
+	expectations       []*RefreshTokenRepositoryMockCreateExpectation
+
+	callArgs []*RefreshTokenRepositoryMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RefreshTokenRepositoryMockCreateExpectation specifies expectation struct of the RefreshTokenRepository.Create
+type RefreshTokenRepositoryMockCreateExpectation struct {
+	mock      *RefreshTokenRepositoryMock
+	params    *RefreshTokenRepositoryMockCreateParams
+	paramPtrs *RefreshTokenRepositoryMockCreateParamPtrs
+	results   *RefreshTokenRepositoryMockCreateResults
+	Counter   uint64
+}
+
+// RefreshTokenRepositoryMockCreateParams contains parameters of the RefreshTokenRepository.Create
+type RefreshTokenRepositoryMockCreateParams struct {
+	ctx   context.Context
+	token *model.RefreshToken
+}
+
+// RefreshTokenRepositoryMockCreateParamPtrs contains pointers to parameters of the RefreshTokenRepository.Create
+type RefreshTokenRepositoryMockCreateParamPtrs struct {
+	ctx   *context.Context
+	token **model.RefreshToken
+}
+
+// RefreshTokenRepositoryMockCreateResults contains results of the RefreshTokenRepository.Create
+type RefreshTokenRepositoryMockCreateResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mRefreshTokenRepositoryMockCreate) Optional() *mRefreshTokenRepositoryMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for RefreshTokenRepository.Create
+func (mmCreate *mRefreshTokenRepositoryMockCreate) Expect(ctx context.Context, token *model.RefreshToken) *mRefreshTokenRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RefreshTokenRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &RefreshTokenRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("RefreshTokenRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &RefreshTokenRepositoryMockCreateParams{ctx, token}
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RefreshTokenRepository.Create
+func (mmCreate *mRefreshTokenRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mRefreshTokenRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RefreshTokenRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &RefreshTokenRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("RefreshTokenRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &RefreshTokenRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreate
+}
+
+// ExpectTokenParam2 sets up expected param token for RefreshTokenRepository.Create
+func (mmCreate *mRefreshTokenRepositoryMockCreate) ExpectTokenParam2(token *model.RefreshToken) *mRefreshTokenRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RefreshTokenRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &RefreshTokenRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("RefreshTokenRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &RefreshTokenRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.token = &token
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the RefreshTokenRepository.Create
+func (mmCreate *mRefreshTokenRepositoryMockCreate) Inspect(f func(ctx context.Context, token *model.RefreshToken)) *mRefreshTokenRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for RefreshTokenRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by RefreshTokenRepository.Create
+func (mmCreate *mRefreshTokenRepositoryMockCreate) Return(err error) *RefreshTokenRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RefreshTokenRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &RefreshTokenRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &RefreshTokenRepositoryMockCreateResults{err}
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the RefreshTokenRepository.Create method
+func (mmCreate *mRefreshTokenRepositoryMockCreate) Set(f func(ctx context.Context, token *model.RefreshToken) (err error)) *RefreshTokenRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the RefreshTokenRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the RefreshTokenRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	return mmCreate.mock
+}
+
+// When sets expectation for the RefreshTokenRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mRefreshTokenRepositoryMockCreate) When(ctx context.Context, token *model.RefreshToken) *RefreshTokenRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RefreshTokenRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &RefreshTokenRepositoryMockCreateExpectation{
+		mock:   mmCreate.mock,
+		params: &RefreshTokenRepositoryMockCreateParams{ctx, token},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RefreshTokenRepository.Create return parameters for the expectation previously defined by the When method
+func (e *RefreshTokenRepositoryMockCreateExpectation) Then(err error) *RefreshTokenRepositoryMock {
+	e.results = &RefreshTokenRepositoryMockCreateResults{err}
+	return e.mock
+}
+
+// Times sets number of times RefreshTokenRepository.Create should be invoked
+func (mmCreate *mRefreshTokenRepositoryMockCreate) Times(n uint64) *mRefreshTokenRepositoryMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of RefreshTokenRepositoryMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	return mmCreate
+}
+
+func (mmCreate *mRefreshTokenRepositoryMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements repository.RefreshTokenRepository
+func (mmCreate *RefreshTokenRepositoryMock) Create(ctx context.Context, token *model.RefreshToken) (err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, token)
+	}
+
+	mm_params := RefreshTokenRepositoryMockCreateParams{ctx, token}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := RefreshTokenRepositoryMockCreateParams{ctx, token}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("RefreshTokenRepositoryMock.Create got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.token != nil && !minimock.Equal(*mm_want_ptrs.token, mm_got.token) {
+				mmCreate.t.Errorf("RefreshTokenRepositoryMock.Create got unexpected parameter token, want: %#v, got: %#v%s\n", *mm_want_ptrs.token, mm_got.token, minimock.Diff(*mm_want_ptrs.token, mm_got.token))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("RefreshTokenRepositoryMock.Create got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the RefreshTokenRepositoryMock.Create")
+		}
+		return (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, token)
+	}
+	mmCreate.t.Fatalf("Unexpected call to RefreshTokenRepositoryMock.Create. %v %v", ctx, token)
+	return
+}
+
+// CreateAfterCounter returns a count of finished RefreshTokenRepositoryMock.Create invocations
+func (mmCreate *RefreshTokenRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of RefreshTokenRepositoryMock.Create invocations
+func (mmCreate *RefreshTokenRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to RefreshTokenRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mRefreshTokenRepositoryMockCreate) Calls() []*RefreshTokenRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*RefreshTokenRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *RefreshTokenRepositoryMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *RefreshTokenRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RefreshTokenRepositoryMock.Create with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RefreshTokenRepositoryMock.Create")
+		} else {
+			m.t.Errorf("Expected call to RefreshTokenRepositoryMock.Create with params: %#v", *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Error("Expected call to RefreshTokenRepositoryMock.Create")
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to RefreshTokenRepositoryMock.Create but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), afterCreateCounter)
+	}
+}
+
+type mRefreshTokenRepositoryMockGet struct {
+	optional           bool
+	mock               *RefreshTokenRepositoryMock
+	defaultExpectation *RefreshTokenRepositoryMockGetExpectation
+	expectations       []*RefreshTokenRepositoryMockGetExpectation
+
+	callArgs []*RefreshTokenRepositoryMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RefreshTokenRepositoryMockGetExpectation specifies expectation struct of the RefreshTokenRepository.Get
+type RefreshTokenRepositoryMockGetExpectation struct {
+	mock      *RefreshTokenRepositoryMock
+	params    *RefreshTokenRepositoryMockGetParams
+	paramPtrs *RefreshTokenRepositoryMockGetParamPtrs
+	results   *RefreshTokenRepositoryMockGetResults
+	Counter   uint64
+}
+
+// RefreshTokenRepositoryMockGetParams contains parameters of the RefreshTokenRepository.Get
+type RefreshTokenRepositoryMockGetParams struct {
+	ctx context.Context
+	id  string
+}
+
+// RefreshTokenRepositoryMockGetParamPtrs contains pointers to parameters of the RefreshTokenRepository.Get
+type RefreshTokenRepositoryMockGetParamPtrs struct {
+	ctx *context.Context
+	id  *string
+}
+
+// RefreshTokenRepositoryMockGetResults contains results of the RefreshTokenRepository.Get
+type RefreshTokenRepositoryMockGetResults struct {
+	rp1 *model.RefreshToken
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mRefreshTokenRepositoryMockGet) Optional() *mRefreshTokenRepositoryMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for RefreshTokenRepository.Get
+func (mmGet *mRefreshTokenRepositoryMockGet) Expect(ctx context.Context, id string) *mRefreshTokenRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RefreshTokenRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &RefreshTokenRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("RefreshTokenRepositoryMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &RefreshTokenRepositoryMockGetParams{ctx, id}
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RefreshTokenRepository.Get
+func (mmGet *mRefreshTokenRepositoryMockGet) ExpectCtxParam1(ctx context.Context) *mRefreshTokenRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RefreshTokenRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &RefreshTokenRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("RefreshTokenRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &RefreshTokenRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGet
+}
+
+// ExpectIdParam2 sets up expected param id for RefreshTokenRepository.Get
+func (mmGet *mRefreshTokenRepositoryMockGet) ExpectIdParam2(id string) *mRefreshTokenRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RefreshTokenRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &RefreshTokenRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("RefreshTokenRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &RefreshTokenRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.id = &id
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the RefreshTokenRepository.Get
+func (mmGet *mRefreshTokenRepositoryMockGet) Inspect(f func(ctx context.Context, id string)) *mRefreshTokenRepositoryMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for RefreshTokenRepositoryMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by RefreshTokenRepository.Get
+func (mmGet *mRefreshTokenRepositoryMockGet) Return(rp1 *model.RefreshToken, err error) *RefreshTokenRepositoryMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RefreshTokenRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &RefreshTokenRepositoryMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &RefreshTokenRepositoryMockGetResults{rp1, err}
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the RefreshTokenRepository.Get method
+func (mmGet *mRefreshTokenRepositoryMockGet) Set(f func(ctx context.Context, id string) (rp1 *model.RefreshToken, err error)) *RefreshTokenRepositoryMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the RefreshTokenRepository.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the RefreshTokenRepository.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	return mmGet.mock
+}
+
+// When sets expectation for the RefreshTokenRepository.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mRefreshTokenRepositoryMockGet) When(ctx context.Context, id string) *RefreshTokenRepositoryMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RefreshTokenRepositoryMock.Get mock is already set by Set")
+	}
+
+	expectation := &RefreshTokenRepositoryMockGetExpectation{
+		mock:   mmGet.mock,
+		params: &RefreshTokenRepositoryMockGetParams{ctx, id},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RefreshTokenRepository.Get return parameters for the expectation previously defined by the When method
+func (e *RefreshTokenRepositoryMockGetExpectation) Then(rp1 *model.RefreshToken, err error) *RefreshTokenRepositoryMock {
+	e.results = &RefreshTokenRepositoryMockGetResults{rp1, err}
+	return e.mock
+}
+
+// Times sets number of times RefreshTokenRepository.Get should be invoked
+func (mmGet *mRefreshTokenRepositoryMockGet) Times(n uint64) *mRefreshTokenRepositoryMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of RefreshTokenRepositoryMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	return mmGet
+}
+
+func (mmGet *mRefreshTokenRepositoryMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements repository.RefreshTokenRepository
+func (mmGet *RefreshTokenRepositoryMock) Get(ctx context.Context, id string) (rp1 *model.RefreshToken, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, id)
+	}
+
+	mm_params := RefreshTokenRepositoryMockGetParams{ctx, id}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rp1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := RefreshTokenRepositoryMockGetParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("RefreshTokenRepositoryMock.Get got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGet.t.Errorf("RefreshTokenRepositoryMock.Get got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("RefreshTokenRepositoryMock.Get got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the RefreshTokenRepositoryMock.Get")
+		}
+		return (*mm_results).rp1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, id)
+	}
+	mmGet.t.Fatalf("Unexpected call to RefreshTokenRepositoryMock.Get. %v %v", ctx, id)
+	return
+}
+
+// GetAfterCounter returns a count of finished RefreshTokenRepositoryMock.Get invocations
+func (mmGet *RefreshTokenRepositoryMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of RefreshTokenRepositoryMock.Get invocations
+func (mmGet *RefreshTokenRepositoryMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to RefreshTokenRepositoryMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mRefreshTokenRepositoryMockGet) Calls() []*RefreshTokenRepositoryMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*RefreshTokenRepositoryMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *RefreshTokenRepositoryMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *RefreshTokenRepositoryMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RefreshTokenRepositoryMock.Get with params: %#v", *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RefreshTokenRepositoryMock.Get")
+		} else {
+			m.t.Errorf("Expected call to RefreshTokenRepositoryMock.Get with params: %#v", *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Error("Expected call to RefreshTokenRepositoryMock.Get")
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to RefreshTokenRepositoryMock.Get but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), afterGetCounter)
+	}
+}
+
+type mRefreshTokenRepositoryMockMarkRotated struct {
+	optional           bool
+	mock               *RefreshTokenRepositoryMock
+	defaultExpectation *RefreshTokenRepositoryMockMarkRotatedExpectation
+	expectations       []*RefreshTokenRepositoryMockMarkRotatedExpectation
+
+	callArgs []*RefreshTokenRepositoryMockMarkRotatedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RefreshTokenRepositoryMockMarkRotatedExpectation specifies expectation struct of the RefreshTokenRepository.MarkRotated
+type RefreshTokenRepositoryMockMarkRotatedExpectation struct {
+	mock      *RefreshTokenRepositoryMock
+	params    *RefreshTokenRepositoryMockMarkRotatedParams
+	paramPtrs *RefreshTokenRepositoryMockMarkRotatedParamPtrs
+	results   *RefreshTokenRepositoryMockMarkRotatedResults
+	Counter   uint64
+}
+
+// RefreshTokenRepositoryMockMarkRotatedParams contains parameters of the RefreshTokenRepository.MarkRotated
+type RefreshTokenRepositoryMockMarkRotatedParams struct {
+	ctx context.Context
+	id  string
+}
+
+// RefreshTokenRepositoryMockMarkRotatedParamPtrs contains pointers to parameters of the RefreshTokenRepository.MarkRotated
+type RefreshTokenRepositoryMockMarkRotatedParamPtrs struct {
+	ctx *context.Context
+	id  *string
+}
+
+// RefreshTokenRepositoryMockMarkRotatedResults contains results of the RefreshTokenRepository.MarkRotated
+type RefreshTokenRepositoryMockMarkRotatedResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkRotated *mRefreshTokenRepositoryMockMarkRotated) Optional() *mRefreshTokenRepositoryMockMarkRotated {
+	mmMarkRotated.optional = true
+	return mmMarkRotated
+}
+
+// Expect sets up expected params for RefreshTokenRepository.MarkRotated
+func (mmMarkRotated *mRefreshTokenRepositoryMockMarkRotated) Expect(ctx context.Context, id string) *mRefreshTokenRepositoryMockMarkRotated {
+	if mmMarkRotated.mock.funcMarkRotated != nil {
+		mmMarkRotated.mock.t.Fatalf("RefreshTokenRepositoryMock.MarkRotated mock is already set by Set")
+	}
+
+	if mmMarkRotated.defaultExpectation == nil {
+		mmMarkRotated.defaultExpectation = &RefreshTokenRepositoryMockMarkRotatedExpectation{}
+	}
+
+	if mmMarkRotated.defaultExpectation.paramPtrs != nil {
+		mmMarkRotated.mock.t.Fatalf("RefreshTokenRepositoryMock.MarkRotated mock is already set by ExpectParams functions")
+	}
+
+	mmMarkRotated.defaultExpectation.params = &RefreshTokenRepositoryMockMarkRotatedParams{ctx, id}
+	for _, e := range mmMarkRotated.expectations {
+		if minimock.Equal(e.params, mmMarkRotated.defaultExpectation.params) {
+			mmMarkRotated.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkRotated.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkRotated
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RefreshTokenRepository.MarkRotated
+func (mmMarkRotated *mRefreshTokenRepositoryMockMarkRotated) ExpectCtxParam1(ctx context.Context) *mRefreshTokenRepositoryMockMarkRotated {
+	if mmMarkRotated.mock.funcMarkRotated != nil {
+		mmMarkRotated.mock.t.Fatalf("RefreshTokenRepositoryMock.MarkRotated mock is already set by Set")
+	}
+
+	if mmMarkRotated.defaultExpectation == nil {
+		mmMarkRotated.defaultExpectation = &RefreshTokenRepositoryMockMarkRotatedExpectation{}
+	}
+
+	if mmMarkRotated.defaultExpectation.params != nil {
+		mmMarkRotated.mock.t.Fatalf("RefreshTokenRepositoryMock.MarkRotated mock is already set by Expect")
+	}
+
+	if mmMarkRotated.defaultExpectation.paramPtrs == nil {
+		mmMarkRotated.defaultExpectation.paramPtrs = &RefreshTokenRepositoryMockMarkRotatedParamPtrs{}
+	}
+	mmMarkRotated.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmMarkRotated
+}
+
+// ExpectIdParam2 sets up expected param id for RefreshTokenRepository.MarkRotated
+func (mmMarkRotated *mRefreshTokenRepositoryMockMarkRotated) ExpectIdParam2(id string) *mRefreshTokenRepositoryMockMarkRotated {
+	if mmMarkRotated.mock.funcMarkRotated != nil {
+		mmMarkRotated.mock.t.Fatalf("RefreshTokenRepositoryMock.MarkRotated mock is already set by Set")
+	}
+
+	if mmMarkRotated.defaultExpectation == nil {
+		mmMarkRotated.defaultExpectation = &RefreshTokenRepositoryMockMarkRotatedExpectation{}
+	}
+
+	if mmMarkRotated.defaultExpectation.params != nil {
+		mmMarkRotated.mock.t.Fatalf("RefreshTokenRepositoryMock.MarkRotated mock is already set by Expect")
+	}
+
+	if mmMarkRotated.defaultExpectation.paramPtrs == nil {
+		mmMarkRotated.defaultExpectation.paramPtrs = &RefreshTokenRepositoryMockMarkRotatedParamPtrs{}
+	}
+	mmMarkRotated.defaultExpectation.paramPtrs.id = &id
+
+	return mmMarkRotated
+}
+
+// Inspect accepts an inspector function that has same arguments as the RefreshTokenRepository.MarkRotated
+func (mmMarkRotated *mRefreshTokenRepositoryMockMarkRotated) Inspect(f func(ctx context.Context, id string)) *mRefreshTokenRepositoryMockMarkRotated {
+	if mmMarkRotated.mock.inspectFuncMarkRotated != nil {
+		mmMarkRotated.mock.t.Fatalf("Inspect function is already set for RefreshTokenRepositoryMock.MarkRotated")
+	}
+
+	mmMarkRotated.mock.inspectFuncMarkRotated = f
+
+	return mmMarkRotated
+}
+
+// Return sets up results that will be returned by RefreshTokenRepository.MarkRotated
+func (mmMarkRotated *mRefreshTokenRepositoryMockMarkRotated) Return(err error) *RefreshTokenRepositoryMock {
+	if mmMarkRotated.mock.funcMarkRotated != nil {
+		mmMarkRotated.mock.t.Fatalf("RefreshTokenRepositoryMock.MarkRotated mock is already set by Set")
+	}
+
+	if mmMarkRotated.defaultExpectation == nil {
+		mmMarkRotated.defaultExpectation = &RefreshTokenRepositoryMockMarkRotatedExpectation{mock: mmMarkRotated.mock}
+	}
+	mmMarkRotated.defaultExpectation.results = &RefreshTokenRepositoryMockMarkRotatedResults{err}
+	return mmMarkRotated.mock
+}
+
+// Set uses given function f to mock the RefreshTokenRepository.MarkRotated method
+func (mmMarkRotated *mRefreshTokenRepositoryMockMarkRotated) Set(f func(ctx context.Context, id string) (err error)) *RefreshTokenRepositoryMock {
+	if mmMarkRotated.defaultExpectation != nil {
+		mmMarkRotated.mock.t.Fatalf("Default expectation is already set for the RefreshTokenRepository.MarkRotated method")
+	}
+
+	if len(mmMarkRotated.expectations) > 0 {
+		mmMarkRotated.mock.t.Fatalf("Some expectations are already set for the RefreshTokenRepository.MarkRotated method")
+	}
+
+	mmMarkRotated.mock.funcMarkRotated = f
+	return mmMarkRotated.mock
+}
+
+// When sets expectation for the RefreshTokenRepository.MarkRotated which will trigger the result defined by the following
+// Then helper
+func (mmMarkRotated *mRefreshTokenRepositoryMockMarkRotated) When(ctx context.Context, id string) *RefreshTokenRepositoryMockMarkRotatedExpectation {
+	if mmMarkRotated.mock.funcMarkRotated != nil {
+		mmMarkRotated.mock.t.Fatalf("RefreshTokenRepositoryMock.MarkRotated mock is already set by Set")
+	}
+
+	expectation := &RefreshTokenRepositoryMockMarkRotatedExpectation{
+		mock:   mmMarkRotated.mock,
+		params: &RefreshTokenRepositoryMockMarkRotatedParams{ctx, id},
+	}
+	mmMarkRotated.expectations = append(mmMarkRotated.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RefreshTokenRepository.MarkRotated return parameters for the expectation previously defined by the When method
+func (e *RefreshTokenRepositoryMockMarkRotatedExpectation) Then(err error) *RefreshTokenRepositoryMock {
+	e.results = &RefreshTokenRepositoryMockMarkRotatedResults{err}
+	return e.mock
+}
+
+// Times sets number of times RefreshTokenRepository.MarkRotated should be invoked
+func (mmMarkRotated *mRefreshTokenRepositoryMockMarkRotated) Times(n uint64) *mRefreshTokenRepositoryMockMarkRotated {
+	if n == 0 {
+		mmMarkRotated.mock.t.Fatalf("Times of RefreshTokenRepositoryMock.MarkRotated mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkRotated.expectedInvocations, n)
+	return mmMarkRotated
+}
+
+func (mmMarkRotated *mRefreshTokenRepositoryMockMarkRotated) invocationsDone() bool {
+	if len(mmMarkRotated.expectations) == 0 && mmMarkRotated.defaultExpectation == nil && mmMarkRotated.mock.funcMarkRotated == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkRotated.mock.afterMarkRotatedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkRotated.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkRotated implements repository.RefreshTokenRepository
+func (mmMarkRotated *RefreshTokenRepositoryMock) MarkRotated(ctx context.Context, id string) (err error) {
+	mm_atomic.AddUint64(&mmMarkRotated.beforeMarkRotatedCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkRotated.afterMarkRotatedCounter, 1)
+
+	if mmMarkRotated.inspectFuncMarkRotated != nil {
+		mmMarkRotated.inspectFuncMarkRotated(ctx, id)
+	}
+
+	mm_params := RefreshTokenRepositoryMockMarkRotatedParams{ctx, id}
+
+	// Record call args
+	mmMarkRotated.MarkRotatedMock.mutex.Lock()
+	mmMarkRotated.MarkRotatedMock.callArgs = append(mmMarkRotated.MarkRotatedMock.callArgs, &mm_params)
+	mmMarkRotated.MarkRotatedMock.mutex.Unlock()
+
+	for _, e := range mmMarkRotated.MarkRotatedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMarkRotated.MarkRotatedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkRotated.MarkRotatedMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkRotated.MarkRotatedMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkRotated.MarkRotatedMock.defaultExpectation.paramPtrs
+
+		mm_got := RefreshTokenRepositoryMockMarkRotatedParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkRotated.t.Errorf("RefreshTokenRepositoryMock.MarkRotated got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmMarkRotated.t.Errorf("RefreshTokenRepositoryMock.MarkRotated got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkRotated.t.Errorf("RefreshTokenRepositoryMock.MarkRotated got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkRotated.MarkRotatedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkRotated.t.Fatal("No results are set for the RefreshTokenRepositoryMock.MarkRotated")
+		}
+		return (*mm_results).err
+	}
+	if mmMarkRotated.funcMarkRotated != nil {
+		return mmMarkRotated.funcMarkRotated(ctx, id)
+	}
+	mmMarkRotated.t.Fatalf("Unexpected call to RefreshTokenRepositoryMock.MarkRotated. %v %v", ctx, id)
+	return
+}
+
+// MarkRotatedAfterCounter returns a count of finished RefreshTokenRepositoryMock.MarkRotated invocations
+func (mmMarkRotated *RefreshTokenRepositoryMock) MarkRotatedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkRotated.afterMarkRotatedCounter)
+}
+
+// MarkRotatedBeforeCounter returns a count of RefreshTokenRepositoryMock.MarkRotated invocations
+func (mmMarkRotated *RefreshTokenRepositoryMock) MarkRotatedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkRotated.beforeMarkRotatedCounter)
+}
+
+// Calls returns a list of arguments used in each call to RefreshTokenRepositoryMock.MarkRotated.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkRotated *mRefreshTokenRepositoryMockMarkRotated) Calls() []*RefreshTokenRepositoryMockMarkRotatedParams {
+	mmMarkRotated.mutex.RLock()
+
+	argCopy := make([]*RefreshTokenRepositoryMockMarkRotatedParams, len(mmMarkRotated.callArgs))
+	copy(argCopy, mmMarkRotated.callArgs)
+
+	mmMarkRotated.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkRotatedDone returns true if the count of the MarkRotated invocations corresponds
+// the number of defined expectations
+func (m *RefreshTokenRepositoryMock) MinimockMarkRotatedDone() bool {
+	if m.MarkRotatedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkRotatedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkRotatedMock.invocationsDone()
+}
+
+// MinimockMarkRotatedInspect logs each unmet expectation
+func (m *RefreshTokenRepositoryMock) MinimockMarkRotatedInspect() {
+	for _, e := range m.MarkRotatedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RefreshTokenRepositoryMock.MarkRotated with params: %#v", *e.params)
+		}
+	}
+
+	afterMarkRotatedCounter := mm_atomic.LoadUint64(&m.afterMarkRotatedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkRotatedMock.defaultExpectation != nil && afterMarkRotatedCounter < 1 {
+		if m.MarkRotatedMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RefreshTokenRepositoryMock.MarkRotated")
+		} else {
+			m.t.Errorf("Expected call to RefreshTokenRepositoryMock.MarkRotated with params: %#v", *m.MarkRotatedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkRotated != nil && afterMarkRotatedCounter < 1 {
+		m.t.Error("Expected call to RefreshTokenRepositoryMock.MarkRotated")
+	}
+
+	if !m.MarkRotatedMock.invocationsDone() && afterMarkRotatedCounter > 0 {
+		m.t.Errorf("Expected %d calls to RefreshTokenRepositoryMock.MarkRotated but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkRotatedMock.expectedInvocations), afterMarkRotatedCounter)
+	}
+}
+
+type mRefreshTokenRepositoryMockRevokeByUsername struct {
+	optional           bool
+	mock               *RefreshTokenRepositoryMock
+	defaultExpectation *RefreshTokenRepositoryMockRevokeByUsernameExpectation
+	expectations       []*RefreshTokenRepositoryMockRevokeByUsernameExpectation
+
+	callArgs []*RefreshTokenRepositoryMockRevokeByUsernameParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RefreshTokenRepositoryMockRevokeByUsernameExpectation specifies expectation struct of the RefreshTokenRepository.RevokeByUsername
+type RefreshTokenRepositoryMockRevokeByUsernameExpectation struct {
+	mock      *RefreshTokenRepositoryMock
+	params    *RefreshTokenRepositoryMockRevokeByUsernameParams
+	paramPtrs *RefreshTokenRepositoryMockRevokeByUsernameParamPtrs
+	results   *RefreshTokenRepositoryMockRevokeByUsernameResults
+	Counter   uint64
+}
+
+// RefreshTokenRepositoryMockRevokeByUsernameParams contains parameters of the RefreshTokenRepository.RevokeByUsername
+type RefreshTokenRepositoryMockRevokeByUsernameParams struct {
+	ctx      context.Context
+	username string
+}
+
+// RefreshTokenRepositoryMockRevokeByUsernameParamPtrs contains pointers to parameters of the RefreshTokenRepository.RevokeByUsername
+type RefreshTokenRepositoryMockRevokeByUsernameParamPtrs struct {
+	ctx      *context.Context
+	username *string
+}
+
+// RefreshTokenRepositoryMockRevokeByUsernameResults contains results of the RefreshTokenRepository.RevokeByUsername
+type RefreshTokenRepositoryMockRevokeByUsernameResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRevokeByUsername *mRefreshTokenRepositoryMockRevokeByUsername) Optional() *mRefreshTokenRepositoryMockRevokeByUsername {
+	mmRevokeByUsername.optional = true
+	return mmRevokeByUsername
+}
+
+// Expect sets up expected params for RefreshTokenRepository.RevokeByUsername
+func (mmRevokeByUsername *mRefreshTokenRepositoryMockRevokeByUsername) Expect(ctx context.Context, username string) *mRefreshTokenRepositoryMockRevokeByUsername {
+	if mmRevokeByUsername.mock.funcRevokeByUsername != nil {
+		mmRevokeByUsername.mock.t.Fatalf("RefreshTokenRepositoryMock.RevokeByUsername mock is already set by Set")
+	}
+
+	if mmRevokeByUsername.defaultExpectation == nil {
+		mmRevokeByUsername.defaultExpectation = &RefreshTokenRepositoryMockRevokeByUsernameExpectation{}
+	}
+
+	if mmRevokeByUsername.defaultExpectation.paramPtrs != nil {
+		mmRevokeByUsername.mock.t.Fatalf("RefreshTokenRepositoryMock.RevokeByUsername mock is already set by ExpectParams functions")
+	}
+
+	mmRevokeByUsername.defaultExpectation.params = &RefreshTokenRepositoryMockRevokeByUsernameParams{ctx, username}
+	for _, e := range mmRevokeByUsername.expectations {
+		if minimock.Equal(e.params, mmRevokeByUsername.defaultExpectation.params) {
+			mmRevokeByUsername.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevokeByUsername.defaultExpectation.params)
+		}
+	}
+
+	return mmRevokeByUsername
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RefreshTokenRepository.RevokeByUsername
+func (mmRevokeByUsername *mRefreshTokenRepositoryMockRevokeByUsername) ExpectCtxParam1(ctx context.Context) *mRefreshTokenRepositoryMockRevokeByUsername {
+	if mmRevokeByUsername.mock.funcRevokeByUsername != nil {
+		mmRevokeByUsername.mock.t.Fatalf("RefreshTokenRepositoryMock.RevokeByUsername mock is already set by Set")
+	}
+
+	if mmRevokeByUsername.defaultExpectation == nil {
+		mmRevokeByUsername.defaultExpectation = &RefreshTokenRepositoryMockRevokeByUsernameExpectation{}
+	}
+
+	if mmRevokeByUsername.defaultExpectation.params != nil {
+		mmRevokeByUsername.mock.t.Fatalf("RefreshTokenRepositoryMock.RevokeByUsername mock is already set by Expect")
+	}
+
+	if mmRevokeByUsername.defaultExpectation.paramPtrs == nil {
+		mmRevokeByUsername.defaultExpectation.paramPtrs = &RefreshTokenRepositoryMockRevokeByUsernameParamPtrs{}
+	}
+	mmRevokeByUsername.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRevokeByUsername
+}
+
+// ExpectUsernameParam2 sets up expected param username for RefreshTokenRepository.RevokeByUsername
+func (mmRevokeByUsername *mRefreshTokenRepositoryMockRevokeByUsername) ExpectUsernameParam2(username string) *mRefreshTokenRepositoryMockRevokeByUsername {
+	if mmRevokeByUsername.mock.funcRevokeByUsername != nil {
+		mmRevokeByUsername.mock.t.Fatalf("RefreshTokenRepositoryMock.RevokeByUsername mock is already set by Set")
+	}
+
+	if mmRevokeByUsername.defaultExpectation == nil {
+		mmRevokeByUsername.defaultExpectation = &RefreshTokenRepositoryMockRevokeByUsernameExpectation{}
+	}
+
+	if mmRevokeByUsername.defaultExpectation.params != nil {
+		mmRevokeByUsername.mock.t.Fatalf("RefreshTokenRepositoryMock.RevokeByUsername mock is already set by Expect")
+	}
+
+	if mmRevokeByUsername.defaultExpectation.paramPtrs == nil {
+		mmRevokeByUsername.defaultExpectation.paramPtrs = &RefreshTokenRepositoryMockRevokeByUsernameParamPtrs{}
+	}
+	mmRevokeByUsername.defaultExpectation.paramPtrs.username = &username
+
+	return mmRevokeByUsername
+}
+
+// Inspect accepts an inspector function that has same arguments as the RefreshTokenRepository.RevokeByUsername
+func (mmRevokeByUsername *mRefreshTokenRepositoryMockRevokeByUsername) Inspect(f func(ctx context.Context, username string)) *mRefreshTokenRepositoryMockRevokeByUsername {
+	if mmRevokeByUsername.mock.inspectFuncRevokeByUsername != nil {
+		mmRevokeByUsername.mock.t.Fatalf("Inspect function is already set for RefreshTokenRepositoryMock.RevokeByUsername")
+	}
+
+	mmRevokeByUsername.mock.inspectFuncRevokeByUsername = f
+
+	return mmRevokeByUsername
+}
+
+// Return sets up results that will be returned by RefreshTokenRepository.RevokeByUsername
+func (mmRevokeByUsername *mRefreshTokenRepositoryMockRevokeByUsername) Return(err error) *RefreshTokenRepositoryMock {
+	if mmRevokeByUsername.mock.funcRevokeByUsername != nil {
+		mmRevokeByUsername.mock.t.Fatalf("RefreshTokenRepositoryMock.RevokeByUsername mock is already set by Set")
+	}
+
+	if mmRevokeByUsername.defaultExpectation == nil {
+		mmRevokeByUsername.defaultExpectation = &RefreshTokenRepositoryMockRevokeByUsernameExpectation{mock: mmRevokeByUsername.mock}
+	}
+	mmRevokeByUsername.defaultExpectation.results = &RefreshTokenRepositoryMockRevokeByUsernameResults{err}
+	return mmRevokeByUsername.mock
+}
+
+// Set uses given function f to mock the RefreshTokenRepository.RevokeByUsername method
+func (mmRevokeByUsername *mRefreshTokenRepositoryMockRevokeByUsername) Set(f func(ctx context.Context, username string) (err error)) *RefreshTokenRepositoryMock {
+	if mmRevokeByUsername.defaultExpectation != nil {
+		mmRevokeByUsername.mock.t.Fatalf("Default expectation is already set for the RefreshTokenRepository.RevokeByUsername method")
+	}
+
+	if len(mmRevokeByUsername.expectations) > 0 {
+		mmRevokeByUsername.mock.t.Fatalf("Some expectations are already set for the RefreshTokenRepository.RevokeByUsername method")
+	}
+
+	mmRevokeByUsername.mock.funcRevokeByUsername = f
+	return mmRevokeByUsername.mock
+}
+
+// When sets expectation for the RefreshTokenRepository.RevokeByUsername which will trigger the result defined by the following
+// Then helper
+func (mmRevokeByUsername *mRefreshTokenRepositoryMockRevokeByUsername) When(ctx context.Context, username string) *RefreshTokenRepositoryMockRevokeByUsernameExpectation {
+	if mmRevokeByUsername.mock.funcRevokeByUsername != nil {
+		mmRevokeByUsername.mock.t.Fatalf("RefreshTokenRepositoryMock.RevokeByUsername mock is already set by Set")
+	}
+
+	expectation := &RefreshTokenRepositoryMockRevokeByUsernameExpectation{
+		mock:   mmRevokeByUsername.mock,
+		params: &RefreshTokenRepositoryMockRevokeByUsernameParams{ctx, username},
+	}
+	mmRevokeByUsername.expectations = append(mmRevokeByUsername.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RefreshTokenRepository.RevokeByUsername return parameters for the expectation previously defined by the When method
+func (e *RefreshTokenRepositoryMockRevokeByUsernameExpectation) Then(err error) *RefreshTokenRepositoryMock {
+	e.results = &RefreshTokenRepositoryMockRevokeByUsernameResults{err}
+	return e.mock
+}
+
+// Times sets number of times RefreshTokenRepository.RevokeByUsername should be invoked
+func (mmRevokeByUsername *mRefreshTokenRepositoryMockRevokeByUsername) Times(n uint64) *mRefreshTokenRepositoryMockRevokeByUsername {
+	if n == 0 {
+		mmRevokeByUsername.mock.t.Fatalf("Times of RefreshTokenRepositoryMock.RevokeByUsername mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRevokeByUsername.expectedInvocations, n)
+	return mmRevokeByUsername
+}
+
+func (mmRevokeByUsername *mRefreshTokenRepositoryMockRevokeByUsername) invocationsDone() bool {
+	if len(mmRevokeByUsername.expectations) == 0 && mmRevokeByUsername.defaultExpectation == nil && mmRevokeByUsername.mock.funcRevokeByUsername == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRevokeByUsername.mock.afterRevokeByUsernameCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevokeByUsername.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RevokeByUsername implements repository.RefreshTokenRepository
+func (mmRevokeByUsername *RefreshTokenRepositoryMock) RevokeByUsername(ctx context.Context, username string) (err error) {
+	mm_atomic.AddUint64(&mmRevokeByUsername.beforeRevokeByUsernameCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevokeByUsername.afterRevokeByUsernameCounter, 1)
+
+	if mmRevokeByUsername.inspectFuncRevokeByUsername != nil {
+		mmRevokeByUsername.inspectFuncRevokeByUsername(ctx, username)
+	}
+
+	mm_params := RefreshTokenRepositoryMockRevokeByUsernameParams{ctx, username}
+
+	// Record call args
+	mmRevokeByUsername.RevokeByUsernameMock.mutex.Lock()
+	mmRevokeByUsername.RevokeByUsernameMock.callArgs = append(mmRevokeByUsername.RevokeByUsernameMock.callArgs, &mm_params)
+	mmRevokeByUsername.RevokeByUsernameMock.mutex.Unlock()
+
+	for _, e := range mmRevokeByUsername.RevokeByUsernameMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRevokeByUsername.RevokeByUsernameMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevokeByUsername.RevokeByUsernameMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevokeByUsername.RevokeByUsernameMock.defaultExpectation.params
+		mm_want_ptrs := mmRevokeByUsername.RevokeByUsernameMock.defaultExpectation.paramPtrs
+
+		mm_got := RefreshTokenRepositoryMockRevokeByUsernameParams{ctx, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevokeByUsername.t.Errorf("RefreshTokenRepositoryMock.RevokeByUsername got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmRevokeByUsername.t.Errorf("RefreshTokenRepositoryMock.RevokeByUsername got unexpected parameter username, want: %#v, got: %#v%s\n", *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevokeByUsername.t.Errorf("RefreshTokenRepositoryMock.RevokeByUsername got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevokeByUsername.RevokeByUsernameMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevokeByUsername.t.Fatal("No results are set for the RefreshTokenRepositoryMock.RevokeByUsername")
+		}
+		return (*mm_results).err
+	}
+	if mmRevokeByUsername.funcRevokeByUsername != nil {
+		return mmRevokeByUsername.funcRevokeByUsername(ctx, username)
+	}
+	mmRevokeByUsername.t.Fatalf("Unexpected call to RefreshTokenRepositoryMock.RevokeByUsername. %v %v", ctx, username)
+	return
+}
+
+// RevokeByUsernameAfterCounter returns a count of finished RefreshTokenRepositoryMock.RevokeByUsername invocations
+func (mmRevokeByUsername *RefreshTokenRepositoryMock) RevokeByUsernameAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeByUsername.afterRevokeByUsernameCounter)
+}
+
+// RevokeByUsernameBeforeCounter returns a count of RefreshTokenRepositoryMock.RevokeByUsername invocations
+func (mmRevokeByUsername *RefreshTokenRepositoryMock) RevokeByUsernameBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeByUsername.beforeRevokeByUsernameCounter)
+}
+
+// Calls returns a list of arguments used in each call to RefreshTokenRepositoryMock.RevokeByUsername.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevokeByUsername *mRefreshTokenRepositoryMockRevokeByUsername) Calls() []*RefreshTokenRepositoryMockRevokeByUsernameParams {
+	mmRevokeByUsername.mutex.RLock()
+
+	argCopy := make([]*RefreshTokenRepositoryMockRevokeByUsernameParams, len(mmRevokeByUsername.callArgs))
+	copy(argCopy, mmRevokeByUsername.callArgs)
+
+	mmRevokeByUsername.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevokeByUsernameDone returns true if the count of the RevokeByUsername invocations corresponds
+// the number of defined expectations
+func (m *RefreshTokenRepositoryMock) MinimockRevokeByUsernameDone() bool {
+	if m.RevokeByUsernameMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RevokeByUsernameMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RevokeByUsernameMock.invocationsDone()
+}
+
+// MinimockRevokeByUsernameInspect logs each unmet expectation
+func (m *RefreshTokenRepositoryMock) MinimockRevokeByUsernameInspect() {
+	for _, e := range m.RevokeByUsernameMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RefreshTokenRepositoryMock.RevokeByUsername with params: %#v", *e.params)
+		}
+	}
+
+	afterRevokeByUsernameCounter := mm_atomic.LoadUint64(&m.afterRevokeByUsernameCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokeByUsernameMock.defaultExpectation != nil && afterRevokeByUsernameCounter < 1 {
+		if m.RevokeByUsernameMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RefreshTokenRepositoryMock.RevokeByUsername")
+		} else {
+			m.t.Errorf("Expected call to RefreshTokenRepositoryMock.RevokeByUsername with params: %#v", *m.RevokeByUsernameMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevokeByUsername != nil && afterRevokeByUsernameCounter < 1 {
+		m.t.Error("Expected call to RefreshTokenRepositoryMock.RevokeByUsername")
+	}
+
+	if !m.RevokeByUsernameMock.invocationsDone() && afterRevokeByUsernameCounter > 0 {
+		m.t.Errorf("Expected %d calls to RefreshTokenRepositoryMock.RevokeByUsername but found %d calls",
+			mm_atomic.LoadUint64(&m.RevokeByUsernameMock.expectedInvocations), afterRevokeByUsernameCounter)
+	}
+}
+
+type mRefreshTokenRepositoryMockRevokeFamily struct {
+	optional           bool
+	mock               *RefreshTokenRepositoryMock
+	defaultExpectation *RefreshTokenRepositoryMockRevokeFamilyExpectation
+	expectations       []*RefreshTokenRepositoryMockRevokeFamilyExpectation
+
+	callArgs []*RefreshTokenRepositoryMockRevokeFamilyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RefreshTokenRepositoryMockRevokeFamilyExpectation specifies expectation struct of the RefreshTokenRepository.RevokeFamily
+type RefreshTokenRepositoryMockRevokeFamilyExpectation struct {
+	mock      *RefreshTokenRepositoryMock
+	params    *RefreshTokenRepositoryMockRevokeFamilyParams
+	paramPtrs *RefreshTokenRepositoryMockRevokeFamilyParamPtrs
+	results   *RefreshTokenRepositoryMockRevokeFamilyResults
+	Counter   uint64
+}
+
+// RefreshTokenRepositoryMockRevokeFamilyParams contains parameters of the RefreshTokenRepository.RevokeFamily
+type RefreshTokenRepositoryMockRevokeFamilyParams struct {
+	ctx      context.Context
+	familyID string
+}
+
+// RefreshTokenRepositoryMockRevokeFamilyParamPtrs contains pointers to parameters of the RefreshTokenRepository.RevokeFamily
+type RefreshTokenRepositoryMockRevokeFamilyParamPtrs struct {
+	ctx      *context.Context
+	familyID *string
+}
+
+// RefreshTokenRepositoryMockRevokeFamilyResults contains results of the RefreshTokenRepository.RevokeFamily
+type RefreshTokenRepositoryMockRevokeFamilyResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRevokeFamily *mRefreshTokenRepositoryMockRevokeFamily) Optional() *mRefreshTokenRepositoryMockRevokeFamily {
+	mmRevokeFamily.optional = true
+	return mmRevokeFamily
+}
+
+// Expect sets up expected params for RefreshTokenRepository.RevokeFamily
+func (mmRevokeFamily *mRefreshTokenRepositoryMockRevokeFamily) Expect(ctx context.Context, familyID string) *mRefreshTokenRepositoryMockRevokeFamily {
+	if mmRevokeFamily.mock.funcRevokeFamily != nil {
+		mmRevokeFamily.mock.t.Fatalf("RefreshTokenRepositoryMock.RevokeFamily mock is already set by Set")
+	}
+
+	if mmRevokeFamily.defaultExpectation == nil {
+		mmRevokeFamily.defaultExpectation = &RefreshTokenRepositoryMockRevokeFamilyExpectation{}
+	}
+
+	if mmRevokeFamily.defaultExpectation.paramPtrs != nil {
+		mmRevokeFamily.mock.t.Fatalf("RefreshTokenRepositoryMock.RevokeFamily mock is already set by ExpectParams functions")
+	}
+
+	mmRevokeFamily.defaultExpectation.params = &RefreshTokenRepositoryMockRevokeFamilyParams{ctx, familyID}
+	for _, e := range mmRevokeFamily.expectations {
+		if minimock.Equal(e.params, mmRevokeFamily.defaultExpectation.params) {
+			mmRevokeFamily.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevokeFamily.defaultExpectation.params)
+		}
+	}
+
+	return mmRevokeFamily
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RefreshTokenRepository.RevokeFamily
+func (mmRevokeFamily *mRefreshTokenRepositoryMockRevokeFamily) ExpectCtxParam1(ctx context.Context) *mRefreshTokenRepositoryMockRevokeFamily {
+	if mmRevokeFamily.mock.funcRevokeFamily != nil {
+		mmRevokeFamily.mock.t.Fatalf("RefreshTokenRepositoryMock.RevokeFamily mock is already set by Set")
+	}
+
+	if mmRevokeFamily.defaultExpectation == nil {
+		mmRevokeFamily.defaultExpectation = &RefreshTokenRepositoryMockRevokeFamilyExpectation{}
+	}
+
+	if mmRevokeFamily.defaultExpectation.params != nil {
+		mmRevokeFamily.mock.t.Fatalf("RefreshTokenRepositoryMock.RevokeFamily mock is already set by Expect")
+	}
+
+	if mmRevokeFamily.defaultExpectation.paramPtrs == nil {
+		mmRevokeFamily.defaultExpectation.paramPtrs = &RefreshTokenRepositoryMockRevokeFamilyParamPtrs{}
+	}
+	mmRevokeFamily.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRevokeFamily
+}
+
+// ExpectFamilyIDParam2 sets up expected param familyID for RefreshTokenRepository.RevokeFamily
+func (mmRevokeFamily *mRefreshTokenRepositoryMockRevokeFamily) ExpectFamilyIDParam2(familyID string) *mRefreshTokenRepositoryMockRevokeFamily {
+	if mmRevokeFamily.mock.funcRevokeFamily != nil {
+		mmRevokeFamily.mock.t.Fatalf("RefreshTokenRepositoryMock.RevokeFamily mock is already set by Set")
+	}
+
+	if mmRevokeFamily.defaultExpectation == nil {
+		mmRevokeFamily.defaultExpectation = &RefreshTokenRepositoryMockRevokeFamilyExpectation{}
+	}
+
+	if mmRevokeFamily.defaultExpectation.params != nil {
+		mmRevokeFamily.mock.t.Fatalf("RefreshTokenRepositoryMock.RevokeFamily mock is already set by Expect")
+	}
+
+	if mmRevokeFamily.defaultExpectation.paramPtrs == nil {
+		mmRevokeFamily.defaultExpectation.paramPtrs = &RefreshTokenRepositoryMockRevokeFamilyParamPtrs{}
+	}
+	mmRevokeFamily.defaultExpectation.paramPtrs.familyID = &familyID
+
+	return mmRevokeFamily
+}
+
+// Inspect accepts an inspector function that has same arguments as the RefreshTokenRepository.RevokeFamily
+func (mmRevokeFamily *mRefreshTokenRepositoryMockRevokeFamily) Inspect(f func(ctx context.Context, familyID string)) *mRefreshTokenRepositoryMockRevokeFamily {
+	if mmRevokeFamily.mock.inspectFuncRevokeFamily != nil {
+		mmRevokeFamily.mock.t.Fatalf("Inspect function is already set for RefreshTokenRepositoryMock.RevokeFamily")
+	}
+
+	mmRevokeFamily.mock.inspectFuncRevokeFamily = f
+
+	return mmRevokeFamily
+}
+
+// Return sets up results that will be returned by RefreshTokenRepository.RevokeFamily
+func (mmRevokeFamily *mRefreshTokenRepositoryMockRevokeFamily) Return(err error) *RefreshTokenRepositoryMock {
+	if mmRevokeFamily.mock.funcRevokeFamily != nil {
+		mmRevokeFamily.mock.t.Fatalf("RefreshTokenRepositoryMock.RevokeFamily mock is already set by Set")
+	}
+
+	if mmRevokeFamily.defaultExpectation == nil {
+		mmRevokeFamily.defaultExpectation = &RefreshTokenRepositoryMockRevokeFamilyExpectation{mock: mmRevokeFamily.mock}
+	}
+	mmRevokeFamily.defaultExpectation.results = &RefreshTokenRepositoryMockRevokeFamilyResults{err}
+	return mmRevokeFamily.mock
+}
+
+// Set uses given function f to mock the RefreshTokenRepository.RevokeFamily method
+func (mmRevokeFamily *mRefreshTokenRepositoryMockRevokeFamily) Set(f func(ctx context.Context, familyID string) (err error)) *RefreshTokenRepositoryMock {
+	if mmRevokeFamily.defaultExpectation != nil {
+		mmRevokeFamily.mock.t.Fatalf("Default expectation is already set for the RefreshTokenRepository.RevokeFamily method")
+	}
+
+	if len(mmRevokeFamily.expectations) > 0 {
+		mmRevokeFamily.mock.t.Fatalf("Some expectations are already set for the RefreshTokenRepository.RevokeFamily method")
+	}
+
+	mmRevokeFamily.mock.funcRevokeFamily = f
+	return mmRevokeFamily.mock
+}
+
+// When sets expectation for the RefreshTokenRepository.RevokeFamily which will trigger the result defined by the following
+// Then helper
+func (mmRevokeFamily *mRefreshTokenRepositoryMockRevokeFamily) When(ctx context.Context, familyID string) *RefreshTokenRepositoryMockRevokeFamilyExpectation {
+	if mmRevokeFamily.mock.funcRevokeFamily != nil {
+		mmRevokeFamily.mock.t.Fatalf("RefreshTokenRepositoryMock.RevokeFamily mock is already set by Set")
+	}
+
+	expectation := &RefreshTokenRepositoryMockRevokeFamilyExpectation{
+		mock:   mmRevokeFamily.mock,
+		params: &RefreshTokenRepositoryMockRevokeFamilyParams{ctx, familyID},
+	}
+	mmRevokeFamily.expectations = append(mmRevokeFamily.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RefreshTokenRepository.RevokeFamily return parameters for the expectation previously defined by the When method
+func (e *RefreshTokenRepositoryMockRevokeFamilyExpectation) Then(err error) *RefreshTokenRepositoryMock {
+	e.results = &RefreshTokenRepositoryMockRevokeFamilyResults{err}
+	return e.mock
+}
+
+// Times sets number of times RefreshTokenRepository.RevokeFamily should be invoked
+func (mmRevokeFamily *mRefreshTokenRepositoryMockRevokeFamily) Times(n uint64) *mRefreshTokenRepositoryMockRevokeFamily {
+	if n == 0 {
+		mmRevokeFamily.mock.t.Fatalf("Times of RefreshTokenRepositoryMock.RevokeFamily mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRevokeFamily.expectedInvocations, n)
+	return mmRevokeFamily
+}
+
+func (mmRevokeFamily *mRefreshTokenRepositoryMockRevokeFamily) invocationsDone() bool {
+	if len(mmRevokeFamily.expectations) == 0 && mmRevokeFamily.defaultExpectation == nil && mmRevokeFamily.mock.funcRevokeFamily == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRevokeFamily.mock.afterRevokeFamilyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevokeFamily.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RevokeFamily implements repository.RefreshTokenRepository
+func (mmRevokeFamily *RefreshTokenRepositoryMock) RevokeFamily(ctx context.Context, familyID string) (err error) {
+	mm_atomic.AddUint64(&mmRevokeFamily.beforeRevokeFamilyCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevokeFamily.afterRevokeFamilyCounter, 1)
+
+	if mmRevokeFamily.inspectFuncRevokeFamily != nil {
+		mmRevokeFamily.inspectFuncRevokeFamily(ctx, familyID)
+	}
+
+	mm_params := RefreshTokenRepositoryMockRevokeFamilyParams{ctx, familyID}
+
+	// Record call args
+	mmRevokeFamily.RevokeFamilyMock.mutex.Lock()
+	mmRevokeFamily.RevokeFamilyMock.callArgs = append(mmRevokeFamily.RevokeFamilyMock.callArgs, &mm_params)
+	mmRevokeFamily.RevokeFamilyMock.mutex.Unlock()
+
+	for _, e := range mmRevokeFamily.RevokeFamilyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRevokeFamily.RevokeFamilyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevokeFamily.RevokeFamilyMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevokeFamily.RevokeFamilyMock.defaultExpectation.params
+		mm_want_ptrs := mmRevokeFamily.RevokeFamilyMock.defaultExpectation.paramPtrs
+
+		mm_got := RefreshTokenRepositoryMockRevokeFamilyParams{ctx, familyID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevokeFamily.t.Errorf("RefreshTokenRepositoryMock.RevokeFamily got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.familyID != nil && !minimock.Equal(*mm_want_ptrs.familyID, mm_got.familyID) {
+				mmRevokeFamily.t.Errorf("RefreshTokenRepositoryMock.RevokeFamily got unexpected parameter familyID, want: %#v, got: %#v%s\n", *mm_want_ptrs.familyID, mm_got.familyID, minimock.Diff(*mm_want_ptrs.familyID, mm_got.familyID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevokeFamily.t.Errorf("RefreshTokenRepositoryMock.RevokeFamily got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevokeFamily.RevokeFamilyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevokeFamily.t.Fatal("No results are set for the RefreshTokenRepositoryMock.RevokeFamily")
+		}
+		return (*mm_results).err
+	}
+	if mmRevokeFamily.funcRevokeFamily != nil {
+		return mmRevokeFamily.funcRevokeFamily(ctx, familyID)
+	}
+	mmRevokeFamily.t.Fatalf("Unexpected call to RefreshTokenRepositoryMock.RevokeFamily. %v %v", ctx, familyID)
+	return
+}
+
+// RevokeFamilyAfterCounter returns a count of finished RefreshTokenRepositoryMock.RevokeFamily invocations
+func (mmRevokeFamily *RefreshTokenRepositoryMock) RevokeFamilyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeFamily.afterRevokeFamilyCounter)
+}
+
+// RevokeFamilyBeforeCounter returns a count of RefreshTokenRepositoryMock.RevokeFamily invocations
+func (mmRevokeFamily *RefreshTokenRepositoryMock) RevokeFamilyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeFamily.beforeRevokeFamilyCounter)
+}
+
+// Calls returns a list of arguments used in each call to RefreshTokenRepositoryMock.RevokeFamily.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevokeFamily *mRefreshTokenRepositoryMockRevokeFamily) Calls() []*RefreshTokenRepositoryMockRevokeFamilyParams {
+	mmRevokeFamily.mutex.RLock()
+
+	argCopy := make([]*RefreshTokenRepositoryMockRevokeFamilyParams, len(mmRevokeFamily.callArgs))
+	copy(argCopy, mmRevokeFamily.callArgs)
+
+	mmRevokeFamily.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevokeFamilyDone returns true if the count of the RevokeFamily invocations corresponds
+// the number of defined expectations
+func (m *RefreshTokenRepositoryMock) MinimockRevokeFamilyDone() bool {
+	if m.RevokeFamilyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RevokeFamilyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RevokeFamilyMock.invocationsDone()
+}
+
+// MinimockRevokeFamilyInspect logs each unmet expectation
+func (m *RefreshTokenRepositoryMock) MinimockRevokeFamilyInspect() {
+	for _, e := range m.RevokeFamilyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RefreshTokenRepositoryMock.RevokeFamily with params: %#v", *e.params)
+		}
+	}
+
+	afterRevokeFamilyCounter := mm_atomic.LoadUint64(&m.afterRevokeFamilyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokeFamilyMock.defaultExpectation != nil && afterRevokeFamilyCounter < 1 {
+		if m.RevokeFamilyMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RefreshTokenRepositoryMock.RevokeFamily")
+		} else {
+			m.t.Errorf("Expected call to RefreshTokenRepositoryMock.RevokeFamily with params: %#v", *m.RevokeFamilyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevokeFamily != nil && afterRevokeFamilyCounter < 1 {
+		m.t.Error("Expected call to RefreshTokenRepositoryMock.RevokeFamily")
+	}
+
+	if !m.RevokeFamilyMock.invocationsDone() && afterRevokeFamilyCounter > 0 {
+		m.t.Errorf("Expected %d calls to RefreshTokenRepositoryMock.RevokeFamily but found %d calls",
+			mm_atomic.LoadUint64(&m.RevokeFamilyMock.expectedInvocations), afterRevokeFamilyCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RefreshTokenRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateInspect()
+
+			m.MinimockGetInspect()
+
+			m.MinimockMarkRotatedInspect()
+
+			m.MinimockRevokeByUsernameInspect()
+
+			m.MinimockRevokeFamilyInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RefreshTokenRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RefreshTokenRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateDone() &&
+		m.MinimockGetDone() &&
+		m.MinimockMarkRotatedDone() &&
+		m.MinimockRevokeByUsernameDone() &&
+		m.MinimockRevokeFamilyDone()
+}
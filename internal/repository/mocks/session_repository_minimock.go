@@ -0,0 +1,2085 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.13). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i di_container/internal/repository.SessionRepository -o session_repository_minimock.go -n SessionRepositoryMock -p mocks
+
+import (
+	"context"
+	"di_container/internal/model"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// SessionRepositoryMock implements repository.SessionRepository
+type SessionRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreate          func(ctx context.Context, session *model.Session) (err error)
+	inspectFuncCreate   func(ctx context.Context, session *model.Session)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mSessionRepositoryMockCreate
+
+	funcGet          func(ctx context.Context, id string) (sp1 *model.Session, err error)
+	inspectFuncGet   func(ctx context.Context, id string)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mSessionRepositoryMockGet
+
+	funcListActive          func(ctx context.Context, username string) (spa1 []*model.Session, err error)
+	inspectFuncListActive   func(ctx context.Context, username string)
+	afterListActiveCounter  uint64
+	beforeListActiveCounter uint64
+	ListActiveMock          mSessionRepositoryMockListActive
+
+	funcRevoke          func(ctx context.Context, id string) (err error)
+	inspectFuncRevoke   func(ctx context.Context, id string)
+	afterRevokeCounter  uint64
+	beforeRevokeCounter uint64
+	RevokeMock          mSessionRepositoryMockRevoke
+
+	funcRevokeByUsername          func(ctx context.Context, username string) (err error)
+	inspectFuncRevokeByUsername   func(ctx context.Context, username string)
+	afterRevokeByUsernameCounter  uint64
+	beforeRevokeByUsernameCounter uint64
+	RevokeByUsernameMock          mSessionRepositoryMockRevokeByUsername
+
+	funcTouch          func(ctx context.Context, id string, expiresAt time.Time) (err error)
+	inspectFuncTouch   func(ctx context.Context, id string, expiresAt time.Time)
+	afterTouchCounter  uint64
+	beforeTouchCounter uint64
+	TouchMock          mSessionRepositoryMockTouch
+}
+
+// NewSessionRepositoryMock returns a mock for repository.SessionRepository
+func NewSessionRepositoryMock(t minimock.Tester) *SessionRepositoryMock {
+	m := &SessionRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateMock = mSessionRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*SessionRepositoryMockCreateParams{}
+
+	m.GetMock = mSessionRepositoryMockGet{mock: m}
+	m.GetMock.callArgs = []*SessionRepositoryMockGetParams{}
+
+	m.ListActiveMock = mSessionRepositoryMockListActive{mock: m}
+	m.ListActiveMock.callArgs = []*SessionRepositoryMockListActiveParams{}
+
+	m.RevokeMock = mSessionRepositoryMockRevoke{mock: m}
+	m.RevokeMock.callArgs = []*SessionRepositoryMockRevokeParams{}
+
+	m.RevokeByUsernameMock = mSessionRepositoryMockRevokeByUsername{mock: m}
+	m.RevokeByUsernameMock.callArgs = []*SessionRepositoryMockRevokeByUsernameParams{}
+
+	m.TouchMock = mSessionRepositoryMockTouch{mock: m}
+	m.TouchMock.callArgs = []*SessionRepositoryMockTouchParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mSessionRepositoryMockCreate struct {
+	optional           bool
+	mock               *SessionRepositoryMock
+	defaultExpectation *SessionRepositoryMockCreateExpectation
+	expectations       []*SessionRepositoryMockCreateExpectation
+
+	callArgs []*SessionRepositoryMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// SessionRepositoryMockCreateExpectation specifies expectation struct of the SessionRepository.Create
+type SessionRepositoryMockCreateExpectation struct {
+	mock      *SessionRepositoryMock
+	params    *SessionRepositoryMockCreateParams
+	paramPtrs *SessionRepositoryMockCreateParamPtrs
+	results   *SessionRepositoryMockCreateResults
+	Counter   uint64
+}
+
+// SessionRepositoryMockCreateParams contains parameters of the SessionRepository.Create
+type SessionRepositoryMockCreateParams struct {
+	ctx     context.Context
+	session *model.Session
+}
+
+// SessionRepositoryMockCreateParamPtrs contains pointers to parameters of the SessionRepository.Create
+type SessionRepositoryMockCreateParamPtrs struct {
+	ctx     *context.Context
+	session **model.Session
+}
+
+// SessionRepositoryMockCreateResults contains results of the SessionRepository.Create
+type SessionRepositoryMockCreateResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mSessionRepositoryMockCreate) Optional() *mSessionRepositoryMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for SessionRepository.Create
+func (mmCreate *mSessionRepositoryMockCreate) Expect(ctx context.Context, session *model.Session) *mSessionRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("SessionRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &SessionRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("SessionRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &SessionRepositoryMockCreateParams{ctx, session}
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for SessionRepository.Create
+func (mmCreate *mSessionRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mSessionRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("SessionRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &SessionRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("SessionRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &SessionRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreate
+}
+
+// ExpectSessionParam2 sets up expected param session for SessionRepository.Create
+func (mmCreate *mSessionRepositoryMockCreate) ExpectSessionParam2(session *model.Session) *mSessionRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("SessionRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &SessionRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("SessionRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &SessionRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.session = &session
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the SessionRepository.Create
+func (mmCreate *mSessionRepositoryMockCreate) Inspect(f func(ctx context.Context, session *model.Session)) *mSessionRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for SessionRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by SessionRepository.Create
+func (mmCreate *mSessionRepositoryMockCreate) Return(err error) *SessionRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("SessionRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &SessionRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &SessionRepositoryMockCreateResults{err}
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the SessionRepository.Create method
+func (mmCreate *mSessionRepositoryMockCreate) Set(f func(ctx context.Context, session *model.Session) (err error)) *SessionRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the SessionRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the SessionRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	return mmCreate.mock
+}
+
+// When sets expectation for the SessionRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mSessionRepositoryMockCreate) When(ctx context.Context, session *model.Session) *SessionRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("SessionRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &SessionRepositoryMockCreateExpectation{
+		mock:   mmCreate.mock,
+		params: &SessionRepositoryMockCreateParams{ctx, session},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up SessionRepository.Create return parameters for the expectation previously defined by the When method
+func (e *SessionRepositoryMockCreateExpectation) Then(err error) *SessionRepositoryMock {
+	e.results = &SessionRepositoryMockCreateResults{err}
+	return e.mock
+}
+
+// Times sets number of times SessionRepository.Create should be invoked
+func (mmCreate *mSessionRepositoryMockCreate) Times(n uint64) *mSessionRepositoryMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of SessionRepositoryMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	return mmCreate
+}
+
+func (mmCreate *mSessionRepositoryMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements repository.SessionRepository
+func (mmCreate *SessionRepositoryMock) Create(ctx context.Context, session *model.Session) (err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, session)
+	}
+
+	mm_params := SessionRepositoryMockCreateParams{ctx, session}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := SessionRepositoryMockCreateParams{ctx, session}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("SessionRepositoryMock.Create got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.session != nil && !minimock.Equal(*mm_want_ptrs.session, mm_got.session) {
+				mmCreate.t.Errorf("SessionRepositoryMock.Create got unexpected parameter session, want: %#v, got: %#v%s\n", *mm_want_ptrs.session, mm_got.session, minimock.Diff(*mm_want_ptrs.session, mm_got.session))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("SessionRepositoryMock.Create got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the SessionRepositoryMock.Create")
+		}
+		return (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, session)
+	}
+	mmCreate.t.Fatalf("Unexpected call to SessionRepositoryMock.Create. %v %v", ctx, session)
+	return
+}
+
+// CreateAfterCounter returns a count of finished SessionRepositoryMock.Create invocations
+func (mmCreate *SessionRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of SessionRepositoryMock.Create invocations
+func (mmCreate *SessionRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to SessionRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mSessionRepositoryMockCreate) Calls() []*SessionRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*SessionRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *SessionRepositoryMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *SessionRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SessionRepositoryMock.Create with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to SessionRepositoryMock.Create")
+		} else {
+			m.t.Errorf("Expected call to SessionRepositoryMock.Create with params: %#v", *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Error("Expected call to SessionRepositoryMock.Create")
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to SessionRepositoryMock.Create but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), afterCreateCounter)
+	}
+}
+
+type mSessionRepositoryMockGet struct {
+	optional           bool
+	mock               *SessionRepositoryMock
+	defaultExpectation *SessionRepositoryMockGetExpectation
+	expectations       []*SessionRepositoryMockGetExpectation
+
+	callArgs []*SessionRepositoryMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// SessionRepositoryMockGetExpectation specifies expectation struct of the SessionRepository.Get
+type SessionRepositoryMockGetExpectation struct {
+	mock      *SessionRepositoryMock
+	params    *SessionRepositoryMockGetParams
+	paramPtrs *SessionRepositoryMockGetParamPtrs
+	results   *SessionRepositoryMockGetResults
+	Counter   uint64
+}
+
+// SessionRepositoryMockGetParams contains parameters of the SessionRepository.Get
+type SessionRepositoryMockGetParams struct {
+	ctx context.Context
+	id  string
+}
+
+// SessionRepositoryMockGetParamPtrs contains pointers to parameters of the SessionRepository.Get
+type SessionRepositoryMockGetParamPtrs struct {
+	ctx *context.Context
+	id  *string
+}
+
+// SessionRepositoryMockGetResults contains results of the SessionRepository.Get
+type SessionRepositoryMockGetResults struct {
+	sp1 *model.Session
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mSessionRepositoryMockGet) Optional() *mSessionRepositoryMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for SessionRepository.Get
+func (mmGet *mSessionRepositoryMockGet) Expect(ctx context.Context, id string) *mSessionRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("SessionRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &SessionRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("SessionRepositoryMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &SessionRepositoryMockGetParams{ctx, id}
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for SessionRepository.Get
+func (mmGet *mSessionRepositoryMockGet) ExpectCtxParam1(ctx context.Context) *mSessionRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("SessionRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &SessionRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("SessionRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &SessionRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGet
+}
+
+// ExpectIdParam2 sets up expected param id for SessionRepository.Get
+func (mmGet *mSessionRepositoryMockGet) ExpectIdParam2(id string) *mSessionRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("SessionRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &SessionRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("SessionRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &SessionRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.id = &id
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the SessionRepository.Get
+func (mmGet *mSessionRepositoryMockGet) Inspect(f func(ctx context.Context, id string)) *mSessionRepositoryMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for SessionRepositoryMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by SessionRepository.Get
+func (mmGet *mSessionRepositoryMockGet) Return(sp1 *model.Session, err error) *SessionRepositoryMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("SessionRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &SessionRepositoryMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &SessionRepositoryMockGetResults{sp1, err}
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the SessionRepository.Get method
+func (mmGet *mSessionRepositoryMockGet) Set(f func(ctx context.Context, id string) (sp1 *model.Session, err error)) *SessionRepositoryMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the SessionRepository.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the SessionRepository.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	return mmGet.mock
+}
+
+// When sets expectation for the SessionRepository.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mSessionRepositoryMockGet) When(ctx context.Context, id string) *SessionRepositoryMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("SessionRepositoryMock.Get mock is already set by Set")
+	}
+
+	expectation := &SessionRepositoryMockGetExpectation{
+		mock:   mmGet.mock,
+		params: &SessionRepositoryMockGetParams{ctx, id},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up SessionRepository.Get return parameters for the expectation previously defined by the When method
+func (e *SessionRepositoryMockGetExpectation) Then(sp1 *model.Session, err error) *SessionRepositoryMock {
+	e.results = &SessionRepositoryMockGetResults{sp1, err}
+	return e.mock
+}
+
+// Times sets number of times SessionRepository.Get should be invoked
+func (mmGet *mSessionRepositoryMockGet) Times(n uint64) *mSessionRepositoryMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of SessionRepositoryMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	return mmGet
+}
+
+func (mmGet *mSessionRepositoryMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements repository.SessionRepository
+func (mmGet *SessionRepositoryMock) Get(ctx context.Context, id string) (sp1 *model.Session, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, id)
+	}
+
+	mm_params := SessionRepositoryMockGetParams{ctx, id}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sp1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := SessionRepositoryMockGetParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("SessionRepositoryMock.Get got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGet.t.Errorf("SessionRepositoryMock.Get got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("SessionRepositoryMock.Get got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the SessionRepositoryMock.Get")
+		}
+		return (*mm_results).sp1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, id)
+	}
+	mmGet.t.Fatalf("Unexpected call to SessionRepositoryMock.Get. %v %v", ctx, id)
+	return
+}
+
+// GetAfterCounter returns a count of finished SessionRepositoryMock.Get invocations
+func (mmGet *SessionRepositoryMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of SessionRepositoryMock.Get invocations
+func (mmGet *SessionRepositoryMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to SessionRepositoryMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mSessionRepositoryMockGet) Calls() []*SessionRepositoryMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*SessionRepositoryMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *SessionRepositoryMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *SessionRepositoryMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SessionRepositoryMock.Get with params: %#v", *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to SessionRepositoryMock.Get")
+		} else {
+			m.t.Errorf("Expected call to SessionRepositoryMock.Get with params: %#v", *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Error("Expected call to SessionRepositoryMock.Get")
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to SessionRepositoryMock.Get but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), afterGetCounter)
+	}
+}
+
+type mSessionRepositoryMockListActive struct {
+	optional           bool
+	mock               *SessionRepositoryMock
+	defaultExpectation *SessionRepositoryMockListActiveExpectation
+	expectations       []*SessionRepositoryMockListActiveExpectation
+
+	callArgs []*SessionRepositoryMockListActiveParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// SessionRepositoryMockListActiveExpectation specifies expectation struct of the SessionRepository.ListActive
+type SessionRepositoryMockListActiveExpectation struct {
+	mock      *SessionRepositoryMock
+	params    *SessionRepositoryMockListActiveParams
+	paramPtrs *SessionRepositoryMockListActiveParamPtrs
+	results   *SessionRepositoryMockListActiveResults
+	Counter   uint64
+}
+
+// SessionRepositoryMockListActiveParams contains parameters of the SessionRepository.ListActive
+type SessionRepositoryMockListActiveParams struct {
+	ctx      context.Context
+	username string
+}
+
+// SessionRepositoryMockListActiveParamPtrs contains pointers to parameters of the SessionRepository.ListActive
+type SessionRepositoryMockListActiveParamPtrs struct {
+	ctx      *context.Context
+	username *string
+}
+
+// SessionRepositoryMockListActiveResults contains results of the SessionRepository.ListActive
+type SessionRepositoryMockListActiveResults struct {
+	spa1 []*model.Session
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListActive *mSessionRepositoryMockListActive) Optional() *mSessionRepositoryMockListActive {
+	mmListActive.optional = true
+	return mmListActive
+}
+
+// Expect sets up expected params for SessionRepository.ListActive
+func (mmListActive *mSessionRepositoryMockListActive) Expect(ctx context.Context, username string) *mSessionRepositoryMockListActive {
+	if mmListActive.mock.funcListActive != nil {
+		mmListActive.mock.t.Fatalf("SessionRepositoryMock.ListActive mock is already set by Set")
+	}
+
+	if mmListActive.defaultExpectation == nil {
+		mmListActive.defaultExpectation = &SessionRepositoryMockListActiveExpectation{}
+	}
+
+	if mmListActive.defaultExpectation.paramPtrs != nil {
+		mmListActive.mock.t.Fatalf("SessionRepositoryMock.ListActive mock is already set by ExpectParams functions")
+	}
+
+	mmListActive.defaultExpectation.params = &SessionRepositoryMockListActiveParams{ctx, username}
+	for _, e := range mmListActive.expectations {
+		if minimock.Equal(e.params, mmListActive.defaultExpectation.params) {
+			mmListActive.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListActive.defaultExpectation.params)
+		}
+	}
+
+	return mmListActive
+}
+
+// ExpectCtxParam1 sets up expected param ctx for SessionRepository.ListActive
+func (mmListActive *mSessionRepositoryMockListActive) ExpectCtxParam1(ctx context.Context) *mSessionRepositoryMockListActive {
+	if mmListActive.mock.funcListActive != nil {
+		mmListActive.mock.t.Fatalf("SessionRepositoryMock.ListActive mock is already set by Set")
+	}
+
+	if mmListActive.defaultExpectation == nil {
+		mmListActive.defaultExpectation = &SessionRepositoryMockListActiveExpectation{}
+	}
+
+	if mmListActive.defaultExpectation.params != nil {
+		mmListActive.mock.t.Fatalf("SessionRepositoryMock.ListActive mock is already set by Expect")
+	}
+
+	if mmListActive.defaultExpectation.paramPtrs == nil {
+		mmListActive.defaultExpectation.paramPtrs = &SessionRepositoryMockListActiveParamPtrs{}
+	}
+	mmListActive.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListActive
+}
+
+// ExpectUsernameParam2 sets up expected param username for SessionRepository.ListActive
+func (mmListActive *mSessionRepositoryMockListActive) ExpectUsernameParam2(username string) *mSessionRepositoryMockListActive {
+	if mmListActive.mock.funcListActive != nil {
+		mmListActive.mock.t.Fatalf("SessionRepositoryMock.ListActive mock is already set by Set")
+	}
+
+	if mmListActive.defaultExpectation == nil {
+		mmListActive.defaultExpectation = &SessionRepositoryMockListActiveExpectation{}
+	}
+
+	if mmListActive.defaultExpectation.params != nil {
+		mmListActive.mock.t.Fatalf("SessionRepositoryMock.ListActive mock is already set by Expect")
+	}
+
+	if mmListActive.defaultExpectation.paramPtrs == nil {
+		mmListActive.defaultExpectation.paramPtrs = &SessionRepositoryMockListActiveParamPtrs{}
+	}
+	mmListActive.defaultExpectation.paramPtrs.username = &username
+
+	return mmListActive
+}
+
+// Inspect accepts an inspector function that has same arguments as the SessionRepository.ListActive
+func (mmListActive *mSessionRepositoryMockListActive) Inspect(f func(ctx context.Context, username string)) *mSessionRepositoryMockListActive {
+	if mmListActive.mock.inspectFuncListActive != nil {
+		mmListActive.mock.t.Fatalf("Inspect function is already set for SessionRepositoryMock.ListActive")
+	}
+
+	mmListActive.mock.inspectFuncListActive = f
+
+	return mmListActive
+}
+
+// Return sets up results that will be returned by SessionRepository.ListActive
+func (mmListActive *mSessionRepositoryMockListActive) Return(spa1 []*model.Session, err error) *SessionRepositoryMock {
+	if mmListActive.mock.funcListActive != nil {
+		mmListActive.mock.t.Fatalf("SessionRepositoryMock.ListActive mock is already set by Set")
+	}
+
+	if mmListActive.defaultExpectation == nil {
+		mmListActive.defaultExpectation = &SessionRepositoryMockListActiveExpectation{mock: mmListActive.mock}
+	}
+	mmListActive.defaultExpectation.results = &SessionRepositoryMockListActiveResults{spa1, err}
+	return mmListActive.mock
+}
+
+// Set uses given function f to mock the SessionRepository.ListActive method
+func (mmListActive *mSessionRepositoryMockListActive) Set(f func(ctx context.Context, username string) (spa1 []*model.Session, err error)) *SessionRepositoryMock {
+	if mmListActive.defaultExpectation != nil {
+		mmListActive.mock.t.Fatalf("Default expectation is already set for the SessionRepository.ListActive method")
+	}
+
+	if len(mmListActive.expectations) > 0 {
+		mmListActive.mock.t.Fatalf("Some expectations are already set for the SessionRepository.ListActive method")
+	}
+
+	mmListActive.mock.funcListActive = f
+	return mmListActive.mock
+}
+
+// When sets expectation for the SessionRepository.ListActive which will trigger the result defined by the following
+// Then helper
+func (mmListActive *mSessionRepositoryMockListActive) When(ctx context.Context, username string) *SessionRepositoryMockListActiveExpectation {
+	if mmListActive.mock.funcListActive != nil {
+		mmListActive.mock.t.Fatalf("SessionRepositoryMock.ListActive mock is already set by Set")
+	}
+
+	expectation := &SessionRepositoryMockListActiveExpectation{
+		mock:   mmListActive.mock,
+		params: &SessionRepositoryMockListActiveParams{ctx, username},
+	}
+	mmListActive.expectations = append(mmListActive.expectations, expectation)
+	return expectation
+}
+
+// Then sets up SessionRepository.ListActive return parameters for the expectation previously defined by the When method
+func (e *SessionRepositoryMockListActiveExpectation) Then(spa1 []*model.Session, err error) *SessionRepositoryMock {
+	e.results = &SessionRepositoryMockListActiveResults{spa1, err}
+	return e.mock
+}
+
+// Times sets number of times SessionRepository.ListActive should be invoked
+func (mmListActive *mSessionRepositoryMockListActive) Times(n uint64) *mSessionRepositoryMockListActive {
+	if n == 0 {
+		mmListActive.mock.t.Fatalf("Times of SessionRepositoryMock.ListActive mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListActive.expectedInvocations, n)
+	return mmListActive
+}
+
+func (mmListActive *mSessionRepositoryMockListActive) invocationsDone() bool {
+	if len(mmListActive.expectations) == 0 && mmListActive.defaultExpectation == nil && mmListActive.mock.funcListActive == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListActive.mock.afterListActiveCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListActive.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListActive implements repository.SessionRepository
+func (mmListActive *SessionRepositoryMock) ListActive(ctx context.Context, username string) (spa1 []*model.Session, err error) {
+	mm_atomic.AddUint64(&mmListActive.beforeListActiveCounter, 1)
+	defer mm_atomic.AddUint64(&mmListActive.afterListActiveCounter, 1)
+
+	if mmListActive.inspectFuncListActive != nil {
+		mmListActive.inspectFuncListActive(ctx, username)
+	}
+
+	mm_params := SessionRepositoryMockListActiveParams{ctx, username}
+
+	// Record call args
+	mmListActive.ListActiveMock.mutex.Lock()
+	mmListActive.ListActiveMock.callArgs = append(mmListActive.ListActiveMock.callArgs, &mm_params)
+	mmListActive.ListActiveMock.mutex.Unlock()
+
+	for _, e := range mmListActive.ListActiveMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.spa1, e.results.err
+		}
+	}
+
+	if mmListActive.ListActiveMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListActive.ListActiveMock.defaultExpectation.Counter, 1)
+		mm_want := mmListActive.ListActiveMock.defaultExpectation.params
+		mm_want_ptrs := mmListActive.ListActiveMock.defaultExpectation.paramPtrs
+
+		mm_got := SessionRepositoryMockListActiveParams{ctx, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListActive.t.Errorf("SessionRepositoryMock.ListActive got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmListActive.t.Errorf("SessionRepositoryMock.ListActive got unexpected parameter username, want: %#v, got: %#v%s\n", *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListActive.t.Errorf("SessionRepositoryMock.ListActive got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListActive.ListActiveMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListActive.t.Fatal("No results are set for the SessionRepositoryMock.ListActive")
+		}
+		return (*mm_results).spa1, (*mm_results).err
+	}
+	if mmListActive.funcListActive != nil {
+		return mmListActive.funcListActive(ctx, username)
+	}
+	mmListActive.t.Fatalf("Unexpected call to SessionRepositoryMock.ListActive. %v %v", ctx, username)
+	return
+}
+
+// ListActiveAfterCounter returns a count of finished SessionRepositoryMock.ListActive invocations
+func (mmListActive *SessionRepositoryMock) ListActiveAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListActive.afterListActiveCounter)
+}
+
+// ListActiveBeforeCounter returns a count of SessionRepositoryMock.ListActive invocations
+func (mmListActive *SessionRepositoryMock) ListActiveBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListActive.beforeListActiveCounter)
+}
+
+// Calls returns a list of arguments used in each call to SessionRepositoryMock.ListActive.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListActive *mSessionRepositoryMockListActive) Calls() []*SessionRepositoryMockListActiveParams {
+	mmListActive.mutex.RLock()
+
+	argCopy := make([]*SessionRepositoryMockListActiveParams, len(mmListActive.callArgs))
+	copy(argCopy, mmListActive.callArgs)
+
+	mmListActive.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListActiveDone returns true if the count of the ListActive invocations corresponds
+// the number of defined expectations
+func (m *SessionRepositoryMock) MinimockListActiveDone() bool {
+	if m.ListActiveMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListActiveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListActiveMock.invocationsDone()
+}
+
+// MinimockListActiveInspect logs each unmet expectation
+func (m *SessionRepositoryMock) MinimockListActiveInspect() {
+	for _, e := range m.ListActiveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SessionRepositoryMock.ListActive with params: %#v", *e.params)
+		}
+	}
+
+	afterListActiveCounter := mm_atomic.LoadUint64(&m.afterListActiveCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListActiveMock.defaultExpectation != nil && afterListActiveCounter < 1 {
+		if m.ListActiveMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to SessionRepositoryMock.ListActive")
+		} else {
+			m.t.Errorf("Expected call to SessionRepositoryMock.ListActive with params: %#v", *m.ListActiveMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListActive != nil && afterListActiveCounter < 1 {
+		m.t.Error("Expected call to SessionRepositoryMock.ListActive")
+	}
+
+	if !m.ListActiveMock.invocationsDone() && afterListActiveCounter > 0 {
+		m.t.Errorf("Expected %d calls to SessionRepositoryMock.ListActive but found %d calls",
+			mm_atomic.LoadUint64(&m.ListActiveMock.expectedInvocations), afterListActiveCounter)
+	}
+}
+
+type mSessionRepositoryMockRevoke struct {
+	optional           bool
+	mock               *SessionRepositoryMock
+	defaultExpectation *SessionRepositoryMockRevokeExpectation
+	expectations       []*SessionRepositoryMockRevokeExpectation
+
+	callArgs []*SessionRepositoryMockRevokeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// SessionRepositoryMockRevokeExpectation specifies expectation struct of the SessionRepository.Revoke
+type SessionRepositoryMockRevokeExpectation struct {
+	mock      *SessionRepositoryMock
+	params    *SessionRepositoryMockRevokeParams
+	paramPtrs *SessionRepositoryMockRevokeParamPtrs
+	results   *SessionRepositoryMockRevokeResults
+	Counter   uint64
+}
+
+// SessionRepositoryMockRevokeParams contains parameters of the SessionRepository.Revoke
+type SessionRepositoryMockRevokeParams struct {
+	ctx context.Context
+	id  string
+}
+
+// SessionRepositoryMockRevokeParamPtrs contains pointers to parameters of the SessionRepository.Revoke
+type SessionRepositoryMockRevokeParamPtrs struct {
+	ctx *context.Context
+	id  *string
+}
+
+// SessionRepositoryMockRevokeResults contains results of the SessionRepository.Revoke
+type SessionRepositoryMockRevokeResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRevoke *mSessionRepositoryMockRevoke) Optional() *mSessionRepositoryMockRevoke {
+	mmRevoke.optional = true
+	return mmRevoke
+}
+
+// Expect sets up expected params for SessionRepository.Revoke
+func (mmRevoke *mSessionRepositoryMockRevoke) Expect(ctx context.Context, id string) *mSessionRepositoryMockRevoke {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("SessionRepositoryMock.Revoke mock is already set by Set")
+	}
+
+	if mmRevoke.defaultExpectation == nil {
+		mmRevoke.defaultExpectation = &SessionRepositoryMockRevokeExpectation{}
+	}
+
+	if mmRevoke.defaultExpectation.paramPtrs != nil {
+		mmRevoke.mock.t.Fatalf("SessionRepositoryMock.Revoke mock is already set by ExpectParams functions")
+	}
+
+	mmRevoke.defaultExpectation.params = &SessionRepositoryMockRevokeParams{ctx, id}
+	for _, e := range mmRevoke.expectations {
+		if minimock.Equal(e.params, mmRevoke.defaultExpectation.params) {
+			mmRevoke.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevoke.defaultExpectation.params)
+		}
+	}
+
+	return mmRevoke
+}
+
+// ExpectCtxParam1 sets up expected param ctx for SessionRepository.Revoke
+func (mmRevoke *mSessionRepositoryMockRevoke) ExpectCtxParam1(ctx context.Context) *mSessionRepositoryMockRevoke {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("SessionRepositoryMock.Revoke mock is already set by Set")
+	}
+
+	if mmRevoke.defaultExpectation == nil {
+		mmRevoke.defaultExpectation = &SessionRepositoryMockRevokeExpectation{}
+	}
+
+	if mmRevoke.defaultExpectation.params != nil {
+		mmRevoke.mock.t.Fatalf("SessionRepositoryMock.Revoke mock is already set by Expect")
+	}
+
+	if mmRevoke.defaultExpectation.paramPtrs == nil {
+		mmRevoke.defaultExpectation.paramPtrs = &SessionRepositoryMockRevokeParamPtrs{}
+	}
+	mmRevoke.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRevoke
+}
+
+// ExpectIdParam2 sets up expected param id for SessionRepository.Revoke
+func (mmRevoke *mSessionRepositoryMockRevoke) ExpectIdParam2(id string) *mSessionRepositoryMockRevoke {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("SessionRepositoryMock.Revoke mock is already set by Set")
+	}
+
+	if mmRevoke.defaultExpectation == nil {
+		mmRevoke.defaultExpectation = &SessionRepositoryMockRevokeExpectation{}
+	}
+
+	if mmRevoke.defaultExpectation.params != nil {
+		mmRevoke.mock.t.Fatalf("SessionRepositoryMock.Revoke mock is already set by Expect")
+	}
+
+	if mmRevoke.defaultExpectation.paramPtrs == nil {
+		mmRevoke.defaultExpectation.paramPtrs = &SessionRepositoryMockRevokeParamPtrs{}
+	}
+	mmRevoke.defaultExpectation.paramPtrs.id = &id
+
+	return mmRevoke
+}
+
+// Inspect accepts an inspector function that has same arguments as the SessionRepository.Revoke
+func (mmRevoke *mSessionRepositoryMockRevoke) Inspect(f func(ctx context.Context, id string)) *mSessionRepositoryMockRevoke {
+	if mmRevoke.mock.inspectFuncRevoke != nil {
+		mmRevoke.mock.t.Fatalf("Inspect function is already set for SessionRepositoryMock.Revoke")
+	}
+
+	mmRevoke.mock.inspectFuncRevoke = f
+
+	return mmRevoke
+}
+
+// Return sets up results that will be returned by SessionRepository.Revoke
+func (mmRevoke *mSessionRepositoryMockRevoke) Return(err error) *SessionRepositoryMock {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("SessionRepositoryMock.Revoke mock is already set by Set")
+	}
+
+	if mmRevoke.defaultExpectation == nil {
+		mmRevoke.defaultExpectation = &SessionRepositoryMockRevokeExpectation{mock: mmRevoke.mock}
+	}
+	mmRevoke.defaultExpectation.results = &SessionRepositoryMockRevokeResults{err}
+	return mmRevoke.mock
+}
+
+// Set uses given function f to mock the SessionRepository.Revoke method
+func (mmRevoke *mSessionRepositoryMockRevoke) Set(f func(ctx context.Context, id string) (err error)) *SessionRepositoryMock {
+	if mmRevoke.defaultExpectation != nil {
+		mmRevoke.mock.t.Fatalf("Default expectation is already set for the SessionRepository.Revoke method")
+	}
+
+	if len(mmRevoke.expectations) > 0 {
+		mmRevoke.mock.t.Fatalf("Some expectations are already set for the SessionRepository.Revoke method")
+	}
+
+	mmRevoke.mock.funcRevoke = f
+	return mmRevoke.mock
+}
+
+// When sets expectation for the SessionRepository.Revoke which will trigger the result defined by the following
+// Then helper
+func (mmRevoke *mSessionRepositoryMockRevoke) When(ctx context.Context, id string) *SessionRepositoryMockRevokeExpectation {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("SessionRepositoryMock.Revoke mock is already set by Set")
+	}
+
+	expectation := &SessionRepositoryMockRevokeExpectation{
+		mock:   mmRevoke.mock,
+		params: &SessionRepositoryMockRevokeParams{ctx, id},
+	}
+	mmRevoke.expectations = append(mmRevoke.expectations, expectation)
+	return expectation
+}
+
+// Then sets up SessionRepository.Revoke return parameters for the expectation previously defined by the When method
+func (e *SessionRepositoryMockRevokeExpectation) Then(err error) *SessionRepositoryMock {
+	e.results = &SessionRepositoryMockRevokeResults{err}
+	return e.mock
+}
+
+// Times sets number of times SessionRepository.Revoke should be invoked
+func (mmRevoke *mSessionRepositoryMockRevoke) Times(n uint64) *mSessionRepositoryMockRevoke {
+	if n == 0 {
+		mmRevoke.mock.t.Fatalf("Times of SessionRepositoryMock.Revoke mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRevoke.expectedInvocations, n)
+	return mmRevoke
+}
+
+func (mmRevoke *mSessionRepositoryMockRevoke) invocationsDone() bool {
+	if len(mmRevoke.expectations) == 0 && mmRevoke.defaultExpectation == nil && mmRevoke.mock.funcRevoke == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRevoke.mock.afterRevokeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevoke.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Revoke implements repository.SessionRepository
+func (mmRevoke *SessionRepositoryMock) Revoke(ctx context.Context, id string) (err error) {
+	mm_atomic.AddUint64(&mmRevoke.beforeRevokeCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevoke.afterRevokeCounter, 1)
+
+	if mmRevoke.inspectFuncRevoke != nil {
+		mmRevoke.inspectFuncRevoke(ctx, id)
+	}
+
+	mm_params := SessionRepositoryMockRevokeParams{ctx, id}
+
+	// Record call args
+	mmRevoke.RevokeMock.mutex.Lock()
+	mmRevoke.RevokeMock.callArgs = append(mmRevoke.RevokeMock.callArgs, &mm_params)
+	mmRevoke.RevokeMock.mutex.Unlock()
+
+	for _, e := range mmRevoke.RevokeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRevoke.RevokeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevoke.RevokeMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevoke.RevokeMock.defaultExpectation.params
+		mm_want_ptrs := mmRevoke.RevokeMock.defaultExpectation.paramPtrs
+
+		mm_got := SessionRepositoryMockRevokeParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevoke.t.Errorf("SessionRepositoryMock.Revoke got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmRevoke.t.Errorf("SessionRepositoryMock.Revoke got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevoke.t.Errorf("SessionRepositoryMock.Revoke got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevoke.RevokeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevoke.t.Fatal("No results are set for the SessionRepositoryMock.Revoke")
+		}
+		return (*mm_results).err
+	}
+	if mmRevoke.funcRevoke != nil {
+		return mmRevoke.funcRevoke(ctx, id)
+	}
+	mmRevoke.t.Fatalf("Unexpected call to SessionRepositoryMock.Revoke. %v %v", ctx, id)
+	return
+}
+
+// RevokeAfterCounter returns a count of finished SessionRepositoryMock.Revoke invocations
+func (mmRevoke *SessionRepositoryMock) RevokeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevoke.afterRevokeCounter)
+}
+
+// RevokeBeforeCounter returns a count of SessionRepositoryMock.Revoke invocations
+func (mmRevoke *SessionRepositoryMock) RevokeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevoke.beforeRevokeCounter)
+}
+
+// Calls returns a list of arguments used in each call to SessionRepositoryMock.Revoke.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevoke *mSessionRepositoryMockRevoke) Calls() []*SessionRepositoryMockRevokeParams {
+	mmRevoke.mutex.RLock()
+
+	argCopy := make([]*SessionRepositoryMockRevokeParams, len(mmRevoke.callArgs))
+	copy(argCopy, mmRevoke.callArgs)
+
+	mmRevoke.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevokeDone returns true if the count of the Revoke invocations corresponds
+// the number of defined expectations
+func (m *SessionRepositoryMock) MinimockRevokeDone() bool {
+	if m.RevokeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RevokeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RevokeMock.invocationsDone()
+}
+
+// MinimockRevokeInspect logs each unmet expectation
+func (m *SessionRepositoryMock) MinimockRevokeInspect() {
+	for _, e := range m.RevokeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SessionRepositoryMock.Revoke with params: %#v", *e.params)
+		}
+	}
+
+	afterRevokeCounter := mm_atomic.LoadUint64(&m.afterRevokeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokeMock.defaultExpectation != nil && afterRevokeCounter < 1 {
+		if m.RevokeMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to SessionRepositoryMock.Revoke")
+		} else {
+			m.t.Errorf("Expected call to SessionRepositoryMock.Revoke with params: %#v", *m.RevokeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevoke != nil && afterRevokeCounter < 1 {
+		m.t.Error("Expected call to SessionRepositoryMock.Revoke")
+	}
+
+	if !m.RevokeMock.invocationsDone() && afterRevokeCounter > 0 {
+		m.t.Errorf("Expected %d calls to SessionRepositoryMock.Revoke but found %d calls",
+			mm_atomic.LoadUint64(&m.RevokeMock.expectedInvocations), afterRevokeCounter)
+	}
+}
+
+type mSessionRepositoryMockRevokeByUsername struct {
+	optional           bool
+	mock               *SessionRepositoryMock
+	defaultExpectation *SessionRepositoryMockRevokeByUsernameExpectation
+	expectations       []*SessionRepositoryMockRevokeByUsernameExpectation
+
+	callArgs []*SessionRepositoryMockRevokeByUsernameParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// SessionRepositoryMockRevokeByUsernameExpectation specifies expectation struct of the SessionRepository.RevokeByUsername
+type SessionRepositoryMockRevokeByUsernameExpectation struct {
+	mock      *SessionRepositoryMock
+	params    *SessionRepositoryMockRevokeByUsernameParams
+	paramPtrs *SessionRepositoryMockRevokeByUsernameParamPtrs
+	results   *SessionRepositoryMockRevokeByUsernameResults
+	Counter   uint64
+}
+
+// SessionRepositoryMockRevokeByUsernameParams contains parameters of the SessionRepository.RevokeByUsername
+type SessionRepositoryMockRevokeByUsernameParams struct {
+	ctx      context.Context
+	username string
+}
+
+// SessionRepositoryMockRevokeByUsernameParamPtrs contains pointers to parameters of the SessionRepository.RevokeByUsername
+type SessionRepositoryMockRevokeByUsernameParamPtrs struct {
+	ctx      *context.Context
+	username *string
+}
+
+// SessionRepositoryMockRevokeByUsernameResults contains results of the SessionRepository.RevokeByUsername
+type SessionRepositoryMockRevokeByUsernameResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRevokeByUsername *mSessionRepositoryMockRevokeByUsername) Optional() *mSessionRepositoryMockRevokeByUsername {
+	mmRevokeByUsername.optional = true
+	return mmRevokeByUsername
+}
+
+// Expect sets up expected params for SessionRepository.RevokeByUsername
+func (mmRevokeByUsername *mSessionRepositoryMockRevokeByUsername) Expect(ctx context.Context, username string) *mSessionRepositoryMockRevokeByUsername {
+	if mmRevokeByUsername.mock.funcRevokeByUsername != nil {
+		mmRevokeByUsername.mock.t.Fatalf("SessionRepositoryMock.RevokeByUsername mock is already set by Set")
+	}
+
+	if mmRevokeByUsername.defaultExpectation == nil {
+		mmRevokeByUsername.defaultExpectation = &SessionRepositoryMockRevokeByUsernameExpectation{}
+	}
+
+	if mmRevokeByUsername.defaultExpectation.paramPtrs != nil {
+		mmRevokeByUsername.mock.t.Fatalf("SessionRepositoryMock.RevokeByUsername mock is already set by ExpectParams functions")
+	}
+
+	mmRevokeByUsername.defaultExpectation.params = &SessionRepositoryMockRevokeByUsernameParams{ctx, username}
+	for _, e := range mmRevokeByUsername.expectations {
+		if minimock.Equal(e.params, mmRevokeByUsername.defaultExpectation.params) {
+			mmRevokeByUsername.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevokeByUsername.defaultExpectation.params)
+		}
+	}
+
+	return mmRevokeByUsername
+}
+
+// ExpectCtxParam1 sets up expected param ctx for SessionRepository.RevokeByUsername
+func (mmRevokeByUsername *mSessionRepositoryMockRevokeByUsername) ExpectCtxParam1(ctx context.Context) *mSessionRepositoryMockRevokeByUsername {
+	if mmRevokeByUsername.mock.funcRevokeByUsername != nil {
+		mmRevokeByUsername.mock.t.Fatalf("SessionRepositoryMock.RevokeByUsername mock is already set by Set")
+	}
+
+	if mmRevokeByUsername.defaultExpectation == nil {
+		mmRevokeByUsername.defaultExpectation = &SessionRepositoryMockRevokeByUsernameExpectation{}
+	}
+
+	if mmRevokeByUsername.defaultExpectation.params != nil {
+		mmRevokeByUsername.mock.t.Fatalf("SessionRepositoryMock.RevokeByUsername mock is already set by Expect")
+	}
+
+	if mmRevokeByUsername.defaultExpectation.paramPtrs == nil {
+		mmRevokeByUsername.defaultExpectation.paramPtrs = &SessionRepositoryMockRevokeByUsernameParamPtrs{}
+	}
+	mmRevokeByUsername.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRevokeByUsername
+}
+
+// ExpectUsernameParam2 sets up expected param username for SessionRepository.RevokeByUsername
+func (mmRevokeByUsername *mSessionRepositoryMockRevokeByUsername) ExpectUsernameParam2(username string) *mSessionRepositoryMockRevokeByUsername {
+	if mmRevokeByUsername.mock.funcRevokeByUsername != nil {
+		mmRevokeByUsername.mock.t.Fatalf("SessionRepositoryMock.RevokeByUsername mock is already set by Set")
+	}
+
+	if mmRevokeByUsername.defaultExpectation == nil {
+		mmRevokeByUsername.defaultExpectation = &SessionRepositoryMockRevokeByUsernameExpectation{}
+	}
+
+	if mmRevokeByUsername.defaultExpectation.params != nil {
+		mmRevokeByUsername.mock.t.Fatalf("SessionRepositoryMock.RevokeByUsername mock is already set by Expect")
+	}
+
+	if mmRevokeByUsername.defaultExpectation.paramPtrs == nil {
+		mmRevokeByUsername.defaultExpectation.paramPtrs = &SessionRepositoryMockRevokeByUsernameParamPtrs{}
+	}
+	mmRevokeByUsername.defaultExpectation.paramPtrs.username = &username
+
+	return mmRevokeByUsername
+}
+
+// Inspect accepts an inspector function that has same arguments as the SessionRepository.RevokeByUsername
+func (mmRevokeByUsername *mSessionRepositoryMockRevokeByUsername) Inspect(f func(ctx context.Context, username string)) *mSessionRepositoryMockRevokeByUsername {
+	if mmRevokeByUsername.mock.inspectFuncRevokeByUsername != nil {
+		mmRevokeByUsername.mock.t.Fatalf("Inspect function is already set for SessionRepositoryMock.RevokeByUsername")
+	}
+
+	mmRevokeByUsername.mock.inspectFuncRevokeByUsername = f
+
+	return mmRevokeByUsername
+}
+
+// Return sets up results that will be returned by SessionRepository.RevokeByUsername
+func (mmRevokeByUsername *mSessionRepositoryMockRevokeByUsername) Return(err error) *SessionRepositoryMock {
+	if mmRevokeByUsername.mock.funcRevokeByUsername != nil {
+		mmRevokeByUsername.mock.t.Fatalf("SessionRepositoryMock.RevokeByUsername mock is already set by Set")
+	}
+
+	if mmRevokeByUsername.defaultExpectation == nil {
+		mmRevokeByUsername.defaultExpectation = &SessionRepositoryMockRevokeByUsernameExpectation{mock: mmRevokeByUsername.mock}
+	}
+	mmRevokeByUsername.defaultExpectation.results = &SessionRepositoryMockRevokeByUsernameResults{err}
+	return mmRevokeByUsername.mock
+}
+
+// Set uses given function f to mock the SessionRepository.RevokeByUsername method
+func (mmRevokeByUsername *mSessionRepositoryMockRevokeByUsername) Set(f func(ctx context.Context, username string) (err error)) *SessionRepositoryMock {
+	if mmRevokeByUsername.defaultExpectation != nil {
+		mmRevokeByUsername.mock.t.Fatalf("Default expectation is already set for the SessionRepository.RevokeByUsername method")
+	}
+
+	if len(mmRevokeByUsername.expectations) > 0 {
+		mmRevokeByUsername.mock.t.Fatalf("Some expectations are already set for the SessionRepository.RevokeByUsername method")
+	}
+
+	mmRevokeByUsername.mock.funcRevokeByUsername = f
+	return mmRevokeByUsername.mock
+}
+
+// When sets expectation for the SessionRepository.RevokeByUsername which will trigger the result defined by the following
+// Then helper
+func (mmRevokeByUsername *mSessionRepositoryMockRevokeByUsername) When(ctx context.Context, username string) *SessionRepositoryMockRevokeByUsernameExpectation {
+	if mmRevokeByUsername.mock.funcRevokeByUsername != nil {
+		mmRevokeByUsername.mock.t.Fatalf("SessionRepositoryMock.RevokeByUsername mock is already set by Set")
+	}
+
+	expectation := &SessionRepositoryMockRevokeByUsernameExpectation{
+		mock:   mmRevokeByUsername.mock,
+		params: &SessionRepositoryMockRevokeByUsernameParams{ctx, username},
+	}
+	mmRevokeByUsername.expectations = append(mmRevokeByUsername.expectations, expectation)
+	return expectation
+}
+
+// Then sets up SessionRepository.RevokeByUsername return parameters for the expectation previously defined by the When method
+func (e *SessionRepositoryMockRevokeByUsernameExpectation) Then(err error) *SessionRepositoryMock {
+	e.results = &SessionRepositoryMockRevokeByUsernameResults{err}
+	return e.mock
+}
+
+// Times sets number of times SessionRepository.RevokeByUsername should be invoked
+func (mmRevokeByUsername *mSessionRepositoryMockRevokeByUsername) Times(n uint64) *mSessionRepositoryMockRevokeByUsername {
+	if n == 0 {
+		mmRevokeByUsername.mock.t.Fatalf("Times of SessionRepositoryMock.RevokeByUsername mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRevokeByUsername.expectedInvocations, n)
+	return mmRevokeByUsername
+}
+
+func (mmRevokeByUsername *mSessionRepositoryMockRevokeByUsername) invocationsDone() bool {
+	if len(mmRevokeByUsername.expectations) == 0 && mmRevokeByUsername.defaultExpectation == nil && mmRevokeByUsername.mock.funcRevokeByUsername == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRevokeByUsername.mock.afterRevokeByUsernameCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevokeByUsername.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RevokeByUsername implements repository.SessionRepository
+func (mmRevokeByUsername *SessionRepositoryMock) RevokeByUsername(ctx context.Context, username string) (err error) {
+	mm_atomic.AddUint64(&mmRevokeByUsername.beforeRevokeByUsernameCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevokeByUsername.afterRevokeByUsernameCounter, 1)
+
+	if mmRevokeByUsername.inspectFuncRevokeByUsername != nil {
+		mmRevokeByUsername.inspectFuncRevokeByUsername(ctx, username)
+	}
+
+	mm_params := SessionRepositoryMockRevokeByUsernameParams{ctx, username}
+
+	// Record call args
+	mmRevokeByUsername.RevokeByUsernameMock.mutex.Lock()
+	mmRevokeByUsername.RevokeByUsernameMock.callArgs = append(mmRevokeByUsername.RevokeByUsernameMock.callArgs, &mm_params)
+	mmRevokeByUsername.RevokeByUsernameMock.mutex.Unlock()
+
+	for _, e := range mmRevokeByUsername.RevokeByUsernameMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRevokeByUsername.RevokeByUsernameMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevokeByUsername.RevokeByUsernameMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevokeByUsername.RevokeByUsernameMock.defaultExpectation.params
+		mm_want_ptrs := mmRevokeByUsername.RevokeByUsernameMock.defaultExpectation.paramPtrs
+
+		mm_got := SessionRepositoryMockRevokeByUsernameParams{ctx, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevokeByUsername.t.Errorf("SessionRepositoryMock.RevokeByUsername got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmRevokeByUsername.t.Errorf("SessionRepositoryMock.RevokeByUsername got unexpected parameter username, want: %#v, got: %#v%s\n", *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevokeByUsername.t.Errorf("SessionRepositoryMock.RevokeByUsername got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevokeByUsername.RevokeByUsernameMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevokeByUsername.t.Fatal("No results are set for the SessionRepositoryMock.RevokeByUsername")
+		}
+		return (*mm_results).err
+	}
+	if mmRevokeByUsername.funcRevokeByUsername != nil {
+		return mmRevokeByUsername.funcRevokeByUsername(ctx, username)
+	}
+	mmRevokeByUsername.t.Fatalf("Unexpected call to SessionRepositoryMock.RevokeByUsername. %v %v", ctx, username)
+	return
+}
+
+// RevokeByUsernameAfterCounter returns a count of finished SessionRepositoryMock.RevokeByUsername invocations
+func (mmRevokeByUsername *SessionRepositoryMock) RevokeByUsernameAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeByUsername.afterRevokeByUsernameCounter)
+}
+
+// RevokeByUsernameBeforeCounter returns a count of SessionRepositoryMock.RevokeByUsername invocations
+func (mmRevokeByUsername *SessionRepositoryMock) RevokeByUsernameBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeByUsername.beforeRevokeByUsernameCounter)
+}
+
+// Calls returns a list of arguments used in each call to SessionRepositoryMock.RevokeByUsername.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevokeByUsername *mSessionRepositoryMockRevokeByUsername) Calls() []*SessionRepositoryMockRevokeByUsernameParams {
+	mmRevokeByUsername.mutex.RLock()
+
+	argCopy := make([]*SessionRepositoryMockRevokeByUsernameParams, len(mmRevokeByUsername.callArgs))
+	copy(argCopy, mmRevokeByUsername.callArgs)
+
+	mmRevokeByUsername.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevokeByUsernameDone returns true if the count of the RevokeByUsername invocations corresponds
+// the number of defined expectations
+func (m *SessionRepositoryMock) MinimockRevokeByUsernameDone() bool {
+	if m.RevokeByUsernameMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RevokeByUsernameMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RevokeByUsernameMock.invocationsDone()
+}
+
+// MinimockRevokeByUsernameInspect logs each unmet expectation
+func (m *SessionRepositoryMock) MinimockRevokeByUsernameInspect() {
+	for _, e := range m.RevokeByUsernameMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SessionRepositoryMock.RevokeByUsername with params: %#v", *e.params)
+		}
+	}
+
+	afterRevokeByUsernameCounter := mm_atomic.LoadUint64(&m.afterRevokeByUsernameCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokeByUsernameMock.defaultExpectation != nil && afterRevokeByUsernameCounter < 1 {
+		if m.RevokeByUsernameMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to SessionRepositoryMock.RevokeByUsername")
+		} else {
+			m.t.Errorf("Expected call to SessionRepositoryMock.RevokeByUsername with params: %#v", *m.RevokeByUsernameMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevokeByUsername != nil && afterRevokeByUsernameCounter < 1 {
+		m.t.Error("Expected call to SessionRepositoryMock.RevokeByUsername")
+	}
+
+	if !m.RevokeByUsernameMock.invocationsDone() && afterRevokeByUsernameCounter > 0 {
+		m.t.Errorf("Expected %d calls to SessionRepositoryMock.RevokeByUsername but found %d calls",
+			mm_atomic.LoadUint64(&m.RevokeByUsernameMock.expectedInvocations), afterRevokeByUsernameCounter)
+	}
+}
+
+type mSessionRepositoryMockTouch struct {
+	optional           bool
+	mock               *SessionRepositoryMock
+	defaultExpectation *SessionRepositoryMockTouchExpectation
+	expectations       []*SessionRepositoryMockTouchExpectation
+
+	callArgs []*SessionRepositoryMockTouchParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// SessionRepositoryMockTouchExpectation specifies expectation struct of the SessionRepository.Touch
+type SessionRepositoryMockTouchExpectation struct {
+	mock      *SessionRepositoryMock
+	params    *SessionRepositoryMockTouchParams
+	paramPtrs *SessionRepositoryMockTouchParamPtrs
+	results   *SessionRepositoryMockTouchResults
+	Counter   uint64
+}
+
+// SessionRepositoryMockTouchParams contains parameters of the SessionRepository.Touch
+type SessionRepositoryMockTouchParams struct {
+	ctx       context.Context
+	id        string
+	expiresAt time.Time
+}
+
+// SessionRepositoryMockTouchParamPtrs contains pointers to parameters of the SessionRepository.Touch
+type SessionRepositoryMockTouchParamPtrs struct {
+	ctx       *context.Context
+	id        *string
+	expiresAt *time.Time
+}
+
+// SessionRepositoryMockTouchResults contains results of the SessionRepository.Touch
+type SessionRepositoryMockTouchResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmTouch *mSessionRepositoryMockTouch) Optional() *mSessionRepositoryMockTouch {
+	mmTouch.optional = true
+	return mmTouch
+}
+
+// Expect sets up expected params for SessionRepository.Touch
+func (mmTouch *mSessionRepositoryMockTouch) Expect(ctx context.Context, id string, expiresAt time.Time) *mSessionRepositoryMockTouch {
+	if mmTouch.mock.funcTouch != nil {
+		mmTouch.mock.t.Fatalf("SessionRepositoryMock.Touch mock is already set by Set")
+	}
+
+	if mmTouch.defaultExpectation == nil {
+		mmTouch.defaultExpectation = &SessionRepositoryMockTouchExpectation{}
+	}
+
+	if mmTouch.defaultExpectation.paramPtrs != nil {
+		mmTouch.mock.t.Fatalf("SessionRepositoryMock.Touch mock is already set by ExpectParams functions")
+	}
+
+	mmTouch.defaultExpectation.params = &SessionRepositoryMockTouchParams{ctx, id, expiresAt}
+	for _, e := range mmTouch.expectations {
+		if minimock.Equal(e.params, mmTouch.defaultExpectation.params) {
+			mmTouch.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmTouch.defaultExpectation.params)
+		}
+	}
+
+	return mmTouch
+}
+
+// ExpectCtxParam1 sets up expected param ctx for SessionRepository.Touch
+func (mmTouch *mSessionRepositoryMockTouch) ExpectCtxParam1(ctx context.Context) *mSessionRepositoryMockTouch {
+	if mmTouch.mock.funcTouch != nil {
+		mmTouch.mock.t.Fatalf("SessionRepositoryMock.Touch mock is already set by Set")
+	}
+
+	if mmTouch.defaultExpectation == nil {
+		mmTouch.defaultExpectation = &SessionRepositoryMockTouchExpectation{}
+	}
+
+	if mmTouch.defaultExpectation.params != nil {
+		mmTouch.mock.t.Fatalf("SessionRepositoryMock.Touch mock is already set by Expect")
+	}
+
+	if mmTouch.defaultExpectation.paramPtrs == nil {
+		mmTouch.defaultExpectation.paramPtrs = &SessionRepositoryMockTouchParamPtrs{}
+	}
+	mmTouch.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmTouch
+}
+
+// ExpectIdParam2 sets up expected param id for SessionRepository.Touch
+func (mmTouch *mSessionRepositoryMockTouch) ExpectIdParam2(id string) *mSessionRepositoryMockTouch {
+	if mmTouch.mock.funcTouch != nil {
+		mmTouch.mock.t.Fatalf("SessionRepositoryMock.Touch mock is already set by Set")
+	}
+
+	if mmTouch.defaultExpectation == nil {
+		mmTouch.defaultExpectation = &SessionRepositoryMockTouchExpectation{}
+	}
+
+	if mmTouch.defaultExpectation.params != nil {
+		mmTouch.mock.t.Fatalf("SessionRepositoryMock.Touch mock is already set by Expect")
+	}
+
+	if mmTouch.defaultExpectation.paramPtrs == nil {
+		mmTouch.defaultExpectation.paramPtrs = &SessionRepositoryMockTouchParamPtrs{}
+	}
+	mmTouch.defaultExpectation.paramPtrs.id = &id
+
+	return mmTouch
+}
+
+// ExpectExpiresAtParam3 sets up expected param expiresAt for SessionRepository.Touch
+func (mmTouch *mSessionRepositoryMockTouch) ExpectExpiresAtParam3(expiresAt time.Time) *mSessionRepositoryMockTouch {
+	if mmTouch.mock.funcTouch != nil {
+		mmTouch.mock.t.Fatalf("SessionRepositoryMock.Touch mock is already set by Set")
+	}
+
+	if mmTouch.defaultExpectation == nil {
+		mmTouch.defaultExpectation = &SessionRepositoryMockTouchExpectation{}
+	}
+
+	if mmTouch.defaultExpectation.params != nil {
+		mmTouch.mock.t.Fatalf("SessionRepositoryMock.Touch mock is already set by Expect")
+	}
+
+	if mmTouch.defaultExpectation.paramPtrs == nil {
+		mmTouch.defaultExpectation.paramPtrs = &SessionRepositoryMockTouchParamPtrs{}
+	}
+	mmTouch.defaultExpectation.paramPtrs.expiresAt = &expiresAt
+
+	return mmTouch
+}
+
+// Inspect accepts an inspector function that has same arguments as the SessionRepository.Touch
+func (mmTouch *mSessionRepositoryMockTouch) Inspect(f func(ctx context.Context, id string, expiresAt time.Time)) *mSessionRepositoryMockTouch {
+	if mmTouch.mock.inspectFuncTouch != nil {
+		mmTouch.mock.t.Fatalf("Inspect function is already set for SessionRepositoryMock.Touch")
+	}
+
+	mmTouch.mock.inspectFuncTouch = f
+
+	return mmTouch
+}
+
+// Return sets up results that will be returned by SessionRepository.Touch
+func (mmTouch *mSessionRepositoryMockTouch) Return(err error) *SessionRepositoryMock {
+	if mmTouch.mock.funcTouch != nil {
+		mmTouch.mock.t.Fatalf("SessionRepositoryMock.Touch mock is already set by Set")
+	}
+
+	if mmTouch.defaultExpectation == nil {
+		mmTouch.defaultExpectation = &SessionRepositoryMockTouchExpectation{mock: mmTouch.mock}
+	}
+	mmTouch.defaultExpectation.results = &SessionRepositoryMockTouchResults{err}
+	return mmTouch.mock
+}
+
+// Set uses given function f to mock the SessionRepository.Touch method
+func (mmTouch *mSessionRepositoryMockTouch) Set(f func(ctx context.Context, id string, expiresAt time.Time) (err error)) *SessionRepositoryMock {
+	if mmTouch.defaultExpectation != nil {
+		mmTouch.mock.t.Fatalf("Default expectation is already set for the SessionRepository.Touch method")
+	}
+
+	if len(mmTouch.expectations) > 0 {
+		mmTouch.mock.t.Fatalf("Some expectations are already set for the SessionRepository.Touch method")
+	}
+
+	mmTouch.mock.funcTouch = f
+	return mmTouch.mock
+}
+
+// When sets expectation for the SessionRepository.Touch which will trigger the result defined by the following
+// Then helper
+func (mmTouch *mSessionRepositoryMockTouch) When(ctx context.Context, id string, expiresAt time.Time) *SessionRepositoryMockTouchExpectation {
+	if mmTouch.mock.funcTouch != nil {
+		mmTouch.mock.t.Fatalf("SessionRepositoryMock.Touch mock is already set by Set")
+	}
+
+	expectation := &SessionRepositoryMockTouchExpectation{
+		mock:   mmTouch.mock,
+		params: &SessionRepositoryMockTouchParams{ctx, id, expiresAt},
+	}
+	mmTouch.expectations = append(mmTouch.expectations, expectation)
+	return expectation
+}
+
+// Then sets up SessionRepository.Touch return parameters for the expectation previously defined by the When method
+func (e *SessionRepositoryMockTouchExpectation) Then(err error) *SessionRepositoryMock {
+	e.results = &SessionRepositoryMockTouchResults{err}
+	return e.mock
+}
+
+// Times sets number of times SessionRepository.Touch should be invoked
+func (mmTouch *mSessionRepositoryMockTouch) Times(n uint64) *mSessionRepositoryMockTouch {
+	if n == 0 {
+		mmTouch.mock.t.Fatalf("Times of SessionRepositoryMock.Touch mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmTouch.expectedInvocations, n)
+	return mmTouch
+}
+
+func (mmTouch *mSessionRepositoryMockTouch) invocationsDone() bool {
+	if len(mmTouch.expectations) == 0 && mmTouch.defaultExpectation == nil && mmTouch.mock.funcTouch == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmTouch.mock.afterTouchCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmTouch.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Touch implements repository.SessionRepository
+func (mmTouch *SessionRepositoryMock) Touch(ctx context.Context, id string, expiresAt time.Time) (err error) {
+	mm_atomic.AddUint64(&mmTouch.beforeTouchCounter, 1)
+	defer mm_atomic.AddUint64(&mmTouch.afterTouchCounter, 1)
+
+	if mmTouch.inspectFuncTouch != nil {
+		mmTouch.inspectFuncTouch(ctx, id, expiresAt)
+	}
+
+	mm_params := SessionRepositoryMockTouchParams{ctx, id, expiresAt}
+
+	// Record call args
+	mmTouch.TouchMock.mutex.Lock()
+	mmTouch.TouchMock.callArgs = append(mmTouch.TouchMock.callArgs, &mm_params)
+	mmTouch.TouchMock.mutex.Unlock()
+
+	for _, e := range mmTouch.TouchMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmTouch.TouchMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmTouch.TouchMock.defaultExpectation.Counter, 1)
+		mm_want := mmTouch.TouchMock.defaultExpectation.params
+		mm_want_ptrs := mmTouch.TouchMock.defaultExpectation.paramPtrs
+
+		mm_got := SessionRepositoryMockTouchParams{ctx, id, expiresAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmTouch.t.Errorf("SessionRepositoryMock.Touch got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmTouch.t.Errorf("SessionRepositoryMock.Touch got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.expiresAt != nil && !minimock.Equal(*mm_want_ptrs.expiresAt, mm_got.expiresAt) {
+				mmTouch.t.Errorf("SessionRepositoryMock.Touch got unexpected parameter expiresAt, want: %#v, got: %#v%s\n", *mm_want_ptrs.expiresAt, mm_got.expiresAt, minimock.Diff(*mm_want_ptrs.expiresAt, mm_got.expiresAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmTouch.t.Errorf("SessionRepositoryMock.Touch got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmTouch.TouchMock.defaultExpectation.results
+		if mm_results == nil {
+			mmTouch.t.Fatal("No results are set for the SessionRepositoryMock.Touch")
+		}
+		return (*mm_results).err
+	}
+	if mmTouch.funcTouch != nil {
+		return mmTouch.funcTouch(ctx, id, expiresAt)
+	}
+	mmTouch.t.Fatalf("Unexpected call to SessionRepositoryMock.Touch. %v %v %v", ctx, id, expiresAt)
+	return
+}
+
+// TouchAfterCounter returns a count of finished SessionRepositoryMock.Touch invocations
+func (mmTouch *SessionRepositoryMock) TouchAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTouch.afterTouchCounter)
+}
+
+// TouchBeforeCounter returns a count of SessionRepositoryMock.Touch invocations
+func (mmTouch *SessionRepositoryMock) TouchBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTouch.beforeTouchCounter)
+}
+
+// Calls returns a list of arguments used in each call to SessionRepositoryMock.Touch.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmTouch *mSessionRepositoryMockTouch) Calls() []*SessionRepositoryMockTouchParams {
+	mmTouch.mutex.RLock()
+
+	argCopy := make([]*SessionRepositoryMockTouchParams, len(mmTouch.callArgs))
+	copy(argCopy, mmTouch.callArgs)
+
+	mmTouch.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockTouchDone returns true if the count of the Touch invocations corresponds
+// the number of defined expectations
+func (m *SessionRepositoryMock) MinimockTouchDone() bool {
+	if m.TouchMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.TouchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.TouchMock.invocationsDone()
+}
+
+// MinimockTouchInspect logs each unmet expectation
+func (m *SessionRepositoryMock) MinimockTouchInspect() {
+	for _, e := range m.TouchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SessionRepositoryMock.Touch with params: %#v", *e.params)
+		}
+	}
+
+	afterTouchCounter := mm_atomic.LoadUint64(&m.afterTouchCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.TouchMock.defaultExpectation != nil && afterTouchCounter < 1 {
+		if m.TouchMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to SessionRepositoryMock.Touch")
+		} else {
+			m.t.Errorf("Expected call to SessionRepositoryMock.Touch with params: %#v", *m.TouchMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcTouch != nil && afterTouchCounter < 1 {
+		m.t.Error("Expected call to SessionRepositoryMock.Touch")
+	}
+
+	if !m.TouchMock.invocationsDone() && afterTouchCounter > 0 {
+		m.t.Errorf("Expected %d calls to SessionRepositoryMock.Touch but found %d calls",
+			mm_atomic.LoadUint64(&m.TouchMock.expectedInvocations), afterTouchCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *SessionRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateInspect()
+
+			m.MinimockGetInspect()
+
+			m.MinimockListActiveInspect()
+
+			m.MinimockRevokeInspect()
+
+			m.MinimockRevokeByUsernameInspect()
+
+			m.MinimockTouchInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *SessionRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *SessionRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateDone() &&
+		m.MinimockGetDone() &&
+		m.MinimockListActiveDone() &&
+		m.MinimockRevokeDone() &&
+		m.MinimockRevokeByUsernameDone() &&
+		m.MinimockTouchDone()
+}
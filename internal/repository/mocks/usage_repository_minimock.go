@@ -0,0 +1,1479 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.13). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i di_container/internal/repository.UsageRepository -o usage_repository_minimock.go -n UsageRepositoryMock -p mocks
+
+import (
+	"context"
+	"di_container/internal/model"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// UsageRepositoryMock implements repository.UsageRepository
+type UsageRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAdd          func(ctx context.Context, owner string, delta *model.Usage) (up1 *model.Usage, err error)
+	inspectFuncAdd   func(ctx context.Context, owner string, delta *model.Usage)
+	afterAddCounter  uint64
+	beforeAddCounter uint64
+	AddMock          mUsageRepositoryMockAdd
+
+	funcCountRequest          func(ctx context.Context, owner string, day time.Time) (i1 int64, err error)
+	inspectFuncCountRequest   func(ctx context.Context, owner string, day time.Time)
+	afterCountRequestCounter  uint64
+	beforeCountRequestCounter uint64
+	CountRequestMock          mUsageRepositoryMockCountRequest
+
+	funcGet          func(ctx context.Context, owner string) (up1 *model.Usage, err error)
+	inspectFuncGet   func(ctx context.Context, owner string)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mUsageRepositoryMockGet
+
+	funcGetRequests          func(ctx context.Context, owner string, day time.Time) (i1 int64, err error)
+	inspectFuncGetRequests   func(ctx context.Context, owner string, day time.Time)
+	afterGetRequestsCounter  uint64
+	beforeGetRequestsCounter uint64
+	GetRequestsMock          mUsageRepositoryMockGetRequests
+}
+
+// NewUsageRepositoryMock returns a mock for repository.UsageRepository
+func NewUsageRepositoryMock(t minimock.Tester) *UsageRepositoryMock {
+	m := &UsageRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddMock = mUsageRepositoryMockAdd{mock: m}
+	m.AddMock.callArgs = []*UsageRepositoryMockAddParams{}
+
+	m.CountRequestMock = mUsageRepositoryMockCountRequest{mock: m}
+	m.CountRequestMock.callArgs = []*UsageRepositoryMockCountRequestParams{}
+
+	m.GetMock = mUsageRepositoryMockGet{mock: m}
+	m.GetMock.callArgs = []*UsageRepositoryMockGetParams{}
+
+	m.GetRequestsMock = mUsageRepositoryMockGetRequests{mock: m}
+	m.GetRequestsMock.callArgs = []*UsageRepositoryMockGetRequestsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mUsageRepositoryMockAdd struct {
+	optional           bool
+	mock               *UsageRepositoryMock
+	defaultExpectation *UsageRepositoryMockAddExpectation
+	expectations       []*UsageRepositoryMockAddExpectation
+
+	callArgs []*UsageRepositoryMockAddParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// UsageRepositoryMockAddExpectation specifies expectation struct of the UsageRepository.Add
+type UsageRepositoryMockAddExpectation struct {
+	mock      *UsageRepositoryMock
+	params    *UsageRepositoryMockAddParams
+	paramPtrs *UsageRepositoryMockAddParamPtrs
+	results   *UsageRepositoryMockAddResults
+	Counter   uint64
+}
+
+// UsageRepositoryMockAddParams contains parameters of the UsageRepository.Add
+type UsageRepositoryMockAddParams struct {
+	ctx   context.Context
+	owner string
+	delta *model.Usage
+}
+
+// UsageRepositoryMockAddParamPtrs contains pointers to parameters of the UsageRepository.Add
+type UsageRepositoryMockAddParamPtrs struct {
+	ctx   *context.Context
+	owner *string
+	delta **model.Usage
+}
+
+// UsageRepositoryMockAddResults contains results of the UsageRepository.Add
+type UsageRepositoryMockAddResults struct {
+	up1 *model.Usage
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAdd *mUsageRepositoryMockAdd) Optional() *mUsageRepositoryMockAdd {
+	mmAdd.optional = true
+	return mmAdd
+}
+
+// Expect sets up expected params for UsageRepository.Add
+func (mmAdd *mUsageRepositoryMockAdd) Expect(ctx context.Context, owner string, delta *model.Usage) *mUsageRepositoryMockAdd {
+	if mmAdd.mock.funcAdd != nil {
+		mmAdd.mock.t.Fatalf("UsageRepositoryMock.Add mock is already set by Set")
+	}
+
+	if mmAdd.defaultExpectation == nil {
+		mmAdd.defaultExpectation = &UsageRepositoryMockAddExpectation{}
+	}
+
+	if mmAdd.defaultExpectation.paramPtrs != nil {
+		mmAdd.mock.t.Fatalf("UsageRepositoryMock.Add mock is already set by ExpectParams functions")
+	}
+
+	mmAdd.defaultExpectation.params = &UsageRepositoryMockAddParams{ctx, owner, delta}
+	for _, e := range mmAdd.expectations {
+		if minimock.Equal(e.params, mmAdd.defaultExpectation.params) {
+			mmAdd.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAdd.defaultExpectation.params)
+		}
+	}
+
+	return mmAdd
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UsageRepository.Add
+func (mmAdd *mUsageRepositoryMockAdd) ExpectCtxParam1(ctx context.Context) *mUsageRepositoryMockAdd {
+	if mmAdd.mock.funcAdd != nil {
+		mmAdd.mock.t.Fatalf("UsageRepositoryMock.Add mock is already set by Set")
+	}
+
+	if mmAdd.defaultExpectation == nil {
+		mmAdd.defaultExpectation = &UsageRepositoryMockAddExpectation{}
+	}
+
+	if mmAdd.defaultExpectation.params != nil {
+		mmAdd.mock.t.Fatalf("UsageRepositoryMock.Add mock is already set by Expect")
+	}
+
+	if mmAdd.defaultExpectation.paramPtrs == nil {
+		mmAdd.defaultExpectation.paramPtrs = &UsageRepositoryMockAddParamPtrs{}
+	}
+	mmAdd.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmAdd
+}
+
+// ExpectOwnerParam2 sets up expected param owner for UsageRepository.Add
+func (mmAdd *mUsageRepositoryMockAdd) ExpectOwnerParam2(owner string) *mUsageRepositoryMockAdd {
+	if mmAdd.mock.funcAdd != nil {
+		mmAdd.mock.t.Fatalf("UsageRepositoryMock.Add mock is already set by Set")
+	}
+
+	if mmAdd.defaultExpectation == nil {
+		mmAdd.defaultExpectation = &UsageRepositoryMockAddExpectation{}
+	}
+
+	if mmAdd.defaultExpectation.params != nil {
+		mmAdd.mock.t.Fatalf("UsageRepositoryMock.Add mock is already set by Expect")
+	}
+
+	if mmAdd.defaultExpectation.paramPtrs == nil {
+		mmAdd.defaultExpectation.paramPtrs = &UsageRepositoryMockAddParamPtrs{}
+	}
+	mmAdd.defaultExpectation.paramPtrs.owner = &owner
+
+	return mmAdd
+}
+
+// ExpectDeltaParam3 sets up expected param delta for UsageRepository.Add
+func (mmAdd *mUsageRepositoryMockAdd) ExpectDeltaParam3(delta *model.Usage) *mUsageRepositoryMockAdd {
+	if mmAdd.mock.funcAdd != nil {
+		mmAdd.mock.t.Fatalf("UsageRepositoryMock.Add mock is already set by Set")
+	}
+
+	if mmAdd.defaultExpectation == nil {
+		mmAdd.defaultExpectation = &UsageRepositoryMockAddExpectation{}
+	}
+
+	if mmAdd.defaultExpectation.params != nil {
+		mmAdd.mock.t.Fatalf("UsageRepositoryMock.Add mock is already set by Expect")
+	}
+
+	if mmAdd.defaultExpectation.paramPtrs == nil {
+		mmAdd.defaultExpectation.paramPtrs = &UsageRepositoryMockAddParamPtrs{}
+	}
+	mmAdd.defaultExpectation.paramPtrs.delta = &delta
+
+	return mmAdd
+}
+
+// Inspect accepts an inspector function that has same arguments as the UsageRepository.Add
+func (mmAdd *mUsageRepositoryMockAdd) Inspect(f func(ctx context.Context, owner string, delta *model.Usage)) *mUsageRepositoryMockAdd {
+	if mmAdd.mock.inspectFuncAdd != nil {
+		mmAdd.mock.t.Fatalf("Inspect function is already set for UsageRepositoryMock.Add")
+	}
+
+	mmAdd.mock.inspectFuncAdd = f
+
+	return mmAdd
+}
+
+// Return sets up results that will be returned by UsageRepository.Add
+func (mmAdd *mUsageRepositoryMockAdd) Return(up1 *model.Usage, err error) *UsageRepositoryMock {
+	if mmAdd.mock.funcAdd != nil {
+		mmAdd.mock.t.Fatalf("UsageRepositoryMock.Add mock is already set by Set")
+	}
+
+	if mmAdd.defaultExpectation == nil {
+		mmAdd.defaultExpectation = &UsageRepositoryMockAddExpectation{mock: mmAdd.mock}
+	}
+	mmAdd.defaultExpectation.results = &UsageRepositoryMockAddResults{up1, err}
+	return mmAdd.mock
+}
+
+// Set uses given function f to mock the UsageRepository.Add method
+func (mmAdd *mUsageRepositoryMockAdd) Set(f func(ctx context.Context, owner string, delta *model.Usage) (up1 *model.Usage, err error)) *UsageRepositoryMock {
+	if mmAdd.defaultExpectation != nil {
+		mmAdd.mock.t.Fatalf("Default expectation is already set for the UsageRepository.Add method")
+	}
+
+	if len(mmAdd.expectations) > 0 {
+		mmAdd.mock.t.Fatalf("Some expectations are already set for the UsageRepository.Add method")
+	}
+
+	mmAdd.mock.funcAdd = f
+	return mmAdd.mock
+}
+
+// When sets expectation for the UsageRepository.Add which will trigger the result defined by the following
+// Then helper
+func (mmAdd *mUsageRepositoryMockAdd) When(ctx context.Context, owner string, delta *model.Usage) *UsageRepositoryMockAddExpectation {
+	if mmAdd.mock.funcAdd != nil {
+		mmAdd.mock.t.Fatalf("UsageRepositoryMock.Add mock is already set by Set")
+	}
+
+	expectation := &UsageRepositoryMockAddExpectation{
+		mock:   mmAdd.mock,
+		params: &UsageRepositoryMockAddParams{ctx, owner, delta},
+	}
+	mmAdd.expectations = append(mmAdd.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UsageRepository.Add return parameters for the expectation previously defined by the When method
+func (e *UsageRepositoryMockAddExpectation) Then(up1 *model.Usage, err error) *UsageRepositoryMock {
+	e.results = &UsageRepositoryMockAddResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times UsageRepository.Add should be invoked
+func (mmAdd *mUsageRepositoryMockAdd) Times(n uint64) *mUsageRepositoryMockAdd {
+	if n == 0 {
+		mmAdd.mock.t.Fatalf("Times of UsageRepositoryMock.Add mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAdd.expectedInvocations, n)
+	return mmAdd
+}
+
+func (mmAdd *mUsageRepositoryMockAdd) invocationsDone() bool {
+	if len(mmAdd.expectations) == 0 && mmAdd.defaultExpectation == nil && mmAdd.mock.funcAdd == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAdd.mock.afterAddCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAdd.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Add implements repository.UsageRepository
+func (mmAdd *UsageRepositoryMock) Add(ctx context.Context, owner string, delta *model.Usage) (up1 *model.Usage, err error) {
+	mm_atomic.AddUint64(&mmAdd.beforeAddCounter, 1)
+	defer mm_atomic.AddUint64(&mmAdd.afterAddCounter, 1)
+
+	if mmAdd.inspectFuncAdd != nil {
+		mmAdd.inspectFuncAdd(ctx, owner, delta)
+	}
+
+	mm_params := UsageRepositoryMockAddParams{ctx, owner, delta}
+
+	// Record call args
+	mmAdd.AddMock.mutex.Lock()
+	mmAdd.AddMock.callArgs = append(mmAdd.AddMock.callArgs, &mm_params)
+	mmAdd.AddMock.mutex.Unlock()
+
+	for _, e := range mmAdd.AddMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmAdd.AddMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAdd.AddMock.defaultExpectation.Counter, 1)
+		mm_want := mmAdd.AddMock.defaultExpectation.params
+		mm_want_ptrs := mmAdd.AddMock.defaultExpectation.paramPtrs
+
+		mm_got := UsageRepositoryMockAddParams{ctx, owner, delta}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAdd.t.Errorf("UsageRepositoryMock.Add got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.owner != nil && !minimock.Equal(*mm_want_ptrs.owner, mm_got.owner) {
+				mmAdd.t.Errorf("UsageRepositoryMock.Add got unexpected parameter owner, want: %#v, got: %#v%s\n", *mm_want_ptrs.owner, mm_got.owner, minimock.Diff(*mm_want_ptrs.owner, mm_got.owner))
+			}
+
+			if mm_want_ptrs.delta != nil && !minimock.Equal(*mm_want_ptrs.delta, mm_got.delta) {
+				mmAdd.t.Errorf("UsageRepositoryMock.Add got unexpected parameter delta, want: %#v, got: %#v%s\n", *mm_want_ptrs.delta, mm_got.delta, minimock.Diff(*mm_want_ptrs.delta, mm_got.delta))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAdd.t.Errorf("UsageRepositoryMock.Add got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAdd.AddMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAdd.t.Fatal("No results are set for the UsageRepositoryMock.Add")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmAdd.funcAdd != nil {
+		return mmAdd.funcAdd(ctx, owner, delta)
+	}
+	mmAdd.t.Fatalf("Unexpected call to UsageRepositoryMock.Add. %v %v %v", ctx, owner, delta)
+	return
+}
+
+// AddAfterCounter returns a count of finished UsageRepositoryMock.Add invocations
+func (mmAdd *UsageRepositoryMock) AddAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAdd.afterAddCounter)
+}
+
+// AddBeforeCounter returns a count of UsageRepositoryMock.Add invocations
+func (mmAdd *UsageRepositoryMock) AddBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAdd.beforeAddCounter)
+}
+
+// Calls returns a list of arguments used in each call to UsageRepositoryMock.Add.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAdd *mUsageRepositoryMockAdd) Calls() []*UsageRepositoryMockAddParams {
+	mmAdd.mutex.RLock()
+
+	argCopy := make([]*UsageRepositoryMockAddParams, len(mmAdd.callArgs))
+	copy(argCopy, mmAdd.callArgs)
+
+	mmAdd.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddDone returns true if the count of the Add invocations corresponds
+// the number of defined expectations
+func (m *UsageRepositoryMock) MinimockAddDone() bool {
+	if m.AddMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddMock.invocationsDone()
+}
+
+// MinimockAddInspect logs each unmet expectation
+func (m *UsageRepositoryMock) MinimockAddInspect() {
+	for _, e := range m.AddMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UsageRepositoryMock.Add with params: %#v", *e.params)
+		}
+	}
+
+	afterAddCounter := mm_atomic.LoadUint64(&m.afterAddCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddMock.defaultExpectation != nil && afterAddCounter < 1 {
+		if m.AddMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UsageRepositoryMock.Add")
+		} else {
+			m.t.Errorf("Expected call to UsageRepositoryMock.Add with params: %#v", *m.AddMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAdd != nil && afterAddCounter < 1 {
+		m.t.Error("Expected call to UsageRepositoryMock.Add")
+	}
+
+	if !m.AddMock.invocationsDone() && afterAddCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsageRepositoryMock.Add but found %d calls",
+			mm_atomic.LoadUint64(&m.AddMock.expectedInvocations), afterAddCounter)
+	}
+}
+
+type mUsageRepositoryMockCountRequest struct {
+	optional           bool
+	mock               *UsageRepositoryMock
+	defaultExpectation *UsageRepositoryMockCountRequestExpectation
+	expectations       []*UsageRepositoryMockCountRequestExpectation
+
+	callArgs []*UsageRepositoryMockCountRequestParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// UsageRepositoryMockCountRequestExpectation specifies expectation struct of the UsageRepository.CountRequest
+type UsageRepositoryMockCountRequestExpectation struct {
+	mock      *UsageRepositoryMock
+	params    *UsageRepositoryMockCountRequestParams
+	paramPtrs *UsageRepositoryMockCountRequestParamPtrs
+	results   *UsageRepositoryMockCountRequestResults
+	Counter   uint64
+}
+
+// UsageRepositoryMockCountRequestParams contains parameters of the UsageRepository.CountRequest
+type UsageRepositoryMockCountRequestParams struct {
+	ctx   context.Context
+	owner string
+	day   time.Time
+}
+
+// UsageRepositoryMockCountRequestParamPtrs contains pointers to parameters of the UsageRepository.CountRequest
+type UsageRepositoryMockCountRequestParamPtrs struct {
+	ctx   *context.Context
+	owner *string
+	day   *time.Time
+}
+
+// UsageRepositoryMockCountRequestResults contains results of the UsageRepository.CountRequest
+type UsageRepositoryMockCountRequestResults struct {
+	i1  int64
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCountRequest *mUsageRepositoryMockCountRequest) Optional() *mUsageRepositoryMockCountRequest {
+	mmCountRequest.optional = true
+	return mmCountRequest
+}
+
+// Expect sets up expected params for UsageRepository.CountRequest
+func (mmCountRequest *mUsageRepositoryMockCountRequest) Expect(ctx context.Context, owner string, day time.Time) *mUsageRepositoryMockCountRequest {
+	if mmCountRequest.mock.funcCountRequest != nil {
+		mmCountRequest.mock.t.Fatalf("UsageRepositoryMock.CountRequest mock is already set by Set")
+	}
+
+	if mmCountRequest.defaultExpectation == nil {
+		mmCountRequest.defaultExpectation = &UsageRepositoryMockCountRequestExpectation{}
+	}
+
+	if mmCountRequest.defaultExpectation.paramPtrs != nil {
+		mmCountRequest.mock.t.Fatalf("UsageRepositoryMock.CountRequest mock is already set by ExpectParams functions")
+	}
+
+	mmCountRequest.defaultExpectation.params = &UsageRepositoryMockCountRequestParams{ctx, owner, day}
+	for _, e := range mmCountRequest.expectations {
+		if minimock.Equal(e.params, mmCountRequest.defaultExpectation.params) {
+			mmCountRequest.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCountRequest.defaultExpectation.params)
+		}
+	}
+
+	return mmCountRequest
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UsageRepository.CountRequest
+func (mmCountRequest *mUsageRepositoryMockCountRequest) ExpectCtxParam1(ctx context.Context) *mUsageRepositoryMockCountRequest {
+	if mmCountRequest.mock.funcCountRequest != nil {
+		mmCountRequest.mock.t.Fatalf("UsageRepositoryMock.CountRequest mock is already set by Set")
+	}
+
+	if mmCountRequest.defaultExpectation == nil {
+		mmCountRequest.defaultExpectation = &UsageRepositoryMockCountRequestExpectation{}
+	}
+
+	if mmCountRequest.defaultExpectation.params != nil {
+		mmCountRequest.mock.t.Fatalf("UsageRepositoryMock.CountRequest mock is already set by Expect")
+	}
+
+	if mmCountRequest.defaultExpectation.paramPtrs == nil {
+		mmCountRequest.defaultExpectation.paramPtrs = &UsageRepositoryMockCountRequestParamPtrs{}
+	}
+	mmCountRequest.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCountRequest
+}
+
+// ExpectOwnerParam2 sets up expected param owner for UsageRepository.CountRequest
+func (mmCountRequest *mUsageRepositoryMockCountRequest) ExpectOwnerParam2(owner string) *mUsageRepositoryMockCountRequest {
+	if mmCountRequest.mock.funcCountRequest != nil {
+		mmCountRequest.mock.t.Fatalf("UsageRepositoryMock.CountRequest mock is already set by Set")
+	}
+
+	if mmCountRequest.defaultExpectation == nil {
+		mmCountRequest.defaultExpectation = &UsageRepositoryMockCountRequestExpectation{}
+	}
+
+	if mmCountRequest.defaultExpectation.params != nil {
+		mmCountRequest.mock.t.Fatalf("UsageRepositoryMock.CountRequest mock is already set by Expect")
+	}
+
+	if mmCountRequest.defaultExpectation.paramPtrs == nil {
+		mmCountRequest.defaultExpectation.paramPtrs = &UsageRepositoryMockCountRequestParamPtrs{}
+	}
+	mmCountRequest.defaultExpectation.paramPtrs.owner = &owner
+
+	return mmCountRequest
+}
+
+// ExpectDayParam3 sets up expected param day for UsageRepository.CountRequest
+func (mmCountRequest *mUsageRepositoryMockCountRequest) ExpectDayParam3(day time.Time) *mUsageRepositoryMockCountRequest {
+	if mmCountRequest.mock.funcCountRequest != nil {
+		mmCountRequest.mock.t.Fatalf("UsageRepositoryMock.CountRequest mock is already set by Set")
+	}
+
+	if mmCountRequest.defaultExpectation == nil {
+		mmCountRequest.defaultExpectation = &UsageRepositoryMockCountRequestExpectation{}
+	}
+
+	if mmCountRequest.defaultExpectation.params != nil {
+		mmCountRequest.mock.t.Fatalf("UsageRepositoryMock.CountRequest mock is already set by Expect")
+	}
+
+	if mmCountRequest.defaultExpectation.paramPtrs == nil {
+		mmCountRequest.defaultExpectation.paramPtrs = &UsageRepositoryMockCountRequestParamPtrs{}
+	}
+	mmCountRequest.defaultExpectation.paramPtrs.day = &day
+
+	return mmCountRequest
+}
+
+// Inspect accepts an inspector function that has same arguments as the UsageRepository.CountRequest
+func (mmCountRequest *mUsageRepositoryMockCountRequest) Inspect(f func(ctx context.Context, owner string, day time.Time)) *mUsageRepositoryMockCountRequest {
+	if mmCountRequest.mock.inspectFuncCountRequest != nil {
+		mmCountRequest.mock.t.Fatalf("Inspect function is already set for UsageRepositoryMock.CountRequest")
+	}
+
+	mmCountRequest.mock.inspectFuncCountRequest = f
+
+	return mmCountRequest
+}
+
+// Return sets up results that will be returned by UsageRepository.CountRequest
+func (mmCountRequest *mUsageRepositoryMockCountRequest) Return(i1 int64, err error) *UsageRepositoryMock {
+	if mmCountRequest.mock.funcCountRequest != nil {
+		mmCountRequest.mock.t.Fatalf("UsageRepositoryMock.CountRequest mock is already set by Set")
+	}
+
+	if mmCountRequest.defaultExpectation == nil {
+		mmCountRequest.defaultExpectation = &UsageRepositoryMockCountRequestExpectation{mock: mmCountRequest.mock}
+	}
+	mmCountRequest.defaultExpectation.results = &UsageRepositoryMockCountRequestResults{i1, err}
+	return mmCountRequest.mock
+}
+
+// Set uses given function f to mock the UsageRepository.CountRequest method
+func (mmCountRequest *mUsageRepositoryMockCountRequest) Set(f func(ctx context.Context, owner string, day time.Time) (i1 int64, err error)) *UsageRepositoryMock {
+	if mmCountRequest.defaultExpectation != nil {
+		mmCountRequest.mock.t.Fatalf("Default expectation is already set for the UsageRepository.CountRequest method")
+	}
+
+	if len(mmCountRequest.expectations) > 0 {
+		mmCountRequest.mock.t.Fatalf("Some expectations are already set for the UsageRepository.CountRequest method")
+	}
+
+	mmCountRequest.mock.funcCountRequest = f
+	return mmCountRequest.mock
+}
+
+// When sets expectation for the UsageRepository.CountRequest which will trigger the result defined by the following
+// Then helper
+func (mmCountRequest *mUsageRepositoryMockCountRequest) When(ctx context.Context, owner string, day time.Time) *UsageRepositoryMockCountRequestExpectation {
+	if mmCountRequest.mock.funcCountRequest != nil {
+		mmCountRequest.mock.t.Fatalf("UsageRepositoryMock.CountRequest mock is already set by Set")
+	}
+
+	expectation := &UsageRepositoryMockCountRequestExpectation{
+		mock:   mmCountRequest.mock,
+		params: &UsageRepositoryMockCountRequestParams{ctx, owner, day},
+	}
+	mmCountRequest.expectations = append(mmCountRequest.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UsageRepository.CountRequest return parameters for the expectation previously defined by the When method
+func (e *UsageRepositoryMockCountRequestExpectation) Then(i1 int64, err error) *UsageRepositoryMock {
+	e.results = &UsageRepositoryMockCountRequestResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times UsageRepository.CountRequest should be invoked
+func (mmCountRequest *mUsageRepositoryMockCountRequest) Times(n uint64) *mUsageRepositoryMockCountRequest {
+	if n == 0 {
+		mmCountRequest.mock.t.Fatalf("Times of UsageRepositoryMock.CountRequest mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCountRequest.expectedInvocations, n)
+	return mmCountRequest
+}
+
+func (mmCountRequest *mUsageRepositoryMockCountRequest) invocationsDone() bool {
+	if len(mmCountRequest.expectations) == 0 && mmCountRequest.defaultExpectation == nil && mmCountRequest.mock.funcCountRequest == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCountRequest.mock.afterCountRequestCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCountRequest.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CountRequest implements repository.UsageRepository
+func (mmCountRequest *UsageRepositoryMock) CountRequest(ctx context.Context, owner string, day time.Time) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCountRequest.beforeCountRequestCounter, 1)
+	defer mm_atomic.AddUint64(&mmCountRequest.afterCountRequestCounter, 1)
+
+	if mmCountRequest.inspectFuncCountRequest != nil {
+		mmCountRequest.inspectFuncCountRequest(ctx, owner, day)
+	}
+
+	mm_params := UsageRepositoryMockCountRequestParams{ctx, owner, day}
+
+	// Record call args
+	mmCountRequest.CountRequestMock.mutex.Lock()
+	mmCountRequest.CountRequestMock.callArgs = append(mmCountRequest.CountRequestMock.callArgs, &mm_params)
+	mmCountRequest.CountRequestMock.mutex.Unlock()
+
+	for _, e := range mmCountRequest.CountRequestMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCountRequest.CountRequestMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCountRequest.CountRequestMock.defaultExpectation.Counter, 1)
+		mm_want := mmCountRequest.CountRequestMock.defaultExpectation.params
+		mm_want_ptrs := mmCountRequest.CountRequestMock.defaultExpectation.paramPtrs
+
+		mm_got := UsageRepositoryMockCountRequestParams{ctx, owner, day}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCountRequest.t.Errorf("UsageRepositoryMock.CountRequest got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.owner != nil && !minimock.Equal(*mm_want_ptrs.owner, mm_got.owner) {
+				mmCountRequest.t.Errorf("UsageRepositoryMock.CountRequest got unexpected parameter owner, want: %#v, got: %#v%s\n", *mm_want_ptrs.owner, mm_got.owner, minimock.Diff(*mm_want_ptrs.owner, mm_got.owner))
+			}
+
+			if mm_want_ptrs.day != nil && !minimock.Equal(*mm_want_ptrs.day, mm_got.day) {
+				mmCountRequest.t.Errorf("UsageRepositoryMock.CountRequest got unexpected parameter day, want: %#v, got: %#v%s\n", *mm_want_ptrs.day, mm_got.day, minimock.Diff(*mm_want_ptrs.day, mm_got.day))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCountRequest.t.Errorf("UsageRepositoryMock.CountRequest got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCountRequest.CountRequestMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCountRequest.t.Fatal("No results are set for the UsageRepositoryMock.CountRequest")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCountRequest.funcCountRequest != nil {
+		return mmCountRequest.funcCountRequest(ctx, owner, day)
+	}
+	mmCountRequest.t.Fatalf("Unexpected call to UsageRepositoryMock.CountRequest. %v %v %v", ctx, owner, day)
+	return
+}
+
+// CountRequestAfterCounter returns a count of finished UsageRepositoryMock.CountRequest invocations
+func (mmCountRequest *UsageRepositoryMock) CountRequestAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountRequest.afterCountRequestCounter)
+}
+
+// CountRequestBeforeCounter returns a count of UsageRepositoryMock.CountRequest invocations
+func (mmCountRequest *UsageRepositoryMock) CountRequestBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountRequest.beforeCountRequestCounter)
+}
+
+// Calls returns a list of arguments used in each call to UsageRepositoryMock.CountRequest.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCountRequest *mUsageRepositoryMockCountRequest) Calls() []*UsageRepositoryMockCountRequestParams {
+	mmCountRequest.mutex.RLock()
+
+	argCopy := make([]*UsageRepositoryMockCountRequestParams, len(mmCountRequest.callArgs))
+	copy(argCopy, mmCountRequest.callArgs)
+
+	mmCountRequest.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCountRequestDone returns true if the count of the CountRequest invocations corresponds
+// the number of defined expectations
+func (m *UsageRepositoryMock) MinimockCountRequestDone() bool {
+	if m.CountRequestMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CountRequestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CountRequestMock.invocationsDone()
+}
+
+// MinimockCountRequestInspect logs each unmet expectation
+func (m *UsageRepositoryMock) MinimockCountRequestInspect() {
+	for _, e := range m.CountRequestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UsageRepositoryMock.CountRequest with params: %#v", *e.params)
+		}
+	}
+
+	afterCountRequestCounter := mm_atomic.LoadUint64(&m.afterCountRequestCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CountRequestMock.defaultExpectation != nil && afterCountRequestCounter < 1 {
+		if m.CountRequestMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UsageRepositoryMock.CountRequest")
+		} else {
+			m.t.Errorf("Expected call to UsageRepositoryMock.CountRequest with params: %#v", *m.CountRequestMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCountRequest != nil && afterCountRequestCounter < 1 {
+		m.t.Error("Expected call to UsageRepositoryMock.CountRequest")
+	}
+
+	if !m.CountRequestMock.invocationsDone() && afterCountRequestCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsageRepositoryMock.CountRequest but found %d calls",
+			mm_atomic.LoadUint64(&m.CountRequestMock.expectedInvocations), afterCountRequestCounter)
+	}
+}
+
+type mUsageRepositoryMockGet struct {
+	optional           bool
+	mock               *UsageRepositoryMock
+	defaultExpectation *UsageRepositoryMockGetExpectation
+	expectations       []*UsageRepositoryMockGetExpectation
+
+	callArgs []*UsageRepositoryMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// UsageRepositoryMockGetExpectation specifies expectation struct of the UsageRepository.Get
+type UsageRepositoryMockGetExpectation struct {
+	mock      *UsageRepositoryMock
+	params    *UsageRepositoryMockGetParams
+	paramPtrs *UsageRepositoryMockGetParamPtrs
+	results   *UsageRepositoryMockGetResults
+	Counter   uint64
+}
+
+// UsageRepositoryMockGetParams contains parameters of the UsageRepository.Get
+type UsageRepositoryMockGetParams struct {
+	ctx   context.Context
+	owner string
+}
+
+// UsageRepositoryMockGetParamPtrs contains pointers to parameters of the UsageRepository.Get
+type UsageRepositoryMockGetParamPtrs struct {
+	ctx   *context.Context
+	owner *string
+}
+
+// UsageRepositoryMockGetResults contains results of the UsageRepository.Get
+type UsageRepositoryMockGetResults struct {
+	up1 *model.Usage
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mUsageRepositoryMockGet) Optional() *mUsageRepositoryMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for UsageRepository.Get
+func (mmGet *mUsageRepositoryMockGet) Expect(ctx context.Context, owner string) *mUsageRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("UsageRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &UsageRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("UsageRepositoryMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &UsageRepositoryMockGetParams{ctx, owner}
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UsageRepository.Get
+func (mmGet *mUsageRepositoryMockGet) ExpectCtxParam1(ctx context.Context) *mUsageRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("UsageRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &UsageRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("UsageRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &UsageRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGet
+}
+
+// ExpectOwnerParam2 sets up expected param owner for UsageRepository.Get
+func (mmGet *mUsageRepositoryMockGet) ExpectOwnerParam2(owner string) *mUsageRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("UsageRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &UsageRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("UsageRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &UsageRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.owner = &owner
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the UsageRepository.Get
+func (mmGet *mUsageRepositoryMockGet) Inspect(f func(ctx context.Context, owner string)) *mUsageRepositoryMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for UsageRepositoryMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by UsageRepository.Get
+func (mmGet *mUsageRepositoryMockGet) Return(up1 *model.Usage, err error) *UsageRepositoryMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("UsageRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &UsageRepositoryMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &UsageRepositoryMockGetResults{up1, err}
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the UsageRepository.Get method
+func (mmGet *mUsageRepositoryMockGet) Set(f func(ctx context.Context, owner string) (up1 *model.Usage, err error)) *UsageRepositoryMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the UsageRepository.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the UsageRepository.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	return mmGet.mock
+}
+
+// When sets expectation for the UsageRepository.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mUsageRepositoryMockGet) When(ctx context.Context, owner string) *UsageRepositoryMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("UsageRepositoryMock.Get mock is already set by Set")
+	}
+
+	expectation := &UsageRepositoryMockGetExpectation{
+		mock:   mmGet.mock,
+		params: &UsageRepositoryMockGetParams{ctx, owner},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UsageRepository.Get return parameters for the expectation previously defined by the When method
+func (e *UsageRepositoryMockGetExpectation) Then(up1 *model.Usage, err error) *UsageRepositoryMock {
+	e.results = &UsageRepositoryMockGetResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times UsageRepository.Get should be invoked
+func (mmGet *mUsageRepositoryMockGet) Times(n uint64) *mUsageRepositoryMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of UsageRepositoryMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	return mmGet
+}
+
+func (mmGet *mUsageRepositoryMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements repository.UsageRepository
+func (mmGet *UsageRepositoryMock) Get(ctx context.Context, owner string) (up1 *model.Usage, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, owner)
+	}
+
+	mm_params := UsageRepositoryMockGetParams{ctx, owner}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := UsageRepositoryMockGetParams{ctx, owner}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("UsageRepositoryMock.Get got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.owner != nil && !minimock.Equal(*mm_want_ptrs.owner, mm_got.owner) {
+				mmGet.t.Errorf("UsageRepositoryMock.Get got unexpected parameter owner, want: %#v, got: %#v%s\n", *mm_want_ptrs.owner, mm_got.owner, minimock.Diff(*mm_want_ptrs.owner, mm_got.owner))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("UsageRepositoryMock.Get got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the UsageRepositoryMock.Get")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, owner)
+	}
+	mmGet.t.Fatalf("Unexpected call to UsageRepositoryMock.Get. %v %v", ctx, owner)
+	return
+}
+
+// GetAfterCounter returns a count of finished UsageRepositoryMock.Get invocations
+func (mmGet *UsageRepositoryMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of UsageRepositoryMock.Get invocations
+func (mmGet *UsageRepositoryMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to UsageRepositoryMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mUsageRepositoryMockGet) Calls() []*UsageRepositoryMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*UsageRepositoryMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *UsageRepositoryMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *UsageRepositoryMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UsageRepositoryMock.Get with params: %#v", *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UsageRepositoryMock.Get")
+		} else {
+			m.t.Errorf("Expected call to UsageRepositoryMock.Get with params: %#v", *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Error("Expected call to UsageRepositoryMock.Get")
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsageRepositoryMock.Get but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), afterGetCounter)
+	}
+}
+
+type mUsageRepositoryMockGetRequests struct {
+	optional           bool
+	mock               *UsageRepositoryMock
+	defaultExpectation *UsageRepositoryMockGetRequestsExpectation
+	expectations       []*UsageRepositoryMockGetRequestsExpectation
+
+	callArgs []*UsageRepositoryMockGetRequestsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// UsageRepositoryMockGetRequestsExpectation specifies expectation struct of the UsageRepository.GetRequests
+type UsageRepositoryMockGetRequestsExpectation struct {
+	mock      *UsageRepositoryMock
+	params    *UsageRepositoryMockGetRequestsParams
+	paramPtrs *UsageRepositoryMockGetRequestsParamPtrs
+	results   *UsageRepositoryMockGetRequestsResults
+	Counter   uint64
+}
+
+// UsageRepositoryMockGetRequestsParams contains parameters of the UsageRepository.GetRequests
+type UsageRepositoryMockGetRequestsParams struct {
+	ctx   context.Context
+	owner string
+	day   time.Time
+}
+
+// UsageRepositoryMockGetRequestsParamPtrs contains pointers to parameters of the UsageRepository.GetRequests
+type UsageRepositoryMockGetRequestsParamPtrs struct {
+	ctx   *context.Context
+	owner *string
+	day   *time.Time
+}
+
+// UsageRepositoryMockGetRequestsResults contains results of the UsageRepository.GetRequests
+type UsageRepositoryMockGetRequestsResults struct {
+	i1  int64
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetRequests *mUsageRepositoryMockGetRequests) Optional() *mUsageRepositoryMockGetRequests {
+	mmGetRequests.optional = true
+	return mmGetRequests
+}
+
+// Expect sets up expected params for UsageRepository.GetRequests
+func (mmGetRequests *mUsageRepositoryMockGetRequests) Expect(ctx context.Context, owner string, day time.Time) *mUsageRepositoryMockGetRequests {
+	if mmGetRequests.mock.funcGetRequests != nil {
+		mmGetRequests.mock.t.Fatalf("UsageRepositoryMock.GetRequests mock is already set by Set")
+	}
+
+	if mmGetRequests.defaultExpectation == nil {
+		mmGetRequests.defaultExpectation = &UsageRepositoryMockGetRequestsExpectation{}
+	}
+
+	if mmGetRequests.defaultExpectation.paramPtrs != nil {
+		mmGetRequests.mock.t.Fatalf("UsageRepositoryMock.GetRequests mock is already set by ExpectParams functions")
+	}
+
+	mmGetRequests.defaultExpectation.params = &UsageRepositoryMockGetRequestsParams{ctx, owner, day}
+	for _, e := range mmGetRequests.expectations {
+		if minimock.Equal(e.params, mmGetRequests.defaultExpectation.params) {
+			mmGetRequests.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetRequests.defaultExpectation.params)
+		}
+	}
+
+	return mmGetRequests
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UsageRepository.GetRequests
+func (mmGetRequests *mUsageRepositoryMockGetRequests) ExpectCtxParam1(ctx context.Context) *mUsageRepositoryMockGetRequests {
+	if mmGetRequests.mock.funcGetRequests != nil {
+		mmGetRequests.mock.t.Fatalf("UsageRepositoryMock.GetRequests mock is already set by Set")
+	}
+
+	if mmGetRequests.defaultExpectation == nil {
+		mmGetRequests.defaultExpectation = &UsageRepositoryMockGetRequestsExpectation{}
+	}
+
+	if mmGetRequests.defaultExpectation.params != nil {
+		mmGetRequests.mock.t.Fatalf("UsageRepositoryMock.GetRequests mock is already set by Expect")
+	}
+
+	if mmGetRequests.defaultExpectation.paramPtrs == nil {
+		mmGetRequests.defaultExpectation.paramPtrs = &UsageRepositoryMockGetRequestsParamPtrs{}
+	}
+	mmGetRequests.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetRequests
+}
+
+// ExpectOwnerParam2 sets up expected param owner for UsageRepository.GetRequests
+func (mmGetRequests *mUsageRepositoryMockGetRequests) ExpectOwnerParam2(owner string) *mUsageRepositoryMockGetRequests {
+	if mmGetRequests.mock.funcGetRequests != nil {
+		mmGetRequests.mock.t.Fatalf("UsageRepositoryMock.GetRequests mock is already set by Set")
+	}
+
+	if mmGetRequests.defaultExpectation == nil {
+		mmGetRequests.defaultExpectation = &UsageRepositoryMockGetRequestsExpectation{}
+	}
+
+	if mmGetRequests.defaultExpectation.params != nil {
+		mmGetRequests.mock.t.Fatalf("UsageRepositoryMock.GetRequests mock is already set by Expect")
+	}
+
+	if mmGetRequests.defaultExpectation.paramPtrs == nil {
+		mmGetRequests.defaultExpectation.paramPtrs = &UsageRepositoryMockGetRequestsParamPtrs{}
+	}
+	mmGetRequests.defaultExpectation.paramPtrs.owner = &owner
+
+	return mmGetRequests
+}
+
+// ExpectDayParam3 sets up expected param day for UsageRepository.GetRequests
+func (mmGetRequests *mUsageRepositoryMockGetRequests) ExpectDayParam3(day time.Time) *mUsageRepositoryMockGetRequests {
+	if mmGetRequests.mock.funcGetRequests != nil {
+		mmGetRequests.mock.t.Fatalf("UsageRepositoryMock.GetRequests mock is already set by Set")
+	}
+
+	if mmGetRequests.defaultExpectation == nil {
+		mmGetRequests.defaultExpectation = &UsageRepositoryMockGetRequestsExpectation{}
+	}
+
+	if mmGetRequests.defaultExpectation.params != nil {
+		mmGetRequests.mock.t.Fatalf("UsageRepositoryMock.GetRequests mock is already set by Expect")
+	}
+
+	if mmGetRequests.defaultExpectation.paramPtrs == nil {
+		mmGetRequests.defaultExpectation.paramPtrs = &UsageRepositoryMockGetRequestsParamPtrs{}
+	}
+	mmGetRequests.defaultExpectation.paramPtrs.day = &day
+
+	return mmGetRequests
+}
+
+// Inspect accepts an inspector function that has same arguments as the UsageRepository.GetRequests
+func (mmGetRequests *mUsageRepositoryMockGetRequests) Inspect(f func(ctx context.Context, owner string, day time.Time)) *mUsageRepositoryMockGetRequests {
+	if mmGetRequests.mock.inspectFuncGetRequests != nil {
+		mmGetRequests.mock.t.Fatalf("Inspect function is already set for UsageRepositoryMock.GetRequests")
+	}
+
+	mmGetRequests.mock.inspectFuncGetRequests = f
+
+	return mmGetRequests
+}
+
+// Return sets up results that will be returned by UsageRepository.GetRequests
+func (mmGetRequests *mUsageRepositoryMockGetRequests) Return(i1 int64, err error) *UsageRepositoryMock {
+	if mmGetRequests.mock.funcGetRequests != nil {
+		mmGetRequests.mock.t.Fatalf("UsageRepositoryMock.GetRequests mock is already set by Set")
+	}
+
+	if mmGetRequests.defaultExpectation == nil {
+		mmGetRequests.defaultExpectation = &UsageRepositoryMockGetRequestsExpectation{mock: mmGetRequests.mock}
+	}
+	mmGetRequests.defaultExpectation.results = &UsageRepositoryMockGetRequestsResults{i1, err}
+	return mmGetRequests.mock
+}
+
+// Set uses given function f to mock the UsageRepository.GetRequests method
+func (mmGetRequests *mUsageRepositoryMockGetRequests) Set(f func(ctx context.Context, owner string, day time.Time) (i1 int64, err error)) *UsageRepositoryMock {
+	if mmGetRequests.defaultExpectation != nil {
+		mmGetRequests.mock.t.Fatalf("Default expectation is already set for the UsageRepository.GetRequests method")
+	}
+
+	if len(mmGetRequests.expectations) > 0 {
+		mmGetRequests.mock.t.Fatalf("Some expectations are already set for the UsageRepository.GetRequests method")
+	}
+
+	mmGetRequests.mock.funcGetRequests = f
+	return mmGetRequests.mock
+}
+
+// When sets expectation for the UsageRepository.GetRequests which will trigger the result defined by the following
+// Then helper
+func (mmGetRequests *mUsageRepositoryMockGetRequests) When(ctx context.Context, owner string, day time.Time) *UsageRepositoryMockGetRequestsExpectation {
+	if mmGetRequests.mock.funcGetRequests != nil {
+		mmGetRequests.mock.t.Fatalf("UsageRepositoryMock.GetRequests mock is already set by Set")
+	}
+
+	expectation := &UsageRepositoryMockGetRequestsExpectation{
+		mock:   mmGetRequests.mock,
+		params: &UsageRepositoryMockGetRequestsParams{ctx, owner, day},
+	}
+	mmGetRequests.expectations = append(mmGetRequests.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UsageRepository.GetRequests return parameters for the expectation previously defined by the When method
+func (e *UsageRepositoryMockGetRequestsExpectation) Then(i1 int64, err error) *UsageRepositoryMock {
+	e.results = &UsageRepositoryMockGetRequestsResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times UsageRepository.GetRequests should be invoked
+func (mmGetRequests *mUsageRepositoryMockGetRequests) Times(n uint64) *mUsageRepositoryMockGetRequests {
+	if n == 0 {
+		mmGetRequests.mock.t.Fatalf("Times of UsageRepositoryMock.GetRequests mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetRequests.expectedInvocations, n)
+	return mmGetRequests
+}
+
+func (mmGetRequests *mUsageRepositoryMockGetRequests) invocationsDone() bool {
+	if len(mmGetRequests.expectations) == 0 && mmGetRequests.defaultExpectation == nil && mmGetRequests.mock.funcGetRequests == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetRequests.mock.afterGetRequestsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetRequests.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetRequests implements repository.UsageRepository
+func (mmGetRequests *UsageRepositoryMock) GetRequests(ctx context.Context, owner string, day time.Time) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmGetRequests.beforeGetRequestsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetRequests.afterGetRequestsCounter, 1)
+
+	if mmGetRequests.inspectFuncGetRequests != nil {
+		mmGetRequests.inspectFuncGetRequests(ctx, owner, day)
+	}
+
+	mm_params := UsageRepositoryMockGetRequestsParams{ctx, owner, day}
+
+	// Record call args
+	mmGetRequests.GetRequestsMock.mutex.Lock()
+	mmGetRequests.GetRequestsMock.callArgs = append(mmGetRequests.GetRequestsMock.callArgs, &mm_params)
+	mmGetRequests.GetRequestsMock.mutex.Unlock()
+
+	for _, e := range mmGetRequests.GetRequestsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmGetRequests.GetRequestsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetRequests.GetRequestsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetRequests.GetRequestsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetRequests.GetRequestsMock.defaultExpectation.paramPtrs
+
+		mm_got := UsageRepositoryMockGetRequestsParams{ctx, owner, day}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetRequests.t.Errorf("UsageRepositoryMock.GetRequests got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.owner != nil && !minimock.Equal(*mm_want_ptrs.owner, mm_got.owner) {
+				mmGetRequests.t.Errorf("UsageRepositoryMock.GetRequests got unexpected parameter owner, want: %#v, got: %#v%s\n", *mm_want_ptrs.owner, mm_got.owner, minimock.Diff(*mm_want_ptrs.owner, mm_got.owner))
+			}
+
+			if mm_want_ptrs.day != nil && !minimock.Equal(*mm_want_ptrs.day, mm_got.day) {
+				mmGetRequests.t.Errorf("UsageRepositoryMock.GetRequests got unexpected parameter day, want: %#v, got: %#v%s\n", *mm_want_ptrs.day, mm_got.day, minimock.Diff(*mm_want_ptrs.day, mm_got.day))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetRequests.t.Errorf("UsageRepositoryMock.GetRequests got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetRequests.GetRequestsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetRequests.t.Fatal("No results are set for the UsageRepositoryMock.GetRequests")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmGetRequests.funcGetRequests != nil {
+		return mmGetRequests.funcGetRequests(ctx, owner, day)
+	}
+	mmGetRequests.t.Fatalf("Unexpected call to UsageRepositoryMock.GetRequests. %v %v %v", ctx, owner, day)
+	return
+}
+
+// GetRequestsAfterCounter returns a count of finished UsageRepositoryMock.GetRequests invocations
+func (mmGetRequests *UsageRepositoryMock) GetRequestsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRequests.afterGetRequestsCounter)
+}
+
+// GetRequestsBeforeCounter returns a count of UsageRepositoryMock.GetRequests invocations
+func (mmGetRequests *UsageRepositoryMock) GetRequestsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRequests.beforeGetRequestsCounter)
+}
+
+// Calls returns a list of arguments used in each call to UsageRepositoryMock.GetRequests.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetRequests *mUsageRepositoryMockGetRequests) Calls() []*UsageRepositoryMockGetRequestsParams {
+	mmGetRequests.mutex.RLock()
+
+	argCopy := make([]*UsageRepositoryMockGetRequestsParams, len(mmGetRequests.callArgs))
+	copy(argCopy, mmGetRequests.callArgs)
+
+	mmGetRequests.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetRequestsDone returns true if the count of the GetRequests invocations corresponds
+// the number of defined expectations
+func (m *UsageRepositoryMock) MinimockGetRequestsDone() bool {
+	if m.GetRequestsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetRequestsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetRequestsMock.invocationsDone()
+}
+
+// MinimockGetRequestsInspect logs each unmet expectation
+func (m *UsageRepositoryMock) MinimockGetRequestsInspect() {
+	for _, e := range m.GetRequestsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UsageRepositoryMock.GetRequests with params: %#v", *e.params)
+		}
+	}
+
+	afterGetRequestsCounter := mm_atomic.LoadUint64(&m.afterGetRequestsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetRequestsMock.defaultExpectation != nil && afterGetRequestsCounter < 1 {
+		if m.GetRequestsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UsageRepositoryMock.GetRequests")
+		} else {
+			m.t.Errorf("Expected call to UsageRepositoryMock.GetRequests with params: %#v", *m.GetRequestsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetRequests != nil && afterGetRequestsCounter < 1 {
+		m.t.Error("Expected call to UsageRepositoryMock.GetRequests")
+	}
+
+	if !m.GetRequestsMock.invocationsDone() && afterGetRequestsCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsageRepositoryMock.GetRequests but found %d calls",
+			mm_atomic.LoadUint64(&m.GetRequestsMock.expectedInvocations), afterGetRequestsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *UsageRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddInspect()
+
+			m.MinimockCountRequestInspect()
+
+			m.MinimockGetInspect()
+
+			m.MinimockGetRequestsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *UsageRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *UsageRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddDone() &&
+		m.MinimockCountRequestDone() &&
+		m.MinimockGetDone() &&
+		m.MinimockGetRequestsDone()
+}
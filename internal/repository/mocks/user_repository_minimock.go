@@ -0,0 +1,3166 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.13). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i di_container/internal/repository.UserRepository -o user_repository_minimock.go -n UserRepositoryMock -p mocks
+
+import (
+	"context"
+	"di_container/internal/model"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// UserRepositoryMock implements repository.UserRepository
+type UserRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcEnableTOTP          func(ctx context.Context, id int64) (err error)
+	inspectFuncEnableTOTP   func(ctx context.Context, id int64)
+	afterEnableTOTPCounter  uint64
+	beforeEnableTOTPCounter uint64
+	EnableTOTPMock          mUserRepositoryMockEnableTOTP
+
+	funcGet          func(ctx context.Context, id int64) (up1 *model.User, err error)
+	inspectFuncGet   func(ctx context.Context, id int64)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mUserRepositoryMockGet
+
+	funcGetByEmail          func(ctx context.Context, email string) (up1 *model.User, err error)
+	inspectFuncGetByEmail   func(ctx context.Context, email string)
+	afterGetByEmailCounter  uint64
+	beforeGetByEmailCounter uint64
+	GetByEmailMock          mUserRepositoryMockGetByEmail
+
+	funcGetByUsername          func(ctx context.Context, username string) (up1 *model.User, err error)
+	inspectFuncGetByUsername   func(ctx context.Context, username string)
+	afterGetByUsernameCounter  uint64
+	beforeGetByUsernameCounter uint64
+	GetByUsernameMock          mUserRepositoryMockGetByUsername
+
+	funcSetEmailVerified          func(ctx context.Context, id int64) (err error)
+	inspectFuncSetEmailVerified   func(ctx context.Context, id int64)
+	afterSetEmailVerifiedCounter  uint64
+	beforeSetEmailVerifiedCounter uint64
+	SetEmailVerifiedMock          mUserRepositoryMockSetEmailVerified
+
+	funcSetTOTPSecret          func(ctx context.Context, id int64, encryptedSecret string) (err error)
+	inspectFuncSetTOTPSecret   func(ctx context.Context, id int64, encryptedSecret string)
+	afterSetTOTPSecretCounter  uint64
+	beforeSetTOTPSecretCounter uint64
+	SetTOTPSecretMock          mUserRepositoryMockSetTOTPSecret
+
+	funcUpdatePassword          func(ctx context.Context, id int64, passwordHash string) (err error)
+	inspectFuncUpdatePassword   func(ctx context.Context, id int64, passwordHash string)
+	afterUpdatePasswordCounter  uint64
+	beforeUpdatePasswordCounter uint64
+	UpdatePasswordMock          mUserRepositoryMockUpdatePassword
+
+	funcUpdateRole          func(ctx context.Context, id int64, role string) (err error)
+	inspectFuncUpdateRole   func(ctx context.Context, id int64, role string)
+	afterUpdateRoleCounter  uint64
+	beforeUpdateRoleCounter uint64
+	UpdateRoleMock          mUserRepositoryMockUpdateRole
+
+	funcUseTOTPStep          func(ctx context.Context, id int64, step int64) (b1 bool, err error)
+	inspectFuncUseTOTPStep   func(ctx context.Context, id int64, step int64)
+	afterUseTOTPStepCounter  uint64
+	beforeUseTOTPStepCounter uint64
+	UseTOTPStepMock          mUserRepositoryMockUseTOTPStep
+}
+
+// NewUserRepositoryMock returns a mock for repository.UserRepository
+func NewUserRepositoryMock(t minimock.Tester) *UserRepositoryMock {
+	m := &UserRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.EnableTOTPMock = mUserRepositoryMockEnableTOTP{mock: m}
+	m.EnableTOTPMock.callArgs = []*UserRepositoryMockEnableTOTPParams{}
+
+	m.GetMock = mUserRepositoryMockGet{mock: m}
+	m.GetMock.callArgs = []*UserRepositoryMockGetParams{}
+
+	m.GetByEmailMock = mUserRepositoryMockGetByEmail{mock: m}
+	m.GetByEmailMock.callArgs = []*UserRepositoryMockGetByEmailParams{}
+
+	m.GetByUsernameMock = mUserRepositoryMockGetByUsername{mock: m}
+	m.GetByUsernameMock.callArgs = []*UserRepositoryMockGetByUsernameParams{}
+
+	m.SetEmailVerifiedMock = mUserRepositoryMockSetEmailVerified{mock: m}
+	m.SetEmailVerifiedMock.callArgs = []*UserRepositoryMockSetEmailVerifiedParams{}
+
+	m.SetTOTPSecretMock = mUserRepositoryMockSetTOTPSecret{mock: m}
+	m.SetTOTPSecretMock.callArgs = []*UserRepositoryMockSetTOTPSecretParams{}
+
+	m.UpdatePasswordMock = mUserRepositoryMockUpdatePassword{mock: m}
+	m.UpdatePasswordMock.callArgs = []*UserRepositoryMockUpdatePasswordParams{}
+
+	m.UpdateRoleMock = mUserRepositoryMockUpdateRole{mock: m}
+	m.UpdateRoleMock.callArgs = []*UserRepositoryMockUpdateRoleParams{}
+
+	m.UseTOTPStepMock = mUserRepositoryMockUseTOTPStep{mock: m}
+	m.UseTOTPStepMock.callArgs = []*UserRepositoryMockUseTOTPStepParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mUserRepositoryMockEnableTOTP struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockEnableTOTPExpectation
+	expectations       []*UserRepositoryMockEnableTOTPExpectation
+
+	callArgs []*UserRepositoryMockEnableTOTPParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// UserRepositoryMockEnableTOTPExpectation specifies expectation struct of the UserRepository.EnableTOTP
+type UserRepositoryMockEnableTOTPExpectation struct {
+	mock      *UserRepositoryMock
+	params    *UserRepositoryMockEnableTOTPParams
+	paramPtrs *UserRepositoryMockEnableTOTPParamPtrs
+	results   *UserRepositoryMockEnableTOTPResults
+	Counter   uint64
+}
+
+// UserRepositoryMockEnableTOTPParams contains parameters of the UserRepository.EnableTOTP
+type UserRepositoryMockEnableTOTPParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// UserRepositoryMockEnableTOTPParamPtrs contains pointers to parameters of the UserRepository.EnableTOTP
+type UserRepositoryMockEnableTOTPParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// UserRepositoryMockEnableTOTPResults contains results of the UserRepository.EnableTOTP
+type UserRepositoryMockEnableTOTPResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmEnableTOTP *mUserRepositoryMockEnableTOTP) Optional() *mUserRepositoryMockEnableTOTP {
+	mmEnableTOTP.optional = true
+	return mmEnableTOTP
+}
+
+// Expect sets up expected params for UserRepository.EnableTOTP
+func (mmEnableTOTP *mUserRepositoryMockEnableTOTP) Expect(ctx context.Context, id int64) *mUserRepositoryMockEnableTOTP {
+	if mmEnableTOTP.mock.funcEnableTOTP != nil {
+		mmEnableTOTP.mock.t.Fatalf("UserRepositoryMock.EnableTOTP mock is already set by Set")
+	}
+
+	if mmEnableTOTP.defaultExpectation == nil {
+		mmEnableTOTP.defaultExpectation = &UserRepositoryMockEnableTOTPExpectation{}
+	}
+
+	if mmEnableTOTP.defaultExpectation.paramPtrs != nil {
+		mmEnableTOTP.mock.t.Fatalf("UserRepositoryMock.EnableTOTP mock is already set by ExpectParams functions")
+	}
+
+	mmEnableTOTP.defaultExpectation.params = &UserRepositoryMockEnableTOTPParams{ctx, id}
+	for _, e := range mmEnableTOTP.expectations {
+		if minimock.Equal(e.params, mmEnableTOTP.defaultExpectation.params) {
+			mmEnableTOTP.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmEnableTOTP.defaultExpectation.params)
+		}
+	}
+
+	return mmEnableTOTP
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.EnableTOTP
+func (mmEnableTOTP *mUserRepositoryMockEnableTOTP) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockEnableTOTP {
+	if mmEnableTOTP.mock.funcEnableTOTP != nil {
+		mmEnableTOTP.mock.t.Fatalf("UserRepositoryMock.EnableTOTP mock is already set by Set")
+	}
+
+	if mmEnableTOTP.defaultExpectation == nil {
+		mmEnableTOTP.defaultExpectation = &UserRepositoryMockEnableTOTPExpectation{}
+	}
+
+	if mmEnableTOTP.defaultExpectation.params != nil {
+		mmEnableTOTP.mock.t.Fatalf("UserRepositoryMock.EnableTOTP mock is already set by Expect")
+	}
+
+	if mmEnableTOTP.defaultExpectation.paramPtrs == nil {
+		mmEnableTOTP.defaultExpectation.paramPtrs = &UserRepositoryMockEnableTOTPParamPtrs{}
+	}
+	mmEnableTOTP.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmEnableTOTP
+}
+
+// ExpectIdParam2 sets up expected param id for UserRepository.EnableTOTP
+func (mmEnableTOTP *mUserRepositoryMockEnableTOTP) ExpectIdParam2(id int64) *mUserRepositoryMockEnableTOTP {
+	if mmEnableTOTP.mock.funcEnableTOTP != nil {
+		mmEnableTOTP.mock.t.Fatalf("UserRepositoryMock.EnableTOTP mock is already set by Set")
+	}
+
+	if mmEnableTOTP.defaultExpectation == nil {
+		mmEnableTOTP.defaultExpectation = &UserRepositoryMockEnableTOTPExpectation{}
+	}
+
+	if mmEnableTOTP.defaultExpectation.params != nil {
+		mmEnableTOTP.mock.t.Fatalf("UserRepositoryMock.EnableTOTP mock is already set by Expect")
+	}
+
+	if mmEnableTOTP.defaultExpectation.paramPtrs == nil {
+		mmEnableTOTP.defaultExpectation.paramPtrs = &UserRepositoryMockEnableTOTPParamPtrs{}
+	}
+	mmEnableTOTP.defaultExpectation.paramPtrs.id = &id
+
+	return mmEnableTOTP
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.EnableTOTP
+func (mmEnableTOTP *mUserRepositoryMockEnableTOTP) Inspect(f func(ctx context.Context, id int64)) *mUserRepositoryMockEnableTOTP {
+	if mmEnableTOTP.mock.inspectFuncEnableTOTP != nil {
+		mmEnableTOTP.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.EnableTOTP")
+	}
+
+	mmEnableTOTP.mock.inspectFuncEnableTOTP = f
+
+	return mmEnableTOTP
+}
+
+// Return sets up results that will be returned by UserRepository.EnableTOTP
+func (mmEnableTOTP *mUserRepositoryMockEnableTOTP) Return(err error) *UserRepositoryMock {
+	if mmEnableTOTP.mock.funcEnableTOTP != nil {
+		mmEnableTOTP.mock.t.Fatalf("UserRepositoryMock.EnableTOTP mock is already set by Set")
+	}
+
+	if mmEnableTOTP.defaultExpectation == nil {
+		mmEnableTOTP.defaultExpectation = &UserRepositoryMockEnableTOTPExpectation{mock: mmEnableTOTP.mock}
+	}
+	mmEnableTOTP.defaultExpectation.results = &UserRepositoryMockEnableTOTPResults{err}
+	return mmEnableTOTP.mock
+}
+
+// Set uses given function f to mock the UserRepository.EnableTOTP method
+func (mmEnableTOTP *mUserRepositoryMockEnableTOTP) Set(f func(ctx context.Context, id int64) (err error)) *UserRepositoryMock {
+	if mmEnableTOTP.defaultExpectation != nil {
+		mmEnableTOTP.mock.t.Fatalf("Default expectation is already set for the UserRepository.EnableTOTP method")
+	}
+
+	if len(mmEnableTOTP.expectations) > 0 {
+		mmEnableTOTP.mock.t.Fatalf("Some expectations are already set for the UserRepository.EnableTOTP method")
+	}
+
+	mmEnableTOTP.mock.funcEnableTOTP = f
+	return mmEnableTOTP.mock
+}
+
+// When sets expectation for the UserRepository.EnableTOTP which will trigger the result defined by the following
+// Then helper
+func (mmEnableTOTP *mUserRepositoryMockEnableTOTP) When(ctx context.Context, id int64) *UserRepositoryMockEnableTOTPExpectation {
+	if mmEnableTOTP.mock.funcEnableTOTP != nil {
+		mmEnableTOTP.mock.t.Fatalf("UserRepositoryMock.EnableTOTP mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockEnableTOTPExpectation{
+		mock:   mmEnableTOTP.mock,
+		params: &UserRepositoryMockEnableTOTPParams{ctx, id},
+	}
+	mmEnableTOTP.expectations = append(mmEnableTOTP.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.EnableTOTP return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockEnableTOTPExpectation) Then(err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockEnableTOTPResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.EnableTOTP should be invoked
+func (mmEnableTOTP *mUserRepositoryMockEnableTOTP) Times(n uint64) *mUserRepositoryMockEnableTOTP {
+	if n == 0 {
+		mmEnableTOTP.mock.t.Fatalf("Times of UserRepositoryMock.EnableTOTP mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmEnableTOTP.expectedInvocations, n)
+	return mmEnableTOTP
+}
+
+func (mmEnableTOTP *mUserRepositoryMockEnableTOTP) invocationsDone() bool {
+	if len(mmEnableTOTP.expectations) == 0 && mmEnableTOTP.defaultExpectation == nil && mmEnableTOTP.mock.funcEnableTOTP == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmEnableTOTP.mock.afterEnableTOTPCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmEnableTOTP.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// EnableTOTP implements repository.UserRepository
+func (mmEnableTOTP *UserRepositoryMock) EnableTOTP(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmEnableTOTP.beforeEnableTOTPCounter, 1)
+	defer mm_atomic.AddUint64(&mmEnableTOTP.afterEnableTOTPCounter, 1)
+
+	if mmEnableTOTP.inspectFuncEnableTOTP != nil {
+		mmEnableTOTP.inspectFuncEnableTOTP(ctx, id)
+	}
+
+	mm_params := UserRepositoryMockEnableTOTPParams{ctx, id}
+
+	// Record call args
+	mmEnableTOTP.EnableTOTPMock.mutex.Lock()
+	mmEnableTOTP.EnableTOTPMock.callArgs = append(mmEnableTOTP.EnableTOTPMock.callArgs, &mm_params)
+	mmEnableTOTP.EnableTOTPMock.mutex.Unlock()
+
+	for _, e := range mmEnableTOTP.EnableTOTPMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmEnableTOTP.EnableTOTPMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmEnableTOTP.EnableTOTPMock.defaultExpectation.Counter, 1)
+		mm_want := mmEnableTOTP.EnableTOTPMock.defaultExpectation.params
+		mm_want_ptrs := mmEnableTOTP.EnableTOTPMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockEnableTOTPParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmEnableTOTP.t.Errorf("UserRepositoryMock.EnableTOTP got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmEnableTOTP.t.Errorf("UserRepositoryMock.EnableTOTP got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmEnableTOTP.t.Errorf("UserRepositoryMock.EnableTOTP got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmEnableTOTP.EnableTOTPMock.defaultExpectation.results
+		if mm_results == nil {
+			mmEnableTOTP.t.Fatal("No results are set for the UserRepositoryMock.EnableTOTP")
+		}
+		return (*mm_results).err
+	}
+	if mmEnableTOTP.funcEnableTOTP != nil {
+		return mmEnableTOTP.funcEnableTOTP(ctx, id)
+	}
+	mmEnableTOTP.t.Fatalf("Unexpected call to UserRepositoryMock.EnableTOTP. %v %v", ctx, id)
+	return
+}
+
+// EnableTOTPAfterCounter returns a count of finished UserRepositoryMock.EnableTOTP invocations
+func (mmEnableTOTP *UserRepositoryMock) EnableTOTPAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEnableTOTP.afterEnableTOTPCounter)
+}
+
+// EnableTOTPBeforeCounter returns a count of UserRepositoryMock.EnableTOTP invocations
+func (mmEnableTOTP *UserRepositoryMock) EnableTOTPBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEnableTOTP.beforeEnableTOTPCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.EnableTOTP.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmEnableTOTP *mUserRepositoryMockEnableTOTP) Calls() []*UserRepositoryMockEnableTOTPParams {
+	mmEnableTOTP.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockEnableTOTPParams, len(mmEnableTOTP.callArgs))
+	copy(argCopy, mmEnableTOTP.callArgs)
+
+	mmEnableTOTP.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockEnableTOTPDone returns true if the count of the EnableTOTP invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockEnableTOTPDone() bool {
+	if m.EnableTOTPMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.EnableTOTPMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.EnableTOTPMock.invocationsDone()
+}
+
+// MinimockEnableTOTPInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockEnableTOTPInspect() {
+	for _, e := range m.EnableTOTPMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.EnableTOTP with params: %#v", *e.params)
+		}
+	}
+
+	afterEnableTOTPCounter := mm_atomic.LoadUint64(&m.afterEnableTOTPCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EnableTOTPMock.defaultExpectation != nil && afterEnableTOTPCounter < 1 {
+		if m.EnableTOTPMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserRepositoryMock.EnableTOTP")
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.EnableTOTP with params: %#v", *m.EnableTOTPMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcEnableTOTP != nil && afterEnableTOTPCounter < 1 {
+		m.t.Error("Expected call to UserRepositoryMock.EnableTOTP")
+	}
+
+	if !m.EnableTOTPMock.invocationsDone() && afterEnableTOTPCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.EnableTOTP but found %d calls",
+			mm_atomic.LoadUint64(&m.EnableTOTPMock.expectedInvocations), afterEnableTOTPCounter)
+	}
+}
+
+type mUserRepositoryMockGet struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockGetExpectation
+	expectations       []*UserRepositoryMockGetExpectation
+
+	callArgs []*UserRepositoryMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// UserRepositoryMockGetExpectation specifies expectation struct of the UserRepository.Get
+type UserRepositoryMockGetExpectation struct {
+	mock      *UserRepositoryMock
+	params    *UserRepositoryMockGetParams
+	paramPtrs *UserRepositoryMockGetParamPtrs
+	results   *UserRepositoryMockGetResults
+	Counter   uint64
+}
+
+// UserRepositoryMockGetParams contains parameters of the UserRepository.Get
+type UserRepositoryMockGetParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// UserRepositoryMockGetParamPtrs contains pointers to parameters of the UserRepository.Get
+type UserRepositoryMockGetParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// UserRepositoryMockGetResults contains results of the UserRepository.Get
+type UserRepositoryMockGetResults struct {
+	up1 *model.User
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mUserRepositoryMockGet) Optional() *mUserRepositoryMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for UserRepository.Get
+func (mmGet *mUserRepositoryMockGet) Expect(ctx context.Context, id int64) *mUserRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("UserRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &UserRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("UserRepositoryMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &UserRepositoryMockGetParams{ctx, id}
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.Get
+func (mmGet *mUserRepositoryMockGet) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("UserRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &UserRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("UserRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &UserRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGet
+}
+
+// ExpectIdParam2 sets up expected param id for UserRepository.Get
+func (mmGet *mUserRepositoryMockGet) ExpectIdParam2(id int64) *mUserRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("UserRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &UserRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("UserRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &UserRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.id = &id
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.Get
+func (mmGet *mUserRepositoryMockGet) Inspect(f func(ctx context.Context, id int64)) *mUserRepositoryMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by UserRepository.Get
+func (mmGet *mUserRepositoryMockGet) Return(up1 *model.User, err error) *UserRepositoryMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("UserRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &UserRepositoryMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &UserRepositoryMockGetResults{up1, err}
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the UserRepository.Get method
+func (mmGet *mUserRepositoryMockGet) Set(f func(ctx context.Context, id int64) (up1 *model.User, err error)) *UserRepositoryMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the UserRepository.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the UserRepository.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	return mmGet.mock
+}
+
+// When sets expectation for the UserRepository.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mUserRepositoryMockGet) When(ctx context.Context, id int64) *UserRepositoryMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("UserRepositoryMock.Get mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockGetExpectation{
+		mock:   mmGet.mock,
+		params: &UserRepositoryMockGetParams{ctx, id},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.Get return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockGetExpectation) Then(up1 *model.User, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockGetResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.Get should be invoked
+func (mmGet *mUserRepositoryMockGet) Times(n uint64) *mUserRepositoryMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of UserRepositoryMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	return mmGet
+}
+
+func (mmGet *mUserRepositoryMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements repository.UserRepository
+func (mmGet *UserRepositoryMock) Get(ctx context.Context, id int64) (up1 *model.User, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, id)
+	}
+
+	mm_params := UserRepositoryMockGetParams{ctx, id}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockGetParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("UserRepositoryMock.Get got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGet.t.Errorf("UserRepositoryMock.Get got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("UserRepositoryMock.Get got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the UserRepositoryMock.Get")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, id)
+	}
+	mmGet.t.Fatalf("Unexpected call to UserRepositoryMock.Get. %v %v", ctx, id)
+	return
+}
+
+// GetAfterCounter returns a count of finished UserRepositoryMock.Get invocations
+func (mmGet *UserRepositoryMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of UserRepositoryMock.Get invocations
+func (mmGet *UserRepositoryMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mUserRepositoryMockGet) Calls() []*UserRepositoryMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.Get with params: %#v", *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserRepositoryMock.Get")
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.Get with params: %#v", *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Error("Expected call to UserRepositoryMock.Get")
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.Get but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), afterGetCounter)
+	}
+}
+
+type mUserRepositoryMockGetByEmail struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockGetByEmailExpectation
+	expectations       []*UserRepositoryMockGetByEmailExpectation
+
+	callArgs []*UserRepositoryMockGetByEmailParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// UserRepositoryMockGetByEmailExpectation specifies expectation struct of the UserRepository.GetByEmail
+type UserRepositoryMockGetByEmailExpectation struct {
+	mock      *UserRepositoryMock
+	params    *UserRepositoryMockGetByEmailParams
+	paramPtrs *UserRepositoryMockGetByEmailParamPtrs
+	results   *UserRepositoryMockGetByEmailResults
+	Counter   uint64
+}
+
+// UserRepositoryMockGetByEmailParams contains parameters of the UserRepository.GetByEmail
+type UserRepositoryMockGetByEmailParams struct {
+	ctx   context.Context
+	email string
+}
+
+// UserRepositoryMockGetByEmailParamPtrs contains pointers to parameters of the UserRepository.GetByEmail
+type UserRepositoryMockGetByEmailParamPtrs struct {
+	ctx   *context.Context
+	email *string
+}
+
+// UserRepositoryMockGetByEmailResults contains results of the UserRepository.GetByEmail
+type UserRepositoryMockGetByEmailResults struct {
+	up1 *model.User
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetByEmail *mUserRepositoryMockGetByEmail) Optional() *mUserRepositoryMockGetByEmail {
+	mmGetByEmail.optional = true
+	return mmGetByEmail
+}
+
+// Expect sets up expected params for UserRepository.GetByEmail
+func (mmGetByEmail *mUserRepositoryMockGetByEmail) Expect(ctx context.Context, email string) *mUserRepositoryMockGetByEmail {
+	if mmGetByEmail.mock.funcGetByEmail != nil {
+		mmGetByEmail.mock.t.Fatalf("UserRepositoryMock.GetByEmail mock is already set by Set")
+	}
+
+	if mmGetByEmail.defaultExpectation == nil {
+		mmGetByEmail.defaultExpectation = &UserRepositoryMockGetByEmailExpectation{}
+	}
+
+	if mmGetByEmail.defaultExpectation.paramPtrs != nil {
+		mmGetByEmail.mock.t.Fatalf("UserRepositoryMock.GetByEmail mock is already set by ExpectParams functions")
+	}
+
+	mmGetByEmail.defaultExpectation.params = &UserRepositoryMockGetByEmailParams{ctx, email}
+	for _, e := range mmGetByEmail.expectations {
+		if minimock.Equal(e.params, mmGetByEmail.defaultExpectation.params) {
+			mmGetByEmail.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetByEmail.defaultExpectation.params)
+		}
+	}
+
+	return mmGetByEmail
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.GetByEmail
+func (mmGetByEmail *mUserRepositoryMockGetByEmail) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockGetByEmail {
+	if mmGetByEmail.mock.funcGetByEmail != nil {
+		mmGetByEmail.mock.t.Fatalf("UserRepositoryMock.GetByEmail mock is already set by Set")
+	}
+
+	if mmGetByEmail.defaultExpectation == nil {
+		mmGetByEmail.defaultExpectation = &UserRepositoryMockGetByEmailExpectation{}
+	}
+
+	if mmGetByEmail.defaultExpectation.params != nil {
+		mmGetByEmail.mock.t.Fatalf("UserRepositoryMock.GetByEmail mock is already set by Expect")
+	}
+
+	if mmGetByEmail.defaultExpectation.paramPtrs == nil {
+		mmGetByEmail.defaultExpectation.paramPtrs = &UserRepositoryMockGetByEmailParamPtrs{}
+	}
+	mmGetByEmail.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetByEmail
+}
+
+// ExpectEmailParam2 sets up expected param email for UserRepository.GetByEmail
+func (mmGetByEmail *mUserRepositoryMockGetByEmail) ExpectEmailParam2(email string) *mUserRepositoryMockGetByEmail {
+	if mmGetByEmail.mock.funcGetByEmail != nil {
+		mmGetByEmail.mock.t.Fatalf("UserRepositoryMock.GetByEmail mock is already set by Set")
+	}
+
+	if mmGetByEmail.defaultExpectation == nil {
+		mmGetByEmail.defaultExpectation = &UserRepositoryMockGetByEmailExpectation{}
+	}
+
+	if mmGetByEmail.defaultExpectation.params != nil {
+		mmGetByEmail.mock.t.Fatalf("UserRepositoryMock.GetByEmail mock is already set by Expect")
+	}
+
+	if mmGetByEmail.defaultExpectation.paramPtrs == nil {
+		mmGetByEmail.defaultExpectation.paramPtrs = &UserRepositoryMockGetByEmailParamPtrs{}
+	}
+	mmGetByEmail.defaultExpectation.paramPtrs.email = &email
+
+	return mmGetByEmail
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.GetByEmail
+func (mmGetByEmail *mUserRepositoryMockGetByEmail) Inspect(f func(ctx context.Context, email string)) *mUserRepositoryMockGetByEmail {
+	if mmGetByEmail.mock.inspectFuncGetByEmail != nil {
+		mmGetByEmail.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.GetByEmail")
+	}
+
+	mmGetByEmail.mock.inspectFuncGetByEmail = f
+
+	return mmGetByEmail
+}
+
+// Return sets up results that will be returned by UserRepository.GetByEmail
+func (mmGetByEmail *mUserRepositoryMockGetByEmail) Return(up1 *model.User, err error) *UserRepositoryMock {
+	if mmGetByEmail.mock.funcGetByEmail != nil {
+		mmGetByEmail.mock.t.Fatalf("UserRepositoryMock.GetByEmail mock is already set by Set")
+	}
+
+	if mmGetByEmail.defaultExpectation == nil {
+		mmGetByEmail.defaultExpectation = &UserRepositoryMockGetByEmailExpectation{mock: mmGetByEmail.mock}
+	}
+	mmGetByEmail.defaultExpectation.results = &UserRepositoryMockGetByEmailResults{up1, err}
+	return mmGetByEmail.mock
+}
+
+// Set uses given function f to mock the UserRepository.GetByEmail method
+func (mmGetByEmail *mUserRepositoryMockGetByEmail) Set(f func(ctx context.Context, email string) (up1 *model.User, err error)) *UserRepositoryMock {
+	if mmGetByEmail.defaultExpectation != nil {
+		mmGetByEmail.mock.t.Fatalf("Default expectation is already set for the UserRepository.GetByEmail method")
+	}
+
+	if len(mmGetByEmail.expectations) > 0 {
+		mmGetByEmail.mock.t.Fatalf("Some expectations are already set for the UserRepository.GetByEmail method")
+	}
+
+	mmGetByEmail.mock.funcGetByEmail = f
+	return mmGetByEmail.mock
+}
+
+// When sets expectation for the UserRepository.GetByEmail which will trigger the result defined by the following
+// Then helper
+func (mmGetByEmail *mUserRepositoryMockGetByEmail) When(ctx context.Context, email string) *UserRepositoryMockGetByEmailExpectation {
+	if mmGetByEmail.mock.funcGetByEmail != nil {
+		mmGetByEmail.mock.t.Fatalf("UserRepositoryMock.GetByEmail mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockGetByEmailExpectation{
+		mock:   mmGetByEmail.mock,
+		params: &UserRepositoryMockGetByEmailParams{ctx, email},
+	}
+	mmGetByEmail.expectations = append(mmGetByEmail.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.GetByEmail return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockGetByEmailExpectation) Then(up1 *model.User, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockGetByEmailResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.GetByEmail should be invoked
+func (mmGetByEmail *mUserRepositoryMockGetByEmail) Times(n uint64) *mUserRepositoryMockGetByEmail {
+	if n == 0 {
+		mmGetByEmail.mock.t.Fatalf("Times of UserRepositoryMock.GetByEmail mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetByEmail.expectedInvocations, n)
+	return mmGetByEmail
+}
+
+func (mmGetByEmail *mUserRepositoryMockGetByEmail) invocationsDone() bool {
+	if len(mmGetByEmail.expectations) == 0 && mmGetByEmail.defaultExpectation == nil && mmGetByEmail.mock.funcGetByEmail == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetByEmail.mock.afterGetByEmailCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetByEmail.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetByEmail implements repository.UserRepository
+func (mmGetByEmail *UserRepositoryMock) GetByEmail(ctx context.Context, email string) (up1 *model.User, err error) {
+	mm_atomic.AddUint64(&mmGetByEmail.beforeGetByEmailCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetByEmail.afterGetByEmailCounter, 1)
+
+	if mmGetByEmail.inspectFuncGetByEmail != nil {
+		mmGetByEmail.inspectFuncGetByEmail(ctx, email)
+	}
+
+	mm_params := UserRepositoryMockGetByEmailParams{ctx, email}
+
+	// Record call args
+	mmGetByEmail.GetByEmailMock.mutex.Lock()
+	mmGetByEmail.GetByEmailMock.callArgs = append(mmGetByEmail.GetByEmailMock.callArgs, &mm_params)
+	mmGetByEmail.GetByEmailMock.mutex.Unlock()
+
+	for _, e := range mmGetByEmail.GetByEmailMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmGetByEmail.GetByEmailMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetByEmail.GetByEmailMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetByEmail.GetByEmailMock.defaultExpectation.params
+		mm_want_ptrs := mmGetByEmail.GetByEmailMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockGetByEmailParams{ctx, email}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetByEmail.t.Errorf("UserRepositoryMock.GetByEmail got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.email != nil && !minimock.Equal(*mm_want_ptrs.email, mm_got.email) {
+				mmGetByEmail.t.Errorf("UserRepositoryMock.GetByEmail got unexpected parameter email, want: %#v, got: %#v%s\n", *mm_want_ptrs.email, mm_got.email, minimock.Diff(*mm_want_ptrs.email, mm_got.email))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetByEmail.t.Errorf("UserRepositoryMock.GetByEmail got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetByEmail.GetByEmailMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetByEmail.t.Fatal("No results are set for the UserRepositoryMock.GetByEmail")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmGetByEmail.funcGetByEmail != nil {
+		return mmGetByEmail.funcGetByEmail(ctx, email)
+	}
+	mmGetByEmail.t.Fatalf("Unexpected call to UserRepositoryMock.GetByEmail. %v %v", ctx, email)
+	return
+}
+
+// GetByEmailAfterCounter returns a count of finished UserRepositoryMock.GetByEmail invocations
+func (mmGetByEmail *UserRepositoryMock) GetByEmailAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetByEmail.afterGetByEmailCounter)
+}
+
+// GetByEmailBeforeCounter returns a count of UserRepositoryMock.GetByEmail invocations
+func (mmGetByEmail *UserRepositoryMock) GetByEmailBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetByEmail.beforeGetByEmailCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.GetByEmail.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetByEmail *mUserRepositoryMockGetByEmail) Calls() []*UserRepositoryMockGetByEmailParams {
+	mmGetByEmail.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockGetByEmailParams, len(mmGetByEmail.callArgs))
+	copy(argCopy, mmGetByEmail.callArgs)
+
+	mmGetByEmail.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetByEmailDone returns true if the count of the GetByEmail invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockGetByEmailDone() bool {
+	if m.GetByEmailMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetByEmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetByEmailMock.invocationsDone()
+}
+
+// MinimockGetByEmailInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockGetByEmailInspect() {
+	for _, e := range m.GetByEmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetByEmail with params: %#v", *e.params)
+		}
+	}
+
+	afterGetByEmailCounter := mm_atomic.LoadUint64(&m.afterGetByEmailCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetByEmailMock.defaultExpectation != nil && afterGetByEmailCounter < 1 {
+		if m.GetByEmailMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserRepositoryMock.GetByEmail")
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetByEmail with params: %#v", *m.GetByEmailMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetByEmail != nil && afterGetByEmailCounter < 1 {
+		m.t.Error("Expected call to UserRepositoryMock.GetByEmail")
+	}
+
+	if !m.GetByEmailMock.invocationsDone() && afterGetByEmailCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.GetByEmail but found %d calls",
+			mm_atomic.LoadUint64(&m.GetByEmailMock.expectedInvocations), afterGetByEmailCounter)
+	}
+}
+
+type mUserRepositoryMockGetByUsername struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockGetByUsernameExpectation
+	expectations       []*UserRepositoryMockGetByUsernameExpectation
+
+	callArgs []*UserRepositoryMockGetByUsernameParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// UserRepositoryMockGetByUsernameExpectation specifies expectation struct of the UserRepository.GetByUsername
+type UserRepositoryMockGetByUsernameExpectation struct {
+	mock      *UserRepositoryMock
+	params    *UserRepositoryMockGetByUsernameParams
+	paramPtrs *UserRepositoryMockGetByUsernameParamPtrs
+	results   *UserRepositoryMockGetByUsernameResults
+	Counter   uint64
+}
+
+// UserRepositoryMockGetByUsernameParams contains parameters of the UserRepository.GetByUsername
+type UserRepositoryMockGetByUsernameParams struct {
+	ctx      context.Context
+	username string
+}
+
+// UserRepositoryMockGetByUsernameParamPtrs contains pointers to parameters of the UserRepository.GetByUsername
+type UserRepositoryMockGetByUsernameParamPtrs struct {
+	ctx      *context.Context
+	username *string
+}
+
+// UserRepositoryMockGetByUsernameResults contains results of the UserRepository.GetByUsername
+type UserRepositoryMockGetByUsernameResults struct {
+	up1 *model.User
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetByUsername *mUserRepositoryMockGetByUsername) Optional() *mUserRepositoryMockGetByUsername {
+	mmGetByUsername.optional = true
+	return mmGetByUsername
+}
+
+// Expect sets up expected params for UserRepository.GetByUsername
+func (mmGetByUsername *mUserRepositoryMockGetByUsername) Expect(ctx context.Context, username string) *mUserRepositoryMockGetByUsername {
+	if mmGetByUsername.mock.funcGetByUsername != nil {
+		mmGetByUsername.mock.t.Fatalf("UserRepositoryMock.GetByUsername mock is already set by Set")
+	}
+
+	if mmGetByUsername.defaultExpectation == nil {
+		mmGetByUsername.defaultExpectation = &UserRepositoryMockGetByUsernameExpectation{}
+	}
+
+	if mmGetByUsername.defaultExpectation.paramPtrs != nil {
+		mmGetByUsername.mock.t.Fatalf("UserRepositoryMock.GetByUsername mock is already set by ExpectParams functions")
+	}
+
+	mmGetByUsername.defaultExpectation.params = &UserRepositoryMockGetByUsernameParams{ctx, username}
+	for _, e := range mmGetByUsername.expectations {
+		if minimock.Equal(e.params, mmGetByUsername.defaultExpectation.params) {
+			mmGetByUsername.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetByUsername.defaultExpectation.params)
+		}
+	}
+
+	return mmGetByUsername
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.GetByUsername
+func (mmGetByUsername *mUserRepositoryMockGetByUsername) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockGetByUsername {
+	if mmGetByUsername.mock.funcGetByUsername != nil {
+		mmGetByUsername.mock.t.Fatalf("UserRepositoryMock.GetByUsername mock is already set by Set")
+	}
+
+	if mmGetByUsername.defaultExpectation == nil {
+		mmGetByUsername.defaultExpectation = &UserRepositoryMockGetByUsernameExpectation{}
+	}
+
+	if mmGetByUsername.defaultExpectation.params != nil {
+		mmGetByUsername.mock.t.Fatalf("UserRepositoryMock.GetByUsername mock is already set by Expect")
+	}
+
+	if mmGetByUsername.defaultExpectation.paramPtrs == nil {
+		mmGetByUsername.defaultExpectation.paramPtrs = &UserRepositoryMockGetByUsernameParamPtrs{}
+	}
+	mmGetByUsername.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetByUsername
+}
+
+// ExpectUsernameParam2 sets up expected param username for UserRepository.GetByUsername
+func (mmGetByUsername *mUserRepositoryMockGetByUsername) ExpectUsernameParam2(username string) *mUserRepositoryMockGetByUsername {
+	if mmGetByUsername.mock.funcGetByUsername != nil {
+		mmGetByUsername.mock.t.Fatalf("UserRepositoryMock.GetByUsername mock is already set by Set")
+	}
+
+	if mmGetByUsername.defaultExpectation == nil {
+		mmGetByUsername.defaultExpectation = &UserRepositoryMockGetByUsernameExpectation{}
+	}
+
+	if mmGetByUsername.defaultExpectation.params != nil {
+		mmGetByUsername.mock.t.Fatalf("UserRepositoryMock.GetByUsername mock is already set by Expect")
+	}
+
+	if mmGetByUsername.defaultExpectation.paramPtrs == nil {
+		mmGetByUsername.defaultExpectation.paramPtrs = &UserRepositoryMockGetByUsernameParamPtrs{}
+	}
+	mmGetByUsername.defaultExpectation.paramPtrs.username = &username
+
+	return mmGetByUsername
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.GetByUsername
+func (mmGetByUsername *mUserRepositoryMockGetByUsername) Inspect(f func(ctx context.Context, username string)) *mUserRepositoryMockGetByUsername {
+	if mmGetByUsername.mock.inspectFuncGetByUsername != nil {
+		mmGetByUsername.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.GetByUsername")
+	}
+
+	mmGetByUsername.mock.inspectFuncGetByUsername = f
+
+	return mmGetByUsername
+}
+
+// Return sets up results that will be returned by UserRepository.GetByUsername
+func (mmGetByUsername *mUserRepositoryMockGetByUsername) Return(up1 *model.User, err error) *UserRepositoryMock {
+	if mmGetByUsername.mock.funcGetByUsername != nil {
+		mmGetByUsername.mock.t.Fatalf("UserRepositoryMock.GetByUsername mock is already set by Set")
+	}
+
+	if mmGetByUsername.defaultExpectation == nil {
+		mmGetByUsername.defaultExpectation = &UserRepositoryMockGetByUsernameExpectation{mock: mmGetByUsername.mock}
+	}
+	mmGetByUsername.defaultExpectation.results = &UserRepositoryMockGetByUsernameResults{up1, err}
+	return mmGetByUsername.mock
+}
+
+// Set uses given function f to mock the UserRepository.GetByUsername method
+func (mmGetByUsername *mUserRepositoryMockGetByUsername) Set(f func(ctx context.Context, username string) (up1 *model.User, err error)) *UserRepositoryMock {
+	if mmGetByUsername.defaultExpectation != nil {
+		mmGetByUsername.mock.t.Fatalf("Default expectation is already set for the UserRepository.GetByUsername method")
+	}
+
+	if len(mmGetByUsername.expectations) > 0 {
+		mmGetByUsername.mock.t.Fatalf("Some expectations are already set for the UserRepository.GetByUsername method")
+	}
+
+	mmGetByUsername.mock.funcGetByUsername = f
+	return mmGetByUsername.mock
+}
+
+// When sets expectation for the UserRepository.GetByUsername which will trigger the result defined by the following
+// Then helper
+func (mmGetByUsername *mUserRepositoryMockGetByUsername) When(ctx context.Context, username string) *UserRepositoryMockGetByUsernameExpectation {
+	if mmGetByUsername.mock.funcGetByUsername != nil {
+		mmGetByUsername.mock.t.Fatalf("UserRepositoryMock.GetByUsername mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockGetByUsernameExpectation{
+		mock:   mmGetByUsername.mock,
+		params: &UserRepositoryMockGetByUsernameParams{ctx, username},
+	}
+	mmGetByUsername.expectations = append(mmGetByUsername.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.GetByUsername return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockGetByUsernameExpectation) Then(up1 *model.User, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockGetByUsernameResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.GetByUsername should be invoked
+func (mmGetByUsername *mUserRepositoryMockGetByUsername) Times(n uint64) *mUserRepositoryMockGetByUsername {
+	if n == 0 {
+		mmGetByUsername.mock.t.Fatalf("Times of UserRepositoryMock.GetByUsername mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetByUsername.expectedInvocations, n)
+	return mmGetByUsername
+}
+
+func (mmGetByUsername *mUserRepositoryMockGetByUsername) invocationsDone() bool {
+	if len(mmGetByUsername.expectations) == 0 && mmGetByUsername.defaultExpectation == nil && mmGetByUsername.mock.funcGetByUsername == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetByUsername.mock.afterGetByUsernameCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetByUsername.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetByUsername implements repository.UserRepository
+func (mmGetByUsername *UserRepositoryMock) GetByUsername(ctx context.Context, username string) (up1 *model.User, err error) {
+	mm_atomic.AddUint64(&mmGetByUsername.beforeGetByUsernameCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetByUsername.afterGetByUsernameCounter, 1)
+
+	if mmGetByUsername.inspectFuncGetByUsername != nil {
+		mmGetByUsername.inspectFuncGetByUsername(ctx, username)
+	}
+
+	mm_params := UserRepositoryMockGetByUsernameParams{ctx, username}
+
+	// Record call args
+	mmGetByUsername.GetByUsernameMock.mutex.Lock()
+	mmGetByUsername.GetByUsernameMock.callArgs = append(mmGetByUsername.GetByUsernameMock.callArgs, &mm_params)
+	mmGetByUsername.GetByUsernameMock.mutex.Unlock()
+
+	for _, e := range mmGetByUsername.GetByUsernameMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmGetByUsername.GetByUsernameMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetByUsername.GetByUsernameMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetByUsername.GetByUsernameMock.defaultExpectation.params
+		mm_want_ptrs := mmGetByUsername.GetByUsernameMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockGetByUsernameParams{ctx, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetByUsername.t.Errorf("UserRepositoryMock.GetByUsername got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmGetByUsername.t.Errorf("UserRepositoryMock.GetByUsername got unexpected parameter username, want: %#v, got: %#v%s\n", *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetByUsername.t.Errorf("UserRepositoryMock.GetByUsername got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetByUsername.GetByUsernameMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetByUsername.t.Fatal("No results are set for the UserRepositoryMock.GetByUsername")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmGetByUsername.funcGetByUsername != nil {
+		return mmGetByUsername.funcGetByUsername(ctx, username)
+	}
+	mmGetByUsername.t.Fatalf("Unexpected call to UserRepositoryMock.GetByUsername. %v %v", ctx, username)
+	return
+}
+
+// GetByUsernameAfterCounter returns a count of finished UserRepositoryMock.GetByUsername invocations
+func (mmGetByUsername *UserRepositoryMock) GetByUsernameAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetByUsername.afterGetByUsernameCounter)
+}
+
+// GetByUsernameBeforeCounter returns a count of UserRepositoryMock.GetByUsername invocations
+func (mmGetByUsername *UserRepositoryMock) GetByUsernameBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetByUsername.beforeGetByUsernameCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.GetByUsername.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetByUsername *mUserRepositoryMockGetByUsername) Calls() []*UserRepositoryMockGetByUsernameParams {
+	mmGetByUsername.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockGetByUsernameParams, len(mmGetByUsername.callArgs))
+	copy(argCopy, mmGetByUsername.callArgs)
+
+	mmGetByUsername.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetByUsernameDone returns true if the count of the GetByUsername invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockGetByUsernameDone() bool {
+	if m.GetByUsernameMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetByUsernameMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetByUsernameMock.invocationsDone()
+}
+
+// MinimockGetByUsernameInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockGetByUsernameInspect() {
+	for _, e := range m.GetByUsernameMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetByUsername with params: %#v", *e.params)
+		}
+	}
+
+	afterGetByUsernameCounter := mm_atomic.LoadUint64(&m.afterGetByUsernameCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetByUsernameMock.defaultExpectation != nil && afterGetByUsernameCounter < 1 {
+		if m.GetByUsernameMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserRepositoryMock.GetByUsername")
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetByUsername with params: %#v", *m.GetByUsernameMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetByUsername != nil && afterGetByUsernameCounter < 1 {
+		m.t.Error("Expected call to UserRepositoryMock.GetByUsername")
+	}
+
+	if !m.GetByUsernameMock.invocationsDone() && afterGetByUsernameCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.GetByUsername but found %d calls",
+			mm_atomic.LoadUint64(&m.GetByUsernameMock.expectedInvocations), afterGetByUsernameCounter)
+	}
+}
+
+type mUserRepositoryMockSetEmailVerified struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockSetEmailVerifiedExpectation
+	expectations       []*UserRepositoryMockSetEmailVerifiedExpectation
+
+	callArgs []*UserRepositoryMockSetEmailVerifiedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// UserRepositoryMockSetEmailVerifiedExpectation specifies expectation struct of the UserRepository.SetEmailVerified
+type UserRepositoryMockSetEmailVerifiedExpectation struct {
+	mock      *UserRepositoryMock
+	params    *UserRepositoryMockSetEmailVerifiedParams
+	paramPtrs *UserRepositoryMockSetEmailVerifiedParamPtrs
+	results   *UserRepositoryMockSetEmailVerifiedResults
+	Counter   uint64
+}
+
+// UserRepositoryMockSetEmailVerifiedParams contains parameters of the UserRepository.SetEmailVerified
+type UserRepositoryMockSetEmailVerifiedParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// UserRepositoryMockSetEmailVerifiedParamPtrs contains pointers to parameters of the UserRepository.SetEmailVerified
+type UserRepositoryMockSetEmailVerifiedParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// UserRepositoryMockSetEmailVerifiedResults contains results of the UserRepository.SetEmailVerified
+type UserRepositoryMockSetEmailVerifiedResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetEmailVerified *mUserRepositoryMockSetEmailVerified) Optional() *mUserRepositoryMockSetEmailVerified {
+	mmSetEmailVerified.optional = true
+	return mmSetEmailVerified
+}
+
+// Expect sets up expected params for UserRepository.SetEmailVerified
+func (mmSetEmailVerified *mUserRepositoryMockSetEmailVerified) Expect(ctx context.Context, id int64) *mUserRepositoryMockSetEmailVerified {
+	if mmSetEmailVerified.mock.funcSetEmailVerified != nil {
+		mmSetEmailVerified.mock.t.Fatalf("UserRepositoryMock.SetEmailVerified mock is already set by Set")
+	}
+
+	if mmSetEmailVerified.defaultExpectation == nil {
+		mmSetEmailVerified.defaultExpectation = &UserRepositoryMockSetEmailVerifiedExpectation{}
+	}
+
+	if mmSetEmailVerified.defaultExpectation.paramPtrs != nil {
+		mmSetEmailVerified.mock.t.Fatalf("UserRepositoryMock.SetEmailVerified mock is already set by ExpectParams functions")
+	}
+
+	mmSetEmailVerified.defaultExpectation.params = &UserRepositoryMockSetEmailVerifiedParams{ctx, id}
+	for _, e := range mmSetEmailVerified.expectations {
+		if minimock.Equal(e.params, mmSetEmailVerified.defaultExpectation.params) {
+			mmSetEmailVerified.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetEmailVerified.defaultExpectation.params)
+		}
+	}
+
+	return mmSetEmailVerified
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.SetEmailVerified
+func (mmSetEmailVerified *mUserRepositoryMockSetEmailVerified) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockSetEmailVerified {
+	if mmSetEmailVerified.mock.funcSetEmailVerified != nil {
+		mmSetEmailVerified.mock.t.Fatalf("UserRepositoryMock.SetEmailVerified mock is already set by Set")
+	}
+
+	if mmSetEmailVerified.defaultExpectation == nil {
+		mmSetEmailVerified.defaultExpectation = &UserRepositoryMockSetEmailVerifiedExpectation{}
+	}
+
+	if mmSetEmailVerified.defaultExpectation.params != nil {
+		mmSetEmailVerified.mock.t.Fatalf("UserRepositoryMock.SetEmailVerified mock is already set by Expect")
+	}
+
+	if mmSetEmailVerified.defaultExpectation.paramPtrs == nil {
+		mmSetEmailVerified.defaultExpectation.paramPtrs = &UserRepositoryMockSetEmailVerifiedParamPtrs{}
+	}
+	mmSetEmailVerified.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmSetEmailVerified
+}
+
+// ExpectIdParam2 sets up expected param id for UserRepository.SetEmailVerified
+func (mmSetEmailVerified *mUserRepositoryMockSetEmailVerified) ExpectIdParam2(id int64) *mUserRepositoryMockSetEmailVerified {
+	if mmSetEmailVerified.mock.funcSetEmailVerified != nil {
+		mmSetEmailVerified.mock.t.Fatalf("UserRepositoryMock.SetEmailVerified mock is already set by Set")
+	}
+
+	if mmSetEmailVerified.defaultExpectation == nil {
+		mmSetEmailVerified.defaultExpectation = &UserRepositoryMockSetEmailVerifiedExpectation{}
+	}
+
+	if mmSetEmailVerified.defaultExpectation.params != nil {
+		mmSetEmailVerified.mock.t.Fatalf("UserRepositoryMock.SetEmailVerified mock is already set by Expect")
+	}
+
+	if mmSetEmailVerified.defaultExpectation.paramPtrs == nil {
+		mmSetEmailVerified.defaultExpectation.paramPtrs = &UserRepositoryMockSetEmailVerifiedParamPtrs{}
+	}
+	mmSetEmailVerified.defaultExpectation.paramPtrs.id = &id
+
+	return mmSetEmailVerified
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.SetEmailVerified
+func (mmSetEmailVerified *mUserRepositoryMockSetEmailVerified) Inspect(f func(ctx context.Context, id int64)) *mUserRepositoryMockSetEmailVerified {
+	if mmSetEmailVerified.mock.inspectFuncSetEmailVerified != nil {
+		mmSetEmailVerified.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.SetEmailVerified")
+	}
+
+	mmSetEmailVerified.mock.inspectFuncSetEmailVerified = f
+
+	return mmSetEmailVerified
+}
+
+// Return sets up results that will be returned by UserRepository.SetEmailVerified
+func (mmSetEmailVerified *mUserRepositoryMockSetEmailVerified) Return(err error) *UserRepositoryMock {
+	if mmSetEmailVerified.mock.funcSetEmailVerified != nil {
+		mmSetEmailVerified.mock.t.Fatalf("UserRepositoryMock.SetEmailVerified mock is already set by Set")
+	}
+
+	if mmSetEmailVerified.defaultExpectation == nil {
+		mmSetEmailVerified.defaultExpectation = &UserRepositoryMockSetEmailVerifiedExpectation{mock: mmSetEmailVerified.mock}
+	}
+	mmSetEmailVerified.defaultExpectation.results = &UserRepositoryMockSetEmailVerifiedResults{err}
+	return mmSetEmailVerified.mock
+}
+
+// Set uses given function f to mock the UserRepository.SetEmailVerified method
+func (mmSetEmailVerified *mUserRepositoryMockSetEmailVerified) Set(f func(ctx context.Context, id int64) (err error)) *UserRepositoryMock {
+	if mmSetEmailVerified.defaultExpectation != nil {
+		mmSetEmailVerified.mock.t.Fatalf("Default expectation is already set for the UserRepository.SetEmailVerified method")
+	}
+
+	if len(mmSetEmailVerified.expectations) > 0 {
+		mmSetEmailVerified.mock.t.Fatalf("Some expectations are already set for the UserRepository.SetEmailVerified method")
+	}
+
+	mmSetEmailVerified.mock.funcSetEmailVerified = f
+	return mmSetEmailVerified.mock
+}
+
+// When sets expectation for the UserRepository.SetEmailVerified which will trigger the result defined by the following
+// Then helper
+func (mmSetEmailVerified *mUserRepositoryMockSetEmailVerified) When(ctx context.Context, id int64) *UserRepositoryMockSetEmailVerifiedExpectation {
+	if mmSetEmailVerified.mock.funcSetEmailVerified != nil {
+		mmSetEmailVerified.mock.t.Fatalf("UserRepositoryMock.SetEmailVerified mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockSetEmailVerifiedExpectation{
+		mock:   mmSetEmailVerified.mock,
+		params: &UserRepositoryMockSetEmailVerifiedParams{ctx, id},
+	}
+	mmSetEmailVerified.expectations = append(mmSetEmailVerified.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.SetEmailVerified return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockSetEmailVerifiedExpectation) Then(err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockSetEmailVerifiedResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.SetEmailVerified should be invoked
+func (mmSetEmailVerified *mUserRepositoryMockSetEmailVerified) Times(n uint64) *mUserRepositoryMockSetEmailVerified {
+	if n == 0 {
+		mmSetEmailVerified.mock.t.Fatalf("Times of UserRepositoryMock.SetEmailVerified mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetEmailVerified.expectedInvocations, n)
+	return mmSetEmailVerified
+}
+
+func (mmSetEmailVerified *mUserRepositoryMockSetEmailVerified) invocationsDone() bool {
+	if len(mmSetEmailVerified.expectations) == 0 && mmSetEmailVerified.defaultExpectation == nil && mmSetEmailVerified.mock.funcSetEmailVerified == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetEmailVerified.mock.afterSetEmailVerifiedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetEmailVerified.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetEmailVerified implements repository.UserRepository
+func (mmSetEmailVerified *UserRepositoryMock) SetEmailVerified(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmSetEmailVerified.beforeSetEmailVerifiedCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetEmailVerified.afterSetEmailVerifiedCounter, 1)
+
+	if mmSetEmailVerified.inspectFuncSetEmailVerified != nil {
+		mmSetEmailVerified.inspectFuncSetEmailVerified(ctx, id)
+	}
+
+	mm_params := UserRepositoryMockSetEmailVerifiedParams{ctx, id}
+
+	// Record call args
+	mmSetEmailVerified.SetEmailVerifiedMock.mutex.Lock()
+	mmSetEmailVerified.SetEmailVerifiedMock.callArgs = append(mmSetEmailVerified.SetEmailVerifiedMock.callArgs, &mm_params)
+	mmSetEmailVerified.SetEmailVerifiedMock.mutex.Unlock()
+
+	for _, e := range mmSetEmailVerified.SetEmailVerifiedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetEmailVerified.SetEmailVerifiedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetEmailVerified.SetEmailVerifiedMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetEmailVerified.SetEmailVerifiedMock.defaultExpectation.params
+		mm_want_ptrs := mmSetEmailVerified.SetEmailVerifiedMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockSetEmailVerifiedParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetEmailVerified.t.Errorf("UserRepositoryMock.SetEmailVerified got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmSetEmailVerified.t.Errorf("UserRepositoryMock.SetEmailVerified got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetEmailVerified.t.Errorf("UserRepositoryMock.SetEmailVerified got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetEmailVerified.SetEmailVerifiedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetEmailVerified.t.Fatal("No results are set for the UserRepositoryMock.SetEmailVerified")
+		}
+		return (*mm_results).err
+	}
+	if mmSetEmailVerified.funcSetEmailVerified != nil {
+		return mmSetEmailVerified.funcSetEmailVerified(ctx, id)
+	}
+	mmSetEmailVerified.t.Fatalf("Unexpected call to UserRepositoryMock.SetEmailVerified. %v %v", ctx, id)
+	return
+}
+
+// SetEmailVerifiedAfterCounter returns a count of finished UserRepositoryMock.SetEmailVerified invocations
+func (mmSetEmailVerified *UserRepositoryMock) SetEmailVerifiedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetEmailVerified.afterSetEmailVerifiedCounter)
+}
+
+// SetEmailVerifiedBeforeCounter returns a count of UserRepositoryMock.SetEmailVerified invocations
+func (mmSetEmailVerified *UserRepositoryMock) SetEmailVerifiedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetEmailVerified.beforeSetEmailVerifiedCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.SetEmailVerified.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetEmailVerified *mUserRepositoryMockSetEmailVerified) Calls() []*UserRepositoryMockSetEmailVerifiedParams {
+	mmSetEmailVerified.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockSetEmailVerifiedParams, len(mmSetEmailVerified.callArgs))
+	copy(argCopy, mmSetEmailVerified.callArgs)
+
+	mmSetEmailVerified.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetEmailVerifiedDone returns true if the count of the SetEmailVerified invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockSetEmailVerifiedDone() bool {
+	if m.SetEmailVerifiedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetEmailVerifiedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetEmailVerifiedMock.invocationsDone()
+}
+
+// MinimockSetEmailVerifiedInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockSetEmailVerifiedInspect() {
+	for _, e := range m.SetEmailVerifiedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.SetEmailVerified with params: %#v", *e.params)
+		}
+	}
+
+	afterSetEmailVerifiedCounter := mm_atomic.LoadUint64(&m.afterSetEmailVerifiedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetEmailVerifiedMock.defaultExpectation != nil && afterSetEmailVerifiedCounter < 1 {
+		if m.SetEmailVerifiedMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserRepositoryMock.SetEmailVerified")
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.SetEmailVerified with params: %#v", *m.SetEmailVerifiedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetEmailVerified != nil && afterSetEmailVerifiedCounter < 1 {
+		m.t.Error("Expected call to UserRepositoryMock.SetEmailVerified")
+	}
+
+	if !m.SetEmailVerifiedMock.invocationsDone() && afterSetEmailVerifiedCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.SetEmailVerified but found %d calls",
+			mm_atomic.LoadUint64(&m.SetEmailVerifiedMock.expectedInvocations), afterSetEmailVerifiedCounter)
+	}
+}
+
+type mUserRepositoryMockSetTOTPSecret struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockSetTOTPSecretExpectation
+	expectations       []*UserRepositoryMockSetTOTPSecretExpectation
+
+	callArgs []*UserRepositoryMockSetTOTPSecretParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// UserRepositoryMockSetTOTPSecretExpectation specifies expectation struct of the UserRepository.SetTOTPSecret
+type UserRepositoryMockSetTOTPSecretExpectation struct {
+	mock      *UserRepositoryMock
+	params    *UserRepositoryMockSetTOTPSecretParams
+	paramPtrs *UserRepositoryMockSetTOTPSecretParamPtrs
+	results   *UserRepositoryMockSetTOTPSecretResults
+	Counter   uint64
+}
+
+// UserRepositoryMockSetTOTPSecretParams contains parameters of the UserRepository.SetTOTPSecret
+type UserRepositoryMockSetTOTPSecretParams struct {
+	ctx             context.Context
+	id              int64
+	encryptedSecret string
+}
+
+// UserRepositoryMockSetTOTPSecretParamPtrs contains pointers to parameters of the UserRepository.SetTOTPSecret
+type UserRepositoryMockSetTOTPSecretParamPtrs struct {
+	ctx             *context.Context
+	id              *int64
+	encryptedSecret *string
+}
+
+// UserRepositoryMockSetTOTPSecretResults contains results of the UserRepository.SetTOTPSecret
+type UserRepositoryMockSetTOTPSecretResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetTOTPSecret *mUserRepositoryMockSetTOTPSecret) Optional() *mUserRepositoryMockSetTOTPSecret {
+	mmSetTOTPSecret.optional = true
+	return mmSetTOTPSecret
+}
+
+// Expect sets up expected params for UserRepository.SetTOTPSecret
+func (mmSetTOTPSecret *mUserRepositoryMockSetTOTPSecret) Expect(ctx context.Context, id int64, encryptedSecret string) *mUserRepositoryMockSetTOTPSecret {
+	if mmSetTOTPSecret.mock.funcSetTOTPSecret != nil {
+		mmSetTOTPSecret.mock.t.Fatalf("UserRepositoryMock.SetTOTPSecret mock is already set by Set")
+	}
+
+	if mmSetTOTPSecret.defaultExpectation == nil {
+		mmSetTOTPSecret.defaultExpectation = &UserRepositoryMockSetTOTPSecretExpectation{}
+	}
+
+	if mmSetTOTPSecret.defaultExpectation.paramPtrs != nil {
+		mmSetTOTPSecret.mock.t.Fatalf("UserRepositoryMock.SetTOTPSecret mock is already set by ExpectParams functions")
+	}
+
+	mmSetTOTPSecret.defaultExpectation.params = &UserRepositoryMockSetTOTPSecretParams{ctx, id, encryptedSecret}
+	for _, e := range mmSetTOTPSecret.expectations {
+		if minimock.Equal(e.params, mmSetTOTPSecret.defaultExpectation.params) {
+			mmSetTOTPSecret.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetTOTPSecret.defaultExpectation.params)
+		}
+	}
+
+	return mmSetTOTPSecret
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.SetTOTPSecret
+func (mmSetTOTPSecret *mUserRepositoryMockSetTOTPSecret) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockSetTOTPSecret {
+	if mmSetTOTPSecret.mock.funcSetTOTPSecret != nil {
+		mmSetTOTPSecret.mock.t.Fatalf("UserRepositoryMock.SetTOTPSecret mock is already set by Set")
+	}
+
+	if mmSetTOTPSecret.defaultExpectation == nil {
+		mmSetTOTPSecret.defaultExpectation = &UserRepositoryMockSetTOTPSecretExpectation{}
+	}
+
+	if mmSetTOTPSecret.defaultExpectation.params != nil {
+		mmSetTOTPSecret.mock.t.Fatalf("UserRepositoryMock.SetTOTPSecret mock is already set by Expect")
+	}
+
+	if mmSetTOTPSecret.defaultExpectation.paramPtrs == nil {
+		mmSetTOTPSecret.defaultExpectation.paramPtrs = &UserRepositoryMockSetTOTPSecretParamPtrs{}
+	}
+	mmSetTOTPSecret.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmSetTOTPSecret
+}
+
+// ExpectIdParam2 sets up expected param id for UserRepository.SetTOTPSecret
+func (mmSetTOTPSecret *mUserRepositoryMockSetTOTPSecret) ExpectIdParam2(id int64) *mUserRepositoryMockSetTOTPSecret {
+	if mmSetTOTPSecret.mock.funcSetTOTPSecret != nil {
+		mmSetTOTPSecret.mock.t.Fatalf("UserRepositoryMock.SetTOTPSecret mock is already set by Set")
+	}
+
+	if mmSetTOTPSecret.defaultExpectation == nil {
+		mmSetTOTPSecret.defaultExpectation = &UserRepositoryMockSetTOTPSecretExpectation{}
+	}
+
+	if mmSetTOTPSecret.defaultExpectation.params != nil {
+		mmSetTOTPSecret.mock.t.Fatalf("UserRepositoryMock.SetTOTPSecret mock is already set by Expect")
+	}
+
+	if mmSetTOTPSecret.defaultExpectation.paramPtrs == nil {
+		mmSetTOTPSecret.defaultExpectation.paramPtrs = &UserRepositoryMockSetTOTPSecretParamPtrs{}
+	}
+	mmSetTOTPSecret.defaultExpectation.paramPtrs.id = &id
+
+	return mmSetTOTPSecret
+}
+
+// ExpectEncryptedSecretParam3 sets up expected param encryptedSecret for UserRepository.SetTOTPSecret
+func (mmSetTOTPSecret *mUserRepositoryMockSetTOTPSecret) ExpectEncryptedSecretParam3(encryptedSecret string) *mUserRepositoryMockSetTOTPSecret {
+	if mmSetTOTPSecret.mock.funcSetTOTPSecret != nil {
+		mmSetTOTPSecret.mock.t.Fatalf("UserRepositoryMock.SetTOTPSecret mock is already set by Set")
+	}
+
+	if mmSetTOTPSecret.defaultExpectation == nil {
+		mmSetTOTPSecret.defaultExpectation = &UserRepositoryMockSetTOTPSecretExpectation{}
+	}
+
+	if mmSetTOTPSecret.defaultExpectation.params != nil {
+		mmSetTOTPSecret.mock.t.Fatalf("UserRepositoryMock.SetTOTPSecret mock is already set by Expect")
+	}
+
+	if mmSetTOTPSecret.defaultExpectation.paramPtrs == nil {
+		mmSetTOTPSecret.defaultExpectation.paramPtrs = &UserRepositoryMockSetTOTPSecretParamPtrs{}
+	}
+	mmSetTOTPSecret.defaultExpectation.paramPtrs.encryptedSecret = &encryptedSecret
+
+	return mmSetTOTPSecret
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.SetTOTPSecret
+func (mmSetTOTPSecret *mUserRepositoryMockSetTOTPSecret) Inspect(f func(ctx context.Context, id int64, encryptedSecret string)) *mUserRepositoryMockSetTOTPSecret {
+	if mmSetTOTPSecret.mock.inspectFuncSetTOTPSecret != nil {
+		mmSetTOTPSecret.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.SetTOTPSecret")
+	}
+
+	mmSetTOTPSecret.mock.inspectFuncSetTOTPSecret = f
+
+	return mmSetTOTPSecret
+}
+
+// Return sets up results that will be returned by UserRepository.SetTOTPSecret
+func (mmSetTOTPSecret *mUserRepositoryMockSetTOTPSecret) Return(err error) *UserRepositoryMock {
+	if mmSetTOTPSecret.mock.funcSetTOTPSecret != nil {
+		mmSetTOTPSecret.mock.t.Fatalf("UserRepositoryMock.SetTOTPSecret mock is already set by Set")
+	}
+
+	if mmSetTOTPSecret.defaultExpectation == nil {
+		mmSetTOTPSecret.defaultExpectation = &UserRepositoryMockSetTOTPSecretExpectation{mock: mmSetTOTPSecret.mock}
+	}
+	mmSetTOTPSecret.defaultExpectation.results = &UserRepositoryMockSetTOTPSecretResults{err}
+	return mmSetTOTPSecret.mock
+}
+
+// Set uses given function f to mock the UserRepository.SetTOTPSecret method
+func (mmSetTOTPSecret *mUserRepositoryMockSetTOTPSecret) Set(f func(ctx context.Context, id int64, encryptedSecret string) (err error)) *UserRepositoryMock {
+	if mmSetTOTPSecret.defaultExpectation != nil {
+		mmSetTOTPSecret.mock.t.Fatalf("Default expectation is already set for the UserRepository.SetTOTPSecret method")
+	}
+
+	if len(mmSetTOTPSecret.expectations) > 0 {
+		mmSetTOTPSecret.mock.t.Fatalf("Some expectations are already set for the UserRepository.SetTOTPSecret method")
+	}
+
+	mmSetTOTPSecret.mock.funcSetTOTPSecret = f
+	return mmSetTOTPSecret.mock
+}
+
+// When sets expectation for the UserRepository.SetTOTPSecret which will trigger the result defined by the following
+// Then helper
+func (mmSetTOTPSecret *mUserRepositoryMockSetTOTPSecret) When(ctx context.Context, id int64, encryptedSecret string) *UserRepositoryMockSetTOTPSecretExpectation {
+	if mmSetTOTPSecret.mock.funcSetTOTPSecret != nil {
+		mmSetTOTPSecret.mock.t.Fatalf("UserRepositoryMock.SetTOTPSecret mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockSetTOTPSecretExpectation{
+		mock:   mmSetTOTPSecret.mock,
+		params: &UserRepositoryMockSetTOTPSecretParams{ctx, id, encryptedSecret},
+	}
+	mmSetTOTPSecret.expectations = append(mmSetTOTPSecret.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.SetTOTPSecret return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockSetTOTPSecretExpectation) Then(err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockSetTOTPSecretResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.SetTOTPSecret should be invoked
+func (mmSetTOTPSecret *mUserRepositoryMockSetTOTPSecret) Times(n uint64) *mUserRepositoryMockSetTOTPSecret {
+	if n == 0 {
+		mmSetTOTPSecret.mock.t.Fatalf("Times of UserRepositoryMock.SetTOTPSecret mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetTOTPSecret.expectedInvocations, n)
+	return mmSetTOTPSecret
+}
+
+func (mmSetTOTPSecret *mUserRepositoryMockSetTOTPSecret) invocationsDone() bool {
+	if len(mmSetTOTPSecret.expectations) == 0 && mmSetTOTPSecret.defaultExpectation == nil && mmSetTOTPSecret.mock.funcSetTOTPSecret == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetTOTPSecret.mock.afterSetTOTPSecretCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetTOTPSecret.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetTOTPSecret implements repository.UserRepository
+func (mmSetTOTPSecret *UserRepositoryMock) SetTOTPSecret(ctx context.Context, id int64, encryptedSecret string) (err error) {
+	mm_atomic.AddUint64(&mmSetTOTPSecret.beforeSetTOTPSecretCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetTOTPSecret.afterSetTOTPSecretCounter, 1)
+
+	if mmSetTOTPSecret.inspectFuncSetTOTPSecret != nil {
+		mmSetTOTPSecret.inspectFuncSetTOTPSecret(ctx, id, encryptedSecret)
+	}
+
+	mm_params := UserRepositoryMockSetTOTPSecretParams{ctx, id, encryptedSecret}
+
+	// Record call args
+	mmSetTOTPSecret.SetTOTPSecretMock.mutex.Lock()
+	mmSetTOTPSecret.SetTOTPSecretMock.callArgs = append(mmSetTOTPSecret.SetTOTPSecretMock.callArgs, &mm_params)
+	mmSetTOTPSecret.SetTOTPSecretMock.mutex.Unlock()
+
+	for _, e := range mmSetTOTPSecret.SetTOTPSecretMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetTOTPSecret.SetTOTPSecretMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetTOTPSecret.SetTOTPSecretMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetTOTPSecret.SetTOTPSecretMock.defaultExpectation.params
+		mm_want_ptrs := mmSetTOTPSecret.SetTOTPSecretMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockSetTOTPSecretParams{ctx, id, encryptedSecret}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetTOTPSecret.t.Errorf("UserRepositoryMock.SetTOTPSecret got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmSetTOTPSecret.t.Errorf("UserRepositoryMock.SetTOTPSecret got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.encryptedSecret != nil && !minimock.Equal(*mm_want_ptrs.encryptedSecret, mm_got.encryptedSecret) {
+				mmSetTOTPSecret.t.Errorf("UserRepositoryMock.SetTOTPSecret got unexpected parameter encryptedSecret, want: %#v, got: %#v%s\n", *mm_want_ptrs.encryptedSecret, mm_got.encryptedSecret, minimock.Diff(*mm_want_ptrs.encryptedSecret, mm_got.encryptedSecret))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetTOTPSecret.t.Errorf("UserRepositoryMock.SetTOTPSecret got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetTOTPSecret.SetTOTPSecretMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetTOTPSecret.t.Fatal("No results are set for the UserRepositoryMock.SetTOTPSecret")
+		}
+		return (*mm_results).err
+	}
+	if mmSetTOTPSecret.funcSetTOTPSecret != nil {
+		return mmSetTOTPSecret.funcSetTOTPSecret(ctx, id, encryptedSecret)
+	}
+	mmSetTOTPSecret.t.Fatalf("Unexpected call to UserRepositoryMock.SetTOTPSecret. %v %v %v", ctx, id, encryptedSecret)
+	return
+}
+
+// SetTOTPSecretAfterCounter returns a count of finished UserRepositoryMock.SetTOTPSecret invocations
+func (mmSetTOTPSecret *UserRepositoryMock) SetTOTPSecretAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetTOTPSecret.afterSetTOTPSecretCounter)
+}
+
+// SetTOTPSecretBeforeCounter returns a count of UserRepositoryMock.SetTOTPSecret invocations
+func (mmSetTOTPSecret *UserRepositoryMock) SetTOTPSecretBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetTOTPSecret.beforeSetTOTPSecretCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.SetTOTPSecret.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetTOTPSecret *mUserRepositoryMockSetTOTPSecret) Calls() []*UserRepositoryMockSetTOTPSecretParams {
+	mmSetTOTPSecret.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockSetTOTPSecretParams, len(mmSetTOTPSecret.callArgs))
+	copy(argCopy, mmSetTOTPSecret.callArgs)
+
+	mmSetTOTPSecret.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetTOTPSecretDone returns true if the count of the SetTOTPSecret invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockSetTOTPSecretDone() bool {
+	if m.SetTOTPSecretMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetTOTPSecretMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetTOTPSecretMock.invocationsDone()
+}
+
+// MinimockSetTOTPSecretInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockSetTOTPSecretInspect() {
+	for _, e := range m.SetTOTPSecretMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.SetTOTPSecret with params: %#v", *e.params)
+		}
+	}
+
+	afterSetTOTPSecretCounter := mm_atomic.LoadUint64(&m.afterSetTOTPSecretCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetTOTPSecretMock.defaultExpectation != nil && afterSetTOTPSecretCounter < 1 {
+		if m.SetTOTPSecretMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserRepositoryMock.SetTOTPSecret")
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.SetTOTPSecret with params: %#v", *m.SetTOTPSecretMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetTOTPSecret != nil && afterSetTOTPSecretCounter < 1 {
+		m.t.Error("Expected call to UserRepositoryMock.SetTOTPSecret")
+	}
+
+	if !m.SetTOTPSecretMock.invocationsDone() && afterSetTOTPSecretCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.SetTOTPSecret but found %d calls",
+			mm_atomic.LoadUint64(&m.SetTOTPSecretMock.expectedInvocations), afterSetTOTPSecretCounter)
+	}
+}
+
+type mUserRepositoryMockUpdatePassword struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockUpdatePasswordExpectation
+	expectations       []*UserRepositoryMockUpdatePasswordExpectation
+
+	callArgs []*UserRepositoryMockUpdatePasswordParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// UserRepositoryMockUpdatePasswordExpectation specifies expectation struct of the UserRepository.UpdatePassword
+type UserRepositoryMockUpdatePasswordExpectation struct {
+	mock      *UserRepositoryMock
+	params    *UserRepositoryMockUpdatePasswordParams
+	paramPtrs *UserRepositoryMockUpdatePasswordParamPtrs
+	results   *UserRepositoryMockUpdatePasswordResults
+	Counter   uint64
+}
+
+// UserRepositoryMockUpdatePasswordParams contains parameters of the UserRepository.UpdatePassword
+type UserRepositoryMockUpdatePasswordParams struct {
+	ctx          context.Context
+	id           int64
+	passwordHash string
+}
+
+// UserRepositoryMockUpdatePasswordParamPtrs contains pointers to parameters of the UserRepository.UpdatePassword
+type UserRepositoryMockUpdatePasswordParamPtrs struct {
+	ctx          *context.Context
+	id           *int64
+	passwordHash *string
+}
+
+// UserRepositoryMockUpdatePasswordResults contains results of the UserRepository.UpdatePassword
+type UserRepositoryMockUpdatePasswordResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) Optional() *mUserRepositoryMockUpdatePassword {
+	mmUpdatePassword.optional = true
+	return mmUpdatePassword
+}
+
+// Expect sets up expected params for UserRepository.UpdatePassword
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) Expect(ctx context.Context, id int64, passwordHash string) *mUserRepositoryMockUpdatePassword {
+	if mmUpdatePassword.mock.funcUpdatePassword != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by Set")
+	}
+
+	if mmUpdatePassword.defaultExpectation == nil {
+		mmUpdatePassword.defaultExpectation = &UserRepositoryMockUpdatePasswordExpectation{}
+	}
+
+	if mmUpdatePassword.defaultExpectation.paramPtrs != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by ExpectParams functions")
+	}
+
+	mmUpdatePassword.defaultExpectation.params = &UserRepositoryMockUpdatePasswordParams{ctx, id, passwordHash}
+	for _, e := range mmUpdatePassword.expectations {
+		if minimock.Equal(e.params, mmUpdatePassword.defaultExpectation.params) {
+			mmUpdatePassword.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdatePassword.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdatePassword
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.UpdatePassword
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockUpdatePassword {
+	if mmUpdatePassword.mock.funcUpdatePassword != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by Set")
+	}
+
+	if mmUpdatePassword.defaultExpectation == nil {
+		mmUpdatePassword.defaultExpectation = &UserRepositoryMockUpdatePasswordExpectation{}
+	}
+
+	if mmUpdatePassword.defaultExpectation.params != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by Expect")
+	}
+
+	if mmUpdatePassword.defaultExpectation.paramPtrs == nil {
+		mmUpdatePassword.defaultExpectation.paramPtrs = &UserRepositoryMockUpdatePasswordParamPtrs{}
+	}
+	mmUpdatePassword.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmUpdatePassword
+}
+
+// ExpectIdParam2 sets up expected param id for UserRepository.UpdatePassword
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) ExpectIdParam2(id int64) *mUserRepositoryMockUpdatePassword {
+	if mmUpdatePassword.mock.funcUpdatePassword != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by Set")
+	}
+
+	if mmUpdatePassword.defaultExpectation == nil {
+		mmUpdatePassword.defaultExpectation = &UserRepositoryMockUpdatePasswordExpectation{}
+	}
+
+	if mmUpdatePassword.defaultExpectation.params != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by Expect")
+	}
+
+	if mmUpdatePassword.defaultExpectation.paramPtrs == nil {
+		mmUpdatePassword.defaultExpectation.paramPtrs = &UserRepositoryMockUpdatePasswordParamPtrs{}
+	}
+	mmUpdatePassword.defaultExpectation.paramPtrs.id = &id
+
+	return mmUpdatePassword
+}
+
+// ExpectPasswordHashParam3 sets up expected param passwordHash for UserRepository.UpdatePassword
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) ExpectPasswordHashParam3(passwordHash string) *mUserRepositoryMockUpdatePassword {
+	if mmUpdatePassword.mock.funcUpdatePassword != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by Set")
+	}
+
+	if mmUpdatePassword.defaultExpectation == nil {
+		mmUpdatePassword.defaultExpectation = &UserRepositoryMockUpdatePasswordExpectation{}
+	}
+
+	if mmUpdatePassword.defaultExpectation.params != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by Expect")
+	}
+
+	if mmUpdatePassword.defaultExpectation.paramPtrs == nil {
+		mmUpdatePassword.defaultExpectation.paramPtrs = &UserRepositoryMockUpdatePasswordParamPtrs{}
+	}
+	mmUpdatePassword.defaultExpectation.paramPtrs.passwordHash = &passwordHash
+
+	return mmUpdatePassword
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.UpdatePassword
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) Inspect(f func(ctx context.Context, id int64, passwordHash string)) *mUserRepositoryMockUpdatePassword {
+	if mmUpdatePassword.mock.inspectFuncUpdatePassword != nil {
+		mmUpdatePassword.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.UpdatePassword")
+	}
+
+	mmUpdatePassword.mock.inspectFuncUpdatePassword = f
+
+	return mmUpdatePassword
+}
+
+// Return sets up results that will be returned by UserRepository.UpdatePassword
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) Return(err error) *UserRepositoryMock {
+	if mmUpdatePassword.mock.funcUpdatePassword != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by Set")
+	}
+
+	if mmUpdatePassword.defaultExpectation == nil {
+		mmUpdatePassword.defaultExpectation = &UserRepositoryMockUpdatePasswordExpectation{mock: mmUpdatePassword.mock}
+	}
+	mmUpdatePassword.defaultExpectation.results = &UserRepositoryMockUpdatePasswordResults{err}
+	return mmUpdatePassword.mock
+}
+
+// Set uses given function f to mock the UserRepository.UpdatePassword method
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) Set(f func(ctx context.Context, id int64, passwordHash string) (err error)) *UserRepositoryMock {
+	if mmUpdatePassword.defaultExpectation != nil {
+		mmUpdatePassword.mock.t.Fatalf("Default expectation is already set for the UserRepository.UpdatePassword method")
+	}
+
+	if len(mmUpdatePassword.expectations) > 0 {
+		mmUpdatePassword.mock.t.Fatalf("Some expectations are already set for the UserRepository.UpdatePassword method")
+	}
+
+	mmUpdatePassword.mock.funcUpdatePassword = f
+	return mmUpdatePassword.mock
+}
+
+// When sets expectation for the UserRepository.UpdatePassword which will trigger the result defined by the following
+// Then helper
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) When(ctx context.Context, id int64, passwordHash string) *UserRepositoryMockUpdatePasswordExpectation {
+	if mmUpdatePassword.mock.funcUpdatePassword != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockUpdatePasswordExpectation{
+		mock:   mmUpdatePassword.mock,
+		params: &UserRepositoryMockUpdatePasswordParams{ctx, id, passwordHash},
+	}
+	mmUpdatePassword.expectations = append(mmUpdatePassword.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.UpdatePassword return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockUpdatePasswordExpectation) Then(err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockUpdatePasswordResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.UpdatePassword should be invoked
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) Times(n uint64) *mUserRepositoryMockUpdatePassword {
+	if n == 0 {
+		mmUpdatePassword.mock.t.Fatalf("Times of UserRepositoryMock.UpdatePassword mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdatePassword.expectedInvocations, n)
+	return mmUpdatePassword
+}
+
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) invocationsDone() bool {
+	if len(mmUpdatePassword.expectations) == 0 && mmUpdatePassword.defaultExpectation == nil && mmUpdatePassword.mock.funcUpdatePassword == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdatePassword.mock.afterUpdatePasswordCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdatePassword.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdatePassword implements repository.UserRepository
+func (mmUpdatePassword *UserRepositoryMock) UpdatePassword(ctx context.Context, id int64, passwordHash string) (err error) {
+	mm_atomic.AddUint64(&mmUpdatePassword.beforeUpdatePasswordCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdatePassword.afterUpdatePasswordCounter, 1)
+
+	if mmUpdatePassword.inspectFuncUpdatePassword != nil {
+		mmUpdatePassword.inspectFuncUpdatePassword(ctx, id, passwordHash)
+	}
+
+	mm_params := UserRepositoryMockUpdatePasswordParams{ctx, id, passwordHash}
+
+	// Record call args
+	mmUpdatePassword.UpdatePasswordMock.mutex.Lock()
+	mmUpdatePassword.UpdatePasswordMock.callArgs = append(mmUpdatePassword.UpdatePasswordMock.callArgs, &mm_params)
+	mmUpdatePassword.UpdatePasswordMock.mutex.Unlock()
+
+	for _, e := range mmUpdatePassword.UpdatePasswordMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdatePassword.UpdatePasswordMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdatePassword.UpdatePasswordMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdatePassword.UpdatePasswordMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdatePassword.UpdatePasswordMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockUpdatePasswordParams{ctx, id, passwordHash}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdatePassword.t.Errorf("UserRepositoryMock.UpdatePassword got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmUpdatePassword.t.Errorf("UserRepositoryMock.UpdatePassword got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.passwordHash != nil && !minimock.Equal(*mm_want_ptrs.passwordHash, mm_got.passwordHash) {
+				mmUpdatePassword.t.Errorf("UserRepositoryMock.UpdatePassword got unexpected parameter passwordHash, want: %#v, got: %#v%s\n", *mm_want_ptrs.passwordHash, mm_got.passwordHash, minimock.Diff(*mm_want_ptrs.passwordHash, mm_got.passwordHash))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdatePassword.t.Errorf("UserRepositoryMock.UpdatePassword got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdatePassword.UpdatePasswordMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdatePassword.t.Fatal("No results are set for the UserRepositoryMock.UpdatePassword")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdatePassword.funcUpdatePassword != nil {
+		return mmUpdatePassword.funcUpdatePassword(ctx, id, passwordHash)
+	}
+	mmUpdatePassword.t.Fatalf("Unexpected call to UserRepositoryMock.UpdatePassword. %v %v %v", ctx, id, passwordHash)
+	return
+}
+
+// UpdatePasswordAfterCounter returns a count of finished UserRepositoryMock.UpdatePassword invocations
+func (mmUpdatePassword *UserRepositoryMock) UpdatePasswordAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdatePassword.afterUpdatePasswordCounter)
+}
+
+// UpdatePasswordBeforeCounter returns a count of UserRepositoryMock.UpdatePassword invocations
+func (mmUpdatePassword *UserRepositoryMock) UpdatePasswordBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdatePassword.beforeUpdatePasswordCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.UpdatePassword.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) Calls() []*UserRepositoryMockUpdatePasswordParams {
+	mmUpdatePassword.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockUpdatePasswordParams, len(mmUpdatePassword.callArgs))
+	copy(argCopy, mmUpdatePassword.callArgs)
+
+	mmUpdatePassword.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdatePasswordDone returns true if the count of the UpdatePassword invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockUpdatePasswordDone() bool {
+	if m.UpdatePasswordMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdatePasswordMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdatePasswordMock.invocationsDone()
+}
+
+// MinimockUpdatePasswordInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockUpdatePasswordInspect() {
+	for _, e := range m.UpdatePasswordMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.UpdatePassword with params: %#v", *e.params)
+		}
+	}
+
+	afterUpdatePasswordCounter := mm_atomic.LoadUint64(&m.afterUpdatePasswordCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdatePasswordMock.defaultExpectation != nil && afterUpdatePasswordCounter < 1 {
+		if m.UpdatePasswordMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserRepositoryMock.UpdatePassword")
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.UpdatePassword with params: %#v", *m.UpdatePasswordMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdatePassword != nil && afterUpdatePasswordCounter < 1 {
+		m.t.Error("Expected call to UserRepositoryMock.UpdatePassword")
+	}
+
+	if !m.UpdatePasswordMock.invocationsDone() && afterUpdatePasswordCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.UpdatePassword but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdatePasswordMock.expectedInvocations), afterUpdatePasswordCounter)
+	}
+}
+
+type mUserRepositoryMockUpdateRole struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockUpdateRoleExpectation
+	expectations       []*UserRepositoryMockUpdateRoleExpectation
+
+	callArgs []*UserRepositoryMockUpdateRoleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// UserRepositoryMockUpdateRoleExpectation specifies expectation struct of the UserRepository.UpdateRole
+type UserRepositoryMockUpdateRoleExpectation struct {
+	mock      *UserRepositoryMock
+	params    *UserRepositoryMockUpdateRoleParams
+	paramPtrs *UserRepositoryMockUpdateRoleParamPtrs
+	results   *UserRepositoryMockUpdateRoleResults
+	Counter   uint64
+}
+
+// UserRepositoryMockUpdateRoleParams contains parameters of the UserRepository.UpdateRole
+type UserRepositoryMockUpdateRoleParams struct {
+	ctx  context.Context
+	id   int64
+	role string
+}
+
+// UserRepositoryMockUpdateRoleParamPtrs contains pointers to parameters of the UserRepository.UpdateRole
+type UserRepositoryMockUpdateRoleParamPtrs struct {
+	ctx  *context.Context
+	id   *int64
+	role *string
+}
+
+// UserRepositoryMockUpdateRoleResults contains results of the UserRepository.UpdateRole
+type UserRepositoryMockUpdateRoleResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateRole *mUserRepositoryMockUpdateRole) Optional() *mUserRepositoryMockUpdateRole {
+	mmUpdateRole.optional = true
+	return mmUpdateRole
+}
+
+// Expect sets up expected params for UserRepository.UpdateRole
+func (mmUpdateRole *mUserRepositoryMockUpdateRole) Expect(ctx context.Context, id int64, role string) *mUserRepositoryMockUpdateRole {
+	if mmUpdateRole.mock.funcUpdateRole != nil {
+		mmUpdateRole.mock.t.Fatalf("UserRepositoryMock.UpdateRole mock is already set by Set")
+	}
+
+	if mmUpdateRole.defaultExpectation == nil {
+		mmUpdateRole.defaultExpectation = &UserRepositoryMockUpdateRoleExpectation{}
+	}
+
+	if mmUpdateRole.defaultExpectation.paramPtrs != nil {
+		mmUpdateRole.mock.t.Fatalf("UserRepositoryMock.UpdateRole mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateRole.defaultExpectation.params = &UserRepositoryMockUpdateRoleParams{ctx, id, role}
+	for _, e := range mmUpdateRole.expectations {
+		if minimock.Equal(e.params, mmUpdateRole.defaultExpectation.params) {
+			mmUpdateRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateRole.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateRole
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.UpdateRole
+func (mmUpdateRole *mUserRepositoryMockUpdateRole) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockUpdateRole {
+	if mmUpdateRole.mock.funcUpdateRole != nil {
+		mmUpdateRole.mock.t.Fatalf("UserRepositoryMock.UpdateRole mock is already set by Set")
+	}
+
+	if mmUpdateRole.defaultExpectation == nil {
+		mmUpdateRole.defaultExpectation = &UserRepositoryMockUpdateRoleExpectation{}
+	}
+
+	if mmUpdateRole.defaultExpectation.params != nil {
+		mmUpdateRole.mock.t.Fatalf("UserRepositoryMock.UpdateRole mock is already set by Expect")
+	}
+
+	if mmUpdateRole.defaultExpectation.paramPtrs == nil {
+		mmUpdateRole.defaultExpectation.paramPtrs = &UserRepositoryMockUpdateRoleParamPtrs{}
+	}
+	mmUpdateRole.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmUpdateRole
+}
+
+// ExpectIdParam2 sets up expected param id for UserRepository.UpdateRole
+func (mmUpdateRole *mUserRepositoryMockUpdateRole) ExpectIdParam2(id int64) *mUserRepositoryMockUpdateRole {
+	if mmUpdateRole.mock.funcUpdateRole != nil {
+		mmUpdateRole.mock.t.Fatalf("UserRepositoryMock.UpdateRole mock is already set by Set")
+	}
+
+	if mmUpdateRole.defaultExpectation == nil {
+		mmUpdateRole.defaultExpectation = &UserRepositoryMockUpdateRoleExpectation{}
+	}
+
+	if mmUpdateRole.defaultExpectation.params != nil {
+		mmUpdateRole.mock.t.Fatalf("UserRepositoryMock.UpdateRole mock is already set by Expect")
+	}
+
+	if mmUpdateRole.defaultExpectation.paramPtrs == nil {
+		mmUpdateRole.defaultExpectation.paramPtrs = &UserRepositoryMockUpdateRoleParamPtrs{}
+	}
+	mmUpdateRole.defaultExpectation.paramPtrs.id = &id
+
+	return mmUpdateRole
+}
+
+// ExpectRoleParam3 sets up expected param role for UserRepository.UpdateRole
+func (mmUpdateRole *mUserRepositoryMockUpdateRole) ExpectRoleParam3(role string) *mUserRepositoryMockUpdateRole {
+	if mmUpdateRole.mock.funcUpdateRole != nil {
+		mmUpdateRole.mock.t.Fatalf("UserRepositoryMock.UpdateRole mock is already set by Set")
+	}
+
+	if mmUpdateRole.defaultExpectation == nil {
+		mmUpdateRole.defaultExpectation = &UserRepositoryMockUpdateRoleExpectation{}
+	}
+
+	if mmUpdateRole.defaultExpectation.params != nil {
+		mmUpdateRole.mock.t.Fatalf("UserRepositoryMock.UpdateRole mock is already set by Expect")
+	}
+
+	if mmUpdateRole.defaultExpectation.paramPtrs == nil {
+		mmUpdateRole.defaultExpectation.paramPtrs = &UserRepositoryMockUpdateRoleParamPtrs{}
+	}
+	mmUpdateRole.defaultExpectation.paramPtrs.role = &role
+
+	return mmUpdateRole
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.UpdateRole
+func (mmUpdateRole *mUserRepositoryMockUpdateRole) Inspect(f func(ctx context.Context, id int64, role string)) *mUserRepositoryMockUpdateRole {
+	if mmUpdateRole.mock.inspectFuncUpdateRole != nil {
+		mmUpdateRole.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.UpdateRole")
+	}
+
+	mmUpdateRole.mock.inspectFuncUpdateRole = f
+
+	return mmUpdateRole
+}
+
+// Return sets up results that will be returned by UserRepository.UpdateRole
+func (mmUpdateRole *mUserRepositoryMockUpdateRole) Return(err error) *UserRepositoryMock {
+	if mmUpdateRole.mock.funcUpdateRole != nil {
+		mmUpdateRole.mock.t.Fatalf("UserRepositoryMock.UpdateRole mock is already set by Set")
+	}
+
+	if mmUpdateRole.defaultExpectation == nil {
+		mmUpdateRole.defaultExpectation = &UserRepositoryMockUpdateRoleExpectation{mock: mmUpdateRole.mock}
+	}
+	mmUpdateRole.defaultExpectation.results = &UserRepositoryMockUpdateRoleResults{err}
+	return mmUpdateRole.mock
+}
+
+// Set uses given function f to mock the UserRepository.UpdateRole method
+func (mmUpdateRole *mUserRepositoryMockUpdateRole) Set(f func(ctx context.Context, id int64, role string) (err error)) *UserRepositoryMock {
+	if mmUpdateRole.defaultExpectation != nil {
+		mmUpdateRole.mock.t.Fatalf("Default expectation is already set for the UserRepository.UpdateRole method")
+	}
+
+	if len(mmUpdateRole.expectations) > 0 {
+		mmUpdateRole.mock.t.Fatalf("Some expectations are already set for the UserRepository.UpdateRole method")
+	}
+
+	mmUpdateRole.mock.funcUpdateRole = f
+	return mmUpdateRole.mock
+}
+
+// When sets expectation for the UserRepository.UpdateRole which will trigger the result defined by the following
+// Then helper
+func (mmUpdateRole *mUserRepositoryMockUpdateRole) When(ctx context.Context, id int64, role string) *UserRepositoryMockUpdateRoleExpectation {
+	if mmUpdateRole.mock.funcUpdateRole != nil {
+		mmUpdateRole.mock.t.Fatalf("UserRepositoryMock.UpdateRole mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockUpdateRoleExpectation{
+		mock:   mmUpdateRole.mock,
+		params: &UserRepositoryMockUpdateRoleParams{ctx, id, role},
+	}
+	mmUpdateRole.expectations = append(mmUpdateRole.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.UpdateRole return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockUpdateRoleExpectation) Then(err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockUpdateRoleResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.UpdateRole should be invoked
+func (mmUpdateRole *mUserRepositoryMockUpdateRole) Times(n uint64) *mUserRepositoryMockUpdateRole {
+	if n == 0 {
+		mmUpdateRole.mock.t.Fatalf("Times of UserRepositoryMock.UpdateRole mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateRole.expectedInvocations, n)
+	return mmUpdateRole
+}
+
+func (mmUpdateRole *mUserRepositoryMockUpdateRole) invocationsDone() bool {
+	if len(mmUpdateRole.expectations) == 0 && mmUpdateRole.defaultExpectation == nil && mmUpdateRole.mock.funcUpdateRole == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateRole.mock.afterUpdateRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateRole.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateRole implements repository.UserRepository
+func (mmUpdateRole *UserRepositoryMock) UpdateRole(ctx context.Context, id int64, role string) (err error) {
+	mm_atomic.AddUint64(&mmUpdateRole.beforeUpdateRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateRole.afterUpdateRoleCounter, 1)
+
+	if mmUpdateRole.inspectFuncUpdateRole != nil {
+		mmUpdateRole.inspectFuncUpdateRole(ctx, id, role)
+	}
+
+	mm_params := UserRepositoryMockUpdateRoleParams{ctx, id, role}
+
+	// Record call args
+	mmUpdateRole.UpdateRoleMock.mutex.Lock()
+	mmUpdateRole.UpdateRoleMock.callArgs = append(mmUpdateRole.UpdateRoleMock.callArgs, &mm_params)
+	mmUpdateRole.UpdateRoleMock.mutex.Unlock()
+
+	for _, e := range mmUpdateRole.UpdateRoleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdateRole.UpdateRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateRole.UpdateRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateRole.UpdateRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateRole.UpdateRoleMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockUpdateRoleParams{ctx, id, role}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateRole.t.Errorf("UserRepositoryMock.UpdateRole got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmUpdateRole.t.Errorf("UserRepositoryMock.UpdateRole got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmUpdateRole.t.Errorf("UserRepositoryMock.UpdateRole got unexpected parameter role, want: %#v, got: %#v%s\n", *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateRole.t.Errorf("UserRepositoryMock.UpdateRole got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateRole.UpdateRoleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateRole.t.Fatal("No results are set for the UserRepositoryMock.UpdateRole")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdateRole.funcUpdateRole != nil {
+		return mmUpdateRole.funcUpdateRole(ctx, id, role)
+	}
+	mmUpdateRole.t.Fatalf("Unexpected call to UserRepositoryMock.UpdateRole. %v %v %v", ctx, id, role)
+	return
+}
+
+// UpdateRoleAfterCounter returns a count of finished UserRepositoryMock.UpdateRole invocations
+func (mmUpdateRole *UserRepositoryMock) UpdateRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateRole.afterUpdateRoleCounter)
+}
+
+// UpdateRoleBeforeCounter returns a count of UserRepositoryMock.UpdateRole invocations
+func (mmUpdateRole *UserRepositoryMock) UpdateRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateRole.beforeUpdateRoleCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.UpdateRole.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateRole *mUserRepositoryMockUpdateRole) Calls() []*UserRepositoryMockUpdateRoleParams {
+	mmUpdateRole.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockUpdateRoleParams, len(mmUpdateRole.callArgs))
+	copy(argCopy, mmUpdateRole.callArgs)
+
+	mmUpdateRole.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateRoleDone returns true if the count of the UpdateRole invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockUpdateRoleDone() bool {
+	if m.UpdateRoleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateRoleMock.invocationsDone()
+}
+
+// MinimockUpdateRoleInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockUpdateRoleInspect() {
+	for _, e := range m.UpdateRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.UpdateRole with params: %#v", *e.params)
+		}
+	}
+
+	afterUpdateRoleCounter := mm_atomic.LoadUint64(&m.afterUpdateRoleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateRoleMock.defaultExpectation != nil && afterUpdateRoleCounter < 1 {
+		if m.UpdateRoleMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserRepositoryMock.UpdateRole")
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.UpdateRole with params: %#v", *m.UpdateRoleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateRole != nil && afterUpdateRoleCounter < 1 {
+		m.t.Error("Expected call to UserRepositoryMock.UpdateRole")
+	}
+
+	if !m.UpdateRoleMock.invocationsDone() && afterUpdateRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.UpdateRole but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateRoleMock.expectedInvocations), afterUpdateRoleCounter)
+	}
+}
+
+type mUserRepositoryMockUseTOTPStep struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockUseTOTPStepExpectation
+	expectations       []*UserRepositoryMockUseTOTPStepExpectation
+
+	callArgs []*UserRepositoryMockUseTOTPStepParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// UserRepositoryMockUseTOTPStepExpectation specifies expectation struct of the UserRepository.UseTOTPStep
+type UserRepositoryMockUseTOTPStepExpectation struct {
+	mock      *UserRepositoryMock
+	params    *UserRepositoryMockUseTOTPStepParams
+	paramPtrs *UserRepositoryMockUseTOTPStepParamPtrs
+	results   *UserRepositoryMockUseTOTPStepResults
+	Counter   uint64
+}
+
+// UserRepositoryMockUseTOTPStepParams contains parameters of the UserRepository.UseTOTPStep
+type UserRepositoryMockUseTOTPStepParams struct {
+	ctx  context.Context
+	id   int64
+	step int64
+}
+
+// UserRepositoryMockUseTOTPStepParamPtrs contains pointers to parameters of the UserRepository.UseTOTPStep
+type UserRepositoryMockUseTOTPStepParamPtrs struct {
+	ctx  *context.Context
+	id   *int64
+	step *int64
+}
+
+// UserRepositoryMockUseTOTPStepResults contains results of the UserRepository.UseTOTPStep
+type UserRepositoryMockUseTOTPStepResults struct {
+	b1  bool
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUseTOTPStep *mUserRepositoryMockUseTOTPStep) Optional() *mUserRepositoryMockUseTOTPStep {
+	mmUseTOTPStep.optional = true
+	return mmUseTOTPStep
+}
+
+// Expect sets up expected params for UserRepository.UseTOTPStep
+func (mmUseTOTPStep *mUserRepositoryMockUseTOTPStep) Expect(ctx context.Context, id int64, step int64) *mUserRepositoryMockUseTOTPStep {
+	if mmUseTOTPStep.mock.funcUseTOTPStep != nil {
+		mmUseTOTPStep.mock.t.Fatalf("UserRepositoryMock.UseTOTPStep mock is already set by Set")
+	}
+
+	if mmUseTOTPStep.defaultExpectation == nil {
+		mmUseTOTPStep.defaultExpectation = &UserRepositoryMockUseTOTPStepExpectation{}
+	}
+
+	if mmUseTOTPStep.defaultExpectation.paramPtrs != nil {
+		mmUseTOTPStep.mock.t.Fatalf("UserRepositoryMock.UseTOTPStep mock is already set by ExpectParams functions")
+	}
+
+	mmUseTOTPStep.defaultExpectation.params = &UserRepositoryMockUseTOTPStepParams{ctx, id, step}
+	for _, e := range mmUseTOTPStep.expectations {
+		if minimock.Equal(e.params, mmUseTOTPStep.defaultExpectation.params) {
+			mmUseTOTPStep.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUseTOTPStep.defaultExpectation.params)
+		}
+	}
+
+	return mmUseTOTPStep
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.UseTOTPStep
+func (mmUseTOTPStep *mUserRepositoryMockUseTOTPStep) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockUseTOTPStep {
+	if mmUseTOTPStep.mock.funcUseTOTPStep != nil {
+		mmUseTOTPStep.mock.t.Fatalf("UserRepositoryMock.UseTOTPStep mock is already set by Set")
+	}
+
+	if mmUseTOTPStep.defaultExpectation == nil {
+		mmUseTOTPStep.defaultExpectation = &UserRepositoryMockUseTOTPStepExpectation{}
+	}
+
+	if mmUseTOTPStep.defaultExpectation.params != nil {
+		mmUseTOTPStep.mock.t.Fatalf("UserRepositoryMock.UseTOTPStep mock is already set by Expect")
+	}
+
+	if mmUseTOTPStep.defaultExpectation.paramPtrs == nil {
+		mmUseTOTPStep.defaultExpectation.paramPtrs = &UserRepositoryMockUseTOTPStepParamPtrs{}
+	}
+	mmUseTOTPStep.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmUseTOTPStep
+}
+
+// ExpectIdParam2 sets up expected param id for UserRepository.UseTOTPStep
+func (mmUseTOTPStep *mUserRepositoryMockUseTOTPStep) ExpectIdParam2(id int64) *mUserRepositoryMockUseTOTPStep {
+	if mmUseTOTPStep.mock.funcUseTOTPStep != nil {
+		mmUseTOTPStep.mock.t.Fatalf("UserRepositoryMock.UseTOTPStep mock is already set by Set")
+	}
+
+	if mmUseTOTPStep.defaultExpectation == nil {
+		mmUseTOTPStep.defaultExpectation = &UserRepositoryMockUseTOTPStepExpectation{}
+	}
+
+	if mmUseTOTPStep.defaultExpectation.params != nil {
+		mmUseTOTPStep.mock.t.Fatalf("UserRepositoryMock.UseTOTPStep mock is already set by Expect")
+	}
+
+	if mmUseTOTPStep.defaultExpectation.paramPtrs == nil {
+		mmUseTOTPStep.defaultExpectation.paramPtrs = &UserRepositoryMockUseTOTPStepParamPtrs{}
+	}
+	mmUseTOTPStep.defaultExpectation.paramPtrs.id = &id
+
+	return mmUseTOTPStep
+}
+
+// ExpectStepParam3 sets up expected param step for UserRepository.UseTOTPStep
+func (mmUseTOTPStep *mUserRepositoryMockUseTOTPStep) ExpectStepParam3(step int64) *mUserRepositoryMockUseTOTPStep {
+	if mmUseTOTPStep.mock.funcUseTOTPStep != nil {
+		mmUseTOTPStep.mock.t.Fatalf("UserRepositoryMock.UseTOTPStep mock is already set by Set")
+	}
+
+	if mmUseTOTPStep.defaultExpectation == nil {
+		mmUseTOTPStep.defaultExpectation = &UserRepositoryMockUseTOTPStepExpectation{}
+	}
+
+	if mmUseTOTPStep.defaultExpectation.params != nil {
+		mmUseTOTPStep.mock.t.Fatalf("UserRepositoryMock.UseTOTPStep mock is already set by Expect")
+	}
+
+	if mmUseTOTPStep.defaultExpectation.paramPtrs == nil {
+		mmUseTOTPStep.defaultExpectation.paramPtrs = &UserRepositoryMockUseTOTPStepParamPtrs{}
+	}
+	mmUseTOTPStep.defaultExpectation.paramPtrs.step = &step
+
+	return mmUseTOTPStep
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.UseTOTPStep
+func (mmUseTOTPStep *mUserRepositoryMockUseTOTPStep) Inspect(f func(ctx context.Context, id int64, step int64)) *mUserRepositoryMockUseTOTPStep {
+	if mmUseTOTPStep.mock.inspectFuncUseTOTPStep != nil {
+		mmUseTOTPStep.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.UseTOTPStep")
+	}
+
+	mmUseTOTPStep.mock.inspectFuncUseTOTPStep = f
+
+	return mmUseTOTPStep
+}
+
+// Return sets up results that will be returned by UserRepository.UseTOTPStep
+func (mmUseTOTPStep *mUserRepositoryMockUseTOTPStep) Return(b1 bool, err error) *UserRepositoryMock {
+	if mmUseTOTPStep.mock.funcUseTOTPStep != nil {
+		mmUseTOTPStep.mock.t.Fatalf("UserRepositoryMock.UseTOTPStep mock is already set by Set")
+	}
+
+	if mmUseTOTPStep.defaultExpectation == nil {
+		mmUseTOTPStep.defaultExpectation = &UserRepositoryMockUseTOTPStepExpectation{mock: mmUseTOTPStep.mock}
+	}
+	mmUseTOTPStep.defaultExpectation.results = &UserRepositoryMockUseTOTPStepResults{b1, err}
+	return mmUseTOTPStep.mock
+}
+
+// Set uses given function f to mock the UserRepository.UseTOTPStep method
+func (mmUseTOTPStep *mUserRepositoryMockUseTOTPStep) Set(f func(ctx context.Context, id int64, step int64) (b1 bool, err error)) *UserRepositoryMock {
+	if mmUseTOTPStep.defaultExpectation != nil {
+		mmUseTOTPStep.mock.t.Fatalf("Default expectation is already set for the UserRepository.UseTOTPStep method")
+	}
+
+	if len(mmUseTOTPStep.expectations) > 0 {
+		mmUseTOTPStep.mock.t.Fatalf("Some expectations are already set for the UserRepository.UseTOTPStep method")
+	}
+
+	mmUseTOTPStep.mock.funcUseTOTPStep = f
+	return mmUseTOTPStep.mock
+}
+
+// When sets expectation for the UserRepository.UseTOTPStep which will trigger the result defined by the following
+// Then helper
+func (mmUseTOTPStep *mUserRepositoryMockUseTOTPStep) When(ctx context.Context, id int64, step int64) *UserRepositoryMockUseTOTPStepExpectation {
+	if mmUseTOTPStep.mock.funcUseTOTPStep != nil {
+		mmUseTOTPStep.mock.t.Fatalf("UserRepositoryMock.UseTOTPStep mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockUseTOTPStepExpectation{
+		mock:   mmUseTOTPStep.mock,
+		params: &UserRepositoryMockUseTOTPStepParams{ctx, id, step},
+	}
+	mmUseTOTPStep.expectations = append(mmUseTOTPStep.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.UseTOTPStep return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockUseTOTPStepExpectation) Then(b1 bool, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockUseTOTPStepResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.UseTOTPStep should be invoked
+func (mmUseTOTPStep *mUserRepositoryMockUseTOTPStep) Times(n uint64) *mUserRepositoryMockUseTOTPStep {
+	if n == 0 {
+		mmUseTOTPStep.mock.t.Fatalf("Times of UserRepositoryMock.UseTOTPStep mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUseTOTPStep.expectedInvocations, n)
+	return mmUseTOTPStep
+}
+
+func (mmUseTOTPStep *mUserRepositoryMockUseTOTPStep) invocationsDone() bool {
+	if len(mmUseTOTPStep.expectations) == 0 && mmUseTOTPStep.defaultExpectation == nil && mmUseTOTPStep.mock.funcUseTOTPStep == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUseTOTPStep.mock.afterUseTOTPStepCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUseTOTPStep.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UseTOTPStep implements repository.UserRepository
+func (mmUseTOTPStep *UserRepositoryMock) UseTOTPStep(ctx context.Context, id int64, step int64) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmUseTOTPStep.beforeUseTOTPStepCounter, 1)
+	defer mm_atomic.AddUint64(&mmUseTOTPStep.afterUseTOTPStepCounter, 1)
+
+	if mmUseTOTPStep.inspectFuncUseTOTPStep != nil {
+		mmUseTOTPStep.inspectFuncUseTOTPStep(ctx, id, step)
+	}
+
+	mm_params := UserRepositoryMockUseTOTPStepParams{ctx, id, step}
+
+	// Record call args
+	mmUseTOTPStep.UseTOTPStepMock.mutex.Lock()
+	mmUseTOTPStep.UseTOTPStepMock.callArgs = append(mmUseTOTPStep.UseTOTPStepMock.callArgs, &mm_params)
+	mmUseTOTPStep.UseTOTPStepMock.mutex.Unlock()
+
+	for _, e := range mmUseTOTPStep.UseTOTPStepMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmUseTOTPStep.UseTOTPStepMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUseTOTPStep.UseTOTPStepMock.defaultExpectation.Counter, 1)
+		mm_want := mmUseTOTPStep.UseTOTPStepMock.defaultExpectation.params
+		mm_want_ptrs := mmUseTOTPStep.UseTOTPStepMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockUseTOTPStepParams{ctx, id, step}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUseTOTPStep.t.Errorf("UserRepositoryMock.UseTOTPStep got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmUseTOTPStep.t.Errorf("UserRepositoryMock.UseTOTPStep got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.step != nil && !minimock.Equal(*mm_want_ptrs.step, mm_got.step) {
+				mmUseTOTPStep.t.Errorf("UserRepositoryMock.UseTOTPStep got unexpected parameter step, want: %#v, got: %#v%s\n", *mm_want_ptrs.step, mm_got.step, minimock.Diff(*mm_want_ptrs.step, mm_got.step))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUseTOTPStep.t.Errorf("UserRepositoryMock.UseTOTPStep got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUseTOTPStep.UseTOTPStepMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUseTOTPStep.t.Fatal("No results are set for the UserRepositoryMock.UseTOTPStep")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmUseTOTPStep.funcUseTOTPStep != nil {
+		return mmUseTOTPStep.funcUseTOTPStep(ctx, id, step)
+	}
+	mmUseTOTPStep.t.Fatalf("Unexpected call to UserRepositoryMock.UseTOTPStep. %v %v %v", ctx, id, step)
+	return
+}
+
+// UseTOTPStepAfterCounter returns a count of finished UserRepositoryMock.UseTOTPStep invocations
+func (mmUseTOTPStep *UserRepositoryMock) UseTOTPStepAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUseTOTPStep.afterUseTOTPStepCounter)
+}
+
+// UseTOTPStepBeforeCounter returns a count of UserRepositoryMock.UseTOTPStep invocations
+func (mmUseTOTPStep *UserRepositoryMock) UseTOTPStepBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUseTOTPStep.beforeUseTOTPStepCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.UseTOTPStep.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUseTOTPStep *mUserRepositoryMockUseTOTPStep) Calls() []*UserRepositoryMockUseTOTPStepParams {
+	mmUseTOTPStep.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockUseTOTPStepParams, len(mmUseTOTPStep.callArgs))
+	copy(argCopy, mmUseTOTPStep.callArgs)
+
+	mmUseTOTPStep.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUseTOTPStepDone returns true if the count of the UseTOTPStep invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockUseTOTPStepDone() bool {
+	if m.UseTOTPStepMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UseTOTPStepMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UseTOTPStepMock.invocationsDone()
+}
+
+// MinimockUseTOTPStepInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockUseTOTPStepInspect() {
+	for _, e := range m.UseTOTPStepMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.UseTOTPStep with params: %#v", *e.params)
+		}
+	}
+
+	afterUseTOTPStepCounter := mm_atomic.LoadUint64(&m.afterUseTOTPStepCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UseTOTPStepMock.defaultExpectation != nil && afterUseTOTPStepCounter < 1 {
+		if m.UseTOTPStepMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserRepositoryMock.UseTOTPStep")
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.UseTOTPStep with params: %#v", *m.UseTOTPStepMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUseTOTPStep != nil && afterUseTOTPStepCounter < 1 {
+		m.t.Error("Expected call to UserRepositoryMock.UseTOTPStep")
+	}
+
+	if !m.UseTOTPStepMock.invocationsDone() && afterUseTOTPStepCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.UseTOTPStep but found %d calls",
+			mm_atomic.LoadUint64(&m.UseTOTPStepMock.expectedInvocations), afterUseTOTPStepCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *UserRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockEnableTOTPInspect()
+
+			m.MinimockGetInspect()
+
+			m.MinimockGetByEmailInspect()
+
+			m.MinimockGetByUsernameInspect()
+
+			m.MinimockSetEmailVerifiedInspect()
+
+			m.MinimockSetTOTPSecretInspect()
+
+			m.MinimockUpdatePasswordInspect()
+
+			m.MinimockUpdateRoleInspect()
+
+			m.MinimockUseTOTPStepInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *UserRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *UserRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockEnableTOTPDone() &&
+		m.MinimockGetDone() &&
+		m.MinimockGetByEmailDone() &&
+		m.MinimockGetByUsernameDone() &&
+		m.MinimockSetEmailVerifiedDone() &&
+		m.MinimockSetTOTPSecretDone() &&
+		m.MinimockUpdatePasswordDone() &&
+		m.MinimockUpdateRoleDone() &&
+		m.MinimockUseTOTPStepDone()
+}
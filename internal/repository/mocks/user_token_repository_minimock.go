@@ -0,0 +1,1115 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.13). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i di_container/internal/repository.UserTokenRepository -o user_token_repository_minimock.go -n UserTokenRepositoryMock -p mocks
+
+import (
+	"context"
+	"di_container/internal/model"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// UserTokenRepositoryMock implements repository.UserTokenRepository
+type UserTokenRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreate          func(ctx context.Context, token *model.UserToken) (err error)
+	inspectFuncCreate   func(ctx context.Context, token *model.UserToken)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mUserTokenRepositoryMockCreate
+
+	funcDeleteUnused          func(ctx context.Context, userID int64, purpose string) (err error)
+	inspectFuncDeleteUnused   func(ctx context.Context, userID int64, purpose string)
+	afterDeleteUnusedCounter  uint64
+	beforeDeleteUnusedCounter uint64
+	DeleteUnusedMock          mUserTokenRepositoryMockDeleteUnused
+
+	funcUse          func(ctx context.Context, hash string, purpose string) (up1 *model.UserToken, err error)
+	inspectFuncUse   func(ctx context.Context, hash string, purpose string)
+	afterUseCounter  uint64
+	beforeUseCounter uint64
+	UseMock          mUserTokenRepositoryMockUse
+}
+
+// NewUserTokenRepositoryMock returns a mock for repository.UserTokenRepository
+func NewUserTokenRepositoryMock(t minimock.Tester) *UserTokenRepositoryMock {
+	m := &UserTokenRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateMock = mUserTokenRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*UserTokenRepositoryMockCreateParams{}
+
+	m.DeleteUnusedMock = mUserTokenRepositoryMockDeleteUnused{mock: m}
+	m.DeleteUnusedMock.callArgs = []*UserTokenRepositoryMockDeleteUnusedParams{}
+
+	m.UseMock = mUserTokenRepositoryMockUse{mock: m}
+	m.UseMock.callArgs = []*UserTokenRepositoryMockUseParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mUserTokenRepositoryMockCreate struct {
+	optional           bool
+	mock               *UserTokenRepositoryMock
+	defaultExpectation *UserTokenRepositoryMockCreateExpectation
+	expectations       []*UserTokenRepositoryMockCreateExpectation
+
+	callArgs []*UserTokenRepositoryMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// UserTokenRepositoryMockCreateExpectation specifies expectation struct of the UserTokenRepository.Create
+type UserTokenRepositoryMockCreateExpectation struct {
+	mock      *UserTokenRepositoryMock
+	params    *UserTokenRepositoryMockCreateParams
+	paramPtrs *UserTokenRepositoryMockCreateParamPtrs
+	results   *UserTokenRepositoryMockCreateResults
+	Counter   uint64
+}
+
+// UserTokenRepositoryMockCreateParams contains parameters of the UserTokenRepository.Create
+type UserTokenRepositoryMockCreateParams struct {
+	ctx   context.Context
+	token *model.UserToken
+}
+
+// UserTokenRepositoryMockCreateParamPtrs contains pointers to parameters of the UserTokenRepository.Create
+type UserTokenRepositoryMockCreateParamPtrs struct {
+	ctx   *context.Context
+	token **model.UserToken
+}
+
+// UserTokenRepositoryMockCreateResults contains results of the UserTokenRepository.Create
+type UserTokenRepositoryMockCreateResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mUserTokenRepositoryMockCreate) Optional() *mUserTokenRepositoryMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for UserTokenRepository.Create
+func (mmCreate *mUserTokenRepositoryMockCreate) Expect(ctx context.Context, token *model.UserToken) *mUserTokenRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("UserTokenRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &UserTokenRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("UserTokenRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &UserTokenRepositoryMockCreateParams{ctx, token}
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserTokenRepository.Create
+func (mmCreate *mUserTokenRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mUserTokenRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("UserTokenRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &UserTokenRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("UserTokenRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &UserTokenRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreate
+}
+
+// ExpectTokenParam2 sets up expected param token for UserTokenRepository.Create
+func (mmCreate *mUserTokenRepositoryMockCreate) ExpectTokenParam2(token *model.UserToken) *mUserTokenRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("UserTokenRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &UserTokenRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("UserTokenRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &UserTokenRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.token = &token
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserTokenRepository.Create
+func (mmCreate *mUserTokenRepositoryMockCreate) Inspect(f func(ctx context.Context, token *model.UserToken)) *mUserTokenRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for UserTokenRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by UserTokenRepository.Create
+func (mmCreate *mUserTokenRepositoryMockCreate) Return(err error) *UserTokenRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("UserTokenRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &UserTokenRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &UserTokenRepositoryMockCreateResults{err}
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the UserTokenRepository.Create method
+func (mmCreate *mUserTokenRepositoryMockCreate) Set(f func(ctx context.Context, token *model.UserToken) (err error)) *UserTokenRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the UserTokenRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the UserTokenRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	return mmCreate.mock
+}
+
+// When sets expectation for the UserTokenRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mUserTokenRepositoryMockCreate) When(ctx context.Context, token *model.UserToken) *UserTokenRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("UserTokenRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &UserTokenRepositoryMockCreateExpectation{
+		mock:   mmCreate.mock,
+		params: &UserTokenRepositoryMockCreateParams{ctx, token},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserTokenRepository.Create return parameters for the expectation previously defined by the When method
+func (e *UserTokenRepositoryMockCreateExpectation) Then(err error) *UserTokenRepositoryMock {
+	e.results = &UserTokenRepositoryMockCreateResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserTokenRepository.Create should be invoked
+func (mmCreate *mUserTokenRepositoryMockCreate) Times(n uint64) *mUserTokenRepositoryMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of UserTokenRepositoryMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	return mmCreate
+}
+
+func (mmCreate *mUserTokenRepositoryMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements repository.UserTokenRepository
+func (mmCreate *UserTokenRepositoryMock) Create(ctx context.Context, token *model.UserToken) (err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, token)
+	}
+
+	mm_params := UserTokenRepositoryMockCreateParams{ctx, token}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := UserTokenRepositoryMockCreateParams{ctx, token}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("UserTokenRepositoryMock.Create got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.token != nil && !minimock.Equal(*mm_want_ptrs.token, mm_got.token) {
+				mmCreate.t.Errorf("UserTokenRepositoryMock.Create got unexpected parameter token, want: %#v, got: %#v%s\n", *mm_want_ptrs.token, mm_got.token, minimock.Diff(*mm_want_ptrs.token, mm_got.token))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("UserTokenRepositoryMock.Create got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the UserTokenRepositoryMock.Create")
+		}
+		return (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, token)
+	}
+	mmCreate.t.Fatalf("Unexpected call to UserTokenRepositoryMock.Create. %v %v", ctx, token)
+	return
+}
+
+// CreateAfterCounter returns a count of finished UserTokenRepositoryMock.Create invocations
+func (mmCreate *UserTokenRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of UserTokenRepositoryMock.Create invocations
+func (mmCreate *UserTokenRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserTokenRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mUserTokenRepositoryMockCreate) Calls() []*UserTokenRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*UserTokenRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *UserTokenRepositoryMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *UserTokenRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserTokenRepositoryMock.Create with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserTokenRepositoryMock.Create")
+		} else {
+			m.t.Errorf("Expected call to UserTokenRepositoryMock.Create with params: %#v", *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Error("Expected call to UserTokenRepositoryMock.Create")
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserTokenRepositoryMock.Create but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), afterCreateCounter)
+	}
+}
+
+type mUserTokenRepositoryMockDeleteUnused struct {
+	optional           bool
+	mock               *UserTokenRepositoryMock
+	defaultExpectation *UserTokenRepositoryMockDeleteUnusedExpectation
+	expectations       []*UserTokenRepositoryMockDeleteUnusedExpectation
+
+	callArgs []*UserTokenRepositoryMockDeleteUnusedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// UserTokenRepositoryMockDeleteUnusedExpectation specifies expectation struct of the UserTokenRepository.DeleteUnused
+type UserTokenRepositoryMockDeleteUnusedExpectation struct {
+	mock      *UserTokenRepositoryMock
+	params    *UserTokenRepositoryMockDeleteUnusedParams
+	paramPtrs *UserTokenRepositoryMockDeleteUnusedParamPtrs
+	results   *UserTokenRepositoryMockDeleteUnusedResults
+	Counter   uint64
+}
+
+// UserTokenRepositoryMockDeleteUnusedParams contains parameters of the UserTokenRepository.DeleteUnused
+type UserTokenRepositoryMockDeleteUnusedParams struct {
+	ctx     context.Context
+	userID  int64
+	purpose string
+}
+
+// UserTokenRepositoryMockDeleteUnusedParamPtrs contains pointers to parameters of the UserTokenRepository.DeleteUnused
+type UserTokenRepositoryMockDeleteUnusedParamPtrs struct {
+	ctx     *context.Context
+	userID  *int64
+	purpose *string
+}
+
+// UserTokenRepositoryMockDeleteUnusedResults contains results of the UserTokenRepository.DeleteUnused
+type UserTokenRepositoryMockDeleteUnusedResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteUnused *mUserTokenRepositoryMockDeleteUnused) Optional() *mUserTokenRepositoryMockDeleteUnused {
+	mmDeleteUnused.optional = true
+	return mmDeleteUnused
+}
+
+// Expect sets up expected params for UserTokenRepository.DeleteUnused
+func (mmDeleteUnused *mUserTokenRepositoryMockDeleteUnused) Expect(ctx context.Context, userID int64, purpose string) *mUserTokenRepositoryMockDeleteUnused {
+	if mmDeleteUnused.mock.funcDeleteUnused != nil {
+		mmDeleteUnused.mock.t.Fatalf("UserTokenRepositoryMock.DeleteUnused mock is already set by Set")
+	}
+
+	if mmDeleteUnused.defaultExpectation == nil {
+		mmDeleteUnused.defaultExpectation = &UserTokenRepositoryMockDeleteUnusedExpectation{}
+	}
+
+	if mmDeleteUnused.defaultExpectation.paramPtrs != nil {
+		mmDeleteUnused.mock.t.Fatalf("UserTokenRepositoryMock.DeleteUnused mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteUnused.defaultExpectation.params = &UserTokenRepositoryMockDeleteUnusedParams{ctx, userID, purpose}
+	for _, e := range mmDeleteUnused.expectations {
+		if minimock.Equal(e.params, mmDeleteUnused.defaultExpectation.params) {
+			mmDeleteUnused.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteUnused.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteUnused
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserTokenRepository.DeleteUnused
+func (mmDeleteUnused *mUserTokenRepositoryMockDeleteUnused) ExpectCtxParam1(ctx context.Context) *mUserTokenRepositoryMockDeleteUnused {
+	if mmDeleteUnused.mock.funcDeleteUnused != nil {
+		mmDeleteUnused.mock.t.Fatalf("UserTokenRepositoryMock.DeleteUnused mock is already set by Set")
+	}
+
+	if mmDeleteUnused.defaultExpectation == nil {
+		mmDeleteUnused.defaultExpectation = &UserTokenRepositoryMockDeleteUnusedExpectation{}
+	}
+
+	if mmDeleteUnused.defaultExpectation.params != nil {
+		mmDeleteUnused.mock.t.Fatalf("UserTokenRepositoryMock.DeleteUnused mock is already set by Expect")
+	}
+
+	if mmDeleteUnused.defaultExpectation.paramPtrs == nil {
+		mmDeleteUnused.defaultExpectation.paramPtrs = &UserTokenRepositoryMockDeleteUnusedParamPtrs{}
+	}
+	mmDeleteUnused.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDeleteUnused
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UserTokenRepository.DeleteUnused
+func (mmDeleteUnused *mUserTokenRepositoryMockDeleteUnused) ExpectUserIDParam2(userID int64) *mUserTokenRepositoryMockDeleteUnused {
+	if mmDeleteUnused.mock.funcDeleteUnused != nil {
+		mmDeleteUnused.mock.t.Fatalf("UserTokenRepositoryMock.DeleteUnused mock is already set by Set")
+	}
+
+	if mmDeleteUnused.defaultExpectation == nil {
+		mmDeleteUnused.defaultExpectation = &UserTokenRepositoryMockDeleteUnusedExpectation{}
+	}
+
+	if mmDeleteUnused.defaultExpectation.params != nil {
+		mmDeleteUnused.mock.t.Fatalf("UserTokenRepositoryMock.DeleteUnused mock is already set by Expect")
+	}
+
+	if mmDeleteUnused.defaultExpectation.paramPtrs == nil {
+		mmDeleteUnused.defaultExpectation.paramPtrs = &UserTokenRepositoryMockDeleteUnusedParamPtrs{}
+	}
+	mmDeleteUnused.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmDeleteUnused
+}
+
+// ExpectPurposeParam3 sets up expected param purpose for UserTokenRepository.DeleteUnused
+func (mmDeleteUnused *mUserTokenRepositoryMockDeleteUnused) ExpectPurposeParam3(purpose string) *mUserTokenRepositoryMockDeleteUnused {
+	if mmDeleteUnused.mock.funcDeleteUnused != nil {
+		mmDeleteUnused.mock.t.Fatalf("UserTokenRepositoryMock.DeleteUnused mock is already set by Set")
+	}
+
+	if mmDeleteUnused.defaultExpectation == nil {
+		mmDeleteUnused.defaultExpectation = &UserTokenRepositoryMockDeleteUnusedExpectation{}
+	}
+
+	if mmDeleteUnused.defaultExpectation.params != nil {
+		mmDeleteUnused.mock.t.Fatalf("UserTokenRepositoryMock.DeleteUnused mock is already set by Expect")
+	}
+
+	if mmDeleteUnused.defaultExpectation.paramPtrs == nil {
+		mmDeleteUnused.defaultExpectation.paramPtrs = &UserTokenRepositoryMockDeleteUnusedParamPtrs{}
+	}
+	mmDeleteUnused.defaultExpectation.paramPtrs.purpose = &purpose
+
+	return mmDeleteUnused
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserTokenRepository.DeleteUnused
+func (mmDeleteUnused *mUserTokenRepositoryMockDeleteUnused) Inspect(f func(ctx context.Context, userID int64, purpose string)) *mUserTokenRepositoryMockDeleteUnused {
+	if mmDeleteUnused.mock.inspectFuncDeleteUnused != nil {
+		mmDeleteUnused.mock.t.Fatalf("Inspect function is already set for UserTokenRepositoryMock.DeleteUnused")
+	}
+
+	mmDeleteUnused.mock.inspectFuncDeleteUnused = f
+
+	return mmDeleteUnused
+}
+
+// Return sets up results that will be returned by UserTokenRepository.DeleteUnused
+func (mmDeleteUnused *mUserTokenRepositoryMockDeleteUnused) Return(err error) *UserTokenRepositoryMock {
+	if mmDeleteUnused.mock.funcDeleteUnused != nil {
+		mmDeleteUnused.mock.t.Fatalf("UserTokenRepositoryMock.DeleteUnused mock is already set by Set")
+	}
+
+	if mmDeleteUnused.defaultExpectation == nil {
+		mmDeleteUnused.defaultExpectation = &UserTokenRepositoryMockDeleteUnusedExpectation{mock: mmDeleteUnused.mock}
+	}
+	mmDeleteUnused.defaultExpectation.results = &UserTokenRepositoryMockDeleteUnusedResults{err}
+	return mmDeleteUnused.mock
+}
+
+// Set uses given function f to mock the UserTokenRepository.DeleteUnused method
+func (mmDeleteUnused *mUserTokenRepositoryMockDeleteUnused) Set(f func(ctx context.Context, userID int64, purpose string) (err error)) *UserTokenRepositoryMock {
+	if mmDeleteUnused.defaultExpectation != nil {
+		mmDeleteUnused.mock.t.Fatalf("Default expectation is already set for the UserTokenRepository.DeleteUnused method")
+	}
+
+	if len(mmDeleteUnused.expectations) > 0 {
+		mmDeleteUnused.mock.t.Fatalf("Some expectations are already set for the UserTokenRepository.DeleteUnused method")
+	}
+
+	mmDeleteUnused.mock.funcDeleteUnused = f
+	return mmDeleteUnused.mock
+}
+
+// When sets expectation for the UserTokenRepository.DeleteUnused which will trigger the result defined by the following
+// Then helper
+func (mmDeleteUnused *mUserTokenRepositoryMockDeleteUnused) When(ctx context.Context, userID int64, purpose string) *UserTokenRepositoryMockDeleteUnusedExpectation {
+	if mmDeleteUnused.mock.funcDeleteUnused != nil {
+		mmDeleteUnused.mock.t.Fatalf("UserTokenRepositoryMock.DeleteUnused mock is already set by Set")
+	}
+
+	expectation := &UserTokenRepositoryMockDeleteUnusedExpectation{
+		mock:   mmDeleteUnused.mock,
+		params: &UserTokenRepositoryMockDeleteUnusedParams{ctx, userID, purpose},
+	}
+	mmDeleteUnused.expectations = append(mmDeleteUnused.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserTokenRepository.DeleteUnused return parameters for the expectation previously defined by the When method
+func (e *UserTokenRepositoryMockDeleteUnusedExpectation) Then(err error) *UserTokenRepositoryMock {
+	e.results = &UserTokenRepositoryMockDeleteUnusedResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserTokenRepository.DeleteUnused should be invoked
+func (mmDeleteUnused *mUserTokenRepositoryMockDeleteUnused) Times(n uint64) *mUserTokenRepositoryMockDeleteUnused {
+	if n == 0 {
+		mmDeleteUnused.mock.t.Fatalf("Times of UserTokenRepositoryMock.DeleteUnused mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteUnused.expectedInvocations, n)
+	return mmDeleteUnused
+}
+
+func (mmDeleteUnused *mUserTokenRepositoryMockDeleteUnused) invocationsDone() bool {
+	if len(mmDeleteUnused.expectations) == 0 && mmDeleteUnused.defaultExpectation == nil && mmDeleteUnused.mock.funcDeleteUnused == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteUnused.mock.afterDeleteUnusedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteUnused.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteUnused implements repository.UserTokenRepository
+func (mmDeleteUnused *UserTokenRepositoryMock) DeleteUnused(ctx context.Context, userID int64, purpose string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteUnused.beforeDeleteUnusedCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteUnused.afterDeleteUnusedCounter, 1)
+
+	if mmDeleteUnused.inspectFuncDeleteUnused != nil {
+		mmDeleteUnused.inspectFuncDeleteUnused(ctx, userID, purpose)
+	}
+
+	mm_params := UserTokenRepositoryMockDeleteUnusedParams{ctx, userID, purpose}
+
+	// Record call args
+	mmDeleteUnused.DeleteUnusedMock.mutex.Lock()
+	mmDeleteUnused.DeleteUnusedMock.callArgs = append(mmDeleteUnused.DeleteUnusedMock.callArgs, &mm_params)
+	mmDeleteUnused.DeleteUnusedMock.mutex.Unlock()
+
+	for _, e := range mmDeleteUnused.DeleteUnusedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteUnused.DeleteUnusedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteUnused.DeleteUnusedMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteUnused.DeleteUnusedMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteUnused.DeleteUnusedMock.defaultExpectation.paramPtrs
+
+		mm_got := UserTokenRepositoryMockDeleteUnusedParams{ctx, userID, purpose}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteUnused.t.Errorf("UserTokenRepositoryMock.DeleteUnused got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteUnused.t.Errorf("UserTokenRepositoryMock.DeleteUnused got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.purpose != nil && !minimock.Equal(*mm_want_ptrs.purpose, mm_got.purpose) {
+				mmDeleteUnused.t.Errorf("UserTokenRepositoryMock.DeleteUnused got unexpected parameter purpose, want: %#v, got: %#v%s\n", *mm_want_ptrs.purpose, mm_got.purpose, minimock.Diff(*mm_want_ptrs.purpose, mm_got.purpose))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteUnused.t.Errorf("UserTokenRepositoryMock.DeleteUnused got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteUnused.DeleteUnusedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteUnused.t.Fatal("No results are set for the UserTokenRepositoryMock.DeleteUnused")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteUnused.funcDeleteUnused != nil {
+		return mmDeleteUnused.funcDeleteUnused(ctx, userID, purpose)
+	}
+	mmDeleteUnused.t.Fatalf("Unexpected call to UserTokenRepositoryMock.DeleteUnused. %v %v %v", ctx, userID, purpose)
+	return
+}
+
+// DeleteUnusedAfterCounter returns a count of finished UserTokenRepositoryMock.DeleteUnused invocations
+func (mmDeleteUnused *UserTokenRepositoryMock) DeleteUnusedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUnused.afterDeleteUnusedCounter)
+}
+
+// DeleteUnusedBeforeCounter returns a count of UserTokenRepositoryMock.DeleteUnused invocations
+func (mmDeleteUnused *UserTokenRepositoryMock) DeleteUnusedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUnused.beforeDeleteUnusedCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserTokenRepositoryMock.DeleteUnused.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteUnused *mUserTokenRepositoryMockDeleteUnused) Calls() []*UserTokenRepositoryMockDeleteUnusedParams {
+	mmDeleteUnused.mutex.RLock()
+
+	argCopy := make([]*UserTokenRepositoryMockDeleteUnusedParams, len(mmDeleteUnused.callArgs))
+	copy(argCopy, mmDeleteUnused.callArgs)
+
+	mmDeleteUnused.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteUnusedDone returns true if the count of the DeleteUnused invocations corresponds
+// the number of defined expectations
+func (m *UserTokenRepositoryMock) MinimockDeleteUnusedDone() bool {
+	if m.DeleteUnusedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteUnusedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteUnusedMock.invocationsDone()
+}
+
+// MinimockDeleteUnusedInspect logs each unmet expectation
+func (m *UserTokenRepositoryMock) MinimockDeleteUnusedInspect() {
+	for _, e := range m.DeleteUnusedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserTokenRepositoryMock.DeleteUnused with params: %#v", *e.params)
+		}
+	}
+
+	afterDeleteUnusedCounter := mm_atomic.LoadUint64(&m.afterDeleteUnusedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteUnusedMock.defaultExpectation != nil && afterDeleteUnusedCounter < 1 {
+		if m.DeleteUnusedMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserTokenRepositoryMock.DeleteUnused")
+		} else {
+			m.t.Errorf("Expected call to UserTokenRepositoryMock.DeleteUnused with params: %#v", *m.DeleteUnusedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteUnused != nil && afterDeleteUnusedCounter < 1 {
+		m.t.Error("Expected call to UserTokenRepositoryMock.DeleteUnused")
+	}
+
+	if !m.DeleteUnusedMock.invocationsDone() && afterDeleteUnusedCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserTokenRepositoryMock.DeleteUnused but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteUnusedMock.expectedInvocations), afterDeleteUnusedCounter)
+	}
+}
+
+type mUserTokenRepositoryMockUse struct {
+	optional           bool
+	mock               *UserTokenRepositoryMock
+	defaultExpectation *UserTokenRepositoryMockUseExpectation
+	expectations       []*UserTokenRepositoryMockUseExpectation
+
+	callArgs []*UserTokenRepositoryMockUseParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// UserTokenRepositoryMockUseExpectation specifies expectation struct of the UserTokenRepository.Use
+type UserTokenRepositoryMockUseExpectation struct {
+	mock      *UserTokenRepositoryMock
+	params    *UserTokenRepositoryMockUseParams
+	paramPtrs *UserTokenRepositoryMockUseParamPtrs
+	results   *UserTokenRepositoryMockUseResults
+	Counter   uint64
+}
+
+// UserTokenRepositoryMockUseParams contains parameters of the UserTokenRepository.Use
+type UserTokenRepositoryMockUseParams struct {
+	ctx     context.Context
+	hash    string
+	purpose string
+}
+
+// UserTokenRepositoryMockUseParamPtrs contains pointers to parameters of the UserTokenRepository.Use
+type UserTokenRepositoryMockUseParamPtrs struct {
+	ctx     *context.Context
+	hash    *string
+	purpose *string
+}
+
+// UserTokenRepositoryMockUseResults contains results of the UserTokenRepository.Use
+type UserTokenRepositoryMockUseResults struct {
+	up1 *model.UserToken
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUse *mUserTokenRepositoryMockUse) Optional() *mUserTokenRepositoryMockUse {
+	mmUse.optional = true
+	return mmUse
+}
+
+// Expect sets up expected params for UserTokenRepository.Use
+func (mmUse *mUserTokenRepositoryMockUse) Expect(ctx context.Context, hash string, purpose string) *mUserTokenRepositoryMockUse {
+	if mmUse.mock.funcUse != nil {
+		mmUse.mock.t.Fatalf("UserTokenRepositoryMock.Use mock is already set by Set")
+	}
+
+	if mmUse.defaultExpectation == nil {
+		mmUse.defaultExpectation = &UserTokenRepositoryMockUseExpectation{}
+	}
+
+	if mmUse.defaultExpectation.paramPtrs != nil {
+		mmUse.mock.t.Fatalf("UserTokenRepositoryMock.Use mock is already set by ExpectParams functions")
+	}
+
+	mmUse.defaultExpectation.params = &UserTokenRepositoryMockUseParams{ctx, hash, purpose}
+	for _, e := range mmUse.expectations {
+		if minimock.Equal(e.params, mmUse.defaultExpectation.params) {
+			mmUse.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUse.defaultExpectation.params)
+		}
+	}
+
+	return mmUse
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserTokenRepository.Use
+func (mmUse *mUserTokenRepositoryMockUse) ExpectCtxParam1(ctx context.Context) *mUserTokenRepositoryMockUse {
+	if mmUse.mock.funcUse != nil {
+		mmUse.mock.t.Fatalf("UserTokenRepositoryMock.Use mock is already set by Set")
+	}
+
+	if mmUse.defaultExpectation == nil {
+		mmUse.defaultExpectation = &UserTokenRepositoryMockUseExpectation{}
+	}
+
+	if mmUse.defaultExpectation.params != nil {
+		mmUse.mock.t.Fatalf("UserTokenRepositoryMock.Use mock is already set by Expect")
+	}
+
+	if mmUse.defaultExpectation.paramPtrs == nil {
+		mmUse.defaultExpectation.paramPtrs = &UserTokenRepositoryMockUseParamPtrs{}
+	}
+	mmUse.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmUse
+}
+
+// ExpectHashParam2 sets up expected param hash for UserTokenRepository.Use
+func (mmUse *mUserTokenRepositoryMockUse) ExpectHashParam2(hash string) *mUserTokenRepositoryMockUse {
+	if mmUse.mock.funcUse != nil {
+		mmUse.mock.t.Fatalf("UserTokenRepositoryMock.Use mock is already set by Set")
+	}
+
+	if mmUse.defaultExpectation == nil {
+		mmUse.defaultExpectation = &UserTokenRepositoryMockUseExpectation{}
+	}
+
+	if mmUse.defaultExpectation.params != nil {
+		mmUse.mock.t.Fatalf("UserTokenRepositoryMock.Use mock is already set by Expect")
+	}
+
+	if mmUse.defaultExpectation.paramPtrs == nil {
+		mmUse.defaultExpectation.paramPtrs = &UserTokenRepositoryMockUseParamPtrs{}
+	}
+	mmUse.defaultExpectation.paramPtrs.hash = &hash
+
+	return mmUse
+}
+
+// ExpectPurposeParam3 sets up expected param purpose for UserTokenRepository.Use
+func (mmUse *mUserTokenRepositoryMockUse) ExpectPurposeParam3(purpose string) *mUserTokenRepositoryMockUse {
+	if mmUse.mock.funcUse != nil {
+		mmUse.mock.t.Fatalf("UserTokenRepositoryMock.Use mock is already set by Set")
+	}
+
+	if mmUse.defaultExpectation == nil {
+		mmUse.defaultExpectation = &UserTokenRepositoryMockUseExpectation{}
+	}
+
+	if mmUse.defaultExpectation.params != nil {
+		mmUse.mock.t.Fatalf("UserTokenRepositoryMock.Use mock is already set by Expect")
+	}
+
+	if mmUse.defaultExpectation.paramPtrs == nil {
+		mmUse.defaultExpectation.paramPtrs = &UserTokenRepositoryMockUseParamPtrs{}
+	}
+	mmUse.defaultExpectation.paramPtrs.purpose = &purpose
+
+	return mmUse
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserTokenRepository.Use
+func (mmUse *mUserTokenRepositoryMockUse) Inspect(f func(ctx context.Context, hash string, purpose string)) *mUserTokenRepositoryMockUse {
+	if mmUse.mock.inspectFuncUse != nil {
+		mmUse.mock.t.Fatalf("Inspect function is already set for UserTokenRepositoryMock.Use")
+	}
+
+	mmUse.mock.inspectFuncUse = f
+
+	return mmUse
+}
+
+// Return sets up results that will be returned by UserTokenRepository.Use
+func (mmUse *mUserTokenRepositoryMockUse) Return(up1 *model.UserToken, err error) *UserTokenRepositoryMock {
+	if mmUse.mock.funcUse != nil {
+		mmUse.mock.t.Fatalf("UserTokenRepositoryMock.Use mock is already set by Set")
+	}
+
+	if mmUse.defaultExpectation == nil {
+		mmUse.defaultExpectation = &UserTokenRepositoryMockUseExpectation{mock: mmUse.mock}
+	}
+	mmUse.defaultExpectation.results = &UserTokenRepositoryMockUseResults{up1, err}
+	return mmUse.mock
+}
+
+// Set uses given function f to mock the UserTokenRepository.Use method
+func (mmUse *mUserTokenRepositoryMockUse) Set(f func(ctx context.Context, hash string, purpose string) (up1 *model.UserToken, err error)) *UserTokenRepositoryMock {
+	if mmUse.defaultExpectation != nil {
+		mmUse.mock.t.Fatalf("Default expectation is already set for the UserTokenRepository.Use method")
+	}
+
+	if len(mmUse.expectations) > 0 {
+		mmUse.mock.t.Fatalf("Some expectations are already set for the UserTokenRepository.Use method")
+	}
+
+	mmUse.mock.funcUse = f
+	return mmUse.mock
+}
+
+// When sets expectation for the UserTokenRepository.Use which will trigger the result defined by the following
+// Then helper
+func (mmUse *mUserTokenRepositoryMockUse) When(ctx context.Context, hash string, purpose string) *UserTokenRepositoryMockUseExpectation {
+	if mmUse.mock.funcUse != nil {
+		mmUse.mock.t.Fatalf("UserTokenRepositoryMock.Use mock is already set by Set")
+	}
+
+	expectation := &UserTokenRepositoryMockUseExpectation{
+		mock:   mmUse.mock,
+		params: &UserTokenRepositoryMockUseParams{ctx, hash, purpose},
+	}
+	mmUse.expectations = append(mmUse.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserTokenRepository.Use return parameters for the expectation previously defined by the When method
+func (e *UserTokenRepositoryMockUseExpectation) Then(up1 *model.UserToken, err error) *UserTokenRepositoryMock {
+	e.results = &UserTokenRepositoryMockUseResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times UserTokenRepository.Use should be invoked
+func (mmUse *mUserTokenRepositoryMockUse) Times(n uint64) *mUserTokenRepositoryMockUse {
+	if n == 0 {
+		mmUse.mock.t.Fatalf("Times of UserTokenRepositoryMock.Use mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUse.expectedInvocations, n)
+	return mmUse
+}
+
+func (mmUse *mUserTokenRepositoryMockUse) invocationsDone() bool {
+	if len(mmUse.expectations) == 0 && mmUse.defaultExpectation == nil && mmUse.mock.funcUse == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUse.mock.afterUseCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUse.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Use implements repository.UserTokenRepository
+func (mmUse *UserTokenRepositoryMock) Use(ctx context.Context, hash string, purpose string) (up1 *model.UserToken, err error) {
+	mm_atomic.AddUint64(&mmUse.beforeUseCounter, 1)
+	defer mm_atomic.AddUint64(&mmUse.afterUseCounter, 1)
+
+	if mmUse.inspectFuncUse != nil {
+		mmUse.inspectFuncUse(ctx, hash, purpose)
+	}
+
+	mm_params := UserTokenRepositoryMockUseParams{ctx, hash, purpose}
+
+	// Record call args
+	mmUse.UseMock.mutex.Lock()
+	mmUse.UseMock.callArgs = append(mmUse.UseMock.callArgs, &mm_params)
+	mmUse.UseMock.mutex.Unlock()
+
+	for _, e := range mmUse.UseMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmUse.UseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUse.UseMock.defaultExpectation.Counter, 1)
+		mm_want := mmUse.UseMock.defaultExpectation.params
+		mm_want_ptrs := mmUse.UseMock.defaultExpectation.paramPtrs
+
+		mm_got := UserTokenRepositoryMockUseParams{ctx, hash, purpose}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUse.t.Errorf("UserTokenRepositoryMock.Use got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.hash != nil && !minimock.Equal(*mm_want_ptrs.hash, mm_got.hash) {
+				mmUse.t.Errorf("UserTokenRepositoryMock.Use got unexpected parameter hash, want: %#v, got: %#v%s\n", *mm_want_ptrs.hash, mm_got.hash, minimock.Diff(*mm_want_ptrs.hash, mm_got.hash))
+			}
+
+			if mm_want_ptrs.purpose != nil && !minimock.Equal(*mm_want_ptrs.purpose, mm_got.purpose) {
+				mmUse.t.Errorf("UserTokenRepositoryMock.Use got unexpected parameter purpose, want: %#v, got: %#v%s\n", *mm_want_ptrs.purpose, mm_got.purpose, minimock.Diff(*mm_want_ptrs.purpose, mm_got.purpose))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUse.t.Errorf("UserTokenRepositoryMock.Use got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUse.UseMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUse.t.Fatal("No results are set for the UserTokenRepositoryMock.Use")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmUse.funcUse != nil {
+		return mmUse.funcUse(ctx, hash, purpose)
+	}
+	mmUse.t.Fatalf("Unexpected call to UserTokenRepositoryMock.Use. %v %v %v", ctx, hash, purpose)
+	return
+}
+
+// UseAfterCounter returns a count of finished UserTokenRepositoryMock.Use invocations
+func (mmUse *UserTokenRepositoryMock) UseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUse.afterUseCounter)
+}
+
+// UseBeforeCounter returns a count of UserTokenRepositoryMock.Use invocations
+func (mmUse *UserTokenRepositoryMock) UseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUse.beforeUseCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserTokenRepositoryMock.Use.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUse *mUserTokenRepositoryMockUse) Calls() []*UserTokenRepositoryMockUseParams {
+	mmUse.mutex.RLock()
+
+	argCopy := make([]*UserTokenRepositoryMockUseParams, len(mmUse.callArgs))
+	copy(argCopy, mmUse.callArgs)
+
+	mmUse.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUseDone returns true if the count of the Use invocations corresponds
+// the number of defined expectations
+func (m *UserTokenRepositoryMock) MinimockUseDone() bool {
+	if m.UseMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UseMock.invocationsDone()
+}
+
+// MinimockUseInspect logs each unmet expectation
+func (m *UserTokenRepositoryMock) MinimockUseInspect() {
+	for _, e := range m.UseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserTokenRepositoryMock.Use with params: %#v", *e.params)
+		}
+	}
+
+	afterUseCounter := mm_atomic.LoadUint64(&m.afterUseCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UseMock.defaultExpectation != nil && afterUseCounter < 1 {
+		if m.UseMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserTokenRepositoryMock.Use")
+		} else {
+			m.t.Errorf("Expected call to UserTokenRepositoryMock.Use with params: %#v", *m.UseMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUse != nil && afterUseCounter < 1 {
+		m.t.Error("Expected call to UserTokenRepositoryMock.Use")
+	}
+
+	if !m.UseMock.invocationsDone() && afterUseCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserTokenRepositoryMock.Use but found %d calls",
+			mm_atomic.LoadUint64(&m.UseMock.expectedInvocations), afterUseCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *UserTokenRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateInspect()
+
+			m.MinimockDeleteUnusedInspect()
+
+			m.MinimockUseInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *UserTokenRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *UserTokenRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateDone() &&
+		m.MinimockDeleteUnusedDone() &&
+		m.MinimockUseDone()
+}
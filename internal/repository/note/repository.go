@@ -10,6 +10,7 @@ import (
 	"di_container/internal/repository"
 	"di_container/internal/repository/note/converter"
 	modelRepo "di_container/internal/repository/note/model"
+	"di_container/internal/repository/scoped"
 )
 
 const (
@@ -31,8 +32,7 @@ func NewRepository(db db.Client) repository.NoteRepository {
 }
 
 func (r *repo) Create(ctx context.Context, info *model.NoteInfo) (int64, error) {
-	builder := sq.Insert(tableName).
-		PlaceholderFormat(sq.Dollar).
+	builder := scoped.Insert(ctx, tableName).
 		Columns(titleColumn, contentColumn).
 		Values(info.Title, info.Content).
 		Suffix("RETURNING id")
@@ -57,8 +57,7 @@ func (r *repo) Create(ctx context.Context, info *model.NoteInfo) (int64, error)
 }
 
 func (r *repo) Get(ctx context.Context, id int64) (*model.Note, error) {
-	builder := sq.Select(idColumn, titleColumn, contentColumn, createdAtColumn, updatedAtColumn).
-		PlaceholderFormat(sq.Dollar).
+	builder := scoped.Select(ctx, idColumn, titleColumn, contentColumn, createdAtColumn, updatedAtColumn).
 		From(tableName).
 		Where(sq.Eq{idColumn: id}).
 		Limit(1)
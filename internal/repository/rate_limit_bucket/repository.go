@@ -0,0 +1,106 @@
+package rate_limit_bucket
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"di_container/internal/client/db"
+	"di_container/internal/model"
+	"di_container/internal/repository"
+	"di_container/internal/repository/scoped"
+)
+
+const (
+	tableName = "rate_limit_bucket"
+
+	keyColumn       = "key"
+	methodColumn    = "method"
+	tokensColumn    = "tokens"
+	updatedAtColumn = "updated_at"
+)
+
+type repo struct {
+	db db.Client
+}
+
+func NewRepository(db db.Client) repository.RateLimitBucketRepository {
+	return &repo{db: db}
+}
+
+// Lock возвращает ведро, создавая полное, если его еще нет. Строка остается заблокированной
+// до конца транзакции, поэтому реплики не выдадут одни и те же токены дважды
+func (r *repo) Lock(ctx context.Context, key string, method string, burst float64) (*model.RateLimitBucket, error) {
+	builder := scoped.Insert(ctx, tableName).
+		Columns(keyColumn, methodColumn, tokensColumn, updatedAtColumn).
+		Values(key, method, burst, sq.Expr("now()")).
+		Suffix(`ON CONFLICT (` + scoped.TenantColumn + `, ` + keyColumn + `, ` + methodColumn + `) DO UPDATE SET
+			` + keyColumn + ` = excluded.` + keyColumn + `
+		RETURNING ` + tokensColumn + `, ` + updatedAtColumn + `, now()`)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "rate_limit_bucket_repository.Lock",
+		QueryRaw: query,
+	}
+
+	var bucket model.RateLimitBucket
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&bucket.Tokens, &bucket.UpdatedAt, &bucket.Now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bucket, nil
+}
+
+func (r *repo) Update(ctx context.Context, key string, method string, tokens float64, updatedAt time.Time) error {
+	builder := scoped.Update(ctx, tableName).
+		Set(tokensColumn, tokens).
+		Set(updatedAtColumn, updatedAt).
+		Where(sq.Eq{keyColumn: key, methodColumn: method})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "rate_limit_bucket_repository.Update",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
+
+// DeleteIdle удаляет ведра арендатора, которые не обновлялись дольше idleTimeout. Строки, которые
+// сейчас заблокированы выдачей токенов, пропускаются, чтобы очистка не ждала запросы клиентов
+func (r *repo) DeleteIdle(ctx context.Context, idleTimeout time.Duration) error {
+	idle := scoped.Select(ctx, keyColumn, methodColumn).
+		From(tableName).
+		Where(sq.Expr(updatedAtColumn+" < now() - make_interval(secs => ?)", idleTimeout.Seconds())).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		// Плейсхолдеры подзапроса нумерует внешний запрос
+		PlaceholderFormat(sq.Question)
+
+	builder := scoped.Delete(ctx, tableName).
+		Where(sq.Expr("("+keyColumn+", "+methodColumn+") IN (?)", idle))
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "rate_limit_bucket_repository.DeleteIdle",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
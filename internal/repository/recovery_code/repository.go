@@ -0,0 +1,95 @@
+package recovery_code
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"di_container/internal/client/db"
+	"di_container/internal/repository"
+	"di_container/internal/repository/scoped"
+)
+
+const (
+	tableName = "recovery_code"
+
+	userIDColumn   = "user_id"
+	codeHashColumn = "code_hash"
+	usedAtColumn   = "used_at"
+)
+
+type repo struct {
+	db db.Client
+}
+
+func NewRepository(db db.Client) repository.RecoveryCodeRepository {
+	return &repo{db: db}
+}
+
+// Replace заменяет все коды восстановления пользователя новыми. Вызывать нужно в транзакции
+func (r *repo) Replace(ctx context.Context, userID int64, codeHashes []string) error {
+	deleteBuilder := scoped.Delete(ctx, tableName).
+		Where(sq.Eq{userIDColumn: userID})
+
+	query, args, err := deleteBuilder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "recovery_code_repository.Replace.Delete",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	if len(codeHashes) == 0 {
+		return nil
+	}
+
+	insertBuilder := scoped.Insert(ctx, tableName).
+		Columns(userIDColumn, codeHashColumn)
+	for _, hash := range codeHashes {
+		insertBuilder = insertBuilder.Values(userID, hash)
+	}
+
+	query, args, err = insertBuilder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q = db.Query{
+		Name:     "recovery_code_repository.Replace.Insert",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
+
+// Use помечает код использованным. Возвращает false, если такого неиспользованного кода нет
+func (r *repo) Use(ctx context.Context, userID int64, codeHash string) (bool, error) {
+	builder := scoped.Update(ctx, tableName).
+		Set(usedAtColumn, sq.Expr("now()")).
+		Where(sq.Eq{userIDColumn: userID, codeHashColumn: codeHash, usedAtColumn: nil})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	q := db.Query{
+		Name:     "recovery_code_repository.Use",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
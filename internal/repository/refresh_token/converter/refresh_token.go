@@ -0,0 +1,18 @@
+package converter
+
+import (
+	"di_container/internal/model"
+	modelRepo "di_container/internal/repository/refresh_token/model"
+)
+
+func ToRefreshTokenFromRepo(token *modelRepo.RefreshToken) *model.RefreshToken {
+	return &model.RefreshToken{
+		ID:        token.ID,
+		FamilyID:  token.FamilyID,
+		Username:  token.Username,
+		ExpiresAt: token.ExpiresAt,
+		CreatedAt: token.CreatedAt,
+		RotatedAt: token.RotatedAt,
+		RevokedAt: token.RevokedAt,
+	}
+}
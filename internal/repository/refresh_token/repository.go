@@ -0,0 +1,131 @@
+package refresh_token
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+
+	"di_container/internal/client/db"
+	"di_container/internal/model"
+	"di_container/internal/repository"
+	"di_container/internal/repository/refresh_token/converter"
+	modelRepo "di_container/internal/repository/refresh_token/model"
+	"di_container/internal/repository/scoped"
+)
+
+const (
+	tableName = "refresh_token"
+
+	idColumn        = "id"
+	familyIDColumn  = "family_id"
+	usernameColumn  = "username"
+	expiresAtColumn = "expires_at"
+	createdAtColumn = "created_at"
+	rotatedAtColumn = "rotated_at"
+	revokedAtColumn = "revoked_at"
+)
+
+type repo struct {
+	db db.Client
+}
+
+func NewRepository(db db.Client) repository.RefreshTokenRepository {
+	return &repo{db: db}
+}
+
+func (r *repo) Create(ctx context.Context, token *model.RefreshToken) error {
+	builder := scoped.Insert(ctx, tableName).
+		Columns(idColumn, familyIDColumn, usernameColumn, expiresAtColumn).
+		Values(token.ID, token.FamilyID, token.Username, token.ExpiresAt)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "refresh_token_repository.Create",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
+
+// Get достает токен и блокирует строку до конца транзакции, чтобы один токен нельзя было ротировать дважды
+func (r *repo) Get(ctx context.Context, id string) (*model.RefreshToken, error) {
+	builder := scoped.Select(ctx, idColumn, familyIDColumn, usernameColumn, expiresAtColumn, createdAtColumn, rotatedAtColumn, revokedAtColumn).
+		From(tableName).
+		Where(sq.Eq{idColumn: id}).
+		Limit(1).
+		Suffix("FOR UPDATE")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "refresh_token_repository.Get",
+		QueryRaw: query,
+	}
+
+	var token modelRepo.RefreshToken
+	err = r.db.DB().ScanOneContext(ctx, &token, q, args...)
+	if pgxscan.NotFound(err) {
+		return nil, repository.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToRefreshTokenFromRepo(&token), nil
+}
+
+func (r *repo) MarkRotated(ctx context.Context, id string) error {
+	builder := scoped.Update(ctx, tableName).
+		Set(rotatedAtColumn, sq.Expr("now()")).
+		Where(sq.Eq{idColumn: id})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "refresh_token_repository.MarkRotated",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
+
+func (r *repo) RevokeFamily(ctx context.Context, familyID string) error {
+	return r.revoke(ctx, "refresh_token_repository.RevokeFamily", sq.Eq{familyIDColumn: familyID})
+}
+
+func (r *repo) RevokeByUsername(ctx context.Context, username string) error {
+	return r.revoke(ctx, "refresh_token_repository.RevokeByUsername", sq.Eq{usernameColumn: username})
+}
+
+func (r *repo) revoke(ctx context.Context, name string, where sq.Eq) error {
+	builder := scoped.Update(ctx, tableName).
+		Set(revokedAtColumn, sq.Expr("now()")).
+		Where(where).
+		Where(sq.Eq{revokedAtColumn: nil})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     name,
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
@@ -2,11 +2,16 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"di_container/internal/model"
 	// desc "di_container/pkg/note_v1"
 )
 
+// ErrNotFound возвращается репозиториями, когда запись не найдена
+var ErrNotFound = errors.New("not found")
+
 type NoteRepository interface {
 	Create(context.Context, *model.NoteInfo) (int64, error)
 	Get(ctx context.Context, id int64) (*model.Note, error)
@@ -15,3 +20,81 @@ type NoteRepository interface {
 type OtherNoteRepository interface {
 	Get(ctx context.Context, id int64) (*model.Note, error)
 }
+
+type RefreshTokenRepository interface {
+	Create(ctx context.Context, token *model.RefreshToken) error
+	Get(ctx context.Context, id string) (*model.RefreshToken, error)
+	MarkRotated(ctx context.Context, id string) error
+	RevokeFamily(ctx context.Context, familyID string) error
+	RevokeByUsername(ctx context.Context, username string) error
+}
+
+type SessionRepository interface {
+	Create(ctx context.Context, session *model.Session) error
+	Get(ctx context.Context, id string) (*model.Session, error)
+	ListActive(ctx context.Context, username string) ([]*model.Session, error)
+	Touch(ctx context.Context, id string, expiresAt time.Time) error
+	Revoke(ctx context.Context, id string) error
+	RevokeByUsername(ctx context.Context, username string) error
+}
+
+type UserRepository interface {
+	Get(ctx context.Context, id int64) (*model.User, error)
+	GetByUsername(ctx context.Context, username string) (*model.User, error)
+	GetByEmail(ctx context.Context, email string) (*model.User, error)
+	UpdatePassword(ctx context.Context, id int64, passwordHash string) error
+	UpdateRole(ctx context.Context, id int64, role string) error
+	SetEmailVerified(ctx context.Context, id int64) error
+	SetTOTPSecret(ctx context.Context, id int64, encryptedSecret string) error
+	EnableTOTP(ctx context.Context, id int64) error
+	UseTOTPStep(ctx context.Context, id int64, step int64) (bool, error)
+}
+
+type RecoveryCodeRepository interface {
+	Replace(ctx context.Context, userID int64, codeHashes []string) error
+	Use(ctx context.Context, userID int64, codeHash string) (bool, error)
+}
+
+type UserTokenRepository interface {
+	Create(ctx context.Context, token *model.UserToken) error
+	Use(ctx context.Context, hash string, purpose string) (*model.UserToken, error)
+	DeleteUnused(ctx context.Context, userID int64, purpose string) error
+}
+
+type APIKeyRepository interface {
+	Create(ctx context.Context, key *model.APIKey) (int64, error)
+	Get(ctx context.Context, id int64) (*model.APIKey, error)
+	GetByHash(ctx context.Context, hash string) (*model.APIKey, error)
+	ListActive(ctx context.Context, username string) ([]*model.APIKey, error)
+	Revoke(ctx context.Context, id int64) error
+	Touch(ctx context.Context, id int64) error
+}
+
+type AuditEventRepository interface {
+	LockChain(ctx context.Context) error
+	LastHash(ctx context.Context) (string, error)
+	Create(ctx context.Context, event *model.AuditEvent) (int64, error)
+	List(ctx context.Context, filter *model.AuditFilter) ([]*model.AuditEvent, error)
+}
+
+type LoginAttemptRepository interface {
+	GetLockedUntil(ctx context.Context, keys ...string) (time.Time, error)
+	RegisterFailure(ctx context.Context, key string, window time.Duration) (int64, error)
+	Lock(ctx context.Context, key string, until time.Time) error
+	Reset(ctx context.Context, key string) error
+}
+
+// UsageRepository хранит счетчики потребления. owner - имя пользователя или пустая строка для арендатора целиком
+type UsageRepository interface {
+	Add(ctx context.Context, owner string, delta *model.Usage) (*model.Usage, error)
+	Get(ctx context.Context, owner string) (*model.Usage, error)
+	CountRequest(ctx context.Context, owner string, day time.Time) (int64, error)
+	GetRequests(ctx context.Context, owner string, day time.Time) (int64, error)
+}
+
+// RateLimitBucketRepository хранит ведра токенов, общие для всех реплик
+type RateLimitBucketRepository interface {
+	Lock(ctx context.Context, key string, method string, burst float64) (*model.RateLimitBucket, error)
+	Update(ctx context.Context, key string, method string, tokens float64, updatedAt time.Time) error
+	DeleteIdle(ctx context.Context, idleTimeout time.Duration) error
+}
@@ -0,0 +1,91 @@
+package scoped
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"di_container/internal/tenant"
+)
+
+// TenantColumn колонка арендатора, которая есть во всех таблицах
+const TenantColumn = "tenant_id"
+
+// ErrNoTenant возвращается при сборке запроса, если в контексте не выбран арендатор
+var ErrNoTenant = errors.New("tenant is not set in context")
+
+// Репозитории собирают запросы только через функции этого пакета, поэтому условие на арендатора
+// нельзя забыть. Прямые вызовы sq.Select/Insert/Update/Delete в репозиториях запрещены тестом.
+// Дополнительно изоляцию обеспечивают политики RLS в базе
+
+// tenantCondition условие на арендатора. Пустой арендатор не дает собрать запрос
+type tenantCondition string
+
+func (c tenantCondition) ToSql() (string, []interface{}, error) {
+	if c == "" {
+		return "", nil, ErrNoTenant
+	}
+
+	return TenantColumn + " = ?", []interface{}{string(c)}, nil
+}
+
+// Select начинает SELECT по строкам арендатора из контекста
+func Select(ctx context.Context, columns ...string) sq.SelectBuilder {
+	return sq.Select(columns...).
+		PlaceholderFormat(sq.Dollar).
+		Where(tenantCondition(tenant.FromContext(ctx)))
+}
+
+// Update начинает UPDATE строк арендатора из контекста
+func Update(ctx context.Context, table string) sq.UpdateBuilder {
+	return sq.Update(table).
+		PlaceholderFormat(sq.Dollar).
+		Where(tenantCondition(tenant.FromContext(ctx)))
+}
+
+// Delete начинает DELETE строк арендатора из контекста
+func Delete(ctx context.Context, table string) sq.DeleteBuilder {
+	return sq.Delete(table).
+		PlaceholderFormat(sq.Dollar).
+		Where(tenantCondition(tenant.FromContext(ctx)))
+}
+
+// InsertBuilder дописывает арендатора первой колонкой в каждую вставляемую строку
+type InsertBuilder struct {
+	builder sq.InsertBuilder
+	tenant  string
+}
+
+// Insert начинает INSERT строк, принадлежащих арендатору из контекста
+func Insert(ctx context.Context, table string) InsertBuilder {
+	return InsertBuilder{
+		builder: sq.Insert(table).
+			PlaceholderFormat(sq.Dollar).
+			Columns(TenantColumn),
+		tenant: tenant.FromContext(ctx),
+	}
+}
+
+func (b InsertBuilder) Columns(columns ...string) InsertBuilder {
+	b.builder = b.builder.Columns(columns...)
+	return b
+}
+
+func (b InsertBuilder) Values(values ...interface{}) InsertBuilder {
+	b.builder = b.builder.Values(append([]interface{}{b.tenant}, values...)...)
+	return b
+}
+
+func (b InsertBuilder) Suffix(sql string, args ...interface{}) InsertBuilder {
+	b.builder = b.builder.Suffix(sql, args...)
+	return b
+}
+
+func (b InsertBuilder) ToSql() (string, []interface{}, error) {
+	if b.tenant == "" {
+		return "", nil, ErrNoTenant
+	}
+
+	return b.builder.ToSql()
+}
@@ -0,0 +1,156 @@
+package tests
+
+import (
+	"context"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/require"
+
+	"di_container/internal/repository/scoped"
+	"di_container/internal/tenant"
+)
+
+func TestScopedBuilders(t *testing.T) {
+	t.Parallel()
+
+	ctx := tenant.WithTenant(context.Background(), "acme")
+
+	t.Run("select", func(t *testing.T) {
+		query, args, err := scoped.Select(ctx, "id").From("note").Where(sq.Eq{"id": 1}).ToSql()
+		require.NoError(t, err)
+		require.Equal(t, "SELECT id FROM note WHERE tenant_id = $1 AND id = $2", query)
+		require.Equal(t, []interface{}{"acme", 1}, args)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		query, args, err := scoped.Update(ctx, "note").Set("title", "x").Where(sq.Eq{"id": 1}).ToSql()
+		require.NoError(t, err)
+		require.Equal(t, "UPDATE note SET title = $1 WHERE tenant_id = $2 AND id = $3", query)
+		require.Equal(t, []interface{}{"x", "acme", 1}, args)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		query, args, err := scoped.Delete(ctx, "note").Where(sq.Eq{"id": 1}).ToSql()
+		require.NoError(t, err)
+		require.Equal(t, "DELETE FROM note WHERE tenant_id = $1 AND id = $2", query)
+		require.Equal(t, []interface{}{"acme", 1}, args)
+	})
+
+	t.Run("insert", func(t *testing.T) {
+		query, args, err := scoped.Insert(ctx, "note").
+			Columns("title", "content").
+			Values("a", "b").
+			Values("c", "d").
+			Suffix("RETURNING id").
+			ToSql()
+		require.NoError(t, err)
+		require.Equal(t, "INSERT INTO note (tenant_id,title,content) VALUES ($1,$2,$3),($4,$5,$6) RETURNING id", query)
+		require.Equal(t, []interface{}{"acme", "a", "b", "acme", "c", "d"}, args)
+	})
+}
+
+func TestScopedBuildersWithoutTenant(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	_, _, err := scoped.Select(ctx, "id").From("note").ToSql()
+	require.ErrorIs(t, err, scoped.ErrNoTenant)
+
+	_, _, err = scoped.Update(ctx, "note").Set("title", "x").ToSql()
+	require.ErrorIs(t, err, scoped.ErrNoTenant)
+
+	_, _, err = scoped.Delete(ctx, "note").ToSql()
+	require.ErrorIs(t, err, scoped.ErrNoTenant)
+
+	_, _, err = scoped.Insert(ctx, "note").Columns("title").Values("a").ToSql()
+	require.ErrorIs(t, err, scoped.ErrNoTenant)
+}
+
+// TestRepositoriesUseScopedBuilders не дает собрать запрос в репозитории в обход scoped
+func TestRepositoriesUseScopedBuilders(t *testing.T) {
+	t.Parallel()
+
+	forbidden := map[string]struct{}{
+		"Select":           {},
+		"Insert":           {},
+		"Update":           {},
+		"Delete":           {},
+		"Replace":          {},
+		"StatementBuilder": {},
+	}
+
+	root := filepath.Join("..", "..")
+	fset := token.NewFileSet()
+
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if info.Name() == "scoped" || info.Name() == "mocks" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") {
+			return nil
+		}
+
+		file, errParse := parser.ParseFile(fset, path, nil, 0)
+		if errParse != nil {
+			return errParse
+		}
+
+		alias := squirrelAlias(file)
+		if alias == "" {
+			return nil
+		}
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			sel, ok := n.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+
+			pkg, ok := sel.X.(*ast.Ident)
+			if !ok || pkg.Name != alias {
+				return true
+			}
+
+			if _, ok = forbidden[sel.Sel.Name]; ok {
+				t.Errorf("%s: %s.%s builds a query without tenant scoping, use package scoped", fset.Position(sel.Pos()), alias, sel.Sel.Name)
+			}
+
+			return true
+		})
+
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func squirrelAlias(file *ast.File) string {
+	for _, imp := range file.Imports {
+		path, _ := strconv.Unquote(imp.Path.Value)
+		if path != "github.com/Masterminds/squirrel" {
+			continue
+		}
+
+		if imp.Name != nil {
+			return imp.Name.Name
+		}
+
+		return "squirrel"
+	}
+
+	return ""
+}
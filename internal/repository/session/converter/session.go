@@ -0,0 +1,30 @@
+package converter
+
+import (
+	"di_container/internal/model"
+	modelRepo "di_container/internal/repository/session/model"
+)
+
+func ToSessionFromRepo(session *modelRepo.Session) *model.Session {
+	return &model.Session{
+		ID:       session.ID,
+		Username: session.Username,
+		Client: model.ClientInfo{
+			IP:        session.ClientIP,
+			UserAgent: session.UserAgent,
+		},
+		CreatedAt:     session.CreatedAt,
+		LastRefreshAt: session.LastRefreshAt,
+		ExpiresAt:     session.ExpiresAt,
+		RevokedAt:     session.RevokedAt,
+	}
+}
+
+func ToSessionsFromRepo(sessions []*modelRepo.Session) []*model.Session {
+	res := make([]*model.Session, 0, len(sessions))
+	for _, s := range sessions {
+		res = append(res, ToSessionFromRepo(s))
+	}
+
+	return res
+}
@@ -0,0 +1,17 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+type Session struct {
+	ID            string       `db:"id"`
+	Username      string       `db:"username"`
+	ClientIP      string       `db:"client_ip"`
+	UserAgent     string       `db:"user_agent"`
+	CreatedAt     time.Time    `db:"created_at"`
+	LastRefreshAt sql.NullTime `db:"last_refresh_at"`
+	ExpiresAt     time.Time    `db:"expires_at"`
+	RevokedAt     sql.NullTime `db:"revoked_at"`
+}
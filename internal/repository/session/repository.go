@@ -0,0 +1,162 @@
+package session
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+
+	"di_container/internal/client/db"
+	"di_container/internal/model"
+	"di_container/internal/repository"
+	"di_container/internal/repository/scoped"
+	"di_container/internal/repository/session/converter"
+	modelRepo "di_container/internal/repository/session/model"
+)
+
+const (
+	tableName = "session"
+
+	idColumn            = "id"
+	usernameColumn      = "username"
+	clientIPColumn      = "client_ip"
+	userAgentColumn     = "user_agent"
+	createdAtColumn     = "created_at"
+	lastRefreshAtColumn = "last_refresh_at"
+	expiresAtColumn     = "expires_at"
+	revokedAtColumn     = "revoked_at"
+)
+
+var columns = []string{idColumn, usernameColumn, clientIPColumn, userAgentColumn, createdAtColumn, lastRefreshAtColumn, expiresAtColumn, revokedAtColumn}
+
+type repo struct {
+	db db.Client
+}
+
+func NewRepository(db db.Client) repository.SessionRepository {
+	return &repo{db: db}
+}
+
+func (r *repo) Create(ctx context.Context, session *model.Session) error {
+	builder := scoped.Insert(ctx, tableName).
+		Columns(idColumn, usernameColumn, clientIPColumn, userAgentColumn, expiresAtColumn).
+		Values(session.ID, session.Username, session.Client.IP, session.Client.UserAgent, session.ExpiresAt)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "session_repository.Create",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
+
+func (r *repo) Get(ctx context.Context, id string) (*model.Session, error) {
+	builder := scoped.Select(ctx, columns...).
+		From(tableName).
+		Where(sq.Eq{idColumn: id}).
+		Limit(1)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "session_repository.Get",
+		QueryRaw: query,
+	}
+
+	var session modelRepo.Session
+	err = r.db.DB().ScanOneContext(ctx, &session, q, args...)
+	if pgxscan.NotFound(err) {
+		return nil, repository.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToSessionFromRepo(&session), nil
+}
+
+// ListActive возвращает неотозванные и неистекшие сессии пользователя
+func (r *repo) ListActive(ctx context.Context, username string) ([]*model.Session, error) {
+	builder := scoped.Select(ctx, columns...).
+		From(tableName).
+		Where(sq.Eq{usernameColumn: username, revokedAtColumn: nil}).
+		Where(sq.Gt{expiresAtColumn: time.Now()}).
+		OrderBy(createdAtColumn + " DESC")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "session_repository.ListActive",
+		QueryRaw: query,
+	}
+
+	var sessions []*modelRepo.Session
+	err = r.db.DB().ScanAllContext(ctx, &sessions, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToSessionsFromRepo(sessions), nil
+}
+
+// Touch отмечает ротацию refresh токена сессии
+func (r *repo) Touch(ctx context.Context, id string, expiresAt time.Time) error {
+	builder := scoped.Update(ctx, tableName).
+		Set(lastRefreshAtColumn, sq.Expr("now()")).
+		Set(expiresAtColumn, expiresAt).
+		Where(sq.Eq{idColumn: id})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "session_repository.Touch",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
+
+func (r *repo) Revoke(ctx context.Context, id string) error {
+	return r.revoke(ctx, "session_repository.Revoke", sq.Eq{idColumn: id})
+}
+
+func (r *repo) RevokeByUsername(ctx context.Context, username string) error {
+	return r.revoke(ctx, "session_repository.RevokeByUsername", sq.Eq{usernameColumn: username})
+}
+
+func (r *repo) revoke(ctx context.Context, name string, where sq.Eq) error {
+	builder := scoped.Update(ctx, tableName).
+		Set(revokedAtColumn, sq.Expr("now()")).
+		Where(where).
+		Where(sq.Eq{revokedAtColumn: nil})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     name,
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
@@ -0,0 +1,147 @@
+package usage
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"di_container/internal/client/db"
+	"di_container/internal/model"
+	"di_container/internal/repository"
+	"di_container/internal/repository/scoped"
+)
+
+const (
+	counterTableName = "usage_counter"
+	requestTableName = "request_counter"
+
+	ownerColumn           = "owner"
+	notesColumn           = "notes"
+	contentBytesColumn    = "content_bytes"
+	attachmentBytesColumn = "attachment_bytes"
+	updatedAtColumn       = "updated_at"
+	dayColumn             = "day"
+	requestsColumn        = "requests"
+)
+
+type repo struct {
+	db db.Client
+}
+
+func NewRepository(db db.Client) repository.UsageRepository {
+	return &repo{db: db}
+}
+
+// Add прибавляет delta к счетчикам и возвращает их новые значения. Строка счетчика остается
+// заблокированной до конца транзакции, поэтому параллельные записи не обойдут квоту
+func (r *repo) Add(ctx context.Context, owner string, delta *model.Usage) (*model.Usage, error) {
+	builder := scoped.Insert(ctx, counterTableName).
+		Columns(ownerColumn, notesColumn, contentBytesColumn, attachmentBytesColumn).
+		Values(owner, delta.Notes, delta.ContentBytes, delta.AttachmentBytes).
+		Suffix(`ON CONFLICT (` + scoped.TenantColumn + `, ` + ownerColumn + `) DO UPDATE SET
+			` + notesColumn + ` = ` + counterTableName + `.` + notesColumn + ` + excluded.` + notesColumn + `,
+			` + contentBytesColumn + ` = ` + counterTableName + `.` + contentBytesColumn + ` + excluded.` + contentBytesColumn + `,
+			` + attachmentBytesColumn + ` = ` + counterTableName + `.` + attachmentBytesColumn + ` + excluded.` + attachmentBytesColumn + `,
+			` + updatedAtColumn + ` = now()
+		RETURNING ` + notesColumn + `, ` + contentBytesColumn + `, ` + attachmentBytesColumn)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "usage_repository.Add",
+		QueryRaw: query,
+	}
+
+	var usage model.Usage
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&usage.Notes, &usage.ContentBytes, &usage.AttachmentBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &usage, nil
+}
+
+// Get возвращает счетчики. Для владельца без записей счетчики нулевые
+func (r *repo) Get(ctx context.Context, owner string) (*model.Usage, error) {
+	builder := scoped.Select(ctx,
+		"coalesce(sum("+notesColumn+"), 0)",
+		"coalesce(sum("+contentBytesColumn+"), 0)",
+		"coalesce(sum("+attachmentBytesColumn+"), 0)",
+	).
+		From(counterTableName).
+		Where(sq.Eq{ownerColumn: owner})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "usage_repository.Get",
+		QueryRaw: query,
+	}
+
+	var usage model.Usage
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&usage.Notes, &usage.ContentBytes, &usage.AttachmentBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &usage, nil
+}
+
+// CountRequest учитывает запрос за день day и возвращает число запросов за этот день вместе с ним
+func (r *repo) CountRequest(ctx context.Context, owner string, day time.Time) (int64, error) {
+	builder := scoped.Insert(ctx, requestTableName).
+		Columns(ownerColumn, dayColumn, requestsColumn).
+		Values(owner, day, 1).
+		Suffix(`ON CONFLICT (` + scoped.TenantColumn + `, ` + ownerColumn + `, ` + dayColumn + `) DO UPDATE SET
+			` + requestsColumn + ` = ` + requestTableName + `.` + requestsColumn + ` + 1
+		RETURNING ` + requestsColumn)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "usage_repository.CountRequest",
+		QueryRaw: query,
+	}
+
+	var requests int64
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&requests)
+	if err != nil {
+		return 0, err
+	}
+
+	return requests, nil
+}
+
+func (r *repo) GetRequests(ctx context.Context, owner string, day time.Time) (int64, error) {
+	builder := scoped.Select(ctx, "coalesce(sum("+requestsColumn+"), 0)").
+		From(requestTableName).
+		Where(sq.Eq{ownerColumn: owner, dayColumn: day})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "usage_repository.GetRequests",
+		QueryRaw: query,
+	}
+
+	var requests int64
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&requests)
+	if err != nil {
+		return 0, err
+	}
+
+	return requests, nil
+}
@@ -0,0 +1,24 @@
+package converter
+
+import (
+	"di_container/internal/model"
+	modelRepo "di_container/internal/repository/user/model"
+)
+
+func ToUserFromRepo(user *modelRepo.User) *model.User {
+	return &model.User{
+		ID:            user.ID,
+		Username:      user.Username,
+		PasswordHash:  user.PasswordHash,
+		Role:          user.Role,
+		Email:         user.Email,
+		EmailVerified: user.EmailVerified,
+		TOTP: model.TOTPInfo{
+			EncryptedSecret: user.TOTPSecret,
+			Enabled:         user.TOTPEnabled,
+			LastStep:        user.TOTPLastStep,
+		},
+		CreatedAt: user.CreatedAt,
+		UpdatedAt: user.UpdatedAt,
+	}
+}
@@ -0,0 +1,20 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+type User struct {
+	ID            int64          `db:"id"`
+	Username      string         `db:"username"`
+	PasswordHash  string         `db:"password_hash"`
+	Role          string         `db:"role"`
+	Email         sql.NullString `db:"email"`
+	EmailVerified bool           `db:"email_verified"`
+	TOTPSecret    sql.NullString `db:"totp_secret"`
+	TOTPEnabled   bool           `db:"totp_enabled"`
+	TOTPLastStep  int64          `db:"totp_last_step"`
+	CreatedAt     time.Time      `db:"created_at"`
+	UpdatedAt     sql.NullTime   `db:"updated_at"`
+}
@@ -0,0 +1,169 @@
+package user
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+
+	"di_container/internal/client/db"
+	"di_container/internal/model"
+	"di_container/internal/repository"
+	"di_container/internal/repository/scoped"
+	"di_container/internal/repository/user/converter"
+	modelRepo "di_container/internal/repository/user/model"
+)
+
+const (
+	tableName = "users"
+
+	idColumn            = "id"
+	usernameColumn      = "username"
+	passwordHashColumn  = "password_hash"
+	roleColumn          = "role"
+	emailColumn         = "email"
+	emailVerifiedColumn = "email_verified"
+	totpSecretColumn    = "totp_secret"
+	totpEnabledColumn   = "totp_enabled"
+	totpLastStepColumn  = "totp_last_step"
+	createdAtColumn     = "created_at"
+	updatedAtColumn     = "updated_at"
+)
+
+type repo struct {
+	db db.Client
+}
+
+func NewRepository(db db.Client) repository.UserRepository {
+	return &repo{db: db}
+}
+
+func (r *repo) Get(ctx context.Context, id int64) (*model.User, error) {
+	return r.get(ctx, "user_repository.Get", sq.Eq{idColumn: id})
+}
+
+func (r *repo) GetByUsername(ctx context.Context, username string) (*model.User, error) {
+	return r.get(ctx, "user_repository.GetByUsername", sq.Eq{usernameColumn: username})
+}
+
+func (r *repo) GetByEmail(ctx context.Context, email string) (*model.User, error) {
+	return r.get(ctx, "user_repository.GetByEmail", sq.Eq{emailColumn: email})
+}
+
+func (r *repo) UpdatePassword(ctx context.Context, id int64, passwordHash string) error {
+	builder := scoped.Update(ctx, tableName).
+		Set(passwordHashColumn, passwordHash).
+		Set(updatedAtColumn, sq.Expr("now()")).
+		Where(sq.Eq{idColumn: id})
+
+	return r.exec(ctx, "user_repository.UpdatePassword", builder)
+}
+
+func (r *repo) UpdateRole(ctx context.Context, id int64, role string) error {
+	builder := scoped.Update(ctx, tableName).
+		Set(roleColumn, role).
+		Set(updatedAtColumn, sq.Expr("now()")).
+		Where(sq.Eq{idColumn: id})
+
+	return r.exec(ctx, "user_repository.UpdateRole", builder)
+}
+
+func (r *repo) SetEmailVerified(ctx context.Context, id int64) error {
+	builder := scoped.Update(ctx, tableName).
+		Set(emailVerifiedColumn, true).
+		Set(updatedAtColumn, sq.Expr("now()")).
+		Where(sq.Eq{idColumn: id})
+
+	return r.exec(ctx, "user_repository.SetEmailVerified", builder)
+}
+
+// SetTOTPSecret сохраняет новый секрет. Второй фактор включается только после подтверждения кодом
+func (r *repo) SetTOTPSecret(ctx context.Context, id int64, encryptedSecret string) error {
+	builder := scoped.Update(ctx, tableName).
+		Set(totpSecretColumn, encryptedSecret).
+		Set(totpEnabledColumn, false).
+		Set(totpLastStepColumn, 0).
+		Set(updatedAtColumn, sq.Expr("now()")).
+		Where(sq.Eq{idColumn: id})
+
+	return r.exec(ctx, "user_repository.SetTOTPSecret", builder)
+}
+
+func (r *repo) EnableTOTP(ctx context.Context, id int64) error {
+	builder := scoped.Update(ctx, tableName).
+		Set(totpEnabledColumn, true).
+		Set(updatedAtColumn, sq.Expr("now()")).
+		Where(sq.Eq{idColumn: id})
+
+	return r.exec(ctx, "user_repository.EnableTOTP", builder)
+}
+
+// UseTOTPStep запоминает шаг использованного кода. Возвращает false, если код этого или более позднего шага
+// уже использовался, так что перехваченный код нельзя применить повторно
+func (r *repo) UseTOTPStep(ctx context.Context, id int64, step int64) (bool, error) {
+	builder := scoped.Update(ctx, tableName).
+		Set(totpLastStepColumn, step).
+		Where(sq.Eq{idColumn: id}).
+		Where(sq.Lt{totpLastStepColumn: step})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	q := db.Query{
+		Name:     "user_repository.UseTOTPStep",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+func (r *repo) exec(ctx context.Context, name string, builder sq.UpdateBuilder) error {
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     name,
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
+
+func (r *repo) get(ctx context.Context, name string, where sq.Eq) (*model.User, error) {
+	builder := scoped.Select(ctx, idColumn, usernameColumn, passwordHashColumn, roleColumn, emailColumn, emailVerifiedColumn,
+		totpSecretColumn, totpEnabledColumn, totpLastStepColumn, createdAtColumn, updatedAtColumn).
+		From(tableName).
+		Where(where).
+		Limit(1)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     name,
+		QueryRaw: query,
+	}
+
+	var user modelRepo.User
+	err = r.db.DB().ScanOneContext(ctx, &user, q, args...)
+	if pgxscan.NotFound(err) {
+		return nil, repository.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToUserFromRepo(&user), nil
+}
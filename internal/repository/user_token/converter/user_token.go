@@ -0,0 +1,15 @@
+package converter
+
+import (
+	"di_container/internal/model"
+	modelRepo "di_container/internal/repository/user_token/model"
+)
+
+func ToUserTokenFromRepo(token *modelRepo.UserToken) *model.UserToken {
+	return &model.UserToken{
+		Hash:      token.Hash,
+		UserID:    token.UserID,
+		Purpose:   token.Purpose,
+		ExpiresAt: token.ExpiresAt,
+	}
+}
@@ -0,0 +1,10 @@
+package model
+
+import "time"
+
+type UserToken struct {
+	Hash      string    `db:"token_hash"`
+	UserID    int64     `db:"user_id"`
+	Purpose   string    `db:"purpose"`
+	ExpiresAt time.Time `db:"expires_at"`
+}
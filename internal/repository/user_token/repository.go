@@ -0,0 +1,102 @@
+package user_token
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+
+	"di_container/internal/client/db"
+	"di_container/internal/model"
+	"di_container/internal/repository"
+	"di_container/internal/repository/scoped"
+	"di_container/internal/repository/user_token/converter"
+	modelRepo "di_container/internal/repository/user_token/model"
+)
+
+const (
+	tableName = "user_token"
+
+	tokenHashColumn = "token_hash"
+	userIDColumn    = "user_id"
+	purposeColumn   = "purpose"
+	expiresAtColumn = "expires_at"
+	usedAtColumn    = "used_at"
+)
+
+type repo struct {
+	db db.Client
+}
+
+func NewRepository(db db.Client) repository.UserTokenRepository {
+	return &repo{db: db}
+}
+
+func (r *repo) Create(ctx context.Context, token *model.UserToken) error {
+	builder := scoped.Insert(ctx, tableName).
+		Columns(tokenHashColumn, userIDColumn, purposeColumn, expiresAtColumn).
+		Values(token.Hash, token.UserID, token.Purpose, token.ExpiresAt)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "user_token_repository.Create",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
+
+// Use помечает токен использованным и возвращает его. Проверка и пометка делаются одним запросом,
+// поэтому токен нельзя использовать дважды даже при параллельных запросах
+func (r *repo) Use(ctx context.Context, hash string, purpose string) (*model.UserToken, error) {
+	builder := scoped.Update(ctx, tableName).
+		Set(usedAtColumn, sq.Expr("now()")).
+		Where(sq.Eq{tokenHashColumn: hash, purposeColumn: purpose, usedAtColumn: nil}).
+		Where(sq.Expr(expiresAtColumn + " > now()")).
+		Suffix("RETURNING " + tokenHashColumn + ", " + userIDColumn + ", " + purposeColumn + ", " + expiresAtColumn)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "user_token_repository.Use",
+		QueryRaw: query,
+	}
+
+	var token modelRepo.UserToken
+	err = r.db.DB().ScanOneContext(ctx, &token, q, args...)
+	if pgxscan.NotFound(err) {
+		return nil, repository.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToUserTokenFromRepo(&token), nil
+}
+
+// DeleteUnused удаляет неиспользованные токены пользователя, чтобы действовал только последний отправленный
+func (r *repo) DeleteUnused(ctx context.Context, userID int64, purpose string) error {
+	builder := scoped.Delete(ctx, tableName).
+		Where(sq.Eq{userIDColumn: userID, purposeColumn: purpose, usedAtColumn: nil})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "user_token_repository.DeleteUnused",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	return err
+}
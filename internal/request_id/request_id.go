@@ -0,0 +1,42 @@
+package request_id
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"regexp"
+)
+
+// Header заголовок, в котором идентификатор запроса приходит от клиента и возвращается в ответе
+const Header = "x-request-id"
+
+type requestIDKey struct{}
+
+// validID ограничивает идентификаторы клиента, чтобы через них нельзя было подделать строки логов
+var validID = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+// Valid проверяет идентификатор, пришедший от клиента
+func Valid(id string) bool {
+	return validID.MatchString(id)
+}
+
+// New создает идентификатор для запроса, клиент которого его не передал
+func New() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+
+	return hex.EncodeToString(b)
+}
+
+// WithRequestID кладет идентификатор запроса в контекст
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// FromContext возвращает идентификатор запроса из контекста или пустую строку
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
@@ -0,0 +1,60 @@
+package access
+
+import (
+	"context"
+	"di_container/internal/logger"
+	"di_container/internal/model"
+	"di_container/internal/tenant"
+	"go.uber.org/zap"
+)
+
+// recordDecision записывает решение о доступе в журнал аудита. Пишутся отказы и явные проверки через AccessV1:
+// разрешение каждого вызова из AuthInterceptor заняло бы блокировку цепочки арендатора на каждом запросе.
+// Ошибка записи только логируется, чтобы журнал не влиял на само решение.
+// Событие попадает в журнал арендатора токена, даже если Check вызван от имени другого сервиса
+func (s *serv) recordDecision(ctx context.Context, claims *model.UserClaims, subject string, req *model.AccessRequest, decision *model.AccessDecision) {
+	ctx = tenant.WithTenant(ctx, claims.TenantID())
+
+	event := &model.AuditEvent{
+		Type:         model.AuditEventAccessCheck,
+		Actor:        claims.Username,
+		Impersonator: claims.Impersonator,
+		Subject:      subject,
+		Method:       req.Method,
+		Reason:       string(decision.Reason),
+	}
+	if decision.Rule != "" {
+		event.Reason += ":" + decision.Rule
+	}
+
+	if !decision.Allowed {
+		s.auditDenial(ctx, event)
+		return
+	}
+
+	if !req.Explicit {
+		return
+	}
+
+	event.Outcome = model.AuditOutcomeAllowed
+	s.audit(ctx, event)
+}
+
+func (s *serv) auditDenial(ctx context.Context, event *model.AuditEvent) {
+	event.Outcome = model.AuditOutcomeDenied
+	s.audit(ctx, event)
+}
+
+func (s *serv) audit(ctx context.Context, event *model.AuditEvent) {
+	err := s.auditService.Record(ctx, event)
+	if err != nil {
+		logger.FromContext(ctx).Error("failed to record audit event",
+			zap.String("type", event.Type),
+			zap.String("actor", event.Actor),
+			zap.String("impersonator", event.Impersonator),
+			zap.String("method", event.Method),
+			zap.String("outcome", event.Outcome),
+			zap.Error(err),
+		)
+	}
+}
@@ -0,0 +1,141 @@
+package access
+
+import (
+	"crypto/sha256"
+	"di_container/internal/model"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// maxCachedTokens и maxDecisionsPerToken ограничивают память кеша. Решения по запросам
+	// с разными атрибутами (например, request.id) сверх лимита просто не кешируются
+	maxCachedTokens      = 10000
+	maxDecisionsPerToken = 256
+)
+
+type tokenEntry struct {
+	claims    *model.UserClaims
+	expiresAt time.Time
+	decisions map[string]*model.AccessDecision
+}
+
+// decisionCache хранит проверенные токены и решения по ним до истечения срока токена
+type decisionCache struct {
+	mu      sync.Mutex
+	entries map[[sha256.Size]byte]*tokenEntry
+}
+
+func newDecisionCache() *decisionCache {
+	return &decisionCache{
+		entries: make(map[[sha256.Size]byte]*tokenEntry),
+	}
+}
+
+func (c *decisionCache) entry(token string) (*tokenEntry, bool) {
+	e, ok := c.entries[sha256.Sum256([]byte(token))]
+	if !ok {
+		return nil, false
+	}
+
+	if !e.expiresAt.After(time.Now()) {
+		delete(c.entries, sha256.Sum256([]byte(token)))
+		return nil, false
+	}
+
+	return e, true
+}
+
+func (c *decisionCache) claims(token string) (*model.UserClaims, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entry(token)
+	if !ok {
+		return nil, false
+	}
+
+	return e.claims, true
+}
+
+func (c *decisionCache) decision(token string, key string) (*model.AccessDecision, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entry(token)
+	if !ok {
+		return nil, false
+	}
+
+	decision, ok := e.decisions[key]
+	return decision, ok
+}
+
+func (c *decisionCache) addToken(token string, claims *model.UserClaims, expiresAt time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.entries) >= maxCachedTokens {
+		c.evict()
+	}
+
+	c.entries[sha256.Sum256([]byte(token))] = &tokenEntry{
+		claims:    claims,
+		expiresAt: expiresAt,
+		decisions: make(map[string]*model.AccessDecision),
+	}
+}
+
+func (c *decisionCache) addDecision(token string, key string, decision *model.AccessDecision) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entry(token)
+	if !ok || len(e.decisions) >= maxDecisionsPerToken {
+		return
+	}
+
+	e.decisions[key] = decision
+}
+
+// evict удаляет истекшие токены, а если их нет, то произвольные, чтобы освободить место
+func (c *decisionCache) evict() {
+	now := time.Now()
+	for k, e := range c.entries {
+		if !e.expiresAt.After(now) {
+			delete(c.entries, k)
+		}
+	}
+
+	for k := range c.entries {
+		if len(c.entries) < maxCachedTokens {
+			return
+		}
+		delete(c.entries, k)
+	}
+}
+
+// decisionKey однозначно описывает запрос без вызывающего, который определяется токеном
+func decisionKey(req *model.AccessRequest) string {
+	var sb strings.Builder
+	sb.WriteString(req.Method)
+	writeAttributes(&sb, "resource", req.Resource)
+	writeAttributes(&sb, "request", req.Request)
+
+	return sb.String()
+}
+
+func writeAttributes(sb *strings.Builder, prefix string, attrs map[string]string) {
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		// Нулевой байт не встречается в именах методов и атрибутах, поэтому ключи не склеиваются
+		sb.WriteString("\x00" + prefix + "." + k + "\x00" + attrs[k])
+	}
+}
@@ -0,0 +1,127 @@
+package access
+
+import (
+	"context"
+	"di_container/internal/model"
+	"di_container/internal/sys"
+	"google.golang.org/grpc/codes"
+	"time"
+)
+
+var errInvalidAccessToken = sys.NewCommonError("access token is invalid", codes.Unauthenticated)
+
+func (s *serv) Check(ctx context.Context, accessToken string, req *model.AccessRequest) (*model.UserClaims, error) {
+	claims, err := s.verifyAccessToken(ctx, accessToken, req)
+	if err != nil {
+		return nil, err
+	}
+
+	decision, err := s.decide(ctx, accessToken, claims, req)
+	if err != nil {
+		return nil, err
+	}
+
+	s.recordDecision(ctx, claims, "", req, decision)
+
+	if !decision.Allowed {
+		return nil, deniedError(decision)
+	}
+
+	return claims, nil
+}
+
+// BatchCheck принимает решения сразу по нескольким запросам. Отказ по отдельному запросу
+// не является ошибкой и возвращается в решении
+func (s *serv) BatchCheck(ctx context.Context, accessToken string, reqs []*model.AccessRequest) ([]*model.AccessDecision, error) {
+	claims, err := s.verifyAccessToken(ctx, accessToken, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	decisions := make([]*model.AccessDecision, 0, len(reqs))
+	for _, req := range reqs {
+		decision, errDecide := s.decide(ctx, accessToken, claims, req)
+		if errDecide != nil {
+			return nil, errDecide
+		}
+
+		s.recordDecision(ctx, claims, "", req, decision)
+
+		decisions = append(decisions, decision)
+	}
+
+	return decisions, nil
+}
+
+// verifyAccessToken проверяет подпись токена. Проверенный токен запоминается до истечения его срока.
+// req нужен только для журнала аудита и может быть nil
+func (s *serv) verifyAccessToken(ctx context.Context, accessToken string, req *model.AccessRequest) (*model.UserClaims, error) {
+	if claims, ok := s.cache.claims(accessToken); ok {
+		return claims, nil
+	}
+
+	claims, err := s.accessKeySet.VerifyToken(accessToken)
+	if err != nil {
+		event := &model.AuditEvent{
+			Type:    model.AuditEventAccessCheck,
+			Outcome: model.AuditOutcomeDenied,
+			Reason:  "invalid_token",
+		}
+		if req != nil {
+			event.Method = req.Method
+		}
+
+		s.auditDenial(ctx, event)
+
+		return nil, errInvalidAccessToken
+	}
+
+	s.cache.addToken(accessToken, claims, time.Unix(claims.ExpiresAt, 0))
+
+	return claims, nil
+}
+
+// decide возвращает решение из кеша токена или вычисляет и запоминает его.
+// Токен не меняется до истечения срока, а политика загружается при старте, поэтому решение не устаревает
+func (s *serv) decide(ctx context.Context, accessToken string, claims *model.UserClaims, req *model.AccessRequest) (*model.AccessDecision, error) {
+	key := decisionKey(req)
+	if decision, ok := s.cache.decision(accessToken, key); ok {
+		return decision, nil
+	}
+
+	decision, err := s.Explain(ctx, claims, req)
+	if err != nil {
+		return nil, err
+	}
+
+	s.cache.addDecision(accessToken, key, decision)
+
+	return decision, nil
+}
+
+// authorize проверяет запрос пользователя по политике доступа. subject попадает в журнал аудита
+func (s *serv) authorize(ctx context.Context, claims *model.UserClaims, subject string, req *model.AccessRequest) (*model.UserClaims, error) {
+	decision, err := s.Explain(ctx, claims, req)
+	if err != nil {
+		return nil, err
+	}
+
+	s.recordDecision(ctx, claims, subject, req, decision)
+
+	if !decision.Allowed {
+		return nil, deniedError(decision)
+	}
+
+	return claims, nil
+}
+
+func deniedError(decision *model.AccessDecision) error {
+	switch decision.Reason {
+	case model.AccessReasonDeniedByScope:
+		return sys.NewCommonError("access token scope does not allow this method", codes.PermissionDenied)
+	case model.AccessReasonDeniedByImpersonation:
+		return sys.NewCommonError("impersonated access tokens cannot manage credentials", codes.PermissionDenied)
+	}
+
+	return sys.NewCommonError("access denied", codes.PermissionDenied)
+}
@@ -0,0 +1,87 @@
+package access
+
+import (
+	"context"
+	"di_container/internal/logger"
+	"di_container/internal/model"
+	"di_container/internal/repository"
+	"di_container/internal/sys"
+	"di_container/internal/tenant"
+	"di_container/internal/utils"
+	"errors"
+	"fmt"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"strings"
+)
+
+var errInvalidAPIKey = sys.NewCommonError("api key is invalid", codes.Unauthenticated)
+
+// CheckAPIKey проверяет ключ вместо access токена. Ключ действует от имени владельца с его текущей ролью,
+// но только для методов из своих scopes
+func (s *serv) CheckAPIKey(ctx context.Context, apiKey string, req *model.AccessRequest) (*model.UserClaims, error) {
+	if !strings.HasPrefix(apiKey, utils.APIKeyPrefix) {
+		return nil, s.invalidAPIKey(ctx, req)
+	}
+
+	key, err := s.apiKeyRepository.GetByHash(ctx, utils.HashSecret(apiKey))
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, s.invalidAPIKey(ctx, req)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !key.IsActive() {
+		return nil, s.invalidAPIKey(ctx, req)
+	}
+
+	subject := fmt.Sprintf("api_key:%d", key.ID)
+
+	if !key.Allows(req.Method) {
+		s.auditDenial(ctx, &model.AuditEvent{
+			Type:    model.AuditEventAccessCheck,
+			Actor:   key.Username,
+			Subject: subject,
+			Method:  req.Method,
+			Reason:  string(model.AccessReasonDeniedByScope),
+		})
+
+		return nil, sys.NewCommonError("api key scopes do not allow this method", codes.PermissionDenied)
+	}
+
+	user, err := s.userRepository.GetByUsername(ctx, key.Username)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, s.invalidAPIKey(ctx, req)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := s.authorize(ctx, &model.UserClaims{
+		Username: user.Username,
+		Role:     user.Role,
+		Tenant:   tenant.FromContext(ctx),
+	}, subject, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Время последнего использования справочное, запрос из-за него не отклоняем
+	err = s.apiKeyRepository.Touch(ctx, key.ID)
+	if err != nil {
+		logger.FromContext(ctx).Warn("failed to touch api key", zap.Int64("api_key_id", key.ID), zap.Error(err))
+	}
+
+	return claims, nil
+}
+
+func (s *serv) invalidAPIKey(ctx context.Context, req *model.AccessRequest) error {
+	s.auditDenial(ctx, &model.AuditEvent{
+		Type:   model.AuditEventAccessCheck,
+		Method: req.Method,
+		Reason: "invalid_api_key",
+	})
+
+	return errInvalidAPIKey
+}
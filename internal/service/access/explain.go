@@ -0,0 +1,26 @@
+package access
+
+import (
+	"context"
+	"di_container/internal/model"
+)
+
+// Explain вычисляет решение для пользователя и возвращает его причину: scope токена, вход под чужой
+// учетной записью или правило политики. Первые две проверки политикой не переопределяются
+func (s *serv) Explain(_ context.Context, claims *model.UserClaims, req *model.AccessRequest) (*model.AccessDecision, error) {
+	if claims.Scope != "" && !model.ScopesAllow(claims.Scopes(), req.Method) {
+		return &model.AccessDecision{Reason: model.AccessReasonDeniedByScope}, nil
+	}
+
+	if _, ok := s.credentialMethods[req.Method]; ok && claims.Impersonator != "" {
+		return &model.AccessDecision{Reason: model.AccessReasonDeniedByImpersonation}, nil
+	}
+
+	evalReq := *req
+	evalReq.Caller = model.Caller{
+		Username: claims.Username,
+		Roles:    []string{claims.Role},
+	}
+
+	return s.policy.Evaluate(&evalReq), nil
+}
@@ -0,0 +1,43 @@
+package access
+
+import (
+	"di_container/internal/policy"
+	"di_container/internal/repository"
+	"di_container/internal/service"
+	"di_container/internal/utils"
+)
+
+type serv struct {
+	accessKeySet     *utils.KeySet
+	policy           *policy.Policy
+	apiKeyRepository repository.APIKeyRepository
+	userRepository   repository.UserRepository
+	auditService     service.AuditService
+	cache            *decisionCache
+	// credentialMethods методы управления учетными данными, недоступные под чужой учетной записью
+	credentialMethods map[string]struct{}
+}
+
+func NewService(
+	accessKeySet *utils.KeySet,
+	policy *policy.Policy,
+	apiKeyRepository repository.APIKeyRepository,
+	userRepository repository.UserRepository,
+	auditService service.AuditService,
+	credentialMethods ...string,
+) service.AccessService {
+	methods := make(map[string]struct{}, len(credentialMethods))
+	for _, m := range credentialMethods {
+		methods[m] = struct{}{}
+	}
+
+	return &serv{
+		accessKeySet:      accessKeySet,
+		policy:            policy,
+		apiKeyRepository:  apiKeyRepository,
+		userRepository:    userRepository,
+		auditService:      auditService,
+		cache:             newDecisionCache(),
+		credentialMethods: methods,
+	}
+}
@@ -0,0 +1,107 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"di_container/internal/model"
+	"di_container/internal/policy"
+	"di_container/internal/service/access"
+	serviceMocks "di_container/internal/service/mocks"
+	"di_container/internal/sys"
+	"di_container/internal/utils"
+)
+
+func TestBatchCheck(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = context.Background()
+		keySet = utils.NewHMACKeySet([]byte(gofakeit.Password(true, true, true, false, false, 32)))
+
+		getReq    = &model.AccessRequest{Method: "/note_v1.NoteV1/Get", Explicit: true}
+		createReq = &model.AccessRequest{Method: "/note_v1.NoteV1/Create", Explicit: true}
+	)
+
+	accessPolicy, err := policy.Load("")
+	require.NoError(t, err)
+
+	userToken, err := keySet.GenerateToken(model.UserInfo{Username: gofakeit.Username(), Role: "user"}, time.Minute)
+	require.NoError(t, err)
+
+	adminToken, err := keySet.GenerateToken(model.UserInfo{Username: gofakeit.Username(), Role: model.RoleAdmin}, time.Minute)
+	require.NoError(t, err)
+
+	scopedToken, err := keySet.GenerateScopedToken(model.UserInfo{Username: gofakeit.Username(), Role: model.RoleAdmin},
+		[]string{createReq.Method}, time.Minute)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		token   string
+		code    codes.Code
+		reasons []model.AccessReason
+	}{
+		{
+			name:    "user case",
+			token:   userToken,
+			code:    codes.OK,
+			reasons: []model.AccessReason{model.AccessReasonDeniedByRule, model.AccessReasonAllowedByDefault},
+		},
+		{
+			name:    "admin case",
+			token:   adminToken,
+			code:    codes.OK,
+			reasons: []model.AccessReason{model.AccessReasonAllowedByRule, model.AccessReasonAllowedByRule},
+		},
+		{
+			name:    "scoped token case",
+			token:   scopedToken,
+			code:    codes.OK,
+			reasons: []model.AccessReason{model.AccessReasonDeniedByScope, model.AccessReasonAllowedByRule},
+		},
+		{
+			name:  "invalid token case",
+			token: userToken + "x",
+			code:  codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			t.Cleanup(mc.Finish)
+
+			auditServiceMock := serviceMocks.NewAuditServiceMock(mc)
+			auditServiceMock.RecordMock.Return(nil)
+
+			service := access.NewService(keySet, accessPolicy, nil, nil, auditServiceMock)
+
+			// Второй вызов отвечает из кеша и должен совпадать с первым
+			for i := 0; i < 2; i++ {
+				decisions, err := service.BatchCheck(ctx, tt.token, []*model.AccessRequest{getReq, createReq})
+				if tt.code != codes.OK {
+					commonErr := sys.GetCommonError(err)
+					require.NotNil(t, commonErr)
+					require.Equal(t, tt.code, commonErr.Code())
+					return
+				}
+
+				require.NoError(t, err)
+				require.Len(t, decisions, len(tt.reasons))
+				for idx, reason := range tt.reasons {
+					require.Equal(t, reason, decisions[idx].Reason)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc/codes"
+
+	"di_container/internal/logger"
+	"di_container/internal/model"
+	"di_container/internal/policy"
+	"di_container/internal/repository"
+	repoMocks "di_container/internal/repository/mocks"
+	"di_container/internal/service/access"
+	serviceMocks "di_container/internal/service/mocks"
+	"di_container/internal/sys"
+	"di_container/internal/utils"
+)
+
+func TestCheckAPIKey(t *testing.T) {
+	t.Parallel()
+	type apiKeyRepositoryMockFunc func(mc *minimock.Controller) repository.APIKeyRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		endpoint = "/note_v1.NoteV1/Create"
+		user     = &model.User{Username: gofakeit.Username(), Role: "user"}
+	)
+	t.Cleanup(mc.Finish)
+
+	logger.Init(zapcore.NewNopCore())
+
+	accessPolicy, err := policy.Load("")
+	require.NoError(t, err)
+
+	key, prefix, err := utils.GenerateAPIKey()
+	require.NoError(t, err)
+
+	newKey := func(scopes []string) *model.APIKey {
+		return &model.APIKey{
+			ID:       gofakeit.Int64(),
+			Username: user.Username,
+			Info:     model.APIKeyInfo{Name: "ci", Scopes: scopes},
+			Prefix:   prefix,
+			Hash:     utils.HashSecret(key),
+		}
+	}
+
+	revokedKey := newKey([]string{model.APIKeyScopeAll})
+	revokedKey.RevokedAt = sql.NullTime{Time: time.Now(), Valid: true}
+
+	tests := []struct {
+		name             string
+		key              string
+		explicit         bool
+		auditErr         error
+		code             codes.Code
+		apiKeyRepository apiKeyRepositoryMockFunc
+	}{
+		{
+			name: "success case",
+			key:  key,
+			code: codes.OK,
+			apiKeyRepository: func(mc *minimock.Controller) repository.APIKeyRepository {
+				mock := repoMocks.NewAPIKeyRepositoryMock(mc)
+				mock.GetByHashMock.Expect(minimock.AnyContext, utils.HashSecret(key)).Return(newKey([]string{"/note_v1.NoteV1/*"}), nil)
+				mock.TouchMock.Return(nil)
+				return mock
+			},
+		},
+		{
+			name:     "explicit check audit failure case",
+			key:      key,
+			explicit: true,
+			auditErr: gofakeit.Error(),
+			code:     codes.OK,
+			apiKeyRepository: func(mc *minimock.Controller) repository.APIKeyRepository {
+				mock := repoMocks.NewAPIKeyRepositoryMock(mc)
+				mock.GetByHashMock.Return(newKey([]string{"/note_v1.NoteV1/*"}), nil)
+				mock.TouchMock.Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "unknown key case",
+			key:  key,
+			code: codes.Unauthenticated,
+			apiKeyRepository: func(mc *minimock.Controller) repository.APIKeyRepository {
+				mock := repoMocks.NewAPIKeyRepositoryMock(mc)
+				mock.GetByHashMock.Return(nil, repository.ErrNotFound)
+				return mock
+			},
+		},
+		{
+			name: "wrong prefix case",
+			key:  "Bearer " + key,
+			code: codes.Unauthenticated,
+			apiKeyRepository: func(mc *minimock.Controller) repository.APIKeyRepository {
+				return repoMocks.NewAPIKeyRepositoryMock(mc)
+			},
+		},
+		{
+			name: "revoked key case",
+			key:  key,
+			code: codes.Unauthenticated,
+			apiKeyRepository: func(mc *minimock.Controller) repository.APIKeyRepository {
+				mock := repoMocks.NewAPIKeyRepositoryMock(mc)
+				mock.GetByHashMock.Return(revokedKey, nil)
+				return mock
+			},
+		},
+		{
+			name: "scope mismatch case",
+			key:  key,
+			code: codes.PermissionDenied,
+			apiKeyRepository: func(mc *minimock.Controller) repository.APIKeyRepository {
+				mock := repoMocks.NewAPIKeyRepositoryMock(mc)
+				mock.GetByHashMock.Return(newKey([]string{"/note_v1.NoteV1/Get"}), nil)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			userRepoMock := repoMocks.NewUserRepositoryMock(mc)
+			if tt.code == codes.OK {
+				userRepoMock.GetByUsernameMock.Expect(minimock.AnyContext, user.Username).Return(user, nil)
+			}
+
+			// Отказ и явная проверка попадают в журнал аудита ровно один раз, разрешение перед вызовом метода - нет
+			outcome := model.AuditOutcomeDenied
+			if tt.code == codes.OK {
+				outcome = model.AuditOutcomeAllowed
+			}
+
+			auditServiceMock := serviceMocks.NewAuditServiceMock(mc)
+			if tt.code != codes.OK || tt.explicit {
+				auditServiceMock.RecordMock.Set(func(_ context.Context, event *model.AuditEvent) error {
+					require.Equal(t, model.AuditEventAccessCheck, event.Type)
+					require.Equal(t, outcome, event.Outcome)
+					require.Equal(t, endpoint, event.Method)
+					return tt.auditErr
+				})
+			}
+
+			service := access.NewService(nil, accessPolicy, tt.apiKeyRepository(mc), userRepoMock, auditServiceMock)
+
+			claims, err := service.CheckAPIKey(ctx, tt.key, &model.AccessRequest{Method: endpoint, Explicit: tt.explicit})
+			if tt.code == codes.OK {
+				require.NoError(t, err)
+				require.Equal(t, user.Username, claims.Username)
+				require.Equal(t, user.Role, claims.Role)
+				return
+			}
+
+			require.Nil(t, claims)
+			commonErr := sys.GetCommonError(err)
+			require.NotNil(t, commonErr)
+			require.Equal(t, tt.code, commonErr.Code())
+		})
+	}
+}
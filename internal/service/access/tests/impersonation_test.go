@@ -0,0 +1,60 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"di_container/internal/model"
+	"di_container/internal/policy"
+	"di_container/internal/service/access"
+	serviceMocks "di_container/internal/service/mocks"
+	"di_container/internal/utils"
+)
+
+func TestBatchCheckImpersonated(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = context.Background()
+		mc     = minimock.NewController(t)
+		keySet = utils.NewHMACKeySet([]byte(gofakeit.Password(true, true, true, false, false, 32)))
+
+		username     = gofakeit.Username()
+		impersonator = gofakeit.Username()
+
+		createAPIKeyMethod = "/auth_v1.AuthV1/CreateAPIKey"
+		createNoteMethod   = "/note_v1.NoteV1/Create"
+	)
+	t.Cleanup(mc.Finish)
+
+	accessPolicy, err := policy.Load("")
+	require.NoError(t, err)
+
+	token, err := keySet.GenerateImpersonationToken(model.UserInfo{Username: username, Role: "user"}, impersonator, time.Minute)
+	require.NoError(t, err)
+
+	// Оба участника попадают в журнал по каждому решению
+	auditServiceMock := serviceMocks.NewAuditServiceMock(mc)
+	auditServiceMock.RecordMock.Set(func(_ context.Context, event *model.AuditEvent) error {
+		require.Equal(t, username, event.Actor)
+		require.Equal(t, impersonator, event.Impersonator)
+		return nil
+	})
+
+	service := access.NewService(keySet, accessPolicy, nil, nil, auditServiceMock, createAPIKeyMethod)
+
+	decisions, err := service.BatchCheck(ctx, token, []*model.AccessRequest{
+		{Method: createAPIKeyMethod, Explicit: true},
+		{Method: createNoteMethod, Explicit: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, decisions, 2)
+	require.False(t, decisions[0].Allowed)
+	require.Equal(t, model.AccessReasonDeniedByImpersonation, decisions[0].Reason)
+	require.True(t, decisions[1].Allowed)
+}
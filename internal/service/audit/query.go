@@ -0,0 +1,96 @@
+package audit
+
+import (
+	"context"
+	"di_container/internal/model"
+	"errors"
+)
+
+const (
+	defaultQueryLimit = 100
+	maxQueryLimit     = 1000
+
+	// exportBatchSize сколько событий читается из базы за раз при выгрузке и проверке журнала
+	exportBatchSize = 500
+)
+
+// errChainBroken останавливает чтение журнала при проверке, дальше первой испорченной записи проверять нечего
+var errChainBroken = errors.New("audit chain is broken")
+
+// Query возвращает страницу журнала. Читается на одно событие больше, чтобы понять, есть ли следующая страница
+func (s *serv) Query(ctx context.Context, filter *model.AuditFilter) (*model.AuditPage, error) {
+	limit := filter.Limit
+	if limit == 0 {
+		limit = defaultQueryLimit
+	}
+	if limit > maxQueryLimit {
+		limit = maxQueryLimit
+	}
+
+	f := *filter
+	f.Limit = limit + 1
+
+	events, err := s.auditEventRepository.List(ctx, &f)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &model.AuditPage{Events: events}
+	if uint64(len(events)) > limit {
+		page.Events = events[:limit]
+		page.NextAfterID = page.Events[limit-1].ID
+	}
+
+	return page, nil
+}
+
+// Export передает в send все события, подходящие под фильтр. Журнал читается порциями,
+// поэтому выгрузка не держит его целиком в памяти. Limit фильтра не учитывается
+func (s *serv) Export(ctx context.Context, filter *model.AuditFilter, send func(*model.AuditEvent) error) error {
+	f := *filter
+	f.Limit = exportBatchSize
+
+	for {
+		events, err := s.auditEventRepository.List(ctx, &f)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			err = send(event)
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(events) < exportBatchSize {
+			return nil
+		}
+
+		f.AfterID = events[len(events)-1].ID
+	}
+}
+
+// Verify пересчитывает цепочку хешей по всему журналу и останавливается на первой испорченной записи
+func (s *serv) Verify(ctx context.Context) (*model.AuditVerification, error) {
+	res := &model.AuditVerification{Valid: true}
+	prevHash := ""
+
+	err := s.Export(ctx, &model.AuditFilter{}, func(event *model.AuditEvent) error {
+		if event.PrevHash != prevHash || chainHash(prevHash, event) != event.Hash {
+			res.Valid = false
+			res.FirstInvalidID = event.ID
+			return errChainBroken
+		}
+
+		res.Checked++
+		prevHash = event.Hash
+
+		return nil
+	})
+	if err != nil && !errors.Is(err, errChainBroken) {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,77 @@
+package audit
+
+import (
+	"context"
+	"crypto/sha256"
+	"di_container/internal/model"
+	"di_container/internal/utils"
+	"encoding/hex"
+	"fmt"
+	"google.golang.org/grpc"
+	"strconv"
+	"time"
+)
+
+// Record дописывает событие в конец цепочки. Адрес клиента, метод, actor и impersonator берутся из контекста, если не заданы.
+// Внутри чужой транзакции событие фиксируется вместе с ней и откатывается, если она откатится
+func (s *serv) Record(ctx context.Context, event *model.AuditEvent) error {
+	if claims, ok := utils.ClaimsFromContext(ctx); ok {
+		if event.Actor == "" {
+			event.Actor = claims.Username
+		}
+		if event.Impersonator == "" {
+			event.Impersonator = claims.Impersonator
+		}
+	}
+
+	if event.Method == "" {
+		event.Method, _ = grpc.Method(ctx)
+	}
+
+	event.IP = utils.ClientInfoFromContext(ctx).IP
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.auditEventRepository.LockChain(ctx)
+		if errTx != nil {
+			return errTx
+		}
+
+		event.PrevHash, errTx = s.auditEventRepository.LastHash(ctx)
+		if errTx != nil {
+			return errTx
+		}
+
+		// Точность базы - микросекунды, иначе хеш прочитанного события не совпадет
+		event.CreatedAt = time.Now().UTC().Truncate(time.Microsecond)
+		event.Hash = chainHash(event.PrevHash, event)
+
+		event.ID, errTx = s.auditEventRepository.Create(ctx, event)
+		return errTx
+	})
+}
+
+// chainHash хеш события вместе с хешем предыдущего. Каждое поле пишется с длиной,
+// чтобы перенос символов между соседними полями менял хеш
+func chainHash(prevHash string, event *model.AuditEvent) string {
+	h := sha256.New()
+	for _, field := range []string{
+		prevHash,
+		strconv.FormatInt(event.CreatedAt.UnixMicro(), 10),
+		event.Type,
+		event.Actor,
+		event.Subject,
+		event.IP,
+		event.Method,
+		event.Outcome,
+		event.Reason,
+	} {
+		_, _ = fmt.Fprintf(h, "%d:%s;", len(field), field)
+	}
+
+	// Поле добавлено позже остальных. Пустое в хеш не входит, чтобы старые записи проверялись как раньше
+	if event.Impersonator != "" {
+		_, _ = fmt.Fprintf(h, "impersonator=%d:%s;", len(event.Impersonator), event.Impersonator)
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
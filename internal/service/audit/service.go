@@ -0,0 +1,19 @@
+package audit
+
+import (
+	"di_container/internal/client/db"
+	"di_container/internal/repository"
+	"di_container/internal/service"
+)
+
+type serv struct {
+	auditEventRepository repository.AuditEventRepository
+	txManager            db.TxManager
+}
+
+func NewService(auditEventRepository repository.AuditEventRepository, txManager db.TxManager) service.AuditService {
+	return &serv{
+		auditEventRepository: auditEventRepository,
+		txManager:            txManager,
+	}
+}
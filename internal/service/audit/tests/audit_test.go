@@ -0,0 +1,105 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"di_container/internal/client/db"
+	dbMocks "di_container/internal/client/db/mocks"
+	"di_container/internal/model"
+	repoMocks "di_container/internal/repository/mocks"
+	"di_container/internal/service/audit"
+)
+
+// newAuditRepositoryMock хранит журнал в памяти, как таблица audit_event
+func newAuditRepositoryMock(mc *minimock.Controller, events *[]*model.AuditEvent) *repoMocks.AuditEventRepositoryMock {
+	mock := repoMocks.NewAuditEventRepositoryMock(mc)
+	mock.LockChainMock.Return(nil)
+	mock.LastHashMock.Set(func(_ context.Context) (string, error) {
+		if len(*events) == 0 {
+			return "", nil
+		}
+		return (*events)[len(*events)-1].Hash, nil
+	})
+	mock.CreateMock.Set(func(_ context.Context, event *model.AuditEvent) (int64, error) {
+		stored := *event
+		stored.ID = int64(len(*events) + 1)
+		*events = append(*events, &stored)
+		return stored.ID, nil
+	})
+	mock.ListMock.Optional().Set(func(_ context.Context, filter *model.AuditFilter) ([]*model.AuditEvent, error) {
+		var res []*model.AuditEvent
+		for _, e := range *events {
+			if e.ID <= filter.AfterID || (filter.Actor != "" && e.Actor != filter.Actor) {
+				continue
+			}
+			if filter.Limit > 0 && uint64(len(res)) == filter.Limit {
+				break
+			}
+			res = append(res, e)
+		}
+		return res, nil
+	})
+
+	return mock
+}
+
+func TestAuditChain(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		actor  = gofakeit.Username()
+		events []*model.AuditEvent
+	)
+	t.Cleanup(mc.Finish)
+
+	txManagerMock := dbMocks.NewTxManagerMock(mc)
+	txManagerMock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) error {
+		return f(ctx)
+	})
+
+	service := audit.NewService(newAuditRepositoryMock(mc, &events), txManagerMock)
+
+	for i := 0; i < 3; i++ {
+		err := service.Record(ctx, &model.AuditEvent{
+			Type:    model.AuditEventLogin,
+			Actor:   actor,
+			Outcome: model.AuditOutcomeSuccess,
+			Reason:  "password",
+		})
+		require.NoError(t, err)
+	}
+
+	require.Len(t, events, 3)
+	require.Empty(t, events[0].PrevHash)
+	require.Equal(t, events[0].Hash, events[1].PrevHash)
+	require.Equal(t, events[1].Hash, events[2].PrevHash)
+
+	res, err := service.Verify(ctx)
+	require.NoError(t, err)
+	require.Equal(t, &model.AuditVerification{Valid: true, Checked: 3}, res)
+
+	page, err := service.Query(ctx, &model.AuditFilter{Actor: actor, Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, page.Events, 2)
+	require.Equal(t, events[1].ID, page.NextAfterID)
+
+	page, err = service.Query(ctx, &model.AuditFilter{Actor: actor, Limit: 2, AfterID: page.NextAfterID})
+	require.NoError(t, err)
+	require.Len(t, page.Events, 1)
+	require.Zero(t, page.NextAfterID)
+
+	// Подмена исхода в середине журнала должна обнаруживаться
+	events[1].Outcome = model.AuditOutcomeFailure
+
+	res, err = service.Verify(ctx)
+	require.NoError(t, err)
+	require.Equal(t, &model.AuditVerification{Valid: false, Checked: 1, FirstInvalidID: events[1].ID}, res)
+}
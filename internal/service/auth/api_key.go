@@ -0,0 +1,90 @@
+package auth
+
+import (
+	"context"
+	"di_container/internal/model"
+	"di_container/internal/repository"
+	"di_container/internal/sys"
+	"di_container/internal/utils"
+	"errors"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"time"
+)
+
+var (
+	errAPIKeyNotFound     = sys.NewCommonError("api key not found", codes.NotFound)
+	errAPIKeyNameRequired = sys.NewCommonError("api key name is required", codes.InvalidArgument)
+	errAPIKeyNoScopes     = sys.NewCommonError("api key must have at least one scope", codes.InvalidArgument)
+	errAPIKeyExpired      = sys.NewCommonError("api key expiration must be in the future", codes.InvalidArgument)
+)
+
+// CreateAPIKey выпускает ключ для actor. Ключ возвращается один раз, в базе остается только его хеш
+func (s *serv) CreateAPIKey(ctx context.Context, actor *model.UserClaims, info *model.APIKeyInfo) (*model.APIKey, string, error) {
+	if info.Name == "" {
+		return nil, "", errAPIKeyNameRequired
+	}
+
+	if len(info.Scopes) == 0 {
+		return nil, "", errAPIKeyNoScopes
+	}
+
+	if info.ExpiresAt.Valid && !info.ExpiresAt.Time.After(time.Now()) {
+		return nil, "", errAPIKeyExpired
+	}
+
+	key, prefix, err := utils.GenerateAPIKey()
+	if err != nil {
+		return nil, "", err
+	}
+
+	apiKey := &model.APIKey{
+		Username:  actor.Username,
+		Info:      *info,
+		Prefix:    prefix,
+		Hash:      utils.HashSecret(key),
+		CreatedAt: time.Now(),
+	}
+
+	apiKey.ID, err = s.apiKeyRepository.Create(ctx, apiKey)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return apiKey, key, nil
+}
+
+func (s *serv) ListAPIKeys(ctx context.Context, actor *model.UserClaims) ([]*model.APIKey, error) {
+	return s.apiKeyRepository.ListActive(ctx, actor.Username)
+}
+
+// RevokeAPIKey отзывает ключ. Отозвать можно свой ключ, админ может отозвать любой
+func (s *serv) RevokeAPIKey(ctx context.Context, actor *model.UserClaims, id int64) error {
+	key, err := s.apiKeyRepository.Get(ctx, id)
+	if errors.Is(err, repository.ErrNotFound) {
+		return errAPIKeyNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	// Не раскрываем существование чужих ключей
+	if !canManageSessions(actor, key.Username) {
+		return errAPIKeyNotFound
+	}
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.apiKeyRepository.Revoke(ctx, id)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.auditService.Record(ctx, &model.AuditEvent{
+			Type:    model.AuditEventTokenRevoke,
+			Actor:   actor.Username,
+			Subject: fmt.Sprintf("api_key:%d", id),
+			Outcome: model.AuditOutcomeSuccess,
+			Reason:  "revoke_api_key",
+		})
+	})
+}
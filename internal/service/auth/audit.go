@@ -0,0 +1,31 @@
+package auth
+
+import (
+	"context"
+	"di_container/internal/logger"
+	"di_container/internal/model"
+	"go.uber.org/zap"
+)
+
+// auditFailure записывает в журнал аудита неудачную операцию. Ошибка записи только логируется,
+// чтобы вызывающий получил ошибку самой операции
+func (s *serv) auditFailure(ctx context.Context, event *model.AuditEvent) {
+	event.Outcome = model.AuditOutcomeFailure
+
+	err := s.auditService.Record(ctx, event)
+	if err != nil {
+		logger.FromContext(ctx).Error("failed to record audit event",
+			zap.String("type", event.Type),
+			zap.String("actor", event.Actor),
+			zap.Error(err),
+		)
+	}
+}
+
+func userSubject(username string) string {
+	return "user:" + username
+}
+
+func sessionSubject(sessionID string) string {
+	return "session:" + sessionID
+}
@@ -0,0 +1,54 @@
+package auth
+
+import (
+	"context"
+	"di_container/internal/client/mail"
+	"di_container/internal/model"
+	"di_container/internal/sys"
+	"fmt"
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	errEmailNotSet          = sys.NewCommonError("user has no email", codes.FailedPrecondition)
+	errEmailAlreadyVerified = sys.NewCommonError("email is already verified", codes.FailedPrecondition)
+)
+
+// RequestEmailVerification отправляет текущему пользователю письмо с токеном подтверждения почты
+func (s *serv) RequestEmailVerification(ctx context.Context, actor *model.UserClaims) error {
+	user, err := s.userRepository.GetByUsername(ctx, actor.Username)
+	if err != nil {
+		return err
+	}
+
+	if !user.Email.Valid {
+		return errEmailNotSet
+	}
+
+	if user.EmailVerified {
+		return errEmailAlreadyVerified
+	}
+
+	token, err := s.issueUserToken(ctx, user.ID, model.UserTokenPurposeEmailVerification, s.userTokenConfig.EmailVerificationExpiration())
+	if err != nil {
+		return err
+	}
+
+	return s.mailer.Send(ctx, &mail.Message{
+		To:      user.Email.String,
+		Subject: "Email verification",
+		Body: fmt.Sprintf("Use this token to verify your email: %s\n\nThe token expires in %s.",
+			token, s.userTokenConfig.EmailVerificationExpiration()),
+	})
+}
+
+func (s *serv) VerifyEmail(ctx context.Context, token string) error {
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		user, errTx := s.useUserToken(ctx, token, model.UserTokenPurposeEmailVerification)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.userRepository.SetEmailVerified(ctx, user.ID)
+	})
+}
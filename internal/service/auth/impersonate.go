@@ -0,0 +1,80 @@
+package auth
+
+import (
+	"context"
+	"di_container/internal/logger"
+	"di_container/internal/model"
+	"di_container/internal/repository"
+	"di_container/internal/sys"
+	"di_container/internal/tenant"
+	"errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	errImpersonateSelf   = sys.NewCommonError("cannot impersonate yourself", codes.InvalidArgument)
+	errImpersonateNested = sys.NewCommonError("impersonated tokens cannot start another impersonation", codes.PermissionDenied)
+	errImpersonateAdmin  = sys.NewCommonError("admins cannot be impersonated", codes.PermissionDenied)
+)
+
+// Impersonate выдает сотруднику поддержки короткоживущий access токен пользователя username.
+// Refresh токен не выдается, сессия пользователя не создается. Кто может вызывать метод, решает политика доступа
+func (s *serv) Impersonate(ctx context.Context, actor *model.UserClaims, username string) (string, error) {
+	if actor.Impersonator != "" {
+		return "", errImpersonateNested
+	}
+
+	if actor.Username == username {
+		return "", errImpersonateSelf
+	}
+
+	user, err := s.userRepository.GetByUsername(ctx, username)
+	if errors.Is(err, repository.ErrNotFound) {
+		return "", errUserNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+
+	// Под админом можно было бы выдать себе его права
+	if user.Role == model.RoleAdmin {
+		s.auditFailure(ctx, &model.AuditEvent{
+			Type:    model.AuditEventImpersonation,
+			Actor:   actor.Username,
+			Subject: userSubject(username),
+			Reason:  "target_is_admin",
+		})
+
+		return "", errImpersonateAdmin
+	}
+
+	accessToken, err := s.accessKeySet.GenerateImpersonationToken(model.UserInfo{
+		Username: user.Username,
+		Role:     user.Role,
+		Tenant:   tenant.FromContext(ctx),
+	},
+		actor.Username,
+		s.impersonationConfig.TokenExpiration(),
+	)
+	if err != nil {
+		return "", err
+	}
+
+	err = s.auditService.Record(ctx, &model.AuditEvent{
+		Type:    model.AuditEventImpersonation,
+		Actor:   actor.Username,
+		Subject: userSubject(username),
+		Outcome: model.AuditOutcomeSuccess,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	logger.FromContext(ctx).Info("impersonation started",
+		zap.String("impersonated_username", user.Username),
+		zap.Duration("expiration", s.impersonationConfig.TokenExpiration()),
+	)
+
+	return accessToken, nil
+}
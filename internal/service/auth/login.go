@@ -0,0 +1,154 @@
+package auth
+
+import (
+	"context"
+	"di_container/internal/model"
+	"di_container/internal/repository"
+	"di_container/internal/sys"
+	"di_container/internal/tenant"
+	"di_container/internal/utils"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"time"
+)
+
+var errInvalidCredentials = sys.NewCommonError("invalid username or password", codes.Unauthenticated)
+
+func (s *serv) Login(ctx context.Context, username string, password string) (*model.LoginResult, error) {
+	client := utils.ClientInfoFromContext(ctx)
+
+	err := s.checkLoginLock(ctx, username, client.IP)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepository.GetByUsername(ctx, username)
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return nil, err
+	}
+
+	passwordHash := utils.DummyPasswordHash
+	if user != nil {
+		passwordHash = user.PasswordHash
+	}
+
+	if !utils.VerifyPassword(passwordHash, password) || user == nil {
+		s.auditFailure(ctx, &model.AuditEvent{
+			Type:    model.AuditEventLogin,
+			Actor:   username,
+			Subject: userSubject(username),
+			Reason:  "invalid_credentials",
+		})
+
+		err = s.registerLoginFailure(ctx, username, client.IP)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, errInvalidCredentials
+	}
+
+	// Счетчик неудачных попыток сбрасывается только после второго фактора,
+	// иначе подбор кода можно было бы чередовать со входом по паролю
+	if user.TOTP.Enabled {
+		challengeToken, errChallenge := utils.GenerateMFAChallengeToken(model.UserInfo{
+			Username: user.Username,
+			Role:     user.Role,
+			Tenant:   tenant.FromContext(ctx),
+		},
+			[]byte(s.config.RefreshTokenSecretKey),
+			s.mfaConfig.ChallengeExpiration(),
+		)
+		if errChallenge != nil {
+			return nil, errChallenge
+		}
+
+		return &model.LoginResult{MFAChallengeToken: challengeToken}, nil
+	}
+
+	err = s.loginAttemptRepository.Reset(ctx, userAttemptKeyPrefix+username)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := s.startSession(ctx, user, client, "password")
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.LoginResult{RefreshToken: refreshToken}, nil
+}
+
+// startSession создает сессию пользователя и выдает первый refresh токен ее семейства.
+// method - чем подтвержден вход, он попадает в журнал аудита
+func (s *serv) startSession(ctx context.Context, user *model.User, client model.ClientInfo, method string) (string, error) {
+	sessionID, err := utils.GenerateTokenID()
+	if err != nil {
+		return "", err
+	}
+
+	var refreshToken string
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.sessionRepository.Create(ctx, &model.Session{
+			ID:        sessionID,
+			Username:  user.Username,
+			Client:    client,
+			ExpiresAt: time.Now().Add(s.config.RefreshTokenExpiration),
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		refreshToken, errTx = s.issueRefreshToken(ctx, model.UserInfo{
+			Username: user.Username,
+			Role:     user.Role,
+			Tenant:   tenant.FromContext(ctx),
+		}, sessionID)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.auditService.Record(ctx, &model.AuditEvent{
+			Type:    model.AuditEventLogin,
+			Actor:   user.Username,
+			Subject: sessionSubject(sessionID),
+			Outcome: model.AuditOutcomeSuccess,
+			Reason:  method,
+		})
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return refreshToken, nil
+}
+
+// issueRefreshToken выпускает новый refresh токен в семействе familyID и сохраняет его.
+// Семейство токенов соответствует сессии, поэтому familyID совпадает с идентификатором сессии
+func (s *serv) issueRefreshToken(ctx context.Context, info model.UserInfo, familyID string) (string, error) {
+	tokenID, err := utils.GenerateTokenID()
+	if err != nil {
+		return "", err
+	}
+
+	refreshToken, err := utils.GenerateRefreshToken(info,
+		tokenID,
+		[]byte(s.config.RefreshTokenSecretKey),
+		s.config.RefreshTokenExpiration,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	err = s.refreshTokenRepository.Create(ctx, &model.RefreshToken{
+		ID:        tokenID,
+		FamilyID:  familyID,
+		Username:  info.Username,
+		ExpiresAt: time.Now().Add(s.config.RefreshTokenExpiration),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return refreshToken, nil
+}
@@ -0,0 +1,101 @@
+package auth
+
+import (
+	"context"
+	"di_container/internal/model"
+	"di_container/internal/sys"
+	"google.golang.org/grpc/codes"
+	"time"
+)
+
+const (
+	userAttemptKeyPrefix = "user:"
+	ipAttemptKeyPrefix   = "ip:"
+)
+
+func loginLockedError(lockedUntil time.Time) error {
+	return sys.NewRetryableError("too many failed login attempts", codes.ResourceExhausted, time.Until(lockedUntil)).
+		WithReason("LOGIN_LOCKED")
+}
+
+// checkLoginLock возвращает ошибку, если вход заблокирован для пользователя или адреса клиента.
+// clientIP - адрес соединения или, за доверенным прокси, адрес из x-forwarded-for. Адрес, присланный самим
+// клиентом, не учитывается, иначе его подменой можно было бы обойти блокировку или заблокировать чужой адрес
+func (s *serv) checkLoginLock(ctx context.Context, username string, clientIP string) error {
+	lockedUntil, err := s.loginAttemptRepository.GetLockedUntil(ctx, userAttemptKeyPrefix+username, ipAttemptKeyPrefix+clientIP)
+	if err != nil {
+		return err
+	}
+
+	if lockedUntil.After(time.Now()) {
+		s.auditFailure(ctx, &model.AuditEvent{
+			Type:    model.AuditEventLogin,
+			Actor:   username,
+			Subject: userSubject(username),
+			Reason:  "locked",
+		})
+
+		return loginLockedError(lockedUntil)
+	}
+
+	return nil
+}
+
+// registerLoginFailure учитывает неудачную попытку входа и, если порог превышен,
+// блокирует вход с экспоненциально растущей длительностью
+func (s *serv) registerLoginFailure(ctx context.Context, username string, clientIP string) error {
+	var lockedUntil time.Time
+
+	attempts := []struct {
+		key         string
+		maxFailures int64
+	}{
+		{key: userAttemptKeyPrefix + username, maxFailures: s.loginConfig.UserMaxFailures()},
+		{key: ipAttemptKeyPrefix + clientIP, maxFailures: s.loginConfig.IPMaxFailures()},
+	}
+
+	for _, a := range attempts {
+		failures, err := s.loginAttemptRepository.RegisterFailure(ctx, a.key, s.loginConfig.FailureWindow())
+		if err != nil {
+			return err
+		}
+
+		lockout := s.lockoutDuration(failures, a.maxFailures)
+		if lockout == 0 {
+			continue
+		}
+
+		until := time.Now().Add(lockout)
+		err = s.loginAttemptRepository.Lock(ctx, a.key, until)
+		if err != nil {
+			return err
+		}
+
+		if until.After(lockedUntil) {
+			lockedUntil = until
+		}
+	}
+
+	if !lockedUntil.IsZero() {
+		return loginLockedError(lockedUntil)
+	}
+
+	return nil
+}
+
+func (s *serv) lockoutDuration(failures int64, maxFailures int64) time.Duration {
+	if failures < maxFailures {
+		return 0
+	}
+
+	lockout := s.loginConfig.LockoutBase()
+	for i := maxFailures; i < failures && lockout < s.loginConfig.LockoutMax(); i++ {
+		lockout *= 2
+	}
+
+	if lockout > s.loginConfig.LockoutMax() {
+		lockout = s.loginConfig.LockoutMax()
+	}
+
+	return lockout
+}
@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"context"
+	"di_container/internal/model"
+	"di_container/internal/repository"
+	"di_container/internal/tenant"
+	"di_container/internal/utils"
+	"errors"
+)
+
+func (s *serv) Logout(ctx context.Context, refreshToken string) error {
+	claims, err := utils.VerifyToken(refreshToken, []byte(s.config.RefreshTokenSecretKey))
+	if err != nil {
+		return errInvalidRefreshToken
+	}
+
+	ctx = tenant.WithTenant(ctx, claims.TenantID())
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		token, errTx := s.refreshTokenRepository.Get(ctx, claims.Id)
+		if errors.Is(errTx, repository.ErrNotFound) {
+			return errInvalidRefreshToken
+		}
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.revokeSession(ctx, token.FamilyID)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.auditService.Record(ctx, &model.AuditEvent{
+			Type:    model.AuditEventTokenRevoke,
+			Actor:   token.Username,
+			Subject: sessionSubject(token.FamilyID),
+			Outcome: model.AuditOutcomeSuccess,
+			Reason:  "logout",
+		})
+	})
+}
+
+func (s *serv) RevokeAllSessions(ctx context.Context, username string) error {
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.refreshTokenRepository.RevokeByUsername(ctx, username)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.sessionRepository.RevokeByUsername(ctx, username)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.auditService.Record(ctx, &model.AuditEvent{
+			Type:    model.AuditEventTokenRevoke,
+			Subject: userSubject(username),
+			Outcome: model.AuditOutcomeSuccess,
+			Reason:  "revoke_all_sessions",
+		})
+	})
+}
+
+// revokeSession отзывает сессию вместе со всем семейством ее refresh токенов
+func (s *serv) revokeSession(ctx context.Context, sessionID string) error {
+	err := s.refreshTokenRepository.RevokeFamily(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+
+	return s.sessionRepository.Revoke(ctx, sessionID)
+}
@@ -0,0 +1,182 @@
+package auth
+
+import (
+	"context"
+	"di_container/internal/model"
+	"di_container/internal/repository"
+	"di_container/internal/sys"
+	"di_container/internal/tenant"
+	"di_container/internal/utils"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"time"
+)
+
+const recoveryCodesCount = 10
+
+var (
+	errInvalidMFAChallenge = sys.NewCommonError("invalid mfa challenge token", codes.Unauthenticated)
+	errInvalidMFACode      = sys.NewCommonError("invalid mfa code", codes.Unauthenticated)
+	errTOTPAlreadyEnabled  = sys.NewCommonError("totp is already enabled", codes.FailedPrecondition)
+	errTOTPNotEnrolled     = sys.NewCommonError("totp enrollment is not started", codes.FailedPrecondition)
+)
+
+// EnrollTOTP генерирует новый TOTP секрет и коды восстановления. Второй фактор начинает
+// действовать только после ConfirmTOTP, чтобы пользователь не потерял доступ из-за неотсканированного кода
+func (s *serv) EnrollTOTP(ctx context.Context, actor *model.UserClaims) (*model.TOTPEnrollment, error) {
+	user, err := s.userRepository.GetByUsername(ctx, actor.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.TOTP.Enabled {
+		return nil, errTOTPAlreadyEnabled
+	}
+
+	secret, err := utils.GenerateTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	encryptedSecret, err := utils.Encrypt(s.mfaConfig.EncryptionKey(), secret)
+	if err != nil {
+		return nil, err
+	}
+
+	recoveryCodes := make([]string, 0, recoveryCodesCount)
+	recoveryCodeHashes := make([]string, 0, recoveryCodesCount)
+	for i := 0; i < recoveryCodesCount; i++ {
+		code, errCode := utils.GenerateTokenID()
+		if errCode != nil {
+			return nil, errCode
+		}
+
+		code = code[:10]
+		recoveryCodes = append(recoveryCodes, code)
+		recoveryCodeHashes = append(recoveryCodeHashes, utils.HashSecret(code))
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.userRepository.SetTOTPSecret(ctx, user.ID, encryptedSecret)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.recoveryCodeRepository.Replace(ctx, user.ID, recoveryCodeHashes)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.TOTPEnrollment{
+		URI:           utils.TOTPURI(s.mfaConfig.Issuer(), user.Username, secret),
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+func (s *serv) ConfirmTOTP(ctx context.Context, actor *model.UserClaims, code string) error {
+	user, err := s.userRepository.GetByUsername(ctx, actor.Username)
+	if err != nil {
+		return err
+	}
+
+	if user.TOTP.Enabled {
+		return errTOTPAlreadyEnabled
+	}
+
+	if !user.TOTP.EncryptedSecret.Valid {
+		return errTOTPNotEnrolled
+	}
+
+	ok, err := s.verifyTOTP(ctx, user, code)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errInvalidMFACode
+	}
+
+	return s.userRepository.EnableTOTP(ctx, user.ID)
+}
+
+// VerifyMFA завершает вход: обменивает challenge токен и TOTP код (или код восстановления) на refresh токен.
+// Неверные коды учитываются так же, как неверные пароли, иначе шестизначный код легко подобрать
+func (s *serv) VerifyMFA(ctx context.Context, challengeToken string, code string) (string, error) {
+	claims, err := utils.VerifyToken(challengeToken, []byte(s.config.RefreshTokenSecretKey))
+	if err != nil || claims.Purpose != model.PurposeMFAChallenge {
+		s.auditFailure(ctx, &model.AuditEvent{
+			Type:   model.AuditEventLogin,
+			Reason: "invalid_mfa_challenge",
+		})
+
+		return "", errInvalidMFAChallenge
+	}
+
+	ctx = tenant.WithTenant(ctx, claims.TenantID())
+	client := utils.ClientInfoFromContext(ctx)
+
+	err = s.checkLoginLock(ctx, claims.Username, client.IP)
+	if err != nil {
+		return "", err
+	}
+
+	user, err := s.userRepository.GetByUsername(ctx, claims.Username)
+	if errors.Is(err, repository.ErrNotFound) {
+		return "", errInvalidMFAChallenge
+	}
+	if err != nil {
+		return "", err
+	}
+
+	ok, err := s.verifyTOTP(ctx, user, code)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		ok, err = s.recoveryCodeRepository.Use(ctx, user.ID, utils.HashSecret(code))
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if !ok {
+		s.auditFailure(ctx, &model.AuditEvent{
+			Type:    model.AuditEventLogin,
+			Actor:   user.Username,
+			Subject: userSubject(user.Username),
+			Reason:  "invalid_mfa_code",
+		})
+
+		err = s.registerLoginFailure(ctx, user.Username, client.IP)
+		if err != nil {
+			return "", err
+		}
+
+		return "", errInvalidMFACode
+	}
+
+	err = s.loginAttemptRepository.Reset(ctx, userAttemptKeyPrefix+user.Username)
+	if err != nil {
+		return "", err
+	}
+
+	return s.startSession(ctx, user, client, "mfa")
+}
+
+func (s *serv) verifyTOTP(ctx context.Context, user *model.User, code string) (bool, error) {
+	if !user.TOTP.EncryptedSecret.Valid {
+		return false, nil
+	}
+
+	secret, err := utils.Decrypt(s.mfaConfig.EncryptionKey(), user.TOTP.EncryptedSecret.String)
+	if err != nil {
+		return false, err
+	}
+
+	step, ok := utils.ValidateTOTP(secret, code, time.Now())
+	if !ok {
+		return false, nil
+	}
+
+	return s.userRepository.UseTOTPStep(ctx, user.ID, step)
+}
@@ -0,0 +1,97 @@
+package auth
+
+import (
+	"context"
+	"di_container/internal/client/mail"
+	"di_container/internal/logger"
+	"di_container/internal/model"
+	"di_container/internal/repository"
+	"di_container/internal/sys"
+	"di_container/internal/utils"
+	"errors"
+	"fmt"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+const minPasswordLength = 8
+
+var errPasswordTooShort = sys.NewCommonError(fmt.Sprintf("password must be at least %d characters long", minPasswordLength), codes.InvalidArgument)
+
+// RequestPasswordReset отправляет на почту токен сброса пароля. Ответ не зависит от того,
+// есть ли пользователь с такой почтой, чтобы по нему нельзя было перебирать адреса
+func (s *serv) RequestPasswordReset(ctx context.Context, email string) error {
+	user, err := s.userRepository.GetByEmail(ctx, email)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	token, err := s.issueUserToken(ctx, user.ID, model.UserTokenPurposePasswordReset, s.userTokenConfig.PasswordResetExpiration())
+	if err != nil {
+		return err
+	}
+
+	err = s.mailer.Send(ctx, &mail.Message{
+		To:      email,
+		Subject: "Password reset",
+		Body: fmt.Sprintf("Use this token to reset the password of %s: %s\n\nThe token expires in %s. If you did not request a reset, ignore this email.",
+			user.Username, token, s.userTokenConfig.PasswordResetExpiration()),
+	})
+	if err != nil {
+		// Ошибка отправки тоже не должна выдавать существование адреса
+		logger.FromContext(ctx).Error("failed to send password reset email", zap.Int64("user_id", user.ID), zap.Error(err))
+	}
+
+	return nil
+}
+
+// ResetPassword меняет пароль по токену из письма. Все сессии пользователя завершаются,
+// а блокировка входа снимается, чтобы заблокированный пользователь мог сразу войти
+func (s *serv) ResetPassword(ctx context.Context, token string, password string) error {
+	if len(password) < minPasswordLength {
+		return errPasswordTooShort
+	}
+
+	passwordHash, err := utils.HashPassword(password)
+	if err != nil {
+		return err
+	}
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		user, errTx := s.useUserToken(ctx, token, model.UserTokenPurposePasswordReset)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.userRepository.UpdatePassword(ctx, user.ID, passwordHash)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.refreshTokenRepository.RevokeByUsername(ctx, user.Username)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.sessionRepository.RevokeByUsername(ctx, user.Username)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.loginAttemptRepository.Reset(ctx, userAttemptKeyPrefix+user.Username)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.auditService.Record(ctx, &model.AuditEvent{
+			Type:    model.AuditEventTokenRevoke,
+			Actor:   user.Username,
+			Subject: userSubject(user.Username),
+			Outcome: model.AuditOutcomeSuccess,
+			Reason:  "password_reset",
+		})
+	})
+}
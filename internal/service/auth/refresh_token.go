@@ -0,0 +1,160 @@
+package auth
+
+import (
+	"context"
+	"di_container/internal/logger"
+	"di_container/internal/model"
+	"di_container/internal/repository"
+	"di_container/internal/tenant"
+	"di_container/internal/utils"
+	"errors"
+	"go.uber.org/zap"
+	"time"
+)
+
+func (s *serv) GetRefreshToken(ctx context.Context, refreshToken string) (string, error) {
+	claims, err := utils.VerifyToken(refreshToken, []byte(s.config.RefreshTokenSecretKey))
+	if err != nil {
+		s.auditRefreshFailure(ctx, "", "refresh_token")
+		return "", errInvalidRefreshToken
+	}
+
+	ctx = tenant.WithTenant(ctx, claims.TenantID())
+
+	var newRefreshToken string
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		token, errTx := s.useRefreshToken(ctx, claims)
+		if errTx != nil || token == nil {
+			return errTx
+		}
+
+		errTx = s.refreshTokenRepository.MarkRotated(ctx, token.ID)
+		if errTx != nil {
+			return errTx
+		}
+
+		newRefreshToken, errTx = s.issueRefreshToken(ctx, model.UserInfo{
+			Username: claims.Username,
+			Role:     claims.Role,
+			Tenant:   claims.TenantID(),
+		}, token.FamilyID)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.sessionRepository.Touch(ctx, token.FamilyID, time.Now().Add(s.config.RefreshTokenExpiration))
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.auditService.Record(ctx, &model.AuditEvent{
+			Type:    model.AuditEventTokenRefresh,
+			Actor:   claims.Username,
+			Subject: sessionSubject(token.FamilyID),
+			Outcome: model.AuditOutcomeSuccess,
+			Reason:  "refresh_token",
+		})
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if newRefreshToken == "" {
+		s.auditRefreshFailure(ctx, claims.Username, "refresh_token")
+		return "", errInvalidRefreshToken
+	}
+
+	return newRefreshToken, nil
+}
+
+func (s *serv) GetAccessToken(ctx context.Context, refreshToken string) (string, error) {
+	claims, err := utils.VerifyToken(refreshToken, []byte(s.config.RefreshTokenSecretKey))
+	if err != nil {
+		s.auditRefreshFailure(ctx, "", "access_token")
+		return "", errInvalidRefreshToken
+	}
+
+	// Refresh токен выпущен для конкретного арендатора и работает независимо от заголовка x-tenant-id
+	ctx = tenant.WithTenant(ctx, claims.TenantID())
+
+	var token *model.RefreshToken
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		token, errTx = s.useRefreshToken(ctx, claims)
+		if errTx != nil || token == nil {
+			return errTx
+		}
+
+		return s.auditService.Record(ctx, &model.AuditEvent{
+			Type:    model.AuditEventTokenRefresh,
+			Actor:   claims.Username,
+			Subject: sessionSubject(token.FamilyID),
+			Outcome: model.AuditOutcomeSuccess,
+			Reason:  "access_token",
+		})
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if token == nil {
+		s.auditRefreshFailure(ctx, claims.Username, "access_token")
+		return "", errInvalidRefreshToken
+	}
+
+	return s.accessKeySet.GenerateToken(model.UserInfo{
+		Username: claims.Username,
+		Role:     claims.Role,
+		Tenant:   claims.TenantID(),
+	},
+		s.config.AccessTokenExpiration,
+	)
+}
+
+// useRefreshToken проверяет, что токен можно использовать, и возвращает nil, если нельзя.
+// Предъявление уже ротированного токена означает, что он утек, поэтому все семейство отзывается.
+// Ошибку в этом случае не возвращаем, иначе отзыв откатится вместе с транзакцией.
+func (s *serv) useRefreshToken(ctx context.Context, claims *model.UserClaims) (*model.RefreshToken, error) {
+	token, err := s.refreshTokenRepository.Get(ctx, claims.Id)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if token.RevokedAt.Valid {
+		return nil, nil
+	}
+
+	if token.RotatedAt.Valid {
+		logger.FromContext(ctx).Warn("refresh token reuse detected, revoking token family",
+			zap.String("username", token.Username),
+			zap.String("family_id", token.FamilyID),
+		)
+
+		err = s.revokeSession(ctx, token.FamilyID)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, s.auditService.Record(ctx, &model.AuditEvent{
+			Type:    model.AuditEventTokenRevoke,
+			Actor:   token.Username,
+			Subject: sessionSubject(token.FamilyID),
+			Outcome: model.AuditOutcomeSuccess,
+			Reason:  "reuse_detected",
+		})
+	}
+
+	return token, nil
+}
+
+// auditRefreshFailure записывает отказ в обмене refresh токена. username пустой, если токен не удалось разобрать
+func (s *serv) auditRefreshFailure(ctx context.Context, username string, reason string) {
+	s.auditFailure(ctx, &model.AuditEvent{
+		Type:   model.AuditEventTokenRefresh,
+		Actor:  username,
+		Reason: reason,
+	})
+}
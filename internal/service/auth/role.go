@@ -0,0 +1,72 @@
+package auth
+
+import (
+	"context"
+	"di_container/internal/model"
+	"di_container/internal/repository"
+	"di_container/internal/sys"
+	"errors"
+	"fmt"
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	errRoleForbidden    = sys.NewCommonError("only admins can change roles", codes.PermissionDenied)
+	errRoleRequired     = sys.NewCommonError("role is required", codes.InvalidArgument)
+	errOwnRoleForbidden = sys.NewCommonError("admins cannot change their own role", codes.FailedPrecondition)
+	errUserNotFound     = sys.NewCommonError("user not found", codes.NotFound)
+)
+
+// SetUserRole меняет роль пользователя. Refresh токены несут роль в claims, поэтому все сессии
+// пользователя отзываются, и новая роль действует со следующего входа
+func (s *serv) SetUserRole(ctx context.Context, actor *model.UserClaims, username string, role string) error {
+	if actor.Role != model.RoleAdmin {
+		return errRoleForbidden
+	}
+
+	if role == "" {
+		return errRoleRequired
+	}
+
+	// Иначе последний админ может случайно лишить себя прав
+	if actor.Username == username {
+		return errOwnRoleForbidden
+	}
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		user, errTx := s.userRepository.GetByUsername(ctx, username)
+		if errors.Is(errTx, repository.ErrNotFound) {
+			return errUserNotFound
+		}
+		if errTx != nil {
+			return errTx
+		}
+
+		if user.Role == role {
+			return nil
+		}
+
+		errTx = s.userRepository.UpdateRole(ctx, user.ID, role)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.refreshTokenRepository.RevokeByUsername(ctx, username)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.sessionRepository.RevokeByUsername(ctx, username)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.auditService.Record(ctx, &model.AuditEvent{
+			Type:    model.AuditEventPermissionChange,
+			Actor:   actor.Username,
+			Subject: userSubject(username),
+			Outcome: model.AuditOutcomeSuccess,
+			Reason:  fmt.Sprintf("role %q -> %q", user.Role, role),
+		})
+	})
+}
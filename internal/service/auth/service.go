@@ -0,0 +1,115 @@
+package auth
+
+import (
+	"di_container/internal/client/db"
+	"di_container/internal/client/mail"
+	"di_container/internal/config"
+	"di_container/internal/config/env"
+	"di_container/internal/repository"
+	"di_container/internal/service"
+	"di_container/internal/sys"
+	"di_container/internal/utils"
+	"google.golang.org/grpc/codes"
+)
+
+var errInvalidRefreshToken = sys.NewCommonError("invalid refresh token", codes.Aborted)
+
+type serv struct {
+	config                 *env.TokenConfigData
+	loginConfig            config.LoginConfig
+	mfaConfig              config.MFAConfig
+	userTokenConfig        config.UserTokenConfig
+	impersonationConfig    config.ImpersonationConfig
+	accessKeySet           *utils.KeySet
+	userRepository         repository.UserRepository
+	refreshTokenRepository repository.RefreshTokenRepository
+	sessionRepository      repository.SessionRepository
+	loginAttemptRepository repository.LoginAttemptRepository
+	recoveryCodeRepository repository.RecoveryCodeRepository
+	userTokenRepository    repository.UserTokenRepository
+	apiKeyRepository       repository.APIKeyRepository
+	txManager              db.TxManager
+	mailer                 mail.Mailer
+	auditService           service.AuditService
+}
+
+func NewService(
+	config *env.TokenConfigData,
+	loginConfig config.LoginConfig,
+	mfaConfig config.MFAConfig,
+	userTokenConfig config.UserTokenConfig,
+	impersonationConfig config.ImpersonationConfig,
+	accessKeySet *utils.KeySet,
+	userRepository repository.UserRepository,
+	refreshTokenRepository repository.RefreshTokenRepository,
+	sessionRepository repository.SessionRepository,
+	loginAttemptRepository repository.LoginAttemptRepository,
+	recoveryCodeRepository repository.RecoveryCodeRepository,
+	userTokenRepository repository.UserTokenRepository,
+	apiKeyRepository repository.APIKeyRepository,
+	txManager db.TxManager,
+	mailer mail.Mailer,
+	auditService service.AuditService,
+) service.AuthService {
+	return &serv{
+		config:                 config,
+		loginConfig:            loginConfig,
+		mfaConfig:              mfaConfig,
+		userTokenConfig:        userTokenConfig,
+		impersonationConfig:    impersonationConfig,
+		accessKeySet:           accessKeySet,
+		userRepository:         userRepository,
+		refreshTokenRepository: refreshTokenRepository,
+		sessionRepository:      sessionRepository,
+		loginAttemptRepository: loginAttemptRepository,
+		recoveryCodeRepository: recoveryCodeRepository,
+		userTokenRepository:    userTokenRepository,
+		apiKeyRepository:       apiKeyRepository,
+		txManager:              txManager,
+		mailer:                 mailer,
+		auditService:           auditService,
+	}
+}
+
+func NewMockService(deps ...interface{}) service.AuthService {
+	srv := serv{}
+
+	for _, v := range deps {
+		switch s := v.(type) {
+		case *env.TokenConfigData:
+			srv.config = s
+		case config.LoginConfig:
+			srv.loginConfig = s
+		case config.MFAConfig:
+			srv.mfaConfig = s
+		case config.UserTokenConfig:
+			srv.userTokenConfig = s
+		case config.ImpersonationConfig:
+			srv.impersonationConfig = s
+		case *utils.KeySet:
+			srv.accessKeySet = s
+		case repository.UserRepository:
+			srv.userRepository = s
+		case repository.RefreshTokenRepository:
+			srv.refreshTokenRepository = s
+		case repository.SessionRepository:
+			srv.sessionRepository = s
+		case repository.LoginAttemptRepository:
+			srv.loginAttemptRepository = s
+		case repository.RecoveryCodeRepository:
+			srv.recoveryCodeRepository = s
+		case repository.UserTokenRepository:
+			srv.userTokenRepository = s
+		case repository.APIKeyRepository:
+			srv.apiKeyRepository = s
+		case db.TxManager:
+			srv.txManager = s
+		case mail.Mailer:
+			srv.mailer = s
+		case service.AuditService:
+			srv.auditService = s
+		}
+	}
+
+	return &srv
+}
@@ -0,0 +1,63 @@
+package auth
+
+import (
+	"context"
+	"di_container/internal/model"
+	"di_container/internal/repository"
+	"di_container/internal/sys"
+	"errors"
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	errSessionNotFound  = sys.NewCommonError("session not found", codes.NotFound)
+	errSessionForbidden = sys.NewCommonError("only admins can manage sessions of other users", codes.PermissionDenied)
+)
+
+// ListSessions возвращает активные сессии пользователя username, а если он не указан, то сессии actor
+func (s *serv) ListSessions(ctx context.Context, actor *model.UserClaims, username string) ([]*model.Session, error) {
+	if username == "" {
+		username = actor.Username
+	}
+
+	if !canManageSessions(actor, username) {
+		return nil, errSessionForbidden
+	}
+
+	return s.sessionRepository.ListActive(ctx, username)
+}
+
+func (s *serv) TerminateSession(ctx context.Context, actor *model.UserClaims, sessionID string) error {
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		session, errTx := s.sessionRepository.Get(ctx, sessionID)
+		if errors.Is(errTx, repository.ErrNotFound) {
+			return errSessionNotFound
+		}
+		if errTx != nil {
+			return errTx
+		}
+
+		// Не раскрываем существование чужих сессий
+		if !canManageSessions(actor, session.Username) {
+			return errSessionNotFound
+		}
+
+		errTx = s.revokeSession(ctx, session.ID)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.auditService.Record(ctx, &model.AuditEvent{
+			Type:    model.AuditEventTokenRevoke,
+			Actor:   actor.Username,
+			Subject: sessionSubject(session.ID),
+			Outcome: model.AuditOutcomeSuccess,
+			Reason:  "terminate_session",
+		})
+	})
+}
+
+// canManageSessions проверяет, что actor может управлять сессиями и ключами пользователя username
+func canManageSessions(actor *model.UserClaims, username string) bool {
+	return actor.Username == username || actor.Role == model.RoleAdmin
+}
@@ -0,0 +1,134 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc/codes"
+
+	"di_container/internal/logger"
+	"di_container/internal/model"
+	"di_container/internal/repository"
+	repoMocks "di_container/internal/repository/mocks"
+	"di_container/internal/service/auth"
+	serviceMocks "di_container/internal/service/mocks"
+	"di_container/internal/sys"
+	"di_container/internal/utils"
+)
+
+type impersonationConfig struct{}
+
+func (impersonationConfig) TokenExpiration() time.Duration { return 15 * time.Minute }
+
+func TestImpersonate(t *testing.T) {
+	t.Parallel()
+	type userRepositoryMockFunc func(mc *minimock.Controller) repository.UserRepository
+
+	var (
+		ctx    = context.Background()
+		mc     = minimock.NewController(t)
+		keySet = utils.NewHMACKeySet([]byte(gofakeit.Password(true, true, true, false, false, 32)))
+
+		support = &model.UserClaims{Username: gofakeit.Username(), Role: "support"}
+		user    = &model.User{Username: gofakeit.Username(), Role: "user"}
+		admin   = &model.User{Username: gofakeit.Username(), Role: model.RoleAdmin}
+	)
+	t.Cleanup(mc.Finish)
+
+	logger.Init(zapcore.NewNopCore())
+
+	tests := []struct {
+		name               string
+		actor              *model.UserClaims
+		username           string
+		code               codes.Code
+		auditOutcome       string
+		userRepositoryMock userRepositoryMockFunc
+	}{
+		{
+			name:         "success case",
+			actor:        support,
+			username:     user.Username,
+			code:         codes.OK,
+			auditOutcome: model.AuditOutcomeSuccess,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetByUsernameMock.Expect(minimock.AnyContext, user.Username).Return(user, nil)
+				return mock
+			},
+		},
+		{
+			name:         "admin target case",
+			actor:        support,
+			username:     admin.Username,
+			code:         codes.PermissionDenied,
+			auditOutcome: model.AuditOutcomeFailure,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetByUsernameMock.Expect(minimock.AnyContext, admin.Username).Return(admin, nil)
+				return mock
+			},
+		},
+		{
+			name:     "nested impersonation case",
+			actor:    &model.UserClaims{Username: user.Username, Role: "support", Impersonator: support.Username},
+			username: gofakeit.Username(),
+			code:     codes.PermissionDenied,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repoMocks.NewUserRepositoryMock(mc)
+			},
+		},
+		{
+			name:     "self case",
+			actor:    support,
+			username: support.Username,
+			code:     codes.InvalidArgument,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repoMocks.NewUserRepositoryMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			auditServiceMock := serviceMocks.NewAuditServiceMock(mc)
+			if tt.auditOutcome != "" {
+				auditServiceMock.RecordMock.Set(func(_ context.Context, event *model.AuditEvent) error {
+					require.Equal(t, model.AuditEventImpersonation, event.Type)
+					require.Equal(t, tt.actor.Username, event.Actor)
+					require.Equal(t, "user:"+tt.username, event.Subject)
+					require.Equal(t, tt.auditOutcome, event.Outcome)
+					return nil
+				})
+			}
+
+			service := auth.NewMockService(impersonationConfig{}, keySet, tt.userRepositoryMock(mc), auditServiceMock)
+
+			accessToken, err := service.Impersonate(ctx, tt.actor, tt.username)
+			if tt.code != codes.OK {
+				require.Empty(t, accessToken)
+				commonErr := sys.GetCommonError(err)
+				require.NotNil(t, commonErr)
+				require.Equal(t, tt.code, commonErr.Code())
+				return
+			}
+
+			require.NoError(t, err)
+
+			claims, err := keySet.VerifyToken(accessToken)
+			require.NoError(t, err)
+			require.Equal(t, user.Username, claims.Username)
+			require.Equal(t, user.Role, claims.Role)
+			require.Equal(t, support.Username, claims.Impersonator)
+			require.WithinDuration(t, time.Now().Add(15*time.Minute), time.Unix(claims.ExpiresAt, 0), time.Minute)
+		})
+	}
+}
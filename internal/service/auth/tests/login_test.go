@@ -0,0 +1,354 @@
+package tests
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"di_container/internal/client/db"
+	dbMocks "di_container/internal/client/db/mocks"
+	"di_container/internal/config/env"
+	"di_container/internal/interceptor"
+	"di_container/internal/logger"
+	"di_container/internal/model"
+	"di_container/internal/repository"
+	repoMocks "di_container/internal/repository/mocks"
+	"di_container/internal/service"
+	"di_container/internal/service/auth"
+	serviceMocks "di_container/internal/service/mocks"
+	"di_container/internal/sys"
+	"di_container/internal/utils"
+)
+
+type loginConfig struct{}
+
+func (loginConfig) UserMaxFailures() int64       { return 3 }
+func (loginConfig) IPMaxFailures() int64         { return 10 }
+func (loginConfig) FailureWindow() time.Duration { return time.Hour }
+func (loginConfig) LockoutBase() time.Duration   { return time.Minute }
+func (loginConfig) LockoutMax() time.Duration    { return time.Hour }
+
+type mfaConfig struct{}
+
+func (mfaConfig) Issuer() string                     { return "di_container" }
+func (mfaConfig) EncryptionKey() []byte              { return make([]byte, 32) }
+func (mfaConfig) ChallengeExpiration() time.Duration { return 5 * time.Minute }
+
+func TestLogin(t *testing.T) {
+	t.Parallel()
+	type userRepositoryMockFunc func(mc *minimock.Controller) repository.UserRepository
+	type loginAttemptRepositoryMockFunc func(mc *minimock.Controller) repository.LoginAttemptRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		config = &env.TokenConfigData{
+			RefreshTokenSecretKey:  gofakeit.Password(true, true, true, false, false, 32),
+			RefreshTokenExpiration: time.Hour,
+		}
+
+		username = gofakeit.Username()
+		password = gofakeit.Password(true, true, true, false, false, 16)
+		userKey  = "user:" + username
+	)
+	t.Cleanup(mc.Finish)
+
+	logger.Init(zapcore.NewNopCore())
+
+	passwordHash, err := utils.HashPassword(password)
+	require.NoError(t, err)
+
+	user := &model.User{
+		Username:     username,
+		PasswordHash: passwordHash,
+		Role:         model.RoleAdmin,
+	}
+
+	userWithTOTP := *user
+	userWithTOTP.TOTP.Enabled = true
+
+	tests := []struct {
+		name                       string
+		password                   string
+		code                       codes.Code
+		mfaRequired                bool
+		userRepositoryMock         userRepositoryMockFunc
+		loginAttemptRepositoryMock loginAttemptRepositoryMockFunc
+	}{
+		{
+			name:     "success case",
+			password: password,
+			code:     codes.OK,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetByUsernameMock.Expect(minimock.AnyContext, username).Return(user, nil)
+				return mock
+			},
+			loginAttemptRepositoryMock: func(mc *minimock.Controller) repository.LoginAttemptRepository {
+				mock := repoMocks.NewLoginAttemptRepositoryMock(mc)
+				mock.GetLockedUntilMock.Return(time.Time{}, nil)
+				mock.ResetMock.Expect(minimock.AnyContext, userKey).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:        "mfa required case",
+			password:    password,
+			code:        codes.OK,
+			mfaRequired: true,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetByUsernameMock.Expect(minimock.AnyContext, username).Return(&userWithTOTP, nil)
+				return mock
+			},
+			loginAttemptRepositoryMock: func(mc *minimock.Controller) repository.LoginAttemptRepository {
+				mock := repoMocks.NewLoginAttemptRepositoryMock(mc)
+				mock.GetLockedUntilMock.Return(time.Time{}, nil)
+				return mock
+			},
+		},
+		{
+			name:     "locked case",
+			password: password,
+			code:     codes.ResourceExhausted,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repoMocks.NewUserRepositoryMock(mc)
+			},
+			loginAttemptRepositoryMock: func(mc *minimock.Controller) repository.LoginAttemptRepository {
+				mock := repoMocks.NewLoginAttemptRepositoryMock(mc)
+				mock.GetLockedUntilMock.Return(time.Now().Add(time.Minute), nil)
+				return mock
+			},
+		},
+		{
+			name:     "wrong password case",
+			password: password + "x",
+			code:     codes.Unauthenticated,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetByUsernameMock.Expect(minimock.AnyContext, username).Return(user, nil)
+				return mock
+			},
+			loginAttemptRepositoryMock: func(mc *minimock.Controller) repository.LoginAttemptRepository {
+				mock := repoMocks.NewLoginAttemptRepositoryMock(mc)
+				mock.GetLockedUntilMock.Return(time.Time{}, nil)
+				mock.RegisterFailureMock.Return(1, nil)
+				return mock
+			},
+		},
+		{
+			name:     "unknown user case",
+			password: password,
+			code:     codes.Unauthenticated,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetByUsernameMock.Expect(minimock.AnyContext, username).Return(nil, repository.ErrNotFound)
+				return mock
+			},
+			loginAttemptRepositoryMock: func(mc *minimock.Controller) repository.LoginAttemptRepository {
+				mock := repoMocks.NewLoginAttemptRepositoryMock(mc)
+				mock.GetLockedUntilMock.Return(time.Time{}, nil)
+				mock.RegisterFailureMock.Return(1, nil)
+				return mock
+			},
+		},
+		{
+			name:     "threshold reached case",
+			password: password + "x",
+			code:     codes.ResourceExhausted,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetByUsernameMock.Expect(minimock.AnyContext, username).Return(user, nil)
+				return mock
+			},
+			loginAttemptRepositoryMock: func(mc *minimock.Controller) repository.LoginAttemptRepository {
+				mock := repoMocks.NewLoginAttemptRepositoryMock(mc)
+				mock.GetLockedUntilMock.Return(time.Time{}, nil)
+				mock.RegisterFailureMock.Set(func(_ context.Context, key string, _ time.Duration) (int64, error) {
+					if key == userKey {
+						return 4, nil
+					}
+					return 1, nil
+				})
+				mock.LockMock.Set(func(_ context.Context, key string, until time.Time) error {
+					require.Equal(t, userKey, key)
+					// Четвертая попытка при пороге в три удваивает базовую блокировку
+					require.WithinDuration(t, time.Now().Add(2*time.Minute), until, time.Second)
+					return nil
+				})
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			txManagerMock := dbMocks.NewTxManagerMock(mc)
+			txManagerMock.ReadCommittedMock.Optional().Set(func(ctx context.Context, f db.Handler) error {
+				return f(ctx)
+			})
+
+			refreshTokenRepoMock := repoMocks.NewRefreshTokenRepositoryMock(mc)
+			sessionRepoMock := repoMocks.NewSessionRepositoryMock(mc)
+			if tt.code == codes.OK && !tt.mfaRequired {
+				refreshTokenRepoMock.CreateMock.Return(nil)
+				sessionRepoMock.CreateMock.Return(nil)
+			}
+
+			// Вход, ожидающий второй фактор, еще не завершен и в журнал аудита не пишется
+			auditServiceMock := serviceMocks.NewAuditServiceMock(mc)
+			if !tt.mfaRequired {
+				outcome := model.AuditOutcomeFailure
+				if tt.code == codes.OK {
+					outcome = model.AuditOutcomeSuccess
+				}
+
+				auditServiceMock.RecordMock.Set(func(_ context.Context, event *model.AuditEvent) error {
+					require.Equal(t, model.AuditEventLogin, event.Type)
+					require.Equal(t, outcome, event.Outcome)
+					require.Equal(t, username, event.Actor)
+					return nil
+				})
+			}
+
+			service := auth.NewMockService(
+				config,
+				loginConfig{},
+				mfaConfig{},
+				tt.userRepositoryMock(mc),
+				tt.loginAttemptRepositoryMock(mc),
+				refreshTokenRepoMock,
+				sessionRepoMock,
+				txManagerMock,
+				auditServiceMock,
+			)
+
+			result, err := service.Login(ctx, username, tt.password)
+			if tt.code == codes.OK {
+				require.NoError(t, err)
+				if tt.mfaRequired {
+					require.Empty(t, result.RefreshToken)
+					require.NotEmpty(t, result.MFAChallengeToken)
+					return
+				}
+				require.NotEmpty(t, result.RefreshToken)
+				require.Empty(t, result.MFAChallengeToken)
+				return
+			}
+
+			require.Nil(t, result)
+			commonErr := sys.GetCommonError(err)
+			require.NotNil(t, commonErr)
+			require.Equal(t, tt.code, commonErr.Code())
+			if tt.code == codes.ResourceExhausted {
+				require.Greater(t, commonErr.RetryAfter(), time.Duration(0))
+			}
+		})
+	}
+}
+
+// TestLoginForgedForwardedFor проверяет, что адрес из x-forwarded-for прямого клиента не попадает в ключ блокировки
+func TestLoginForgedForwardedFor(t *testing.T) {
+	t.Parallel()
+
+	var (
+		username = gofakeit.Username()
+		password = gofakeit.Password(true, true, true, false, false, 16)
+
+		attackerIP = "203.0.113.7"
+		victimIP   = "198.51.100.1"
+
+		config = &env.TokenConfigData{
+			RefreshTokenSecretKey:  gofakeit.Password(true, true, true, false, false, 32),
+			RefreshTokenExpiration: time.Hour,
+		}
+	)
+
+	logger.Init(zapcore.NewNopCore())
+
+	passwordHash, err := utils.HashPassword(password)
+	require.NoError(t, err)
+
+	user := &model.User{Username: username, PasswordHash: passwordHash, Role: "user"}
+
+	// Вызов проходит через ClientInfoInterceptor, как в сервере
+	login := func(srv service.AuthService, password string) error {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(attackerIP), Port: 50000}})
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", victimIP))
+
+		_, err := interceptor.NewClientInfoInterceptor("secret", nil).Unary(ctx, nil, &grpc.UnaryServerInfo{},
+			func(ctx context.Context, _ interface{}) (interface{}, error) {
+				return srv.Login(ctx, username, password)
+			})
+		return err
+	}
+
+	newService := func(mc *minimock.Controller, userRepo repository.UserRepository, loginAttemptRepo repository.LoginAttemptRepository) service.AuthService {
+		auditServiceMock := serviceMocks.NewAuditServiceMock(mc)
+		auditServiceMock.RecordMock.Return(nil)
+
+		return auth.NewMockService(config, loginConfig{}, mfaConfig{}, userRepo, loginAttemptRepo,
+			repoMocks.NewRefreshTokenRepositoryMock(mc), repoMocks.NewSessionRepositoryMock(mc), dbMocks.NewTxManagerMock(mc), auditServiceMock)
+	}
+
+	t.Run("forged header does not bypass lock", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		t.Cleanup(mc.Finish)
+
+		loginAttemptRepoMock := repoMocks.NewLoginAttemptRepositoryMock(mc)
+		loginAttemptRepoMock.GetLockedUntilMock.Expect(minimock.AnyContext, "user:"+username, "ip:"+attackerIP).
+			Return(time.Now().Add(time.Minute), nil)
+
+		err := login(newService(mc, repoMocks.NewUserRepositoryMock(mc), loginAttemptRepoMock), password)
+
+		commonErr := sys.GetCommonError(err)
+		require.NotNil(t, commonErr)
+		require.Equal(t, codes.ResourceExhausted, commonErr.Code())
+	})
+
+	t.Run("forged header does not lock victim address", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		t.Cleanup(mc.Finish)
+
+		userRepoMock := repoMocks.NewUserRepositoryMock(mc)
+		userRepoMock.GetByUsernameMock.Return(user, nil)
+
+		loginAttemptRepoMock := repoMocks.NewLoginAttemptRepositoryMock(mc)
+		loginAttemptRepoMock.GetLockedUntilMock.Return(time.Time{}, nil)
+		loginAttemptRepoMock.RegisterFailureMock.Set(func(_ context.Context, key string, _ time.Duration) (int64, error) {
+			require.NotEqual(t, "ip:"+victimIP, key)
+			if key == "ip:"+attackerIP {
+				return loginConfig{}.IPMaxFailures() + 1, nil
+			}
+			return 1, nil
+		})
+		loginAttemptRepoMock.LockMock.Set(func(_ context.Context, key string, _ time.Time) error {
+			require.Equal(t, "ip:"+attackerIP, key)
+			return nil
+		})
+
+		err := login(newService(mc, userRepoMock, loginAttemptRepoMock), password+"x")
+
+		commonErr := sys.GetCommonError(err)
+		require.NotNil(t, commonErr)
+		require.Equal(t, codes.ResourceExhausted, commonErr.Code())
+	})
+}
@@ -0,0 +1,202 @@
+package tests
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"di_container/internal/client/db"
+	dbMocks "di_container/internal/client/db/mocks"
+	"di_container/internal/client/mail"
+	mailMocks "di_container/internal/client/mail/mocks"
+	"di_container/internal/model"
+	"di_container/internal/repository"
+	repoMocks "di_container/internal/repository/mocks"
+	"di_container/internal/service/auth"
+	serviceMocks "di_container/internal/service/mocks"
+	"di_container/internal/sys"
+	"di_container/internal/utils"
+)
+
+type userTokenConfig struct{}
+
+func (userTokenConfig) PasswordResetExpiration() time.Duration     { return time.Hour }
+func (userTokenConfig) EmailVerificationExpiration() time.Duration { return 24 * time.Hour }
+
+func TestRequestPasswordReset(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		email = gofakeit.Email()
+		user  = &model.User{ID: gofakeit.Int64(), Username: gofakeit.Username()}
+	)
+	t.Cleanup(mc.Finish)
+
+	t.Run("unknown email case", func(t *testing.T) {
+		t.Parallel()
+
+		userRepoMock := repoMocks.NewUserRepositoryMock(mc)
+		userRepoMock.GetByEmailMock.Expect(minimock.AnyContext, email).Return(nil, repository.ErrNotFound)
+
+		service := auth.NewMockService(userTokenConfig{}, userRepoMock, mailMocks.NewMailerMock(mc))
+
+		require.NoError(t, service.RequestPasswordReset(ctx, email))
+	})
+
+	t.Run("success case", func(t *testing.T) {
+		t.Parallel()
+
+		userRepoMock := repoMocks.NewUserRepositoryMock(mc)
+		userRepoMock.GetByEmailMock.Expect(minimock.AnyContext, email).Return(user, nil)
+
+		txManagerMock := dbMocks.NewTxManagerMock(mc)
+		txManagerMock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) error {
+			return f(ctx)
+		})
+
+		var storedHash string
+		userTokenRepoMock := repoMocks.NewUserTokenRepositoryMock(mc)
+		userTokenRepoMock.DeleteUnusedMock.Expect(minimock.AnyContext, user.ID, model.UserTokenPurposePasswordReset).Return(nil)
+		userTokenRepoMock.CreateMock.Set(func(_ context.Context, token *model.UserToken) error {
+			require.Equal(t, user.ID, token.UserID)
+			require.Equal(t, model.UserTokenPurposePasswordReset, token.Purpose)
+			storedHash = token.Hash
+			return nil
+		})
+
+		mailerMock := mailMocks.NewMailerMock(mc)
+		mailerMock.SendMock.Set(func(_ context.Context, msg *mail.Message) error {
+			require.Equal(t, email, msg.To)
+			// В письме сам токен, а в базе только его хеш
+			require.NotContains(t, msg.Body, storedHash)
+			fields := strings.Fields(msg.Body)
+			found := false
+			for _, f := range fields {
+				if utils.HashSecret(strings.TrimSuffix(f, ".")) == storedHash {
+					found = true
+				}
+			}
+			require.True(t, found)
+			return nil
+		})
+
+		service := auth.NewMockService(userTokenConfig{}, userRepoMock, userTokenRepoMock, txManagerMock, mailerMock)
+
+		require.NoError(t, service.RequestPasswordReset(ctx, email))
+	})
+}
+
+func TestResetPassword(t *testing.T) {
+	t.Parallel()
+	type userTokenRepositoryMockFunc func(mc *minimock.Controller) repository.UserTokenRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		token    = gofakeit.UUID()
+		password = gofakeit.Password(true, true, true, false, false, 16)
+		user     = &model.User{ID: gofakeit.Int64(), Username: gofakeit.Username()}
+	)
+	t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name                    string
+		password                string
+		code                    codes.Code
+		userTokenRepositoryMock userTokenRepositoryMockFunc
+	}{
+		{
+			name:     "success case",
+			password: password,
+			code:     codes.OK,
+			userTokenRepositoryMock: func(mc *minimock.Controller) repository.UserTokenRepository {
+				mock := repoMocks.NewUserTokenRepositoryMock(mc)
+				mock.UseMock.Expect(minimock.AnyContext, utils.HashSecret(token), model.UserTokenPurposePasswordReset).
+					Return(&model.UserToken{UserID: user.ID}, nil)
+				return mock
+			},
+		},
+		{
+			name:     "invalid token case",
+			password: password,
+			code:     codes.InvalidArgument,
+			userTokenRepositoryMock: func(mc *minimock.Controller) repository.UserTokenRepository {
+				mock := repoMocks.NewUserTokenRepositoryMock(mc)
+				mock.UseMock.Return(nil, repository.ErrNotFound)
+				return mock
+			},
+		},
+		{
+			name:     "short password case",
+			password: "short",
+			code:     codes.InvalidArgument,
+			userTokenRepositoryMock: func(mc *minimock.Controller) repository.UserTokenRepository {
+				return repoMocks.NewUserTokenRepositoryMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			txManagerMock := dbMocks.NewTxManagerMock(mc)
+			txManagerMock.ReadCommittedMock.Optional().Set(func(ctx context.Context, f db.Handler) error {
+				return f(ctx)
+			})
+
+			userRepoMock := repoMocks.NewUserRepositoryMock(mc)
+			refreshTokenRepoMock := repoMocks.NewRefreshTokenRepositoryMock(mc)
+			sessionRepoMock := repoMocks.NewSessionRepositoryMock(mc)
+			loginAttemptRepoMock := repoMocks.NewLoginAttemptRepositoryMock(mc)
+			auditServiceMock := serviceMocks.NewAuditServiceMock(mc)
+			if tt.code == codes.OK {
+				userRepoMock.GetMock.Expect(minimock.AnyContext, user.ID).Return(user, nil)
+				userRepoMock.UpdatePasswordMock.Set(func(_ context.Context, id int64, passwordHash string) error {
+					require.Equal(t, user.ID, id)
+					require.True(t, utils.VerifyPassword(passwordHash, tt.password))
+					return nil
+				})
+				refreshTokenRepoMock.RevokeByUsernameMock.Expect(minimock.AnyContext, user.Username).Return(nil)
+				sessionRepoMock.RevokeByUsernameMock.Expect(minimock.AnyContext, user.Username).Return(nil)
+				loginAttemptRepoMock.ResetMock.Expect(minimock.AnyContext, "user:"+user.Username).Return(nil)
+				auditServiceMock.RecordMock.Set(func(_ context.Context, event *model.AuditEvent) error {
+					require.Equal(t, model.AuditEventTokenRevoke, event.Type)
+					require.Equal(t, "user:"+user.Username, event.Subject)
+					return nil
+				})
+			}
+
+			service := auth.NewMockService(
+				userRepoMock,
+				tt.userTokenRepositoryMock(mc),
+				refreshTokenRepoMock,
+				sessionRepoMock,
+				loginAttemptRepoMock,
+				txManagerMock,
+				auditServiceMock,
+			)
+
+			err := service.ResetPassword(ctx, token, tt.password)
+			if tt.code == codes.OK {
+				require.NoError(t, err)
+				return
+			}
+
+			commonErr := sys.GetCommonError(err)
+			require.NotNil(t, commonErr)
+			require.Equal(t, tt.code, commonErr.Code())
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"di_container/internal/client/db"
+	dbMocks "di_container/internal/client/db/mocks"
+	"di_container/internal/config/env"
+	"di_container/internal/logger"
+	"di_container/internal/model"
+	"di_container/internal/repository"
+	repoMocks "di_container/internal/repository/mocks"
+	"di_container/internal/service/auth"
+	serviceMocks "di_container/internal/service/mocks"
+	"di_container/internal/utils"
+)
+
+func TestGetRefreshToken(t *testing.T) {
+	t.Parallel()
+	type refreshTokenRepositoryMockFunc func(mc *minimock.Controller) repository.RefreshTokenRepository
+	type sessionRepositoryMockFunc func(mc *minimock.Controller) repository.SessionRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		config = &env.TokenConfigData{
+			RefreshTokenSecretKey:  gofakeit.Password(true, true, true, false, false, 32),
+			AccessTokenSecretKey:   gofakeit.Password(true, true, true, false, false, 32),
+			RefreshTokenExpiration: time.Hour,
+			AccessTokenExpiration:  time.Minute,
+		}
+
+		username = gofakeit.Username()
+		tokenID  = gofakeit.UUID()
+		familyID = gofakeit.UUID()
+
+		activeToken = &model.RefreshToken{
+			ID:       tokenID,
+			FamilyID: familyID,
+			Username: username,
+		}
+		rotatedToken = &model.RefreshToken{
+			ID:        tokenID,
+			FamilyID:  familyID,
+			Username:  username,
+			RotatedAt: sql.NullTime{Time: time.Now(), Valid: true},
+		}
+	)
+	t.Cleanup(mc.Finish)
+
+	logger.Init(zapcore.NewNopCore())
+
+	refreshToken, err := utils.GenerateRefreshToken(model.UserInfo{Username: username, Role: "admin"},
+		tokenID,
+		[]byte(config.RefreshTokenSecretKey),
+		config.RefreshTokenExpiration,
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name                       string
+		refreshToken               string
+		wantErr                    bool
+		auditEvents                []string
+		refreshTokenRepositoryMock refreshTokenRepositoryMockFunc
+		sessionRepositoryMock      sessionRepositoryMockFunc
+	}{
+		{
+			name:         "success case",
+			refreshToken: refreshToken,
+			wantErr:      false,
+			auditEvents:  []string{model.AuditEventTokenRefresh},
+			refreshTokenRepositoryMock: func(mc *minimock.Controller) repository.RefreshTokenRepository {
+				mock := repoMocks.NewRefreshTokenRepositoryMock(mc)
+				mock.GetMock.Expect(minimock.AnyContext, tokenID).Return(activeToken, nil)
+				mock.MarkRotatedMock.Expect(minimock.AnyContext, tokenID).Return(nil)
+				mock.CreateMock.Set(func(_ context.Context, token *model.RefreshToken) error {
+					require.Equal(t, familyID, token.FamilyID)
+					require.NotEqual(t, tokenID, token.ID)
+					return nil
+				})
+				return mock
+			},
+			sessionRepositoryMock: func(mc *minimock.Controller) repository.SessionRepository {
+				mock := repoMocks.NewSessionRepositoryMock(mc)
+				mock.TouchMock.Set(func(_ context.Context, id string, _ time.Time) error {
+					require.Equal(t, familyID, id)
+					return nil
+				})
+				return mock
+			},
+		},
+		{
+			name:         "reuse detected case",
+			refreshToken: refreshToken,
+			wantErr:      true,
+			auditEvents:  []string{model.AuditEventTokenRevoke, model.AuditEventTokenRefresh},
+			refreshTokenRepositoryMock: func(mc *minimock.Controller) repository.RefreshTokenRepository {
+				mock := repoMocks.NewRefreshTokenRepositoryMock(mc)
+				mock.GetMock.Expect(minimock.AnyContext, tokenID).Return(rotatedToken, nil)
+				mock.RevokeFamilyMock.Expect(minimock.AnyContext, familyID).Return(nil)
+				return mock
+			},
+			sessionRepositoryMock: func(mc *minimock.Controller) repository.SessionRepository {
+				mock := repoMocks.NewSessionRepositoryMock(mc)
+				mock.RevokeMock.Expect(minimock.AnyContext, familyID).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:         "unknown token case",
+			refreshToken: refreshToken,
+			wantErr:      true,
+			auditEvents:  []string{model.AuditEventTokenRefresh},
+			refreshTokenRepositoryMock: func(mc *minimock.Controller) repository.RefreshTokenRepository {
+				mock := repoMocks.NewRefreshTokenRepositoryMock(mc)
+				mock.GetMock.Expect(minimock.AnyContext, tokenID).Return(nil, repository.ErrNotFound)
+				return mock
+			},
+			sessionRepositoryMock: func(mc *minimock.Controller) repository.SessionRepository {
+				return repoMocks.NewSessionRepositoryMock(mc)
+			},
+		},
+		{
+			name:         "invalid signature case",
+			refreshToken: refreshToken + "x",
+			wantErr:      true,
+			auditEvents:  []string{model.AuditEventTokenRefresh},
+			refreshTokenRepositoryMock: func(mc *minimock.Controller) repository.RefreshTokenRepository {
+				return repoMocks.NewRefreshTokenRepositoryMock(mc)
+			},
+			sessionRepositoryMock: func(mc *minimock.Controller) repository.SessionRepository {
+				return repoMocks.NewSessionRepositoryMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			txManagerMock := dbMocks.NewTxManagerMock(mc)
+			txManagerMock.ReadCommittedMock.Optional().Set(func(ctx context.Context, f db.Handler) error {
+				return f(ctx)
+			})
+
+			var auditEvents []string
+			auditServiceMock := serviceMocks.NewAuditServiceMock(mc)
+			auditServiceMock.RecordMock.Set(func(_ context.Context, event *model.AuditEvent) error {
+				auditEvents = append(auditEvents, event.Type)
+				return nil
+			})
+
+			service := auth.NewMockService(config, tt.refreshTokenRepositoryMock(mc), tt.sessionRepositoryMock(mc), txManagerMock, auditServiceMock)
+
+			newRefreshToken, err := service.GetRefreshToken(ctx, tt.refreshToken)
+			require.Equal(t, tt.auditEvents, auditEvents)
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Empty(t, newRefreshToken)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotEqual(t, tt.refreshToken, newRefreshToken)
+		})
+	}
+}
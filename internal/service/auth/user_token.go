@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"context"
+	"di_container/internal/model"
+	"di_container/internal/repository"
+	"di_container/internal/sys"
+	"di_container/internal/utils"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"time"
+)
+
+var errInvalidUserToken = sys.NewCommonError("token is invalid or expired", codes.InvalidArgument)
+
+// issueUserToken выпускает одноразовый токен для письма. Ранее отправленные неиспользованные
+// токены того же назначения перестают действовать
+func (s *serv) issueUserToken(ctx context.Context, userID int64, purpose string, ttl time.Duration) (string, error) {
+	token, err := utils.GenerateTokenID()
+	if err != nil {
+		return "", err
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.userTokenRepository.DeleteUnused(ctx, userID, purpose)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.userTokenRepository.Create(ctx, &model.UserToken{
+			Hash:      utils.HashSecret(token),
+			UserID:    userID,
+			Purpose:   purpose,
+			ExpiresAt: time.Now().Add(ttl),
+		})
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// useUserToken погашает токен и возвращает пользователя, которому он был выдан. Вызывать нужно в транзакции
+func (s *serv) useUserToken(ctx context.Context, token string, purpose string) (*model.User, error) {
+	userToken, err := s.userTokenRepository.Use(ctx, utils.HashSecret(token), purpose)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, errInvalidUserToken
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.userRepository.Get(ctx, userToken.UserID)
+}
@@ -2,3 +2,5 @@ package service
 
 //go:generate sh -c "rm -rf mocks && mkdir -p mocks"
 //go:generate minimock -i NoteService -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i AuditService -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i QuotaService -o ./mocks/ -s "_minimock.go"
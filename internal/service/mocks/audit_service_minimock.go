@@ -0,0 +1,1392 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.13). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i di_container/internal/service.AuditService -o audit_service_minimock.go -n AuditServiceMock -p mocks
+
+import (
+	"context"
+	"di_container/internal/model"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// AuditServiceMock implements service.AuditService
+type AuditServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcExport          func(ctx context.Context, filter *model.AuditFilter, send func(*model.AuditEvent) error) (err error)
+	inspectFuncExport   func(ctx context.Context, filter *model.AuditFilter, send func(*model.AuditEvent) error)
+	afterExportCounter  uint64
+	beforeExportCounter uint64
+	ExportMock          mAuditServiceMockExport
+
+	funcQuery          func(ctx context.Context, filter *model.AuditFilter) (ap1 *model.AuditPage, err error)
+	inspectFuncQuery   func(ctx context.Context, filter *model.AuditFilter)
+	afterQueryCounter  uint64
+	beforeQueryCounter uint64
+	QueryMock          mAuditServiceMockQuery
+
+	funcRecord          func(ctx context.Context, event *model.AuditEvent) (err error)
+	inspectFuncRecord   func(ctx context.Context, event *model.AuditEvent)
+	afterRecordCounter  uint64
+	beforeRecordCounter uint64
+	RecordMock          mAuditServiceMockRecord
+
+	funcVerify          func(ctx context.Context) (ap1 *model.AuditVerification, err error)
+	inspectFuncVerify   func(ctx context.Context)
+	afterVerifyCounter  uint64
+	beforeVerifyCounter uint64
+	VerifyMock          mAuditServiceMockVerify
+}
+
+// NewAuditServiceMock returns a mock for service.AuditService
+func NewAuditServiceMock(t minimock.Tester) *AuditServiceMock {
+	m := &AuditServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ExportMock = mAuditServiceMockExport{mock: m}
+	m.ExportMock.callArgs = []*AuditServiceMockExportParams{}
+
+	m.QueryMock = mAuditServiceMockQuery{mock: m}
+	m.QueryMock.callArgs = []*AuditServiceMockQueryParams{}
+
+	m.RecordMock = mAuditServiceMockRecord{mock: m}
+	m.RecordMock.callArgs = []*AuditServiceMockRecordParams{}
+
+	m.VerifyMock = mAuditServiceMockVerify{mock: m}
+	m.VerifyMock.callArgs = []*AuditServiceMockVerifyParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAuditServiceMockExport struct {
+	optional           bool
+	mock               *AuditServiceMock
+	defaultExpectation *AuditServiceMockExportExpectation
+	expectations       []*AuditServiceMockExportExpectation
+
+	callArgs []*AuditServiceMockExportParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AuditServiceMockExportExpectation specifies expectation struct of the AuditService.Export
+type AuditServiceMockExportExpectation struct {
+	mock      *AuditServiceMock
+	params    *AuditServiceMockExportParams
+	paramPtrs *AuditServiceMockExportParamPtrs
+	results   *AuditServiceMockExportResults
+	Counter   uint64
+}
+
+// AuditServiceMockExportParams contains parameters of the AuditService.Export
+type AuditServiceMockExportParams struct {
+	ctx    context.Context
+	filter *model.AuditFilter
+	send   func(*model.AuditEvent) error
+}
+
+// AuditServiceMockExportParamPtrs contains pointers to parameters of the AuditService.Export
+type AuditServiceMockExportParamPtrs struct {
+	ctx    *context.Context
+	filter **model.AuditFilter
+	send   *func(*model.AuditEvent) error
+}
+
+// AuditServiceMockExportResults contains results of the AuditService.Export
+type AuditServiceMockExportResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmExport *mAuditServiceMockExport) Optional() *mAuditServiceMockExport {
+	mmExport.optional = true
+	return mmExport
+}
+
+// Expect sets up expected params for AuditService.Export
+func (mmExport *mAuditServiceMockExport) Expect(ctx context.Context, filter *model.AuditFilter, send func(*model.AuditEvent) error) *mAuditServiceMockExport {
+	if mmExport.mock.funcExport != nil {
+		mmExport.mock.t.Fatalf("AuditServiceMock.Export mock is already set by Set")
+	}
+
+	if mmExport.defaultExpectation == nil {
+		mmExport.defaultExpectation = &AuditServiceMockExportExpectation{}
+	}
+
+	if mmExport.defaultExpectation.paramPtrs != nil {
+		mmExport.mock.t.Fatalf("AuditServiceMock.Export mock is already set by ExpectParams functions")
+	}
+
+	mmExport.defaultExpectation.params = &AuditServiceMockExportParams{ctx, filter, send}
+	for _, e := range mmExport.expectations {
+		if minimock.Equal(e.params, mmExport.defaultExpectation.params) {
+			mmExport.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExport.defaultExpectation.params)
+		}
+	}
+
+	return mmExport
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditService.Export
+func (mmExport *mAuditServiceMockExport) ExpectCtxParam1(ctx context.Context) *mAuditServiceMockExport {
+	if mmExport.mock.funcExport != nil {
+		mmExport.mock.t.Fatalf("AuditServiceMock.Export mock is already set by Set")
+	}
+
+	if mmExport.defaultExpectation == nil {
+		mmExport.defaultExpectation = &AuditServiceMockExportExpectation{}
+	}
+
+	if mmExport.defaultExpectation.params != nil {
+		mmExport.mock.t.Fatalf("AuditServiceMock.Export mock is already set by Expect")
+	}
+
+	if mmExport.defaultExpectation.paramPtrs == nil {
+		mmExport.defaultExpectation.paramPtrs = &AuditServiceMockExportParamPtrs{}
+	}
+	mmExport.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmExport
+}
+
+// ExpectFilterParam2 sets up expected param filter for AuditService.Export
+func (mmExport *mAuditServiceMockExport) ExpectFilterParam2(filter *model.AuditFilter) *mAuditServiceMockExport {
+	if mmExport.mock.funcExport != nil {
+		mmExport.mock.t.Fatalf("AuditServiceMock.Export mock is already set by Set")
+	}
+
+	if mmExport.defaultExpectation == nil {
+		mmExport.defaultExpectation = &AuditServiceMockExportExpectation{}
+	}
+
+	if mmExport.defaultExpectation.params != nil {
+		mmExport.mock.t.Fatalf("AuditServiceMock.Export mock is already set by Expect")
+	}
+
+	if mmExport.defaultExpectation.paramPtrs == nil {
+		mmExport.defaultExpectation.paramPtrs = &AuditServiceMockExportParamPtrs{}
+	}
+	mmExport.defaultExpectation.paramPtrs.filter = &filter
+
+	return mmExport
+}
+
+// ExpectSendParam3 sets up expected param send for AuditService.Export
+func (mmExport *mAuditServiceMockExport) ExpectSendParam3(send func(*model.AuditEvent) error) *mAuditServiceMockExport {
+	if mmExport.mock.funcExport != nil {
+		mmExport.mock.t.Fatalf("AuditServiceMock.Export mock is already set by Set")
+	}
+
+	if mmExport.defaultExpectation == nil {
+		mmExport.defaultExpectation = &AuditServiceMockExportExpectation{}
+	}
+
+	if mmExport.defaultExpectation.params != nil {
+		mmExport.mock.t.Fatalf("AuditServiceMock.Export mock is already set by Expect")
+	}
+
+	if mmExport.defaultExpectation.paramPtrs == nil {
+		mmExport.defaultExpectation.paramPtrs = &AuditServiceMockExportParamPtrs{}
+	}
+	mmExport.defaultExpectation.paramPtrs.send = &send
+
+	return mmExport
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditService.Export
+func (mmExport *mAuditServiceMockExport) Inspect(f func(ctx context.Context, filter *model.AuditFilter, send func(*model.AuditEvent) error)) *mAuditServiceMockExport {
+	if mmExport.mock.inspectFuncExport != nil {
+		mmExport.mock.t.Fatalf("Inspect function is already set for AuditServiceMock.Export")
+	}
+
+	mmExport.mock.inspectFuncExport = f
+
+	return mmExport
+}
+
+// Return sets up results that will be returned by AuditService.Export
+func (mmExport *mAuditServiceMockExport) Return(err error) *AuditServiceMock {
+	if mmExport.mock.funcExport != nil {
+		mmExport.mock.t.Fatalf("AuditServiceMock.Export mock is already set by Set")
+	}
+
+	if mmExport.defaultExpectation == nil {
+		mmExport.defaultExpectation = &AuditServiceMockExportExpectation{mock: mmExport.mock}
+	}
+	mmExport.defaultExpectation.results = &AuditServiceMockExportResults{err}
+	return mmExport.mock
+}
+
+// Set uses given function f to mock the AuditService.Export method
+func (mmExport *mAuditServiceMockExport) Set(f func(ctx context.Context, filter *model.AuditFilter, send func(*model.AuditEvent) error) (err error)) *AuditServiceMock {
+	if mmExport.defaultExpectation != nil {
+		mmExport.mock.t.Fatalf("Default expectation is already set for the AuditService.Export method")
+	}
+
+	if len(mmExport.expectations) > 0 {
+		mmExport.mock.t.Fatalf("Some expectations are already set for the AuditService.Export method")
+	}
+
+	mmExport.mock.funcExport = f
+	return mmExport.mock
+}
+
+// When sets expectation for the AuditService.Export which will trigger the result defined by the following
+// Then helper
+func (mmExport *mAuditServiceMockExport) When(ctx context.Context, filter *model.AuditFilter, send func(*model.AuditEvent) error) *AuditServiceMockExportExpectation {
+	if mmExport.mock.funcExport != nil {
+		mmExport.mock.t.Fatalf("AuditServiceMock.Export mock is already set by Set")
+	}
+
+	expectation := &AuditServiceMockExportExpectation{
+		mock:   mmExport.mock,
+		params: &AuditServiceMockExportParams{ctx, filter, send},
+	}
+	mmExport.expectations = append(mmExport.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditService.Export return parameters for the expectation previously defined by the When method
+func (e *AuditServiceMockExportExpectation) Then(err error) *AuditServiceMock {
+	e.results = &AuditServiceMockExportResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuditService.Export should be invoked
+func (mmExport *mAuditServiceMockExport) Times(n uint64) *mAuditServiceMockExport {
+	if n == 0 {
+		mmExport.mock.t.Fatalf("Times of AuditServiceMock.Export mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmExport.expectedInvocations, n)
+	return mmExport
+}
+
+func (mmExport *mAuditServiceMockExport) invocationsDone() bool {
+	if len(mmExport.expectations) == 0 && mmExport.defaultExpectation == nil && mmExport.mock.funcExport == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmExport.mock.afterExportCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmExport.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Export implements service.AuditService
+func (mmExport *AuditServiceMock) Export(ctx context.Context, filter *model.AuditFilter, send func(*model.AuditEvent) error) (err error) {
+	mm_atomic.AddUint64(&mmExport.beforeExportCounter, 1)
+	defer mm_atomic.AddUint64(&mmExport.afterExportCounter, 1)
+
+	if mmExport.inspectFuncExport != nil {
+		mmExport.inspectFuncExport(ctx, filter, send)
+	}
+
+	mm_params := AuditServiceMockExportParams{ctx, filter, send}
+
+	// Record call args
+	mmExport.ExportMock.mutex.Lock()
+	mmExport.ExportMock.callArgs = append(mmExport.ExportMock.callArgs, &mm_params)
+	mmExport.ExportMock.mutex.Unlock()
+
+	for _, e := range mmExport.ExportMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmExport.ExportMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExport.ExportMock.defaultExpectation.Counter, 1)
+		mm_want := mmExport.ExportMock.defaultExpectation.params
+		mm_want_ptrs := mmExport.ExportMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditServiceMockExportParams{ctx, filter, send}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmExport.t.Errorf("AuditServiceMock.Export got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmExport.t.Errorf("AuditServiceMock.Export got unexpected parameter filter, want: %#v, got: %#v%s\n", *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+			if mm_want_ptrs.send != nil && !minimock.Equal(*mm_want_ptrs.send, mm_got.send) {
+				mmExport.t.Errorf("AuditServiceMock.Export got unexpected parameter send, want: %#v, got: %#v%s\n", *mm_want_ptrs.send, mm_got.send, minimock.Diff(*mm_want_ptrs.send, mm_got.send))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExport.t.Errorf("AuditServiceMock.Export got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExport.ExportMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExport.t.Fatal("No results are set for the AuditServiceMock.Export")
+		}
+		return (*mm_results).err
+	}
+	if mmExport.funcExport != nil {
+		return mmExport.funcExport(ctx, filter, send)
+	}
+	mmExport.t.Fatalf("Unexpected call to AuditServiceMock.Export. %v %v %v", ctx, filter, send)
+	return
+}
+
+// ExportAfterCounter returns a count of finished AuditServiceMock.Export invocations
+func (mmExport *AuditServiceMock) ExportAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExport.afterExportCounter)
+}
+
+// ExportBeforeCounter returns a count of AuditServiceMock.Export invocations
+func (mmExport *AuditServiceMock) ExportBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExport.beforeExportCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditServiceMock.Export.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExport *mAuditServiceMockExport) Calls() []*AuditServiceMockExportParams {
+	mmExport.mutex.RLock()
+
+	argCopy := make([]*AuditServiceMockExportParams, len(mmExport.callArgs))
+	copy(argCopy, mmExport.callArgs)
+
+	mmExport.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExportDone returns true if the count of the Export invocations corresponds
+// the number of defined expectations
+func (m *AuditServiceMock) MinimockExportDone() bool {
+	if m.ExportMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ExportMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ExportMock.invocationsDone()
+}
+
+// MinimockExportInspect logs each unmet expectation
+func (m *AuditServiceMock) MinimockExportInspect() {
+	for _, e := range m.ExportMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditServiceMock.Export with params: %#v", *e.params)
+		}
+	}
+
+	afterExportCounter := mm_atomic.LoadUint64(&m.afterExportCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExportMock.defaultExpectation != nil && afterExportCounter < 1 {
+		if m.ExportMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AuditServiceMock.Export")
+		} else {
+			m.t.Errorf("Expected call to AuditServiceMock.Export with params: %#v", *m.ExportMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExport != nil && afterExportCounter < 1 {
+		m.t.Error("Expected call to AuditServiceMock.Export")
+	}
+
+	if !m.ExportMock.invocationsDone() && afterExportCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditServiceMock.Export but found %d calls",
+			mm_atomic.LoadUint64(&m.ExportMock.expectedInvocations), afterExportCounter)
+	}
+}
+
+type mAuditServiceMockQuery struct {
+	optional           bool
+	mock               *AuditServiceMock
+	defaultExpectation *AuditServiceMockQueryExpectation
+	expectations       []*AuditServiceMockQueryExpectation
+
+	callArgs []*AuditServiceMockQueryParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AuditServiceMockQueryExpectation specifies expectation struct of the AuditService.Query
+type AuditServiceMockQueryExpectation struct {
+	mock      *AuditServiceMock
+	params    *AuditServiceMockQueryParams
+	paramPtrs *AuditServiceMockQueryParamPtrs
+	results   *AuditServiceMockQueryResults
+	Counter   uint64
+}
+
+// AuditServiceMockQueryParams contains parameters of the AuditService.Query
+type AuditServiceMockQueryParams struct {
+	ctx    context.Context
+	filter *model.AuditFilter
+}
+
+// AuditServiceMockQueryParamPtrs contains pointers to parameters of the AuditService.Query
+type AuditServiceMockQueryParamPtrs struct {
+	ctx    *context.Context
+	filter **model.AuditFilter
+}
+
+// AuditServiceMockQueryResults contains results of the AuditService.Query
+type AuditServiceMockQueryResults struct {
+	ap1 *model.AuditPage
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmQuery *mAuditServiceMockQuery) Optional() *mAuditServiceMockQuery {
+	mmQuery.optional = true
+	return mmQuery
+}
+
+// Expect sets up expected params for AuditService.Query
+func (mmQuery *mAuditServiceMockQuery) Expect(ctx context.Context, filter *model.AuditFilter) *mAuditServiceMockQuery {
+	if mmQuery.mock.funcQuery != nil {
+		mmQuery.mock.t.Fatalf("AuditServiceMock.Query mock is already set by Set")
+	}
+
+	if mmQuery.defaultExpectation == nil {
+		mmQuery.defaultExpectation = &AuditServiceMockQueryExpectation{}
+	}
+
+	if mmQuery.defaultExpectation.paramPtrs != nil {
+		mmQuery.mock.t.Fatalf("AuditServiceMock.Query mock is already set by ExpectParams functions")
+	}
+
+	mmQuery.defaultExpectation.params = &AuditServiceMockQueryParams{ctx, filter}
+	for _, e := range mmQuery.expectations {
+		if minimock.Equal(e.params, mmQuery.defaultExpectation.params) {
+			mmQuery.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmQuery.defaultExpectation.params)
+		}
+	}
+
+	return mmQuery
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditService.Query
+func (mmQuery *mAuditServiceMockQuery) ExpectCtxParam1(ctx context.Context) *mAuditServiceMockQuery {
+	if mmQuery.mock.funcQuery != nil {
+		mmQuery.mock.t.Fatalf("AuditServiceMock.Query mock is already set by Set")
+	}
+
+	if mmQuery.defaultExpectation == nil {
+		mmQuery.defaultExpectation = &AuditServiceMockQueryExpectation{}
+	}
+
+	if mmQuery.defaultExpectation.params != nil {
+		mmQuery.mock.t.Fatalf("AuditServiceMock.Query mock is already set by Expect")
+	}
+
+	if mmQuery.defaultExpectation.paramPtrs == nil {
+		mmQuery.defaultExpectation.paramPtrs = &AuditServiceMockQueryParamPtrs{}
+	}
+	mmQuery.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmQuery
+}
+
+// ExpectFilterParam2 sets up expected param filter for AuditService.Query
+func (mmQuery *mAuditServiceMockQuery) ExpectFilterParam2(filter *model.AuditFilter) *mAuditServiceMockQuery {
+	if mmQuery.mock.funcQuery != nil {
+		mmQuery.mock.t.Fatalf("AuditServiceMock.Query mock is already set by Set")
+	}
+
+	if mmQuery.defaultExpectation == nil {
+		mmQuery.defaultExpectation = &AuditServiceMockQueryExpectation{}
+	}
+
+	if mmQuery.defaultExpectation.params != nil {
+		mmQuery.mock.t.Fatalf("AuditServiceMock.Query mock is already set by Expect")
+	}
+
+	if mmQuery.defaultExpectation.paramPtrs == nil {
+		mmQuery.defaultExpectation.paramPtrs = &AuditServiceMockQueryParamPtrs{}
+	}
+	mmQuery.defaultExpectation.paramPtrs.filter = &filter
+
+	return mmQuery
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditService.Query
+func (mmQuery *mAuditServiceMockQuery) Inspect(f func(ctx context.Context, filter *model.AuditFilter)) *mAuditServiceMockQuery {
+	if mmQuery.mock.inspectFuncQuery != nil {
+		mmQuery.mock.t.Fatalf("Inspect function is already set for AuditServiceMock.Query")
+	}
+
+	mmQuery.mock.inspectFuncQuery = f
+
+	return mmQuery
+}
+
+// Return sets up results that will be returned by AuditService.Query
+func (mmQuery *mAuditServiceMockQuery) Return(ap1 *model.AuditPage, err error) *AuditServiceMock {
+	if mmQuery.mock.funcQuery != nil {
+		mmQuery.mock.t.Fatalf("AuditServiceMock.Query mock is already set by Set")
+	}
+
+	if mmQuery.defaultExpectation == nil {
+		mmQuery.defaultExpectation = &AuditServiceMockQueryExpectation{mock: mmQuery.mock}
+	}
+	mmQuery.defaultExpectation.results = &AuditServiceMockQueryResults{ap1, err}
+	return mmQuery.mock
+}
+
+// Set uses given function f to mock the AuditService.Query method
+func (mmQuery *mAuditServiceMockQuery) Set(f func(ctx context.Context, filter *model.AuditFilter) (ap1 *model.AuditPage, err error)) *AuditServiceMock {
+	if mmQuery.defaultExpectation != nil {
+		mmQuery.mock.t.Fatalf("Default expectation is already set for the AuditService.Query method")
+	}
+
+	if len(mmQuery.expectations) > 0 {
+		mmQuery.mock.t.Fatalf("Some expectations are already set for the AuditService.Query method")
+	}
+
+	mmQuery.mock.funcQuery = f
+	return mmQuery.mock
+}
+
+// When sets expectation for the AuditService.Query which will trigger the result defined by the following
+// Then helper
+func (mmQuery *mAuditServiceMockQuery) When(ctx context.Context, filter *model.AuditFilter) *AuditServiceMockQueryExpectation {
+	if mmQuery.mock.funcQuery != nil {
+		mmQuery.mock.t.Fatalf("AuditServiceMock.Query mock is already set by Set")
+	}
+
+	expectation := &AuditServiceMockQueryExpectation{
+		mock:   mmQuery.mock,
+		params: &AuditServiceMockQueryParams{ctx, filter},
+	}
+	mmQuery.expectations = append(mmQuery.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditService.Query return parameters for the expectation previously defined by the When method
+func (e *AuditServiceMockQueryExpectation) Then(ap1 *model.AuditPage, err error) *AuditServiceMock {
+	e.results = &AuditServiceMockQueryResults{ap1, err}
+	return e.mock
+}
+
+// Times sets number of times AuditService.Query should be invoked
+func (mmQuery *mAuditServiceMockQuery) Times(n uint64) *mAuditServiceMockQuery {
+	if n == 0 {
+		mmQuery.mock.t.Fatalf("Times of AuditServiceMock.Query mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmQuery.expectedInvocations, n)
+	return mmQuery
+}
+
+func (mmQuery *mAuditServiceMockQuery) invocationsDone() bool {
+	if len(mmQuery.expectations) == 0 && mmQuery.defaultExpectation == nil && mmQuery.mock.funcQuery == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmQuery.mock.afterQueryCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmQuery.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Query implements service.AuditService
+func (mmQuery *AuditServiceMock) Query(ctx context.Context, filter *model.AuditFilter) (ap1 *model.AuditPage, err error) {
+	mm_atomic.AddUint64(&mmQuery.beforeQueryCounter, 1)
+	defer mm_atomic.AddUint64(&mmQuery.afterQueryCounter, 1)
+
+	if mmQuery.inspectFuncQuery != nil {
+		mmQuery.inspectFuncQuery(ctx, filter)
+	}
+
+	mm_params := AuditServiceMockQueryParams{ctx, filter}
+
+	// Record call args
+	mmQuery.QueryMock.mutex.Lock()
+	mmQuery.QueryMock.callArgs = append(mmQuery.QueryMock.callArgs, &mm_params)
+	mmQuery.QueryMock.mutex.Unlock()
+
+	for _, e := range mmQuery.QueryMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ap1, e.results.err
+		}
+	}
+
+	if mmQuery.QueryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmQuery.QueryMock.defaultExpectation.Counter, 1)
+		mm_want := mmQuery.QueryMock.defaultExpectation.params
+		mm_want_ptrs := mmQuery.QueryMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditServiceMockQueryParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmQuery.t.Errorf("AuditServiceMock.Query got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmQuery.t.Errorf("AuditServiceMock.Query got unexpected parameter filter, want: %#v, got: %#v%s\n", *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmQuery.t.Errorf("AuditServiceMock.Query got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmQuery.QueryMock.defaultExpectation.results
+		if mm_results == nil {
+			mmQuery.t.Fatal("No results are set for the AuditServiceMock.Query")
+		}
+		return (*mm_results).ap1, (*mm_results).err
+	}
+	if mmQuery.funcQuery != nil {
+		return mmQuery.funcQuery(ctx, filter)
+	}
+	mmQuery.t.Fatalf("Unexpected call to AuditServiceMock.Query. %v %v", ctx, filter)
+	return
+}
+
+// QueryAfterCounter returns a count of finished AuditServiceMock.Query invocations
+func (mmQuery *AuditServiceMock) QueryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmQuery.afterQueryCounter)
+}
+
+// QueryBeforeCounter returns a count of AuditServiceMock.Query invocations
+func (mmQuery *AuditServiceMock) QueryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmQuery.beforeQueryCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditServiceMock.Query.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmQuery *mAuditServiceMockQuery) Calls() []*AuditServiceMockQueryParams {
+	mmQuery.mutex.RLock()
+
+	argCopy := make([]*AuditServiceMockQueryParams, len(mmQuery.callArgs))
+	copy(argCopy, mmQuery.callArgs)
+
+	mmQuery.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockQueryDone returns true if the count of the Query invocations corresponds
+// the number of defined expectations
+func (m *AuditServiceMock) MinimockQueryDone() bool {
+	if m.QueryMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.QueryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.QueryMock.invocationsDone()
+}
+
+// MinimockQueryInspect logs each unmet expectation
+func (m *AuditServiceMock) MinimockQueryInspect() {
+	for _, e := range m.QueryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditServiceMock.Query with params: %#v", *e.params)
+		}
+	}
+
+	afterQueryCounter := mm_atomic.LoadUint64(&m.afterQueryCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.QueryMock.defaultExpectation != nil && afterQueryCounter < 1 {
+		if m.QueryMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AuditServiceMock.Query")
+		} else {
+			m.t.Errorf("Expected call to AuditServiceMock.Query with params: %#v", *m.QueryMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcQuery != nil && afterQueryCounter < 1 {
+		m.t.Error("Expected call to AuditServiceMock.Query")
+	}
+
+	if !m.QueryMock.invocationsDone() && afterQueryCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditServiceMock.Query but found %d calls",
+			mm_atomic.LoadUint64(&m.QueryMock.expectedInvocations), afterQueryCounter)
+	}
+}
+
+type mAuditServiceMockRecord struct {
+	optional           bool
+	mock               *AuditServiceMock
+	defaultExpectation *AuditServiceMockRecordExpectation
+	expectations       []*AuditServiceMockRecordExpectation
+
+	callArgs []*AuditServiceMockRecordParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AuditServiceMockRecordExpectation specifies expectation struct of the AuditService.Record
+type AuditServiceMockRecordExpectation struct {
+	mock      *AuditServiceMock
+	params    *AuditServiceMockRecordParams
+	paramPtrs *AuditServiceMockRecordParamPtrs
+	results   *AuditServiceMockRecordResults
+	Counter   uint64
+}
+
+// AuditServiceMockRecordParams contains parameters of the AuditService.Record
+type AuditServiceMockRecordParams struct {
+	ctx   context.Context
+	event *model.AuditEvent
+}
+
+// AuditServiceMockRecordParamPtrs contains pointers to parameters of the AuditService.Record
+type AuditServiceMockRecordParamPtrs struct {
+	ctx   *context.Context
+	event **model.AuditEvent
+}
+
+// AuditServiceMockRecordResults contains results of the AuditService.Record
+type AuditServiceMockRecordResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRecord *mAuditServiceMockRecord) Optional() *mAuditServiceMockRecord {
+	mmRecord.optional = true
+	return mmRecord
+}
+
+// Expect sets up expected params for AuditService.Record
+func (mmRecord *mAuditServiceMockRecord) Expect(ctx context.Context, event *model.AuditEvent) *mAuditServiceMockRecord {
+	if mmRecord.mock.funcRecord != nil {
+		mmRecord.mock.t.Fatalf("AuditServiceMock.Record mock is already set by Set")
+	}
+
+	if mmRecord.defaultExpectation == nil {
+		mmRecord.defaultExpectation = &AuditServiceMockRecordExpectation{}
+	}
+
+	if mmRecord.defaultExpectation.paramPtrs != nil {
+		mmRecord.mock.t.Fatalf("AuditServiceMock.Record mock is already set by ExpectParams functions")
+	}
+
+	mmRecord.defaultExpectation.params = &AuditServiceMockRecordParams{ctx, event}
+	for _, e := range mmRecord.expectations {
+		if minimock.Equal(e.params, mmRecord.defaultExpectation.params) {
+			mmRecord.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRecord.defaultExpectation.params)
+		}
+	}
+
+	return mmRecord
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditService.Record
+func (mmRecord *mAuditServiceMockRecord) ExpectCtxParam1(ctx context.Context) *mAuditServiceMockRecord {
+	if mmRecord.mock.funcRecord != nil {
+		mmRecord.mock.t.Fatalf("AuditServiceMock.Record mock is already set by Set")
+	}
+
+	if mmRecord.defaultExpectation == nil {
+		mmRecord.defaultExpectation = &AuditServiceMockRecordExpectation{}
+	}
+
+	if mmRecord.defaultExpectation.params != nil {
+		mmRecord.mock.t.Fatalf("AuditServiceMock.Record mock is already set by Expect")
+	}
+
+	if mmRecord.defaultExpectation.paramPtrs == nil {
+		mmRecord.defaultExpectation.paramPtrs = &AuditServiceMockRecordParamPtrs{}
+	}
+	mmRecord.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRecord
+}
+
+// ExpectEventParam2 sets up expected param event for AuditService.Record
+func (mmRecord *mAuditServiceMockRecord) ExpectEventParam2(event *model.AuditEvent) *mAuditServiceMockRecord {
+	if mmRecord.mock.funcRecord != nil {
+		mmRecord.mock.t.Fatalf("AuditServiceMock.Record mock is already set by Set")
+	}
+
+	if mmRecord.defaultExpectation == nil {
+		mmRecord.defaultExpectation = &AuditServiceMockRecordExpectation{}
+	}
+
+	if mmRecord.defaultExpectation.params != nil {
+		mmRecord.mock.t.Fatalf("AuditServiceMock.Record mock is already set by Expect")
+	}
+
+	if mmRecord.defaultExpectation.paramPtrs == nil {
+		mmRecord.defaultExpectation.paramPtrs = &AuditServiceMockRecordParamPtrs{}
+	}
+	mmRecord.defaultExpectation.paramPtrs.event = &event
+
+	return mmRecord
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditService.Record
+func (mmRecord *mAuditServiceMockRecord) Inspect(f func(ctx context.Context, event *model.AuditEvent)) *mAuditServiceMockRecord {
+	if mmRecord.mock.inspectFuncRecord != nil {
+		mmRecord.mock.t.Fatalf("Inspect function is already set for AuditServiceMock.Record")
+	}
+
+	mmRecord.mock.inspectFuncRecord = f
+
+	return mmRecord
+}
+
+// Return sets up results that will be returned by AuditService.Record
+func (mmRecord *mAuditServiceMockRecord) Return(err error) *AuditServiceMock {
+	if mmRecord.mock.funcRecord != nil {
+		mmRecord.mock.t.Fatalf("AuditServiceMock.Record mock is already set by Set")
+	}
+
+	if mmRecord.defaultExpectation == nil {
+		mmRecord.defaultExpectation = &AuditServiceMockRecordExpectation{mock: mmRecord.mock}
+	}
+	mmRecord.defaultExpectation.results = &AuditServiceMockRecordResults{err}
+	return mmRecord.mock
+}
+
+// Set uses given function f to mock the AuditService.Record method
+func (mmRecord *mAuditServiceMockRecord) Set(f func(ctx context.Context, event *model.AuditEvent) (err error)) *AuditServiceMock {
+	if mmRecord.defaultExpectation != nil {
+		mmRecord.mock.t.Fatalf("Default expectation is already set for the AuditService.Record method")
+	}
+
+	if len(mmRecord.expectations) > 0 {
+		mmRecord.mock.t.Fatalf("Some expectations are already set for the AuditService.Record method")
+	}
+
+	mmRecord.mock.funcRecord = f
+	return mmRecord.mock
+}
+
+// When sets expectation for the AuditService.Record which will trigger the result defined by the following
+// Then helper
+func (mmRecord *mAuditServiceMockRecord) When(ctx context.Context, event *model.AuditEvent) *AuditServiceMockRecordExpectation {
+	if mmRecord.mock.funcRecord != nil {
+		mmRecord.mock.t.Fatalf("AuditServiceMock.Record mock is already set by Set")
+	}
+
+	expectation := &AuditServiceMockRecordExpectation{
+		mock:   mmRecord.mock,
+		params: &AuditServiceMockRecordParams{ctx, event},
+	}
+	mmRecord.expectations = append(mmRecord.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditService.Record return parameters for the expectation previously defined by the When method
+func (e *AuditServiceMockRecordExpectation) Then(err error) *AuditServiceMock {
+	e.results = &AuditServiceMockRecordResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuditService.Record should be invoked
+func (mmRecord *mAuditServiceMockRecord) Times(n uint64) *mAuditServiceMockRecord {
+	if n == 0 {
+		mmRecord.mock.t.Fatalf("Times of AuditServiceMock.Record mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRecord.expectedInvocations, n)
+	return mmRecord
+}
+
+func (mmRecord *mAuditServiceMockRecord) invocationsDone() bool {
+	if len(mmRecord.expectations) == 0 && mmRecord.defaultExpectation == nil && mmRecord.mock.funcRecord == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRecord.mock.afterRecordCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRecord.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Record implements service.AuditService
+func (mmRecord *AuditServiceMock) Record(ctx context.Context, event *model.AuditEvent) (err error) {
+	mm_atomic.AddUint64(&mmRecord.beforeRecordCounter, 1)
+	defer mm_atomic.AddUint64(&mmRecord.afterRecordCounter, 1)
+
+	if mmRecord.inspectFuncRecord != nil {
+		mmRecord.inspectFuncRecord(ctx, event)
+	}
+
+	mm_params := AuditServiceMockRecordParams{ctx, event}
+
+	// Record call args
+	mmRecord.RecordMock.mutex.Lock()
+	mmRecord.RecordMock.callArgs = append(mmRecord.RecordMock.callArgs, &mm_params)
+	mmRecord.RecordMock.mutex.Unlock()
+
+	for _, e := range mmRecord.RecordMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRecord.RecordMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRecord.RecordMock.defaultExpectation.Counter, 1)
+		mm_want := mmRecord.RecordMock.defaultExpectation.params
+		mm_want_ptrs := mmRecord.RecordMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditServiceMockRecordParams{ctx, event}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRecord.t.Errorf("AuditServiceMock.Record got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.event != nil && !minimock.Equal(*mm_want_ptrs.event, mm_got.event) {
+				mmRecord.t.Errorf("AuditServiceMock.Record got unexpected parameter event, want: %#v, got: %#v%s\n", *mm_want_ptrs.event, mm_got.event, minimock.Diff(*mm_want_ptrs.event, mm_got.event))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRecord.t.Errorf("AuditServiceMock.Record got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRecord.RecordMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRecord.t.Fatal("No results are set for the AuditServiceMock.Record")
+		}
+		return (*mm_results).err
+	}
+	if mmRecord.funcRecord != nil {
+		return mmRecord.funcRecord(ctx, event)
+	}
+	mmRecord.t.Fatalf("Unexpected call to AuditServiceMock.Record. %v %v", ctx, event)
+	return
+}
+
+// RecordAfterCounter returns a count of finished AuditServiceMock.Record invocations
+func (mmRecord *AuditServiceMock) RecordAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecord.afterRecordCounter)
+}
+
+// RecordBeforeCounter returns a count of AuditServiceMock.Record invocations
+func (mmRecord *AuditServiceMock) RecordBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecord.beforeRecordCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditServiceMock.Record.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRecord *mAuditServiceMockRecord) Calls() []*AuditServiceMockRecordParams {
+	mmRecord.mutex.RLock()
+
+	argCopy := make([]*AuditServiceMockRecordParams, len(mmRecord.callArgs))
+	copy(argCopy, mmRecord.callArgs)
+
+	mmRecord.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRecordDone returns true if the count of the Record invocations corresponds
+// the number of defined expectations
+func (m *AuditServiceMock) MinimockRecordDone() bool {
+	if m.RecordMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RecordMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RecordMock.invocationsDone()
+}
+
+// MinimockRecordInspect logs each unmet expectation
+func (m *AuditServiceMock) MinimockRecordInspect() {
+	for _, e := range m.RecordMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditServiceMock.Record with params: %#v", *e.params)
+		}
+	}
+
+	afterRecordCounter := mm_atomic.LoadUint64(&m.afterRecordCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RecordMock.defaultExpectation != nil && afterRecordCounter < 1 {
+		if m.RecordMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AuditServiceMock.Record")
+		} else {
+			m.t.Errorf("Expected call to AuditServiceMock.Record with params: %#v", *m.RecordMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRecord != nil && afterRecordCounter < 1 {
+		m.t.Error("Expected call to AuditServiceMock.Record")
+	}
+
+	if !m.RecordMock.invocationsDone() && afterRecordCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditServiceMock.Record but found %d calls",
+			mm_atomic.LoadUint64(&m.RecordMock.expectedInvocations), afterRecordCounter)
+	}
+}
+
+type mAuditServiceMockVerify struct {
+	optional           bool
+	mock               *AuditServiceMock
+	defaultExpectation *AuditServiceMockVerifyExpectation
+	expectations       []*AuditServiceMockVerifyExpectation
+
+	callArgs []*AuditServiceMockVerifyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AuditServiceMockVerifyExpectation specifies expectation struct of the AuditService.Verify
+type AuditServiceMockVerifyExpectation struct {
+	mock      *AuditServiceMock
+	params    *AuditServiceMockVerifyParams
+	paramPtrs *AuditServiceMockVerifyParamPtrs
+	results   *AuditServiceMockVerifyResults
+	Counter   uint64
+}
+
+// AuditServiceMockVerifyParams contains parameters of the AuditService.Verify
+type AuditServiceMockVerifyParams struct {
+	ctx context.Context
+}
+
+// AuditServiceMockVerifyParamPtrs contains pointers to parameters of the AuditService.Verify
+type AuditServiceMockVerifyParamPtrs struct {
+	ctx *context.Context
+}
+
+// AuditServiceMockVerifyResults contains results of the AuditService.Verify
+type AuditServiceMockVerifyResults struct {
+	ap1 *model.AuditVerification
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmVerify *mAuditServiceMockVerify) Optional() *mAuditServiceMockVerify {
+	mmVerify.optional = true
+	return mmVerify
+}
+
+// Expect sets up expected params for AuditService.Verify
+func (mmVerify *mAuditServiceMockVerify) Expect(ctx context.Context) *mAuditServiceMockVerify {
+	if mmVerify.mock.funcVerify != nil {
+		mmVerify.mock.t.Fatalf("AuditServiceMock.Verify mock is already set by Set")
+	}
+
+	if mmVerify.defaultExpectation == nil {
+		mmVerify.defaultExpectation = &AuditServiceMockVerifyExpectation{}
+	}
+
+	if mmVerify.defaultExpectation.paramPtrs != nil {
+		mmVerify.mock.t.Fatalf("AuditServiceMock.Verify mock is already set by ExpectParams functions")
+	}
+
+	mmVerify.defaultExpectation.params = &AuditServiceMockVerifyParams{ctx}
+	for _, e := range mmVerify.expectations {
+		if minimock.Equal(e.params, mmVerify.defaultExpectation.params) {
+			mmVerify.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmVerify.defaultExpectation.params)
+		}
+	}
+
+	return mmVerify
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditService.Verify
+func (mmVerify *mAuditServiceMockVerify) ExpectCtxParam1(ctx context.Context) *mAuditServiceMockVerify {
+	if mmVerify.mock.funcVerify != nil {
+		mmVerify.mock.t.Fatalf("AuditServiceMock.Verify mock is already set by Set")
+	}
+
+	if mmVerify.defaultExpectation == nil {
+		mmVerify.defaultExpectation = &AuditServiceMockVerifyExpectation{}
+	}
+
+	if mmVerify.defaultExpectation.params != nil {
+		mmVerify.mock.t.Fatalf("AuditServiceMock.Verify mock is already set by Expect")
+	}
+
+	if mmVerify.defaultExpectation.paramPtrs == nil {
+		mmVerify.defaultExpectation.paramPtrs = &AuditServiceMockVerifyParamPtrs{}
+	}
+	mmVerify.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmVerify
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditService.Verify
+func (mmVerify *mAuditServiceMockVerify) Inspect(f func(ctx context.Context)) *mAuditServiceMockVerify {
+	if mmVerify.mock.inspectFuncVerify != nil {
+		mmVerify.mock.t.Fatalf("Inspect function is already set for AuditServiceMock.Verify")
+	}
+
+	mmVerify.mock.inspectFuncVerify = f
+
+	return mmVerify
+}
+
+// Return sets up results that will be returned by AuditService.Verify
+func (mmVerify *mAuditServiceMockVerify) Return(ap1 *model.AuditVerification, err error) *AuditServiceMock {
+	if mmVerify.mock.funcVerify != nil {
+		mmVerify.mock.t.Fatalf("AuditServiceMock.Verify mock is already set by Set")
+	}
+
+	if mmVerify.defaultExpectation == nil {
+		mmVerify.defaultExpectation = &AuditServiceMockVerifyExpectation{mock: mmVerify.mock}
+	}
+	mmVerify.defaultExpectation.results = &AuditServiceMockVerifyResults{ap1, err}
+	return mmVerify.mock
+}
+
+// Set uses given function f to mock the AuditService.Verify method
+func (mmVerify *mAuditServiceMockVerify) Set(f func(ctx context.Context) (ap1 *model.AuditVerification, err error)) *AuditServiceMock {
+	if mmVerify.defaultExpectation != nil {
+		mmVerify.mock.t.Fatalf("Default expectation is already set for the AuditService.Verify method")
+	}
+
+	if len(mmVerify.expectations) > 0 {
+		mmVerify.mock.t.Fatalf("Some expectations are already set for the AuditService.Verify method")
+	}
+
+	mmVerify.mock.funcVerify = f
+	return mmVerify.mock
+}
+
+// When sets expectation for the AuditService.Verify which will trigger the result defined by the following
+// Then helper
+func (mmVerify *mAuditServiceMockVerify) When(ctx context.Context) *AuditServiceMockVerifyExpectation {
+	if mmVerify.mock.funcVerify != nil {
+		mmVerify.mock.t.Fatalf("AuditServiceMock.Verify mock is already set by Set")
+	}
+
+	expectation := &AuditServiceMockVerifyExpectation{
+		mock:   mmVerify.mock,
+		params: &AuditServiceMockVerifyParams{ctx},
+	}
+	mmVerify.expectations = append(mmVerify.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditService.Verify return parameters for the expectation previously defined by the When method
+func (e *AuditServiceMockVerifyExpectation) Then(ap1 *model.AuditVerification, err error) *AuditServiceMock {
+	e.results = &AuditServiceMockVerifyResults{ap1, err}
+	return e.mock
+}
+
+// Times sets number of times AuditService.Verify should be invoked
+func (mmVerify *mAuditServiceMockVerify) Times(n uint64) *mAuditServiceMockVerify {
+	if n == 0 {
+		mmVerify.mock.t.Fatalf("Times of AuditServiceMock.Verify mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmVerify.expectedInvocations, n)
+	return mmVerify
+}
+
+func (mmVerify *mAuditServiceMockVerify) invocationsDone() bool {
+	if len(mmVerify.expectations) == 0 && mmVerify.defaultExpectation == nil && mmVerify.mock.funcVerify == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmVerify.mock.afterVerifyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmVerify.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Verify implements service.AuditService
+func (mmVerify *AuditServiceMock) Verify(ctx context.Context) (ap1 *model.AuditVerification, err error) {
+	mm_atomic.AddUint64(&mmVerify.beforeVerifyCounter, 1)
+	defer mm_atomic.AddUint64(&mmVerify.afterVerifyCounter, 1)
+
+	if mmVerify.inspectFuncVerify != nil {
+		mmVerify.inspectFuncVerify(ctx)
+	}
+
+	mm_params := AuditServiceMockVerifyParams{ctx}
+
+	// Record call args
+	mmVerify.VerifyMock.mutex.Lock()
+	mmVerify.VerifyMock.callArgs = append(mmVerify.VerifyMock.callArgs, &mm_params)
+	mmVerify.VerifyMock.mutex.Unlock()
+
+	for _, e := range mmVerify.VerifyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ap1, e.results.err
+		}
+	}
+
+	if mmVerify.VerifyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmVerify.VerifyMock.defaultExpectation.Counter, 1)
+		mm_want := mmVerify.VerifyMock.defaultExpectation.params
+		mm_want_ptrs := mmVerify.VerifyMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditServiceMockVerifyParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmVerify.t.Errorf("AuditServiceMock.Verify got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmVerify.t.Errorf("AuditServiceMock.Verify got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmVerify.VerifyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmVerify.t.Fatal("No results are set for the AuditServiceMock.Verify")
+		}
+		return (*mm_results).ap1, (*mm_results).err
+	}
+	if mmVerify.funcVerify != nil {
+		return mmVerify.funcVerify(ctx)
+	}
+	mmVerify.t.Fatalf("Unexpected call to AuditServiceMock.Verify. %v", ctx)
+	return
+}
+
+// VerifyAfterCounter returns a count of finished AuditServiceMock.Verify invocations
+func (mmVerify *AuditServiceMock) VerifyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmVerify.afterVerifyCounter)
+}
+
+// VerifyBeforeCounter returns a count of AuditServiceMock.Verify invocations
+func (mmVerify *AuditServiceMock) VerifyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmVerify.beforeVerifyCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditServiceMock.Verify.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmVerify *mAuditServiceMockVerify) Calls() []*AuditServiceMockVerifyParams {
+	mmVerify.mutex.RLock()
+
+	argCopy := make([]*AuditServiceMockVerifyParams, len(mmVerify.callArgs))
+	copy(argCopy, mmVerify.callArgs)
+
+	mmVerify.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockVerifyDone returns true if the count of the Verify invocations corresponds
+// the number of defined expectations
+func (m *AuditServiceMock) MinimockVerifyDone() bool {
+	if m.VerifyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.VerifyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.VerifyMock.invocationsDone()
+}
+
+// MinimockVerifyInspect logs each unmet expectation
+func (m *AuditServiceMock) MinimockVerifyInspect() {
+	for _, e := range m.VerifyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditServiceMock.Verify with params: %#v", *e.params)
+		}
+	}
+
+	afterVerifyCounter := mm_atomic.LoadUint64(&m.afterVerifyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.VerifyMock.defaultExpectation != nil && afterVerifyCounter < 1 {
+		if m.VerifyMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AuditServiceMock.Verify")
+		} else {
+			m.t.Errorf("Expected call to AuditServiceMock.Verify with params: %#v", *m.VerifyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcVerify != nil && afterVerifyCounter < 1 {
+		m.t.Error("Expected call to AuditServiceMock.Verify")
+	}
+
+	if !m.VerifyMock.invocationsDone() && afterVerifyCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditServiceMock.Verify but found %d calls",
+			mm_atomic.LoadUint64(&m.VerifyMock.expectedInvocations), afterVerifyCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AuditServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockExportInspect()
+
+			m.MinimockQueryInspect()
+
+			m.MinimockRecordInspect()
+
+			m.MinimockVerifyInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AuditServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AuditServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockExportDone() &&
+		m.MinimockQueryDone() &&
+		m.MinimockRecordDone() &&
+		m.MinimockVerifyDone()
+}
@@ -0,0 +1,1003 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.13). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i di_container/internal/service.QuotaService -o quota_service_minimock.go -n QuotaServiceMock -p mocks
+
+import (
+	"context"
+	"di_container/internal/model"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// QuotaServiceMock implements service.QuotaService
+type QuotaServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcConsume          func(ctx context.Context, delta *model.Usage) (err error)
+	inspectFuncConsume   func(ctx context.Context, delta *model.Usage)
+	afterConsumeCounter  uint64
+	beforeConsumeCounter uint64
+	ConsumeMock          mQuotaServiceMockConsume
+
+	funcCountRequest          func(ctx context.Context) (err error)
+	inspectFuncCountRequest   func(ctx context.Context)
+	afterCountRequestCounter  uint64
+	beforeCountRequestCounter uint64
+	CountRequestMock          mQuotaServiceMockCountRequest
+
+	funcGetUsage          func(ctx context.Context) (up1 *model.UsageReport, err error)
+	inspectFuncGetUsage   func(ctx context.Context)
+	afterGetUsageCounter  uint64
+	beforeGetUsageCounter uint64
+	GetUsageMock          mQuotaServiceMockGetUsage
+}
+
+// NewQuotaServiceMock returns a mock for service.QuotaService
+func NewQuotaServiceMock(t minimock.Tester) *QuotaServiceMock {
+	m := &QuotaServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ConsumeMock = mQuotaServiceMockConsume{mock: m}
+	m.ConsumeMock.callArgs = []*QuotaServiceMockConsumeParams{}
+
+	m.CountRequestMock = mQuotaServiceMockCountRequest{mock: m}
+	m.CountRequestMock.callArgs = []*QuotaServiceMockCountRequestParams{}
+
+	m.GetUsageMock = mQuotaServiceMockGetUsage{mock: m}
+	m.GetUsageMock.callArgs = []*QuotaServiceMockGetUsageParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mQuotaServiceMockConsume struct {
+	optional           bool
+	mock               *QuotaServiceMock
+	defaultExpectation *QuotaServiceMockConsumeExpectation
+	expectations       []*QuotaServiceMockConsumeExpectation
+
+	callArgs []*QuotaServiceMockConsumeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// QuotaServiceMockConsumeExpectation specifies expectation struct of the QuotaService.Consume
+type QuotaServiceMockConsumeExpectation struct {
+	mock      *QuotaServiceMock
+	params    *QuotaServiceMockConsumeParams
+	paramPtrs *QuotaServiceMockConsumeParamPtrs
+	results   *QuotaServiceMockConsumeResults
+	Counter   uint64
+}
+
+// QuotaServiceMockConsumeParams contains parameters of the QuotaService.Consume
+type QuotaServiceMockConsumeParams struct {
+	ctx   context.Context
+	delta *model.Usage
+}
+
+// QuotaServiceMockConsumeParamPtrs contains pointers to parameters of the QuotaService.Consume
+type QuotaServiceMockConsumeParamPtrs struct {
+	ctx   *context.Context
+	delta **model.Usage
+}
+
+// QuotaServiceMockConsumeResults contains results of the QuotaService.Consume
+type QuotaServiceMockConsumeResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmConsume *mQuotaServiceMockConsume) Optional() *mQuotaServiceMockConsume {
+	mmConsume.optional = true
+	return mmConsume
+}
+
+// Expect sets up expected params for QuotaService.Consume
+func (mmConsume *mQuotaServiceMockConsume) Expect(ctx context.Context, delta *model.Usage) *mQuotaServiceMockConsume {
+	if mmConsume.mock.funcConsume != nil {
+		mmConsume.mock.t.Fatalf("QuotaServiceMock.Consume mock is already set by Set")
+	}
+
+	if mmConsume.defaultExpectation == nil {
+		mmConsume.defaultExpectation = &QuotaServiceMockConsumeExpectation{}
+	}
+
+	if mmConsume.defaultExpectation.paramPtrs != nil {
+		mmConsume.mock.t.Fatalf("QuotaServiceMock.Consume mock is already set by ExpectParams functions")
+	}
+
+	mmConsume.defaultExpectation.params = &QuotaServiceMockConsumeParams{ctx, delta}
+	for _, e := range mmConsume.expectations {
+		if minimock.Equal(e.params, mmConsume.defaultExpectation.params) {
+			mmConsume.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmConsume.defaultExpectation.params)
+		}
+	}
+
+	return mmConsume
+}
+
+// ExpectCtxParam1 sets up expected param ctx for QuotaService.Consume
+func (mmConsume *mQuotaServiceMockConsume) ExpectCtxParam1(ctx context.Context) *mQuotaServiceMockConsume {
+	if mmConsume.mock.funcConsume != nil {
+		mmConsume.mock.t.Fatalf("QuotaServiceMock.Consume mock is already set by Set")
+	}
+
+	if mmConsume.defaultExpectation == nil {
+		mmConsume.defaultExpectation = &QuotaServiceMockConsumeExpectation{}
+	}
+
+	if mmConsume.defaultExpectation.params != nil {
+		mmConsume.mock.t.Fatalf("QuotaServiceMock.Consume mock is already set by Expect")
+	}
+
+	if mmConsume.defaultExpectation.paramPtrs == nil {
+		mmConsume.defaultExpectation.paramPtrs = &QuotaServiceMockConsumeParamPtrs{}
+	}
+	mmConsume.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmConsume
+}
+
+// ExpectDeltaParam2 sets up expected param delta for QuotaService.Consume
+func (mmConsume *mQuotaServiceMockConsume) ExpectDeltaParam2(delta *model.Usage) *mQuotaServiceMockConsume {
+	if mmConsume.mock.funcConsume != nil {
+		mmConsume.mock.t.Fatalf("QuotaServiceMock.Consume mock is already set by Set")
+	}
+
+	if mmConsume.defaultExpectation == nil {
+		mmConsume.defaultExpectation = &QuotaServiceMockConsumeExpectation{}
+	}
+
+	if mmConsume.defaultExpectation.params != nil {
+		mmConsume.mock.t.Fatalf("QuotaServiceMock.Consume mock is already set by Expect")
+	}
+
+	if mmConsume.defaultExpectation.paramPtrs == nil {
+		mmConsume.defaultExpectation.paramPtrs = &QuotaServiceMockConsumeParamPtrs{}
+	}
+	mmConsume.defaultExpectation.paramPtrs.delta = &delta
+
+	return mmConsume
+}
+
+// Inspect accepts an inspector function that has same arguments as the QuotaService.Consume
+func (mmConsume *mQuotaServiceMockConsume) Inspect(f func(ctx context.Context, delta *model.Usage)) *mQuotaServiceMockConsume {
+	if mmConsume.mock.inspectFuncConsume != nil {
+		mmConsume.mock.t.Fatalf("Inspect function is already set for QuotaServiceMock.Consume")
+	}
+
+	mmConsume.mock.inspectFuncConsume = f
+
+	return mmConsume
+}
+
+// Return sets up results that will be returned by QuotaService.Consume
+func (mmConsume *mQuotaServiceMockConsume) Return(err error) *QuotaServiceMock {
+	if mmConsume.mock.funcConsume != nil {
+		mmConsume.mock.t.Fatalf("QuotaServiceMock.Consume mock is already set by Set")
+	}
+
+	if mmConsume.defaultExpectation == nil {
+		mmConsume.defaultExpectation = &QuotaServiceMockConsumeExpectation{mock: mmConsume.mock}
+	}
+	mmConsume.defaultExpectation.results = &QuotaServiceMockConsumeResults{err}
+	return mmConsume.mock
+}
+
+// Set uses given function f to mock the QuotaService.Consume method
+func (mmConsume *mQuotaServiceMockConsume) Set(f func(ctx context.Context, delta *model.Usage) (err error)) *QuotaServiceMock {
+	if mmConsume.defaultExpectation != nil {
+		mmConsume.mock.t.Fatalf("Default expectation is already set for the QuotaService.Consume method")
+	}
+
+	if len(mmConsume.expectations) > 0 {
+		mmConsume.mock.t.Fatalf("Some expectations are already set for the QuotaService.Consume method")
+	}
+
+	mmConsume.mock.funcConsume = f
+	return mmConsume.mock
+}
+
+// When sets expectation for the QuotaService.Consume which will trigger the result defined by the following
+// Then helper
+func (mmConsume *mQuotaServiceMockConsume) When(ctx context.Context, delta *model.Usage) *QuotaServiceMockConsumeExpectation {
+	if mmConsume.mock.funcConsume != nil {
+		mmConsume.mock.t.Fatalf("QuotaServiceMock.Consume mock is already set by Set")
+	}
+
+	expectation := &QuotaServiceMockConsumeExpectation{
+		mock:   mmConsume.mock,
+		params: &QuotaServiceMockConsumeParams{ctx, delta},
+	}
+	mmConsume.expectations = append(mmConsume.expectations, expectation)
+	return expectation
+}
+
+// Then sets up QuotaService.Consume return parameters for the expectation previously defined by the When method
+func (e *QuotaServiceMockConsumeExpectation) Then(err error) *QuotaServiceMock {
+	e.results = &QuotaServiceMockConsumeResults{err}
+	return e.mock
+}
+
+// Times sets number of times QuotaService.Consume should be invoked
+func (mmConsume *mQuotaServiceMockConsume) Times(n uint64) *mQuotaServiceMockConsume {
+	if n == 0 {
+		mmConsume.mock.t.Fatalf("Times of QuotaServiceMock.Consume mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmConsume.expectedInvocations, n)
+	return mmConsume
+}
+
+func (mmConsume *mQuotaServiceMockConsume) invocationsDone() bool {
+	if len(mmConsume.expectations) == 0 && mmConsume.defaultExpectation == nil && mmConsume.mock.funcConsume == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmConsume.mock.afterConsumeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmConsume.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Consume implements service.QuotaService
+func (mmConsume *QuotaServiceMock) Consume(ctx context.Context, delta *model.Usage) (err error) {
+	mm_atomic.AddUint64(&mmConsume.beforeConsumeCounter, 1)
+	defer mm_atomic.AddUint64(&mmConsume.afterConsumeCounter, 1)
+
+	if mmConsume.inspectFuncConsume != nil {
+		mmConsume.inspectFuncConsume(ctx, delta)
+	}
+
+	mm_params := QuotaServiceMockConsumeParams{ctx, delta}
+
+	// Record call args
+	mmConsume.ConsumeMock.mutex.Lock()
+	mmConsume.ConsumeMock.callArgs = append(mmConsume.ConsumeMock.callArgs, &mm_params)
+	mmConsume.ConsumeMock.mutex.Unlock()
+
+	for _, e := range mmConsume.ConsumeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmConsume.ConsumeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmConsume.ConsumeMock.defaultExpectation.Counter, 1)
+		mm_want := mmConsume.ConsumeMock.defaultExpectation.params
+		mm_want_ptrs := mmConsume.ConsumeMock.defaultExpectation.paramPtrs
+
+		mm_got := QuotaServiceMockConsumeParams{ctx, delta}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmConsume.t.Errorf("QuotaServiceMock.Consume got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.delta != nil && !minimock.Equal(*mm_want_ptrs.delta, mm_got.delta) {
+				mmConsume.t.Errorf("QuotaServiceMock.Consume got unexpected parameter delta, want: %#v, got: %#v%s\n", *mm_want_ptrs.delta, mm_got.delta, minimock.Diff(*mm_want_ptrs.delta, mm_got.delta))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmConsume.t.Errorf("QuotaServiceMock.Consume got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmConsume.ConsumeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmConsume.t.Fatal("No results are set for the QuotaServiceMock.Consume")
+		}
+		return (*mm_results).err
+	}
+	if mmConsume.funcConsume != nil {
+		return mmConsume.funcConsume(ctx, delta)
+	}
+	mmConsume.t.Fatalf("Unexpected call to QuotaServiceMock.Consume. %v %v", ctx, delta)
+	return
+}
+
+// ConsumeAfterCounter returns a count of finished QuotaServiceMock.Consume invocations
+func (mmConsume *QuotaServiceMock) ConsumeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConsume.afterConsumeCounter)
+}
+
+// ConsumeBeforeCounter returns a count of QuotaServiceMock.Consume invocations
+func (mmConsume *QuotaServiceMock) ConsumeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConsume.beforeConsumeCounter)
+}
+
+// Calls returns a list of arguments used in each call to QuotaServiceMock.Consume.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmConsume *mQuotaServiceMockConsume) Calls() []*QuotaServiceMockConsumeParams {
+	mmConsume.mutex.RLock()
+
+	argCopy := make([]*QuotaServiceMockConsumeParams, len(mmConsume.callArgs))
+	copy(argCopy, mmConsume.callArgs)
+
+	mmConsume.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockConsumeDone returns true if the count of the Consume invocations corresponds
+// the number of defined expectations
+func (m *QuotaServiceMock) MinimockConsumeDone() bool {
+	if m.ConsumeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ConsumeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ConsumeMock.invocationsDone()
+}
+
+// MinimockConsumeInspect logs each unmet expectation
+func (m *QuotaServiceMock) MinimockConsumeInspect() {
+	for _, e := range m.ConsumeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to QuotaServiceMock.Consume with params: %#v", *e.params)
+		}
+	}
+
+	afterConsumeCounter := mm_atomic.LoadUint64(&m.afterConsumeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ConsumeMock.defaultExpectation != nil && afterConsumeCounter < 1 {
+		if m.ConsumeMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to QuotaServiceMock.Consume")
+		} else {
+			m.t.Errorf("Expected call to QuotaServiceMock.Consume with params: %#v", *m.ConsumeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcConsume != nil && afterConsumeCounter < 1 {
+		m.t.Error("Expected call to QuotaServiceMock.Consume")
+	}
+
+	if !m.ConsumeMock.invocationsDone() && afterConsumeCounter > 0 {
+		m.t.Errorf("Expected %d calls to QuotaServiceMock.Consume but found %d calls",
+			mm_atomic.LoadUint64(&m.ConsumeMock.expectedInvocations), afterConsumeCounter)
+	}
+}
+
+type mQuotaServiceMockCountRequest struct {
+	optional           bool
+	mock               *QuotaServiceMock
+	defaultExpectation *QuotaServiceMockCountRequestExpectation
+	expectations       []*QuotaServiceMockCountRequestExpectation
+
+	callArgs []*QuotaServiceMockCountRequestParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// QuotaServiceMockCountRequestExpectation specifies expectation struct of the QuotaService.CountRequest
+type QuotaServiceMockCountRequestExpectation struct {
+	mock      *QuotaServiceMock
+	params    *QuotaServiceMockCountRequestParams
+	paramPtrs *QuotaServiceMockCountRequestParamPtrs
+	results   *QuotaServiceMockCountRequestResults
+	Counter   uint64
+}
+
+// QuotaServiceMockCountRequestParams contains parameters of the QuotaService.CountRequest
+type QuotaServiceMockCountRequestParams struct {
+	ctx context.Context
+}
+
+// QuotaServiceMockCountRequestParamPtrs contains pointers to parameters of the QuotaService.CountRequest
+type QuotaServiceMockCountRequestParamPtrs struct {
+	ctx *context.Context
+}
+
+// QuotaServiceMockCountRequestResults contains results of the QuotaService.CountRequest
+type QuotaServiceMockCountRequestResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCountRequest *mQuotaServiceMockCountRequest) Optional() *mQuotaServiceMockCountRequest {
+	mmCountRequest.optional = true
+	return mmCountRequest
+}
+
+// Expect sets up expected params for QuotaService.CountRequest
+func (mmCountRequest *mQuotaServiceMockCountRequest) Expect(ctx context.Context) *mQuotaServiceMockCountRequest {
+	if mmCountRequest.mock.funcCountRequest != nil {
+		mmCountRequest.mock.t.Fatalf("QuotaServiceMock.CountRequest mock is already set by Set")
+	}
+
+	if mmCountRequest.defaultExpectation == nil {
+		mmCountRequest.defaultExpectation = &QuotaServiceMockCountRequestExpectation{}
+	}
+
+	if mmCountRequest.defaultExpectation.paramPtrs != nil {
+		mmCountRequest.mock.t.Fatalf("QuotaServiceMock.CountRequest mock is already set by ExpectParams functions")
+	}
+
+	mmCountRequest.defaultExpectation.params = &QuotaServiceMockCountRequestParams{ctx}
+	for _, e := range mmCountRequest.expectations {
+		if minimock.Equal(e.params, mmCountRequest.defaultExpectation.params) {
+			mmCountRequest.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCountRequest.defaultExpectation.params)
+		}
+	}
+
+	return mmCountRequest
+}
+
+// ExpectCtxParam1 sets up expected param ctx for QuotaService.CountRequest
+func (mmCountRequest *mQuotaServiceMockCountRequest) ExpectCtxParam1(ctx context.Context) *mQuotaServiceMockCountRequest {
+	if mmCountRequest.mock.funcCountRequest != nil {
+		mmCountRequest.mock.t.Fatalf("QuotaServiceMock.CountRequest mock is already set by Set")
+	}
+
+	if mmCountRequest.defaultExpectation == nil {
+		mmCountRequest.defaultExpectation = &QuotaServiceMockCountRequestExpectation{}
+	}
+
+	if mmCountRequest.defaultExpectation.params != nil {
+		mmCountRequest.mock.t.Fatalf("QuotaServiceMock.CountRequest mock is already set by Expect")
+	}
+
+	if mmCountRequest.defaultExpectation.paramPtrs == nil {
+		mmCountRequest.defaultExpectation.paramPtrs = &QuotaServiceMockCountRequestParamPtrs{}
+	}
+	mmCountRequest.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCountRequest
+}
+
+// Inspect accepts an inspector function that has same arguments as the QuotaService.CountRequest
+func (mmCountRequest *mQuotaServiceMockCountRequest) Inspect(f func(ctx context.Context)) *mQuotaServiceMockCountRequest {
+	if mmCountRequest.mock.inspectFuncCountRequest != nil {
+		mmCountRequest.mock.t.Fatalf("Inspect function is already set for QuotaServiceMock.CountRequest")
+	}
+
+	mmCountRequest.mock.inspectFuncCountRequest = f
+
+	return mmCountRequest
+}
+
+// Return sets up results that will be returned by QuotaService.CountRequest
+func (mmCountRequest *mQuotaServiceMockCountRequest) Return(err error) *QuotaServiceMock {
+	if mmCountRequest.mock.funcCountRequest != nil {
+		mmCountRequest.mock.t.Fatalf("QuotaServiceMock.CountRequest mock is already set by Set")
+	}
+
+	if mmCountRequest.defaultExpectation == nil {
+		mmCountRequest.defaultExpectation = &QuotaServiceMockCountRequestExpectation{mock: mmCountRequest.mock}
+	}
+	mmCountRequest.defaultExpectation.results = &QuotaServiceMockCountRequestResults{err}
+	return mmCountRequest.mock
+}
+
+// Set uses given function f to mock the QuotaService.CountRequest method
+func (mmCountRequest *mQuotaServiceMockCountRequest) Set(f func(ctx context.Context) (err error)) *QuotaServiceMock {
+	if mmCountRequest.defaultExpectation != nil {
+		mmCountRequest.mock.t.Fatalf("Default expectation is already set for the QuotaService.CountRequest method")
+	}
+
+	if len(mmCountRequest.expectations) > 0 {
+		mmCountRequest.mock.t.Fatalf("Some expectations are already set for the QuotaService.CountRequest method")
+	}
+
+	mmCountRequest.mock.funcCountRequest = f
+	return mmCountRequest.mock
+}
+
+// When sets expectation for the QuotaService.CountRequest which will trigger the result defined by the following
+// Then helper
+func (mmCountRequest *mQuotaServiceMockCountRequest) When(ctx context.Context) *QuotaServiceMockCountRequestExpectation {
+	if mmCountRequest.mock.funcCountRequest != nil {
+		mmCountRequest.mock.t.Fatalf("QuotaServiceMock.CountRequest mock is already set by Set")
+	}
+
+	expectation := &QuotaServiceMockCountRequestExpectation{
+		mock:   mmCountRequest.mock,
+		params: &QuotaServiceMockCountRequestParams{ctx},
+	}
+	mmCountRequest.expectations = append(mmCountRequest.expectations, expectation)
+	return expectation
+}
+
+// Then sets up QuotaService.CountRequest return parameters for the expectation previously defined by the When method
+func (e *QuotaServiceMockCountRequestExpectation) Then(err error) *QuotaServiceMock {
+	e.results = &QuotaServiceMockCountRequestResults{err}
+	return e.mock
+}
+
+// Times sets number of times QuotaService.CountRequest should be invoked
+func (mmCountRequest *mQuotaServiceMockCountRequest) Times(n uint64) *mQuotaServiceMockCountRequest {
+	if n == 0 {
+		mmCountRequest.mock.t.Fatalf("Times of QuotaServiceMock.CountRequest mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCountRequest.expectedInvocations, n)
+	return mmCountRequest
+}
+
+func (mmCountRequest *mQuotaServiceMockCountRequest) invocationsDone() bool {
+	if len(mmCountRequest.expectations) == 0 && mmCountRequest.defaultExpectation == nil && mmCountRequest.mock.funcCountRequest == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCountRequest.mock.afterCountRequestCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCountRequest.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CountRequest implements service.QuotaService
+func (mmCountRequest *QuotaServiceMock) CountRequest(ctx context.Context) (err error) {
+	mm_atomic.AddUint64(&mmCountRequest.beforeCountRequestCounter, 1)
+	defer mm_atomic.AddUint64(&mmCountRequest.afterCountRequestCounter, 1)
+
+	if mmCountRequest.inspectFuncCountRequest != nil {
+		mmCountRequest.inspectFuncCountRequest(ctx)
+	}
+
+	mm_params := QuotaServiceMockCountRequestParams{ctx}
+
+	// Record call args
+	mmCountRequest.CountRequestMock.mutex.Lock()
+	mmCountRequest.CountRequestMock.callArgs = append(mmCountRequest.CountRequestMock.callArgs, &mm_params)
+	mmCountRequest.CountRequestMock.mutex.Unlock()
+
+	for _, e := range mmCountRequest.CountRequestMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCountRequest.CountRequestMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCountRequest.CountRequestMock.defaultExpectation.Counter, 1)
+		mm_want := mmCountRequest.CountRequestMock.defaultExpectation.params
+		mm_want_ptrs := mmCountRequest.CountRequestMock.defaultExpectation.paramPtrs
+
+		mm_got := QuotaServiceMockCountRequestParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCountRequest.t.Errorf("QuotaServiceMock.CountRequest got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCountRequest.t.Errorf("QuotaServiceMock.CountRequest got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCountRequest.CountRequestMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCountRequest.t.Fatal("No results are set for the QuotaServiceMock.CountRequest")
+		}
+		return (*mm_results).err
+	}
+	if mmCountRequest.funcCountRequest != nil {
+		return mmCountRequest.funcCountRequest(ctx)
+	}
+	mmCountRequest.t.Fatalf("Unexpected call to QuotaServiceMock.CountRequest. %v", ctx)
+	return
+}
+
+// CountRequestAfterCounter returns a count of finished QuotaServiceMock.CountRequest invocations
+func (mmCountRequest *QuotaServiceMock) CountRequestAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountRequest.afterCountRequestCounter)
+}
+
+// CountRequestBeforeCounter returns a count of QuotaServiceMock.CountRequest invocations
+func (mmCountRequest *QuotaServiceMock) CountRequestBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountRequest.beforeCountRequestCounter)
+}
+
+// Calls returns a list of arguments used in each call to QuotaServiceMock.CountRequest.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCountRequest *mQuotaServiceMockCountRequest) Calls() []*QuotaServiceMockCountRequestParams {
+	mmCountRequest.mutex.RLock()
+
+	argCopy := make([]*QuotaServiceMockCountRequestParams, len(mmCountRequest.callArgs))
+	copy(argCopy, mmCountRequest.callArgs)
+
+	mmCountRequest.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCountRequestDone returns true if the count of the CountRequest invocations corresponds
+// the number of defined expectations
+func (m *QuotaServiceMock) MinimockCountRequestDone() bool {
+	if m.CountRequestMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CountRequestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CountRequestMock.invocationsDone()
+}
+
+// MinimockCountRequestInspect logs each unmet expectation
+func (m *QuotaServiceMock) MinimockCountRequestInspect() {
+	for _, e := range m.CountRequestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to QuotaServiceMock.CountRequest with params: %#v", *e.params)
+		}
+	}
+
+	afterCountRequestCounter := mm_atomic.LoadUint64(&m.afterCountRequestCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CountRequestMock.defaultExpectation != nil && afterCountRequestCounter < 1 {
+		if m.CountRequestMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to QuotaServiceMock.CountRequest")
+		} else {
+			m.t.Errorf("Expected call to QuotaServiceMock.CountRequest with params: %#v", *m.CountRequestMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCountRequest != nil && afterCountRequestCounter < 1 {
+		m.t.Error("Expected call to QuotaServiceMock.CountRequest")
+	}
+
+	if !m.CountRequestMock.invocationsDone() && afterCountRequestCounter > 0 {
+		m.t.Errorf("Expected %d calls to QuotaServiceMock.CountRequest but found %d calls",
+			mm_atomic.LoadUint64(&m.CountRequestMock.expectedInvocations), afterCountRequestCounter)
+	}
+}
+
+type mQuotaServiceMockGetUsage struct {
+	optional           bool
+	mock               *QuotaServiceMock
+	defaultExpectation *QuotaServiceMockGetUsageExpectation
+	expectations       []*QuotaServiceMockGetUsageExpectation
+
+	callArgs []*QuotaServiceMockGetUsageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// QuotaServiceMockGetUsageExpectation specifies expectation struct of the QuotaService.GetUsage
+type QuotaServiceMockGetUsageExpectation struct {
+	mock      *QuotaServiceMock
+	params    *QuotaServiceMockGetUsageParams
+	paramPtrs *QuotaServiceMockGetUsageParamPtrs
+	results   *QuotaServiceMockGetUsageResults
+	Counter   uint64
+}
+
+// QuotaServiceMockGetUsageParams contains parameters of the QuotaService.GetUsage
+type QuotaServiceMockGetUsageParams struct {
+	ctx context.Context
+}
+
+// QuotaServiceMockGetUsageParamPtrs contains pointers to parameters of the QuotaService.GetUsage
+type QuotaServiceMockGetUsageParamPtrs struct {
+	ctx *context.Context
+}
+
+// QuotaServiceMockGetUsageResults contains results of the QuotaService.GetUsage
+type QuotaServiceMockGetUsageResults struct {
+	up1 *model.UsageReport
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetUsage *mQuotaServiceMockGetUsage) Optional() *mQuotaServiceMockGetUsage {
+	mmGetUsage.optional = true
+	return mmGetUsage
+}
+
+// Expect sets up expected params for QuotaService.GetUsage
+func (mmGetUsage *mQuotaServiceMockGetUsage) Expect(ctx context.Context) *mQuotaServiceMockGetUsage {
+	if mmGetUsage.mock.funcGetUsage != nil {
+		mmGetUsage.mock.t.Fatalf("QuotaServiceMock.GetUsage mock is already set by Set")
+	}
+
+	if mmGetUsage.defaultExpectation == nil {
+		mmGetUsage.defaultExpectation = &QuotaServiceMockGetUsageExpectation{}
+	}
+
+	if mmGetUsage.defaultExpectation.paramPtrs != nil {
+		mmGetUsage.mock.t.Fatalf("QuotaServiceMock.GetUsage mock is already set by ExpectParams functions")
+	}
+
+	mmGetUsage.defaultExpectation.params = &QuotaServiceMockGetUsageParams{ctx}
+	for _, e := range mmGetUsage.expectations {
+		if minimock.Equal(e.params, mmGetUsage.defaultExpectation.params) {
+			mmGetUsage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUsage.defaultExpectation.params)
+		}
+	}
+
+	return mmGetUsage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for QuotaService.GetUsage
+func (mmGetUsage *mQuotaServiceMockGetUsage) ExpectCtxParam1(ctx context.Context) *mQuotaServiceMockGetUsage {
+	if mmGetUsage.mock.funcGetUsage != nil {
+		mmGetUsage.mock.t.Fatalf("QuotaServiceMock.GetUsage mock is already set by Set")
+	}
+
+	if mmGetUsage.defaultExpectation == nil {
+		mmGetUsage.defaultExpectation = &QuotaServiceMockGetUsageExpectation{}
+	}
+
+	if mmGetUsage.defaultExpectation.params != nil {
+		mmGetUsage.mock.t.Fatalf("QuotaServiceMock.GetUsage mock is already set by Expect")
+	}
+
+	if mmGetUsage.defaultExpectation.paramPtrs == nil {
+		mmGetUsage.defaultExpectation.paramPtrs = &QuotaServiceMockGetUsageParamPtrs{}
+	}
+	mmGetUsage.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetUsage
+}
+
+// Inspect accepts an inspector function that has same arguments as the QuotaService.GetUsage
+func (mmGetUsage *mQuotaServiceMockGetUsage) Inspect(f func(ctx context.Context)) *mQuotaServiceMockGetUsage {
+	if mmGetUsage.mock.inspectFuncGetUsage != nil {
+		mmGetUsage.mock.t.Fatalf("Inspect function is already set for QuotaServiceMock.GetUsage")
+	}
+
+	mmGetUsage.mock.inspectFuncGetUsage = f
+
+	return mmGetUsage
+}
+
+// Return sets up results that will be returned by QuotaService.GetUsage
+func (mmGetUsage *mQuotaServiceMockGetUsage) Return(up1 *model.UsageReport, err error) *QuotaServiceMock {
+	if mmGetUsage.mock.funcGetUsage != nil {
+		mmGetUsage.mock.t.Fatalf("QuotaServiceMock.GetUsage mock is already set by Set")
+	}
+
+	if mmGetUsage.defaultExpectation == nil {
+		mmGetUsage.defaultExpectation = &QuotaServiceMockGetUsageExpectation{mock: mmGetUsage.mock}
+	}
+	mmGetUsage.defaultExpectation.results = &QuotaServiceMockGetUsageResults{up1, err}
+	return mmGetUsage.mock
+}
+
+// Set uses given function f to mock the QuotaService.GetUsage method
+func (mmGetUsage *mQuotaServiceMockGetUsage) Set(f func(ctx context.Context) (up1 *model.UsageReport, err error)) *QuotaServiceMock {
+	if mmGetUsage.defaultExpectation != nil {
+		mmGetUsage.mock.t.Fatalf("Default expectation is already set for the QuotaService.GetUsage method")
+	}
+
+	if len(mmGetUsage.expectations) > 0 {
+		mmGetUsage.mock.t.Fatalf("Some expectations are already set for the QuotaService.GetUsage method")
+	}
+
+	mmGetUsage.mock.funcGetUsage = f
+	return mmGetUsage.mock
+}
+
+// When sets expectation for the QuotaService.GetUsage which will trigger the result defined by the following
+// Then helper
+func (mmGetUsage *mQuotaServiceMockGetUsage) When(ctx context.Context) *QuotaServiceMockGetUsageExpectation {
+	if mmGetUsage.mock.funcGetUsage != nil {
+		mmGetUsage.mock.t.Fatalf("QuotaServiceMock.GetUsage mock is already set by Set")
+	}
+
+	expectation := &QuotaServiceMockGetUsageExpectation{
+		mock:   mmGetUsage.mock,
+		params: &QuotaServiceMockGetUsageParams{ctx},
+	}
+	mmGetUsage.expectations = append(mmGetUsage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up QuotaService.GetUsage return parameters for the expectation previously defined by the When method
+func (e *QuotaServiceMockGetUsageExpectation) Then(up1 *model.UsageReport, err error) *QuotaServiceMock {
+	e.results = &QuotaServiceMockGetUsageResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times QuotaService.GetUsage should be invoked
+func (mmGetUsage *mQuotaServiceMockGetUsage) Times(n uint64) *mQuotaServiceMockGetUsage {
+	if n == 0 {
+		mmGetUsage.mock.t.Fatalf("Times of QuotaServiceMock.GetUsage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetUsage.expectedInvocations, n)
+	return mmGetUsage
+}
+
+func (mmGetUsage *mQuotaServiceMockGetUsage) invocationsDone() bool {
+	if len(mmGetUsage.expectations) == 0 && mmGetUsage.defaultExpectation == nil && mmGetUsage.mock.funcGetUsage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetUsage.mock.afterGetUsageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetUsage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetUsage implements service.QuotaService
+func (mmGetUsage *QuotaServiceMock) GetUsage(ctx context.Context) (up1 *model.UsageReport, err error) {
+	mm_atomic.AddUint64(&mmGetUsage.beforeGetUsageCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUsage.afterGetUsageCounter, 1)
+
+	if mmGetUsage.inspectFuncGetUsage != nil {
+		mmGetUsage.inspectFuncGetUsage(ctx)
+	}
+
+	mm_params := QuotaServiceMockGetUsageParams{ctx}
+
+	// Record call args
+	mmGetUsage.GetUsageMock.mutex.Lock()
+	mmGetUsage.GetUsageMock.callArgs = append(mmGetUsage.GetUsageMock.callArgs, &mm_params)
+	mmGetUsage.GetUsageMock.mutex.Unlock()
+
+	for _, e := range mmGetUsage.GetUsageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmGetUsage.GetUsageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUsage.GetUsageMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUsage.GetUsageMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUsage.GetUsageMock.defaultExpectation.paramPtrs
+
+		mm_got := QuotaServiceMockGetUsageParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetUsage.t.Errorf("QuotaServiceMock.GetUsage got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUsage.t.Errorf("QuotaServiceMock.GetUsage got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUsage.GetUsageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUsage.t.Fatal("No results are set for the QuotaServiceMock.GetUsage")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmGetUsage.funcGetUsage != nil {
+		return mmGetUsage.funcGetUsage(ctx)
+	}
+	mmGetUsage.t.Fatalf("Unexpected call to QuotaServiceMock.GetUsage. %v", ctx)
+	return
+}
+
+// GetUsageAfterCounter returns a count of finished QuotaServiceMock.GetUsage invocations
+func (mmGetUsage *QuotaServiceMock) GetUsageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUsage.afterGetUsageCounter)
+}
+
+// GetUsageBeforeCounter returns a count of QuotaServiceMock.GetUsage invocations
+func (mmGetUsage *QuotaServiceMock) GetUsageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUsage.beforeGetUsageCounter)
+}
+
+// Calls returns a list of arguments used in each call to QuotaServiceMock.GetUsage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUsage *mQuotaServiceMockGetUsage) Calls() []*QuotaServiceMockGetUsageParams {
+	mmGetUsage.mutex.RLock()
+
+	argCopy := make([]*QuotaServiceMockGetUsageParams, len(mmGetUsage.callArgs))
+	copy(argCopy, mmGetUsage.callArgs)
+
+	mmGetUsage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUsageDone returns true if the count of the GetUsage invocations corresponds
+// the number of defined expectations
+func (m *QuotaServiceMock) MinimockGetUsageDone() bool {
+	if m.GetUsageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetUsageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetUsageMock.invocationsDone()
+}
+
+// MinimockGetUsageInspect logs each unmet expectation
+func (m *QuotaServiceMock) MinimockGetUsageInspect() {
+	for _, e := range m.GetUsageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to QuotaServiceMock.GetUsage with params: %#v", *e.params)
+		}
+	}
+
+	afterGetUsageCounter := mm_atomic.LoadUint64(&m.afterGetUsageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUsageMock.defaultExpectation != nil && afterGetUsageCounter < 1 {
+		if m.GetUsageMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to QuotaServiceMock.GetUsage")
+		} else {
+			m.t.Errorf("Expected call to QuotaServiceMock.GetUsage with params: %#v", *m.GetUsageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUsage != nil && afterGetUsageCounter < 1 {
+		m.t.Error("Expected call to QuotaServiceMock.GetUsage")
+	}
+
+	if !m.GetUsageMock.invocationsDone() && afterGetUsageCounter > 0 {
+		m.t.Errorf("Expected %d calls to QuotaServiceMock.GetUsage but found %d calls",
+			mm_atomic.LoadUint64(&m.GetUsageMock.expectedInvocations), afterGetUsageCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *QuotaServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockConsumeInspect()
+
+			m.MinimockCountRequestInspect()
+
+			m.MinimockGetUsageInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *QuotaServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *QuotaServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockConsumeDone() &&
+		m.MinimockCountRequestDone() &&
+		m.MinimockGetUsageDone()
+}
@@ -20,7 +20,11 @@ func (s *serv) Create(ctx context.Context, info *model.NoteInfo) (int64, error)
 			return errTx
 		}
 
-		return nil
+		// Счетчики квот меняются в той же транзакции, поэтому заметка сверх квоты не сохранится
+		return s.quotaService.Consume(ctx, &model.Usage{
+			Notes:        1,
+			ContentBytes: int64(len(info.Title) + len(info.Content)),
+		})
 	})
 
 	if err != nil {
@@ -9,15 +9,18 @@ import (
 type serv struct {
 	noteRepository repository.NoteRepository
 	txManger       db.TxManager
+	quotaService   service.QuotaService
 }
 
 func NewService(
 	noteRepository repository.NoteRepository,
 	txManager db.TxManager,
+	quotaService service.QuotaService,
 ) service.NoteService {
 	return &serv{
 		noteRepository: noteRepository,
 		txManger:       txManager,
+		quotaService:   quotaService,
 	}
 }
 
@@ -28,6 +31,10 @@ func NewMockService(deps ...interface{}) service.NoteService {
 		switch s := v.(type) {
 		case repository.NoteRepository:
 			srv.noteRepository = s
+		case db.TxManager:
+			srv.txManger = s
+		case service.QuotaService:
+			srv.quotaService = s
 		}
 	}
 
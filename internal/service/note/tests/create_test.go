@@ -2,10 +2,15 @@ package tests
 
 import (
 	"context"
+	"di_container/internal/client/db"
+	dbMocks "di_container/internal/client/db/mocks"
 	"di_container/internal/model"
 	"di_container/internal/repository"
 	repoMocks "di_container/internal/repository/mocks"
+	"di_container/internal/service"
+	serviceMocks "di_container/internal/service/mocks"
 	"di_container/internal/service/note"
+	"di_container/internal/sys"
 	"fmt"
 	"testing"
 
@@ -17,6 +22,7 @@ import (
 func TestCreate(t *testing.T) {
 	t.Parallel()
 	type noteRepositoryMockFunc func(mc *minimock.Controller) repository.NoteRepository
+	type quotaServiceMockFunc func(mc *minimock.Controller) service.QuotaService
 
 	type args struct {
 		ctx context.Context
@@ -31,7 +37,13 @@ func TestCreate(t *testing.T) {
 		title   = gofakeit.Animal()
 		content = gofakeit.Animal()
 
-		repoErr = fmt.Errorf("repo error")
+		repoErr  = fmt.Errorf("repo error")
+		quotaErr = sys.NewQuotaError("quota exceeded", 0, model.QuotaViolation{
+			Subject: "tenant:default",
+			Quota:   model.QuotaNotes,
+			Limit:   10,
+			Used:    11,
+		})
 
 		req = &model.NoteInfo{
 			Title:   title,
@@ -46,6 +58,7 @@ func TestCreate(t *testing.T) {
 		want               int64
 		err                error
 		noteRepositoryMock noteRepositoryMockFunc
+		quotaServiceMock   quotaServiceMockFunc
 	}{
 		{
 			name: "success case",
@@ -58,6 +71,35 @@ func TestCreate(t *testing.T) {
 			noteRepositoryMock: func(mc *minimock.Controller) repository.NoteRepository {
 				mock := repoMocks.NewNoteRepositoryMock(mc)
 				mock.CreateMock.Expect(ctx, req).Return(id, nil)
+				mock.GetMock.Expect(ctx, id).Return(&model.Note{ID: id, Info: *req}, nil)
+				return mock
+			},
+			quotaServiceMock: func(mc *minimock.Controller) service.QuotaService {
+				mock := serviceMocks.NewQuotaServiceMock(mc)
+				mock.ConsumeMock.Expect(ctx, &model.Usage{
+					Notes:        1,
+					ContentBytes: int64(len(title) + len(content)),
+				}).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "quota exceeded case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: 0,
+			err:  quotaErr,
+			noteRepositoryMock: func(mc *minimock.Controller) repository.NoteRepository {
+				mock := repoMocks.NewNoteRepositoryMock(mc)
+				mock.CreateMock.Expect(ctx, req).Return(id, nil)
+				mock.GetMock.Expect(ctx, id).Return(&model.Note{ID: id, Info: *req}, nil)
+				return mock
+			},
+			quotaServiceMock: func(mc *minimock.Controller) service.QuotaService {
+				mock := serviceMocks.NewQuotaServiceMock(mc)
+				mock.ConsumeMock.Return(quotaErr)
 				return mock
 			},
 		},
@@ -74,6 +116,9 @@ func TestCreate(t *testing.T) {
 				mock.CreateMock.Expect(ctx, req).Return(0, repoErr)
 				return mock
 			},
+			quotaServiceMock: func(mc *minimock.Controller) service.QuotaService {
+				return serviceMocks.NewQuotaServiceMock(mc)
+			},
 		},
 	}
 
@@ -83,7 +128,14 @@ func TestCreate(t *testing.T) {
 			t.Parallel()
 
 			noteRepoMock := tt.noteRepositoryMock(mc)
-			service := note.NewMockService(noteRepoMock)
+			quotaServiceMock := tt.quotaServiceMock(mc)
+
+			txManagerMock := dbMocks.NewTxManagerMock(mc)
+			txManagerMock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) error {
+				return f(ctx)
+			})
+
+			service := note.NewMockService(noteRepoMock, txManagerMock, quotaServiceMock)
 
 			newID, err := service.Create(tt.args.ctx, tt.args.req)
 			require.Equal(t, tt.err, err)
@@ -0,0 +1,43 @@
+package oauth
+
+import (
+	"context"
+	"crypto/subtle"
+	"di_container/internal/model"
+	"di_container/internal/repository"
+	"di_container/internal/utils"
+	"errors"
+	"strconv"
+)
+
+var errInvalidClient = model.NewOAuthError(model.OAuthErrorInvalidClient, "client authentication failed")
+
+// authenticateClient проверяет client_id и client_secret и возвращает ключ клиента и его владельца
+func (s *serv) authenticateClient(ctx context.Context, clientID string, clientSecret string) (*model.APIKey, *model.User, error) {
+	id, err := strconv.ParseInt(clientID, 10, 64)
+	if err != nil {
+		return nil, nil, errInvalidClient
+	}
+
+	key, err := s.apiKeyRepository.Get(ctx, id)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, nil, errInvalidClient
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(key.Hash), []byte(utils.HashSecret(clientSecret))) != 1 || !key.IsActive() {
+		return nil, nil, errInvalidClient
+	}
+
+	user, err := s.userRepository.GetByUsername(ctx, key.Username)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, nil, errInvalidClient
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return key, user, nil
+}
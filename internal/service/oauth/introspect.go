@@ -0,0 +1,73 @@
+package oauth
+
+import (
+	"context"
+	"di_container/internal/model"
+	"di_container/internal/repository"
+	"di_container/internal/tenant"
+	"di_container/internal/utils"
+	"errors"
+	"time"
+)
+
+const (
+	tokenTypeAccess  = "access_token"
+	tokenTypeRefresh = "refresh_token"
+)
+
+// Introspect сообщает, действует ли токен. По RFC 7662 вызывающий должен быть аутентифицирован,
+// иначе endpoint можно использовать для проверки украденных токенов
+func (s *serv) Introspect(ctx context.Context, clientID string, clientSecret string, token string) (*model.TokenIntrospection, error) {
+	_, _, err := s.authenticateClient(ctx, clientID, clientSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	// Клиент видит только токены своего арендатора
+	tenantID := tenant.FromContext(ctx)
+
+	if claims, errVerify := s.accessKeySet.VerifyToken(token); errVerify == nil {
+		if claims.TenantID() != tenantID {
+			return &model.TokenIntrospection{}, nil
+		}
+
+		return toIntrospection(claims, tokenTypeAccess), nil
+	}
+
+	claims, err := utils.VerifyToken(token, []byte(s.config.RefreshTokenSecretKey))
+	if err != nil || claims.Purpose != "" || claims.TenantID() != tenantID {
+		return &model.TokenIntrospection{}, nil
+	}
+
+	// Подпись refresh токена еще не значит, что он действует: он мог быть ротирован или отозван
+	refreshToken, err := s.refreshTokenRepository.Get(ctx, claims.Id)
+	if errors.Is(err, repository.ErrNotFound) {
+		return &model.TokenIntrospection{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !refreshToken.IsActive() {
+		return &model.TokenIntrospection{}, nil
+	}
+
+	return toIntrospection(claims, tokenTypeRefresh), nil
+}
+
+func toIntrospection(claims *model.UserClaims, tokenType string) *model.TokenIntrospection {
+	res := &model.TokenIntrospection{
+		Active:    true,
+		Username:  claims.Username,
+		Scopes:    claims.Scopes(),
+		TokenType: tokenType,
+		ExpiresAt: time.Unix(claims.ExpiresAt, 0),
+		TokenID:   claims.Id,
+	}
+
+	if claims.IssuedAt != 0 {
+		res.IssuedAt = time.Unix(claims.IssuedAt, 0)
+	}
+
+	return res
+}
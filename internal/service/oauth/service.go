@@ -0,0 +1,37 @@
+package oauth
+
+import (
+	"di_container/internal/config/env"
+	"di_container/internal/repository"
+	"di_container/internal/service"
+	"di_container/internal/utils"
+)
+
+type serv struct {
+	config                 *env.TokenConfigData
+	accessKeySet           *utils.KeySet
+	authService            service.AuthService
+	apiKeyRepository       repository.APIKeyRepository
+	userRepository         repository.UserRepository
+	refreshTokenRepository repository.RefreshTokenRepository
+}
+
+// NewService создает OAuth2 фасад над AuthV1. Клиентами OAuth2 являются API ключи:
+// client_id это идентификатор ключа, а client_secret сам ключ
+func NewService(
+	config *env.TokenConfigData,
+	accessKeySet *utils.KeySet,
+	authService service.AuthService,
+	apiKeyRepository repository.APIKeyRepository,
+	userRepository repository.UserRepository,
+	refreshTokenRepository repository.RefreshTokenRepository,
+) service.OAuthService {
+	return &serv{
+		config:                 config,
+		accessKeySet:           accessKeySet,
+		authService:            authService,
+		apiKeyRepository:       apiKeyRepository,
+		userRepository:         userRepository,
+		refreshTokenRepository: refreshTokenRepository,
+	}
+}
@@ -0,0 +1,124 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"di_container/internal/config/env"
+	"di_container/internal/logger"
+	"di_container/internal/model"
+	repoMocks "di_container/internal/repository/mocks"
+	"di_container/internal/service/oauth"
+	"di_container/internal/tenant"
+	"di_container/internal/utils"
+)
+
+func TestClientCredentials(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = tenant.WithTenant(context.Background(), "acme")
+		mc  = minimock.NewController(t)
+
+		config = &env.TokenConfigData{
+			AccessTokenExpiration: time.Minute,
+		}
+		keySet = utils.NewHMACKeySet([]byte(gofakeit.Password(true, true, true, false, false, 32)))
+
+		user      = &model.User{Username: gofakeit.Username(), Role: "user"}
+		keyScopes = []string{"/note_v1.NoteV1/*"}
+	)
+	t.Cleanup(mc.Finish)
+
+	logger.Init(zapcore.NewNopCore())
+
+	secret, prefix, err := utils.GenerateAPIKey()
+	require.NoError(t, err)
+
+	apiKey := &model.APIKey{
+		ID:       gofakeit.Int64(),
+		Username: user.Username,
+		Info:     model.APIKeyInfo{Name: "ci", Scopes: keyScopes},
+		Prefix:   prefix,
+		Hash:     utils.HashSecret(secret),
+	}
+	clientID := strconv.FormatInt(apiKey.ID, 10)
+
+	tests := []struct {
+		name       string
+		secret     string
+		scopes     []string
+		wantScopes []string
+		errCode    string
+	}{
+		{
+			name:       "client scopes by default case",
+			secret:     secret,
+			wantScopes: keyScopes,
+		},
+		{
+			name:       "narrowed scope case",
+			secret:     secret,
+			scopes:     []string{"/note_v1.NoteV1/Get"},
+			wantScopes: []string{"/note_v1.NoteV1/Get"},
+		},
+		{
+			name:    "wrong secret case",
+			secret:  secret + "x",
+			errCode: model.OAuthErrorInvalidClient,
+		},
+		{
+			name:    "scope not granted case",
+			secret:  secret,
+			scopes:  []string{"/auth_v1.AuthV1/CreateAPIKey"},
+			errCode: model.OAuthErrorInvalidScope,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			apiKeyRepoMock := repoMocks.NewAPIKeyRepositoryMock(mc)
+			apiKeyRepoMock.GetMock.Expect(minimock.AnyContext, apiKey.ID).Return(apiKey, nil)
+
+			userRepoMock := repoMocks.NewUserRepositoryMock(mc)
+			if tt.errCode != model.OAuthErrorInvalidClient {
+				userRepoMock.GetByUsernameMock.Expect(minimock.AnyContext, user.Username).Return(user, nil)
+			}
+			if tt.errCode == "" {
+				apiKeyRepoMock.TouchMock.Expect(minimock.AnyContext, apiKey.ID).Return(nil)
+			}
+
+			service := oauth.NewService(config, keySet, nil, apiKeyRepoMock, userRepoMock, nil)
+
+			token, err := service.ClientCredentials(ctx, clientID, tt.secret, tt.scopes)
+			if tt.errCode != "" {
+				var oauthErr *model.OAuthError
+				require.True(t, errors.As(err, &oauthErr))
+				require.Equal(t, tt.errCode, oauthErr.Code)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, model.OAuthTokenTypeBearer, token.TokenType)
+			require.Empty(t, token.RefreshToken)
+			require.Equal(t, tt.wantScopes, token.Scopes)
+
+			claims, err := keySet.VerifyToken(token.AccessToken)
+			require.NoError(t, err)
+			require.Equal(t, user.Username, claims.Username)
+			require.Equal(t, tt.wantScopes, claims.Scopes())
+			require.Equal(t, "acme", claims.TenantID())
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package tests
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"di_container/internal/config/env"
+	"di_container/internal/model"
+	repoMocks "di_container/internal/repository/mocks"
+	"di_container/internal/service/oauth"
+	"di_container/internal/tenant"
+	"di_container/internal/utils"
+)
+
+func TestIntrospectTenantIsolation(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		config = &env.TokenConfigData{
+			AccessTokenExpiration: time.Minute,
+		}
+		keySet = utils.NewHMACKeySet([]byte(gofakeit.Password(true, true, true, false, false, 32)))
+
+		user = &model.User{Username: gofakeit.Username(), Role: "user"}
+	)
+	t.Cleanup(mc.Finish)
+
+	secret, prefix, err := utils.GenerateAPIKey()
+	require.NoError(t, err)
+
+	apiKey := &model.APIKey{
+		ID:       gofakeit.Int64(),
+		Username: user.Username,
+		Info:     model.APIKeyInfo{Name: "ci"},
+		Prefix:   prefix,
+		Hash:     utils.HashSecret(secret),
+	}
+	clientID := strconv.FormatInt(apiKey.ID, 10)
+
+	accessToken, err := keySet.GenerateToken(model.UserInfo{
+		Username: gofakeit.Username(),
+		Role:     "user",
+		Tenant:   "acme",
+	}, time.Minute)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		tenant     string
+		wantActive bool
+	}{
+		{
+			name:       "same tenant case",
+			tenant:     "acme",
+			wantActive: true,
+		},
+		{
+			name:   "other tenant case",
+			tenant: "globex",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			apiKeyRepoMock := repoMocks.NewAPIKeyRepositoryMock(mc)
+			apiKeyRepoMock.GetMock.Expect(minimock.AnyContext, apiKey.ID).Return(apiKey, nil)
+
+			userRepoMock := repoMocks.NewUserRepositoryMock(mc)
+			userRepoMock.GetByUsernameMock.Expect(minimock.AnyContext, user.Username).Return(user, nil)
+
+			service := oauth.NewService(config, keySet, nil, apiKeyRepoMock, userRepoMock, nil)
+
+			ctx := tenant.WithTenant(context.Background(), tt.tenant)
+			res, err := service.Introspect(ctx, clientID, secret, accessToken)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantActive, res.Active)
+		})
+	}
+}
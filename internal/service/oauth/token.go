@@ -0,0 +1,86 @@
+package oauth
+
+import (
+	"context"
+	"di_container/internal/logger"
+	"di_container/internal/model"
+	"di_container/internal/sys"
+	"di_container/internal/tenant"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+// ClientCredentials выдает access токен владельцу ключа. Токен ограничен запрошенными scopes,
+// которые должны входить в scopes ключа. Refresh токен по RFC 6749 для этого гранта не выдается
+func (s *serv) ClientCredentials(ctx context.Context, clientID string, clientSecret string, scopes []string) (*model.OAuthToken, error) {
+	key, user, err := s.authenticateClient(ctx, clientID, clientSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(scopes) == 0 {
+		scopes = key.Info.Scopes
+	}
+
+	for _, scope := range scopes {
+		if !model.ScopesAllow(key.Info.Scopes, scope) {
+			return nil, model.NewOAuthError(model.OAuthErrorInvalidScope, "scope "+scope+" is not granted to the client")
+		}
+	}
+
+	accessToken, err := s.accessKeySet.GenerateScopedToken(model.UserInfo{
+		Username: user.Username,
+		Role:     user.Role,
+		Tenant:   tenant.FromContext(ctx),
+	}, scopes, s.config.AccessTokenExpiration)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.apiKeyRepository.Touch(ctx, key.ID)
+	if err != nil {
+		logger.FromContext(ctx).Warn("failed to touch api key", zap.Int64("api_key_id", key.ID), zap.Error(err))
+	}
+
+	return &model.OAuthToken{
+		AccessToken: accessToken,
+		TokenType:   model.OAuthTokenTypeBearer,
+		ExpiresIn:   s.config.AccessTokenExpiration,
+		Scopes:      scopes,
+	}, nil
+}
+
+// RefreshToken ротирует refresh токен так же, как GetRefreshToken, и сразу выдает access токен по новому
+func (s *serv) RefreshToken(ctx context.Context, refreshToken string) (*model.OAuthToken, error) {
+	newRefreshToken, err := s.authService.GetRefreshToken(ctx, refreshToken)
+	if err != nil {
+		return nil, toOAuthError(err)
+	}
+
+	accessToken, err := s.authService.GetAccessToken(ctx, newRefreshToken)
+	if err != nil {
+		return nil, toOAuthError(err)
+	}
+
+	return &model.OAuthToken{
+		AccessToken:  accessToken,
+		TokenType:    model.OAuthTokenTypeBearer,
+		ExpiresIn:    s.config.AccessTokenExpiration,
+		RefreshToken: newRefreshToken,
+	}, nil
+}
+
+// toOAuthError переводит отказ AuthV1 в invalid_grant, остальные ошибки оставляет внутренними
+func toOAuthError(err error) error {
+	commonErr := sys.GetCommonError(err)
+	if commonErr == nil {
+		return err
+	}
+
+	switch commonErr.Code() {
+	case codes.Aborted, codes.Unauthenticated, codes.InvalidArgument, codes.PermissionDenied:
+		return model.NewOAuthError(model.OAuthErrorInvalidGrant, commonErr.Error())
+	default:
+		return err
+	}
+}
@@ -0,0 +1,69 @@
+package quota
+
+import (
+	"context"
+	"di_container/internal/model"
+	"di_container/internal/sys"
+	"di_container/internal/tenant"
+	"di_container/internal/utils"
+)
+
+// Consume учитывает delta в счетчиках арендатора и пользователя из контекста и отклоняет запись,
+// если она выводит их за квоту. Вызывать нужно в транзакции самой записи: при отказе откатываются
+// и запись, и счетчики. Уменьшение потребления не отклоняется, даже если лимит уже превышен
+func (s *serv) Consume(ctx context.Context, delta *model.Usage) error {
+	var violations []model.QuotaViolation
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		usage, errTx := s.usageRepository.Add(ctx, tenantOwner, delta)
+		if errTx != nil {
+			return errTx
+		}
+		violations = append(violations, s.config.TenantLimits().Exceeded(tenantSubject(ctx), usage)...)
+
+		if claims, ok := utils.ClaimsFromContext(ctx); ok {
+			usage, errTx = s.usageRepository.Add(ctx, claims.Username, delta)
+			if errTx != nil {
+				return errTx
+			}
+			violations = append(violations, s.config.UserLimits().Exceeded(userSubject(claims.Username), usage)...)
+		}
+
+		violations = grown(violations, delta)
+		if len(violations) > 0 {
+			return sys.NewQuotaError("quota exceeded", 0, violations...)
+		}
+
+		return nil
+	})
+}
+
+// grown оставляет только квоты, потребление по которым выросло
+func grown(violations []model.QuotaViolation, delta *model.Usage) []model.QuotaViolation {
+	res := violations[:0]
+	for _, v := range violations {
+		var d int64
+		switch v.Quota {
+		case model.QuotaNotes:
+			d = delta.Notes
+		case model.QuotaContentBytes:
+			d = delta.ContentBytes
+		case model.QuotaAttachmentBytes:
+			d = delta.AttachmentBytes
+		}
+
+		if d > 0 {
+			res = append(res, v)
+		}
+	}
+
+	return res
+}
+
+func tenantSubject(ctx context.Context) string {
+	return "tenant:" + tenant.FromContext(ctx)
+}
+
+func userSubject(username string) string {
+	return "user:" + username
+}
@@ -0,0 +1,61 @@
+package quota
+
+import (
+	"context"
+	"di_container/internal/model"
+	"di_container/internal/sys"
+	"di_container/internal/utils"
+	"time"
+)
+
+const day = 24 * time.Hour
+
+// CountRequest учитывает запрос в дневных счетчиках арендатора и пользователя и отклоняет его сверх лимита.
+// Отклоненные запросы тоже учитываются. Если лимиты запросов не заданы, запросы не считаются вовсе,
+// чтобы не писать в базу на каждый вызов
+func (s *serv) CountRequest(ctx context.Context) error {
+	tenantLimit := s.config.TenantLimits().RequestsPerDay
+	userLimit := s.config.UserLimits().RequestsPerDay
+	if tenantLimit == 0 && userLimit == 0 {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	today := now.Truncate(day)
+
+	var violations []model.QuotaViolation
+
+	requests, err := s.usageRepository.CountRequest(ctx, tenantOwner, today)
+	if err != nil {
+		return err
+	}
+	if tenantLimit > 0 && requests > tenantLimit {
+		violations = append(violations, model.QuotaViolation{
+			Subject: tenantSubject(ctx),
+			Quota:   model.QuotaRequestsPerDay,
+			Limit:   tenantLimit,
+			Used:    requests,
+		})
+	}
+
+	if claims, ok := utils.ClaimsFromContext(ctx); ok {
+		requests, err = s.usageRepository.CountRequest(ctx, claims.Username, today)
+		if err != nil {
+			return err
+		}
+		if userLimit > 0 && requests > userLimit {
+			violations = append(violations, model.QuotaViolation{
+				Subject: userSubject(claims.Username),
+				Quota:   model.QuotaRequestsPerDay,
+				Limit:   userLimit,
+				Used:    requests,
+			})
+		}
+	}
+
+	if len(violations) > 0 {
+		return sys.NewQuotaError("daily request quota exceeded", today.Add(day).Sub(now), violations...)
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package quota
+
+import (
+	"di_container/internal/client/db"
+	"di_container/internal/config"
+	"di_container/internal/repository"
+	"di_container/internal/service"
+)
+
+// tenantOwner владелец счетчиков арендатора целиком
+const tenantOwner = ""
+
+type serv struct {
+	usageRepository repository.UsageRepository
+	txManager       db.TxManager
+	config          config.QuotaConfig
+}
+
+func NewService(usageRepository repository.UsageRepository, txManager db.TxManager, config config.QuotaConfig) service.QuotaService {
+	return &serv{
+		usageRepository: usageRepository,
+		txManager:       txManager,
+		config:          config,
+	}
+}
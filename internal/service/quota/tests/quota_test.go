@@ -0,0 +1,163 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"di_container/internal/client/db"
+	dbMocks "di_container/internal/client/db/mocks"
+	"di_container/internal/model"
+	repoMocks "di_container/internal/repository/mocks"
+	"di_container/internal/service/quota"
+	"di_container/internal/sys"
+	"di_container/internal/tenant"
+	"di_container/internal/utils"
+)
+
+type quotaConfig struct {
+	tenant model.QuotaLimits
+	user   model.QuotaLimits
+}
+
+func (c quotaConfig) TenantLimits() model.QuotaLimits {
+	return c.tenant
+}
+
+func (c quotaConfig) UserLimits() model.QuotaLimits {
+	return c.user
+}
+
+func newTxManagerMock(mc *minimock.Controller) *dbMocks.TxManagerMock {
+	txManagerMock := dbMocks.NewTxManagerMock(mc)
+	txManagerMock.ReadCommittedMock.Optional().Set(func(ctx context.Context, f db.Handler) error {
+		return f(ctx)
+	})
+
+	return txManagerMock
+}
+
+func TestConsume(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = utils.MakeContextClaims(
+			tenant.WithTenant(context.Background(), "acme"),
+			&model.UserClaims{Username: "alice"},
+		)
+
+		config = quotaConfig{
+			tenant: model.QuotaLimits{Notes: 100, ContentBytes: 1000, AttachmentBytes: 5000},
+			user:   model.QuotaLimits{Notes: 10},
+		}
+	)
+
+	tests := []struct {
+		name           string
+		delta          *model.Usage
+		tenantUsage    *model.Usage
+		userUsage      *model.Usage
+		wantViolations []model.QuotaViolation
+	}{
+		{
+			name:        "within quota case",
+			delta:       &model.Usage{Notes: 1, ContentBytes: 10},
+			tenantUsage: &model.Usage{Notes: 50, ContentBytes: 500},
+			userUsage:   &model.Usage{Notes: 5, ContentBytes: 50},
+		},
+		{
+			name:        "user and tenant exceeded case",
+			delta:       &model.Usage{Notes: 1, ContentBytes: 10},
+			tenantUsage: &model.Usage{Notes: 50, ContentBytes: 1001},
+			userUsage:   &model.Usage{Notes: 11, ContentBytes: 50},
+			wantViolations: []model.QuotaViolation{
+				{Subject: "tenant:acme", Quota: model.QuotaContentBytes, Limit: 1000, Used: 1001},
+				{Subject: "user:alice", Quota: model.QuotaNotes, Limit: 10, Used: 11},
+			},
+		},
+		{
+			name:        "attachment exceeded case",
+			delta:       &model.Usage{AttachmentBytes: 2000},
+			tenantUsage: &model.Usage{Notes: 50, ContentBytes: 500, AttachmentBytes: 6000},
+			userUsage:   &model.Usage{Notes: 5, AttachmentBytes: 2000},
+			wantViolations: []model.QuotaViolation{
+				{Subject: "tenant:acme", Quota: model.QuotaAttachmentBytes, Limit: 5000, Used: 6000},
+			},
+		},
+		{
+			name:        "decrease over quota case",
+			delta:       &model.Usage{Notes: -1, ContentBytes: -10},
+			tenantUsage: &model.Usage{Notes: 50, ContentBytes: 1500},
+			userUsage:   &model.Usage{Notes: 20},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+
+			usageRepoMock := repoMocks.NewUsageRepositoryMock(mc)
+			usageRepoMock.AddMock.When(minimock.AnyContext, "", tt.delta).Then(tt.tenantUsage, nil)
+			usageRepoMock.AddMock.When(minimock.AnyContext, "alice", tt.delta).Then(tt.userUsage, nil)
+
+			service := quota.NewService(usageRepoMock, newTxManagerMock(mc), config)
+
+			err := service.Consume(ctx, tt.delta)
+			if tt.wantViolations == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			commonErr := sys.GetCommonError(err)
+			require.NotNil(t, commonErr)
+			require.Equal(t, codes.ResourceExhausted, commonErr.Code())
+			require.Equal(t, tt.wantViolations, commonErr.QuotaViolations())
+		})
+	}
+}
+
+func TestCountRequest(t *testing.T) {
+	t.Parallel()
+
+	ctx := tenant.WithTenant(context.Background(), "acme")
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	t.Run("no limits case", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		service := quota.NewService(repoMocks.NewUsageRepositoryMock(mc), newTxManagerMock(mc), quotaConfig{})
+
+		require.NoError(t, service.CountRequest(ctx))
+	})
+
+	t.Run("daily limit exceeded case", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+
+		usageRepoMock := repoMocks.NewUsageRepositoryMock(mc)
+		usageRepoMock.CountRequestMock.Expect(minimock.AnyContext, "", today).Return(6, nil)
+
+		service := quota.NewService(usageRepoMock, newTxManagerMock(mc), quotaConfig{
+			tenant: model.QuotaLimits{RequestsPerDay: 5},
+		})
+
+		err := service.CountRequest(ctx)
+		commonErr := sys.GetCommonError(err)
+		require.NotNil(t, commonErr)
+		require.Equal(t, codes.ResourceExhausted, commonErr.Code())
+		require.Greater(t, commonErr.RetryAfter(), time.Duration(0))
+		require.LessOrEqual(t, commonErr.RetryAfter(), 24*time.Hour)
+		require.Equal(t, []model.QuotaViolation{
+			{Subject: "tenant:acme", Quota: model.QuotaRequestsPerDay, Limit: 5, Used: 6},
+		}, commonErr.QuotaViolations())
+	})
+}
@@ -0,0 +1,48 @@
+package quota
+
+import (
+	"context"
+	"di_container/internal/model"
+	"di_container/internal/utils"
+	"time"
+)
+
+// GetUsage возвращает потребление и лимиты арендатора и, для аутентифицированного запроса, вызывающего пользователя
+func (s *serv) GetUsage(ctx context.Context) (*model.UsageReport, error) {
+	today := time.Now().UTC().Truncate(day)
+
+	tenantUsage, err := s.getUsage(ctx, tenantOwner, today)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &model.UsageReport{
+		Tenant:       *tenantUsage,
+		TenantLimits: s.config.TenantLimits(),
+		UserLimits:   s.config.UserLimits(),
+	}
+
+	if claims, ok := utils.ClaimsFromContext(ctx); ok {
+		userUsage, errUser := s.getUsage(ctx, claims.Username, today)
+		if errUser != nil {
+			return nil, errUser
+		}
+		report.User = *userUsage
+	}
+
+	return report, nil
+}
+
+func (s *serv) getUsage(ctx context.Context, owner string, today time.Time) (*model.Usage, error) {
+	usage, err := s.usageRepository.Get(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	usage.RequestsToday, err = s.usageRepository.GetRequests(ctx, owner, today)
+	if err != nil {
+		return nil, err
+	}
+
+	return usage, nil
+}
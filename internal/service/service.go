@@ -15,8 +15,49 @@ type OtherService interface {
 }
 
 type AuthService interface {
-	Login(ctx context.Context)
-	GetAccessToken(ctx context.Context)
-	GetRefreshToken(ctx context.Context)
-	Check(ctx context.Context)
+	Login(ctx context.Context, username string, password string) (*model.LoginResult, error)
+	VerifyMFA(ctx context.Context, challengeToken string, code string) (string, error)
+	EnrollTOTP(ctx context.Context, actor *model.UserClaims) (*model.TOTPEnrollment, error)
+	ConfirmTOTP(ctx context.Context, actor *model.UserClaims, code string) error
+	GetRefreshToken(ctx context.Context, refreshToken string) (string, error)
+	GetAccessToken(ctx context.Context, refreshToken string) (string, error)
+	Logout(ctx context.Context, refreshToken string) error
+	RevokeAllSessions(ctx context.Context, username string) error
+	ListSessions(ctx context.Context, actor *model.UserClaims, username string) ([]*model.Session, error)
+	TerminateSession(ctx context.Context, actor *model.UserClaims, sessionID string) error
+	RequestPasswordReset(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, token string, password string) error
+	RequestEmailVerification(ctx context.Context, actor *model.UserClaims) error
+	VerifyEmail(ctx context.Context, token string) error
+	CreateAPIKey(ctx context.Context, actor *model.UserClaims, info *model.APIKeyInfo) (*model.APIKey, string, error)
+	ListAPIKeys(ctx context.Context, actor *model.UserClaims) ([]*model.APIKey, error)
+	RevokeAPIKey(ctx context.Context, actor *model.UserClaims, id int64) error
+	SetUserRole(ctx context.Context, actor *model.UserClaims, username string, role string) error
+	Impersonate(ctx context.Context, actor *model.UserClaims, username string) (string, error)
+}
+
+type AccessService interface {
+	Check(ctx context.Context, accessToken string, req *model.AccessRequest) (*model.UserClaims, error)
+	CheckAPIKey(ctx context.Context, apiKey string, req *model.AccessRequest) (*model.UserClaims, error)
+	BatchCheck(ctx context.Context, accessToken string, reqs []*model.AccessRequest) ([]*model.AccessDecision, error)
+	Explain(ctx context.Context, claims *model.UserClaims, req *model.AccessRequest) (*model.AccessDecision, error)
+}
+
+type OAuthService interface {
+	ClientCredentials(ctx context.Context, clientID string, clientSecret string, scopes []string) (*model.OAuthToken, error)
+	RefreshToken(ctx context.Context, refreshToken string) (*model.OAuthToken, error)
+	Introspect(ctx context.Context, clientID string, clientSecret string, token string) (*model.TokenIntrospection, error)
+}
+
+type AuditService interface {
+	Record(ctx context.Context, event *model.AuditEvent) error
+	Query(ctx context.Context, filter *model.AuditFilter) (*model.AuditPage, error)
+	Export(ctx context.Context, filter *model.AuditFilter, send func(*model.AuditEvent) error) error
+	Verify(ctx context.Context) (*model.AuditVerification, error)
+}
+
+type QuotaService interface {
+	Consume(ctx context.Context, delta *model.Usage) error
+	CountRequest(ctx context.Context) error
+	GetUsage(ctx context.Context) (*model.UsageReport, error)
 }
@@ -1,17 +1,52 @@
 package sys
 
 import (
+	"time"
+
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
+
+	"di_container/internal/model"
 )
 
 type commonError struct {
-	msg  string
-	code codes.Code
+	msg             string
+	code            codes.Code
+	reason          string
+	retryAfter      time.Duration
+	quotaViolations []model.QuotaViolation
+	metadata        map[string]string
 }
 
 func NewCommonError(msg string, code codes.Code) *commonError {
-	return &commonError{msg, code}
+	return &commonError{msg: msg, code: code}
+}
+
+// NewRetryableError ошибка, после которой запрос можно повторить не раньше, чем через retryAfter
+func NewRetryableError(msg string, code codes.Code, retryAfter time.Duration) *commonError {
+	return &commonError{msg: msg, code: code, retryAfter: retryAfter}
+}
+
+// NewQuotaError ошибка ResourceExhausted с перечнем превышенных квот. retryAfter задается, если квота
+// сама восстановится со временем, например дневной лимит запросов
+func NewQuotaError(msg string, retryAfter time.Duration, violations ...model.QuotaViolation) *commonError {
+	return &commonError{msg: msg, code: codes.ResourceExhausted, reason: "QUOTA_EXCEEDED", retryAfter: retryAfter, quotaViolations: violations}
+}
+
+// WithReason задает стабильную причину ошибки в формате UPPER_SNAKE_CASE. Клиенты различают ошибки
+// по ней, а не по тексту, поэтому менять причину существующей ошибки нельзя
+func (r *commonError) WithReason(reason string) *commonError {
+	r.reason = reason
+	return r
+}
+
+// WithMetadata добавляет к ошибке пару ключ-значение, которую клиент получит в метаданных ErrorInfo
+func (r *commonError) WithMetadata(key string, value string) *commonError {
+	if r.metadata == nil {
+		r.metadata = make(map[string]string)
+	}
+	r.metadata[key] = value
+	return r
 }
 
 func (r *commonError) Error() string {
@@ -22,6 +57,23 @@ func (r *commonError) Code() codes.Code {
 	return r.code
 }
 
+// Reason причина ошибки или пустая строка, если она не задана
+func (r *commonError) Reason() string {
+	return r.reason
+}
+
+func (r *commonError) RetryAfter() time.Duration {
+	return r.retryAfter
+}
+
+func (r *commonError) QuotaViolations() []model.QuotaViolation {
+	return r.quotaViolations
+}
+
+func (r *commonError) Metadata() map[string]string {
+	return r.metadata
+}
+
 func IsCommonError(err error) bool {
 	var ce *commonError
 	return errors.As(err, &ce)
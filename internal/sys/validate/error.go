@@ -1,33 +1,120 @@
 package validate
 
 import (
-	"encoding/json"
 	"errors"
+	"strings"
 )
 
+// FieldViolation нарушение правила для одного поля. Field - путь к полю запроса, например "info.title",
+// пустой для ошибок запроса целиком
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
 type ValidationErrors struct {
-	Messages []string `json:"error_messages"`
+	Violations []FieldViolation
 }
 
-func (v *ValidationErrors) addError(message string) {
-	v.Messages = append(v.Messages, message)
+func (v *ValidationErrors) addViolations(violations ...FieldViolation) {
+	v.Violations = append(v.Violations, violations...)
 }
 
+// NewValidationErrors ошибки, которые не относятся к конкретному полю
 func NewValidationErrors(messages ...string) *ValidationErrors {
+	ve := &ValidationErrors{}
+	for _, message := range messages {
+		ve.addViolations(FieldViolation{Description: message})
+	}
+
+	return ve
+}
+
+// NewFieldViolation ошибка значения поля field
+func NewFieldViolation(field string, description string) *ValidationErrors {
 	return &ValidationErrors{
-		Messages: messages,
+		Violations: []FieldViolation{{Field: field, Description: description}},
 	}
 }
 
 func (v *ValidationErrors) Error() string {
-	data, err := json.Marshal(v.Messages)
-	if err != nil {
-		return err.Error()
+	messages := make([]string, 0, len(v.Violations))
+	for _, violation := range v.Violations {
+		if violation.Field == "" {
+			messages = append(messages, violation.Description)
+			continue
+		}
+		messages = append(messages, violation.Field+": "+violation.Description)
 	}
-	return string(data)
+
+	return strings.Join(messages, "; ")
+}
+
+// fieldError ошибка, которую возвращает Validate() сообщений, сгенерированных protoc-gen-validate
+type fieldError interface {
+	error
+	Field() string
+	Reason() string
+}
+
+// multiFieldError ошибка ValidateAll() с нарушениями по всем полям сразу
+type multiFieldError interface {
+	error
+	AllErrors() []error
 }
 
 func IsValidationError(err error) bool {
+	return GetValidationErrors(err) != nil
+}
+
+// GetValidationErrors достает ошибки валидации из err. Ошибки protoc-gen-validate переводятся
+// в нарушения полей, путь вложенного поля собирается по цепочке Cause
+func GetValidationErrors(err error) *ValidationErrors {
 	var ve *ValidationErrors
-	return errors.As(err, &ve)
+	if errors.As(err, &ve) {
+		return ve
+	}
+
+	var multi multiFieldError
+	if errors.As(err, &multi) {
+		res := &ValidationErrors{}
+		for _, e := range multi.AllErrors() {
+			if v := GetValidationErrors(e); v != nil {
+				res.addViolations(v.Violations...)
+			}
+		}
+		if len(res.Violations) > 0 {
+			return res
+		}
+	}
+
+	var fe fieldError
+	if errors.As(err, &fe) {
+		return &ValidationErrors{Violations: []FieldViolation{fieldViolation(fe)}}
+	}
+
+	return nil
+}
+
+func fieldViolation(fe fieldError) FieldViolation {
+	path := []string{fe.Field()}
+	description := fe.Reason()
+
+	for {
+		cause, ok := fe.(interface{ Cause() error })
+		if !ok {
+			break
+		}
+
+		var nested fieldError
+		if !errors.As(cause.Cause(), &nested) {
+			break
+		}
+
+		fe = nested
+		path = append(path, fe.Field())
+		description = fe.Reason()
+	}
+
+	return FieldViolation{Field: strings.Join(path, "."), Description: description}
 }
@@ -11,7 +11,7 @@ func Validate(ctx context.Context, conds ...Condition) error {
 		err := c(ctx)
 		if err != nil {
 			if IsValidationError(err) {
-				ve.addError(err.Error())
+				ve.addViolations(GetValidationErrors(err).Violations...)
 				continue
 			}
 
@@ -19,7 +19,7 @@ func Validate(ctx context.Context, conds ...Condition) error {
 		}
 	}
 
-	if ve.Messages == nil {
+	if len(ve.Violations) == 0 {
 		return nil
 	}
 
@@ -29,7 +29,7 @@ func Validate(ctx context.Context, conds ...Condition) error {
 func ValidateID(id int64) Condition {
 	return func(ctx context.Context) error {
 		if id <= 0 {
-			return NewValidationErrors("id must be greater than 0")
+			return NewFieldViolation("id", "must be greater than 0")
 		}
 
 		return nil
@@ -39,7 +39,7 @@ func ValidateID(id int64) Condition {
 func OtherValidateID(id int64) Condition {
 	return func(ctx context.Context) error {
 		if id <= 100 {
-			return NewValidationErrors("id must be greater than 100")
+			return NewFieldViolation("id", "must be greater than 100")
 		}
 
 		return nil
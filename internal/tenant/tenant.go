@@ -0,0 +1,41 @@
+package tenant
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// Default арендатор, которому принадлежат данные, созданные до разделения на арендаторов,
+	// и запросы без заголовка x-tenant-id
+	Default = "default"
+
+	// Header заголовок, которым арендатора выбирают запросы без access токена: публичные методы и API ключи
+	Header = "x-tenant-id"
+)
+
+type tenantKey struct{}
+
+var validID = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,62}$`)
+
+// Validate проверяет идентификатор арендатора
+func Validate(id string) error {
+	if !validID.MatchString(id) {
+		return errors.Errorf("invalid tenant id %q", id)
+	}
+
+	return nil
+}
+
+// WithTenant кладет арендатора в контекст. Все запросы репозиториев с этим контекстом видят только его данные
+func WithTenant(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, id)
+}
+
+// FromContext возвращает арендатора из контекста или пустую строку, если он не выбран
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(tenantKey{}).(string)
+	return id
+}
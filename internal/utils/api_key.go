@@ -0,0 +1,47 @@
+package utils
+
+import (
+	"context"
+	"google.golang.org/grpc/metadata"
+	"strings"
+)
+
+const (
+	// APIKeyPrefix позволяет отличить ключ от других секретов, например при поиске утечек в логах и репозиториях
+	APIKeyPrefix = "dck_"
+
+	apiKeyHeader = "x-api-key"
+	// apiKeyDisplayLength сколько символов ключа хранится открыто, чтобы пользователь мог узнать его в списке
+	apiKeyDisplayLength = len(APIKeyPrefix) + 8
+)
+
+// GenerateAPIKey создает новый ключ и возвращает его вместе с открытой частью для отображения
+func GenerateAPIKey() (string, string, error) {
+	secret, err := GenerateTokenID()
+	if err != nil {
+		return "", "", err
+	}
+
+	key := APIKeyPrefix + secret
+	return key, key[:apiKeyDisplayLength], nil
+}
+
+// ExtractAPIKey достает ключ из заголовка x-api-key входящих метаданных
+func ExtractAPIKey(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	values := md.Get(apiKeyHeader)
+	if len(values) == 0 || len(values[0]) == 0 {
+		return "", false
+	}
+
+	return values[0], true
+}
+
+// IsAPIKeyHeader проверяет, что HTTP заголовок содержит ключ и его нужно пробросить в gRPC
+func IsAPIKeyHeader(key string) bool {
+	return strings.EqualFold(key, apiKeyHeader)
+}
@@ -0,0 +1,145 @@
+package utils
+
+import (
+	"context"
+	"di_container/internal/concurrency_limiter"
+	"di_container/internal/model"
+	"di_container/internal/request_id"
+	"di_container/internal/tenant"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"net"
+	"strings"
+)
+
+type claimsKey struct{}
+
+type clientIPKey struct{}
+
+const (
+	authorizationHeader = "authorization"
+	// ForwardedForHeader адреса клиента и прокси, через которые прошел запрос. Гейтвей дописывает в конец адрес,
+	// с которого к нему пришли
+	ForwardedForHeader     = "x-forwarded-for"
+	userAgentHeader        = "user-agent"
+	gatewayUserAgentHeader = "grpcgateway-user-agent"
+)
+
+// ExtractToken достает токен из заголовка authorization входящих метаданных
+func ExtractToken(ctx context.Context, authPrefix string) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", errors.New("metadata is not provided")
+	}
+
+	authHeader, ok := md[authorizationHeader]
+	if !ok || len(authHeader) == 0 {
+		return "", errors.New("authorization header is not provided")
+	}
+
+	if !strings.HasPrefix(authHeader[0], authPrefix) {
+		return "", errors.New("invalid authorization header format")
+	}
+
+	return strings.TrimPrefix(authHeader[0], authPrefix), nil
+}
+
+// MakeContextClaims кладет claims пользователя в контекст
+func MakeContextClaims(ctx context.Context, claims *model.UserClaims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+// ClaimsFromContext достает claims пользователя, положенные в контекст интерцептором авторизации
+func ClaimsFromContext(ctx context.Context) (*model.UserClaims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*model.UserClaims)
+	return claims, ok
+}
+
+// ExtractTenant достает арендатора из заголовка x-tenant-id входящих метаданных
+func ExtractTenant(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	values := md.Get(tenant.Header)
+	if len(values) == 0 || len(values[0]) == 0 {
+		return "", false
+	}
+
+	return values[0], true
+}
+
+// IsTenantHeader проверяет, что HTTP заголовок выбирает арендатора и его нужно пробросить в gRPC
+func IsTenantHeader(key string) bool {
+	return strings.EqualFold(key, tenant.Header)
+}
+
+// ExtractPriority достает важность запроса из заголовка x-priority входящих метаданных
+func ExtractPriority(ctx context.Context) concurrency_limiter.Priority {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return concurrency_limiter.PriorityNormal
+	}
+
+	values := md.Get(concurrency_limiter.Header)
+	if len(values) == 0 {
+		return concurrency_limiter.PriorityNormal
+	}
+
+	return concurrency_limiter.ParsePriority(values[0])
+}
+
+// IsPriorityHeader проверяет, что HTTP заголовок задает важность запроса и его нужно пробросить в gRPC
+func IsPriorityHeader(key string) bool {
+	return strings.EqualFold(key, concurrency_limiter.Header)
+}
+
+// IsRequestIDHeader проверяет, что HTTP заголовок содержит идентификатор запроса и его нужно пробросить в gRPC
+func IsRequestIDHeader(key string) bool {
+	return strings.EqualFold(key, request_id.Header)
+}
+
+// WithClientIP запоминает адрес клиента, который определил ClientInfoInterceptor
+func WithClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, clientIPKey{}, ip)
+}
+
+// PeerIP адрес соединения без порта
+func PeerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+
+	return host
+}
+
+// ClientInfoFromContext достает адрес и user agent клиента. Адрес берется из ClientInfoInterceptor,
+// который учитывает x-forwarded-for только от доверенных источников, иначе это адрес соединения.
+// Сам заголовок здесь не читается: его может прислать любой клиент
+func ClientInfoFromContext(ctx context.Context) model.ClientInfo {
+	info := model.ClientInfo{IP: PeerIP(ctx)}
+	if ip, ok := ctx.Value(clientIPKey{}).(string); ok {
+		info.IP = ip
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return info
+	}
+
+	if userAgent := md.Get(gatewayUserAgentHeader); len(userAgent) > 0 {
+		info.UserAgent = userAgent[0]
+	} else if userAgent = md.Get(userAgentHeader); len(userAgent) > 0 {
+		info.UserAgent = userAgent[0]
+	}
+
+	return info
+}
@@ -0,0 +1,254 @@
+package utils
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"di_container/internal/model"
+	"encoding/base64"
+	"encoding/pem"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/pkg/errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const keyFileExt = ".pem"
+
+type key struct {
+	id         string
+	method     jwt.SigningMethod
+	signingKey interface{}
+	verifyKey  interface{}
+}
+
+// KeySet набор ключей для подписи и проверки токенов.
+// Подписывается всегда одним ключом, а проверяются токены любым ключом набора,
+// поэтому при ротации старый ключ остается в наборе, пока не истекут выпущенные им токены.
+type KeySet struct {
+	signingKey *key
+	keys       map[string]*key
+}
+
+// NewHMACKeySet набор из одного общего секрета, токены подписываются HS256 без kid
+func NewHMACKeySet(secretKey []byte) *KeySet {
+	k := &key{
+		method:     jwt.SigningMethodHS256,
+		signingKey: secretKey,
+		verifyKey:  secretKey,
+	}
+
+	return &KeySet{
+		signingKey: k,
+		keys:       map[string]*key{"": k},
+	}
+}
+
+// LoadKeySet загружает ключи из файлов <kid>.pem в директории dir.
+// Файл может содержать приватный (PKCS8) или публичный (PKIX) ключ RSA или Ed25519.
+// Ключом с идентификатором signingKeyID подписываются новые токены, поэтому он должен быть приватным.
+func LoadKeySet(dir string, signingKeyID string) (*KeySet, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*"+keyFileExt))
+	if err != nil {
+		return nil, err
+	}
+
+	ks := &KeySet{keys: make(map[string]*key, len(files))}
+	for _, file := range files {
+		k, err := loadKey(file)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load key %s", file)
+		}
+
+		ks.keys[k.id] = k
+	}
+
+	signingKey, ok := ks.keys[signingKeyID]
+	if !ok {
+		return nil, errors.Errorf("signing key %q not found in %s", signingKeyID, dir)
+	}
+	if signingKey.signingKey == nil {
+		return nil, errors.Errorf("signing key %q is not a private key", signingKeyID)
+	}
+	ks.signingKey = signingKey
+
+	return ks, nil
+}
+
+func loadKey(file string) (*key, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	k := &key{id: strings.TrimSuffix(filepath.Base(file), keyFileExt)}
+
+	var parsed interface{}
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, errors.Errorf("unsupported PEM block type %q", block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch pk := parsed.(type) {
+	case *rsa.PrivateKey:
+		k.method, k.signingKey, k.verifyKey = jwt.SigningMethodRS256, pk, &pk.PublicKey
+	case *rsa.PublicKey:
+		k.method, k.verifyKey = jwt.SigningMethodRS256, pk
+	case ed25519.PrivateKey:
+		k.method, k.signingKey, k.verifyKey = signingMethodEdDSA, pk, pk.Public()
+	case ed25519.PublicKey:
+		k.method, k.verifyKey = signingMethodEdDSA, pk
+	default:
+		return nil, errors.Errorf("unsupported key type %T", parsed)
+	}
+
+	return k, nil
+}
+
+func (ks *KeySet) GenerateToken(info model.UserInfo, duration time.Duration) (string, error) {
+	return ks.GenerateScopedToken(info, nil, duration)
+}
+
+// GenerateScopedToken выпускает access токен, который действует только для методов из scopes
+func (ks *KeySet) GenerateScopedToken(info model.UserInfo, scopes []string, duration time.Duration) (string, error) {
+	now := time.Now()
+	claims := model.UserClaims{
+		StandardClaims: jwt.StandardClaims{
+			ExpiresAt: now.Add(duration).Unix(),
+			IssuedAt:  now.Unix(),
+		},
+		Username: info.Username,
+		Role:     info.Role,
+		Scope:    strings.Join(scopes, " "),
+		Tenant:   info.Tenant,
+	}
+
+	return ks.sign(claims)
+}
+
+// GenerateImpersonationToken выпускает access токен пользователя info, в котором указан действующий от его имени impersonator
+func (ks *KeySet) GenerateImpersonationToken(info model.UserInfo, impersonator string, duration time.Duration) (string, error) {
+	now := time.Now()
+	return ks.sign(model.UserClaims{
+		StandardClaims: jwt.StandardClaims{
+			ExpiresAt: now.Add(duration).Unix(),
+			IssuedAt:  now.Unix(),
+		},
+		Username:     info.Username,
+		Role:         info.Role,
+		Impersonator: impersonator,
+		Tenant:       info.Tenant,
+	})
+}
+
+func (ks *KeySet) sign(claims model.UserClaims) (string, error) {
+	token := jwt.NewWithClaims(ks.signingKey.method, claims)
+	if ks.signingKey.id != "" {
+		token.Header["kid"] = ks.signingKey.id
+	}
+
+	return token.SignedString(ks.signingKey.signingKey)
+}
+
+func (ks *KeySet) VerifyToken(tokenStr string) (*model.UserClaims, error) {
+	token, err := jwt.ParseWithClaims(
+		tokenStr,
+		&model.UserClaims{},
+		func(token *jwt.Token) (interface{}, error) {
+			kid, _ := token.Header["kid"].(string)
+
+			k, ok := ks.keys[kid]
+			if !ok {
+				return nil, errors.Errorf("unknown key id %q", kid)
+			}
+
+			// Алгоритм определяется ключом, а не заголовком токена
+			if token.Method.Alg() != k.method.Alg() {
+				return nil, errors.Errorf("unexpected token signing method")
+			}
+
+			return k.verifyKey, nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Errorf("Invalid token: %s", err.Error())
+	}
+
+	claims, ok := token.Claims.(*model.UserClaims)
+	if !ok {
+		return nil, errors.Errorf("Invalid token claims")
+	}
+
+	// Токены специального назначения (например, MFA challenge) не дают доступа
+	if claims.Purpose != "" {
+		return nil, errors.Errorf("Invalid token purpose")
+	}
+
+	return claims, nil
+}
+
+// JWK публичный ключ в формате RFC 7517
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKS возвращает публичные ключи набора. Симметричные ключи не публикуются.
+func (ks *KeySet) JWKS() JWKS {
+	res := JWKS{Keys: make([]JWK, 0, len(ks.keys))}
+
+	for _, k := range ks.keys {
+		jwk := JWK{
+			Kid: k.id,
+			Use: "sig",
+			Alg: k.method.Alg(),
+		}
+
+		switch pk := k.verifyKey.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(pk.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pk.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(pk)
+		default:
+			continue
+		}
+
+		res.Keys = append(res.Keys, jwk)
+	}
+
+	sort.Slice(res.Keys, func(i, j int) bool {
+		return res.Keys[i].Kid < res.Keys[j].Kid
+	})
+
+	return res
+}
@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// RequestAttributes возвращает скалярные поля верхнего уровня gRPC запроса в виде строк,
+// чтобы на них могли ссылаться правила политики доступа (request.id)
+func RequestAttributes(req interface{}) map[string]string {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+
+	attrs := make(map[string]string)
+	msg.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.IsList() || fd.IsMap() {
+			return true
+		}
+
+		switch fd.Kind() {
+		case protoreflect.MessageKind, protoreflect.GroupKind, protoreflect.BytesKind:
+			return true
+		case protoreflect.EnumKind:
+			attrs[string(fd.Name())] = fmt.Sprint(v.Enum())
+		default:
+			attrs[string(fd.Name())] = fmt.Sprint(v.Interface())
+		}
+
+		return true
+	})
+
+	return attrs
+}
@@ -0,0 +1,96 @@
+package tests
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+
+	"di_container/internal/model"
+	"di_container/internal/utils"
+)
+
+func writeKey(t *testing.T, dir string, kid string, blockType string, der []byte) {
+	t.Helper()
+
+	data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	require.NoError(t, os.WriteFile(filepath.Join(dir, kid+".pem"), data, 0o600))
+}
+
+func TestKeySetRotation(t *testing.T) {
+	t.Parallel()
+
+	var (
+		dir  = t.TempDir()
+		info = model.UserInfo{
+			Username: gofakeit.Username(),
+			Role:     "admin",
+		}
+	)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	rsaDER, err := x509.MarshalPKCS8PrivateKey(rsaKey)
+	require.NoError(t, err)
+	writeKey(t, dir, "rsa-old", "PRIVATE KEY", rsaDER)
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	edDER, err := x509.MarshalPKCS8PrivateKey(edKey)
+	require.NoError(t, err)
+	writeKey(t, dir, "ed-new", "PRIVATE KEY", edDER)
+
+	oldKeySet, err := utils.LoadKeySet(dir, "rsa-old")
+	require.NoError(t, err)
+	oldToken, err := oldKeySet.GenerateToken(info, time.Minute)
+	require.NoError(t, err)
+
+	newKeySet, err := utils.LoadKeySet(dir, "ed-new")
+	require.NoError(t, err)
+	newToken, err := newKeySet.GenerateToken(info, time.Minute)
+	require.NoError(t, err)
+
+	// После ротации токены, подписанные старым ключом, все еще проверяются
+	for _, token := range []string{oldToken, newToken} {
+		claims, err := newKeySet.VerifyToken(token)
+		require.NoError(t, err)
+		require.Equal(t, info.Username, claims.Username)
+		require.Equal(t, info.Role, claims.Role)
+	}
+
+	jwks := newKeySet.JWKS()
+	require.Len(t, jwks.Keys, 2)
+	require.Equal(t, "ed-new", jwks.Keys[0].Kid)
+	require.Equal(t, "OKP", jwks.Keys[0].Kty)
+	require.Equal(t, "EdDSA", jwks.Keys[0].Alg)
+	require.Equal(t, "rsa-old", jwks.Keys[1].Kid)
+	require.Equal(t, "RSA", jwks.Keys[1].Kty)
+	require.Equal(t, "RS256", jwks.Keys[1].Alg)
+
+	_, err = utils.NewHMACKeySet([]byte(gofakeit.Password(true, true, true, false, false, 32))).VerifyToken(newToken)
+	require.Error(t, err)
+	require.Empty(t, utils.NewHMACKeySet([]byte("secret")).JWKS().Keys)
+}
+
+func TestLoadKeySetPublicSigningKey(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	edPublic, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(edPublic)
+	require.NoError(t, err)
+	writeKey(t, dir, "public-only", "PUBLIC KEY", der)
+
+	_, err = utils.LoadKeySet(dir, "public-only")
+	require.Error(t, err)
+}
@@ -0,0 +1,54 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"di_container/internal/utils"
+)
+
+// Векторы из RFC 6238 (SHA1), обрезанные до шести цифр
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestTOTPCode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{unix: 59, code: "287082"},
+		{unix: 1111111109, code: "081804"},
+		{unix: 1234567890, code: "005924"},
+	}
+
+	for _, tt := range tests {
+		code, err := utils.TOTPCode(rfcSecret, utils.TOTPStep(time.Unix(tt.unix, 0)))
+		require.NoError(t, err)
+		require.Equal(t, tt.code, code)
+	}
+}
+
+func TestValidateTOTP(t *testing.T) {
+	t.Parallel()
+
+	secret, err := utils.GenerateTOTPSecret()
+	require.NoError(t, err)
+
+	now := time.Now()
+	code, err := utils.TOTPCode(secret, utils.TOTPStep(now))
+	require.NoError(t, err)
+
+	step, ok := utils.ValidateTOTP(secret, code, now)
+	require.True(t, ok)
+	require.Equal(t, utils.TOTPStep(now), step)
+
+	// Допускается расхождение часов на один шаг
+	_, ok = utils.ValidateTOTP(secret, code, now.Add(30*time.Second))
+	require.True(t, ok)
+
+	_, ok = utils.ValidateTOTP(secret, code, now.Add(2*time.Minute))
+	require.False(t, ok)
+}
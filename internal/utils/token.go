@@ -1,19 +1,40 @@
 package utils
 
 import (
+	"crypto/rand"
 	"di_container/internal/model"
+	"encoding/hex"
 	"github.com/dgrijalva/jwt-go"
 	"github.com/pkg/errors"
 	"time"
 )
 
+const tokenIDLength = 16
+
 func GenerateToken(info model.UserInfo, secretKey []byte, duration time.Duration) (string, error) {
+	return generateToken(info, "", "", secretKey, duration)
+}
+
+// GenerateRefreshToken выпускает токен с заполненным jti, по которому токен ищется в хранилище
+func GenerateRefreshToken(info model.UserInfo, tokenID string, secretKey []byte, duration time.Duration) (string, error) {
+	return generateToken(info, tokenID, "", secretKey, duration)
+}
+
+// GenerateMFAChallengeToken выпускает токен, подтверждающий, что пароль уже проверен и осталось ввести код
+func GenerateMFAChallengeToken(info model.UserInfo, secretKey []byte, duration time.Duration) (string, error) {
+	return generateToken(info, "", model.PurposeMFAChallenge, secretKey, duration)
+}
+
+func generateToken(info model.UserInfo, tokenID string, purpose string, secretKey []byte, duration time.Duration) (string, error) {
 	claims := model.UserClaims{
 		StandardClaims: jwt.StandardClaims{
+			Id:        tokenID,
 			ExpiresAt: time.Now().Add(duration).Unix(),
 		},
 		Username: info.Username,
 		Role:     info.Role,
+		Purpose:  purpose,
+		Tenant:   info.Tenant,
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
@@ -21,6 +42,15 @@ func GenerateToken(info model.UserInfo, secretKey []byte, duration time.Duration
 	return token.SignedString(secretKey)
 }
 
+func GenerateTokenID() (string, error) {
+	b := make([]byte, tokenIDLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Errorf("failed to generate token id: %s", err.Error())
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
 func VerifyToken(tokenStr string, secretKey []byte) (*model.UserClaims, error) {
 	token, err := jwt.ParseWithClaims(
 		tokenStr,
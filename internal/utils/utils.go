@@ -2,6 +2,19 @@ package utils
 
 import "golang.org/x/crypto/bcrypt"
 
+// DummyPasswordHash сверяется с паролем, когда пользователь не найден,
+// чтобы по времени ответа нельзя было понять, существует ли пользователь
+var DummyPasswordHash, _ = HashPassword("dummy password")
+
+func HashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+
+	return string(hash), nil
+}
+
 func VerifyPassword(hashedPassword string, candidatePassword string) bool {
 	err := bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(candidatePassword))
 	return err == nil
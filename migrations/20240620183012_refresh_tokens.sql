@@ -0,0 +1,16 @@
+-- +goose Up
+create table refresh_token (
+    id text primary key,
+    family_id text not null,
+    username text not null,
+    expires_at timestamp not null,
+    created_at timestamp not null default now(),
+    rotated_at timestamp,
+    revoked_at timestamp
+);
+
+create index refresh_token_family_id_idx on refresh_token (family_id);
+create index refresh_token_username_idx on refresh_token (username);
+
+-- +goose Down
+drop table refresh_token;
@@ -0,0 +1,16 @@
+-- +goose Up
+create table session (
+    id text primary key,
+    username text not null,
+    client_ip text not null default '',
+    user_agent text not null default '',
+    created_at timestamp not null default now(),
+    last_refresh_at timestamp,
+    expires_at timestamp not null,
+    revoked_at timestamp
+);
+
+create index session_username_idx on session (username);
+
+-- +goose Down
+drop table session;
@@ -0,0 +1,20 @@
+-- +goose Up
+create table users (
+    id serial primary key,
+    username text not null unique,
+    password_hash text not null,
+    role text not null default 'user',
+    created_at timestamp not null default now(),
+    updated_at timestamp
+);
+
+create table login_attempt (
+    key text primary key,
+    failures int not null default 0,
+    last_failure_at timestamp not null default now(),
+    locked_until timestamp
+);
+
+-- +goose Down
+drop table login_attempt;
+drop table users;
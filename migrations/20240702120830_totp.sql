@@ -0,0 +1,22 @@
+-- +goose Up
+alter table users
+    add column totp_secret text,
+    add column totp_enabled boolean not null default false,
+    add column totp_last_step bigint not null default 0;
+
+create table recovery_code (
+    id serial primary key,
+    user_id int not null references users (id) on delete cascade,
+    code_hash text not null,
+    used_at timestamp
+);
+
+create index recovery_code_user_id_idx on recovery_code (user_id);
+
+-- +goose Down
+drop table recovery_code;
+
+alter table users
+    drop column totp_secret,
+    drop column totp_enabled,
+    drop column totp_last_step;
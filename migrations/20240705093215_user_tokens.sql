@@ -0,0 +1,22 @@
+-- +goose Up
+alter table users
+    add column email text unique,
+    add column email_verified boolean not null default false;
+
+create table user_token (
+    token_hash text primary key,
+    user_id int not null references users (id) on delete cascade,
+    purpose text not null,
+    expires_at timestamp not null,
+    created_at timestamp not null default now(),
+    used_at timestamp
+);
+
+create index user_token_user_id_purpose_idx on user_token (user_id, purpose);
+
+-- +goose Down
+drop table user_token;
+
+alter table users
+    drop column email,
+    drop column email_verified;
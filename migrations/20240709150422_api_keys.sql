@@ -0,0 +1,18 @@
+-- +goose Up
+create table api_key (
+    id serial primary key,
+    username text not null,
+    name text not null,
+    prefix text not null,
+    key_hash text not null unique,
+    scopes text[] not null,
+    expires_at timestamp,
+    last_used_at timestamp,
+    created_at timestamp not null default now(),
+    revoked_at timestamp
+);
+
+create index api_key_username_idx on api_key (username);
+
+-- +goose Down
+drop table api_key;
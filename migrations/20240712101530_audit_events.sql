@@ -0,0 +1,35 @@
+-- +goose Up
+create table audit_event (
+    id bigserial primary key,
+    type text not null,
+    actor text not null,
+    subject text not null,
+    client_ip text not null,
+    method text not null,
+    outcome text not null,
+    reason text not null,
+    prev_hash text not null,
+    hash text not null unique,
+    created_at timestamptz not null
+);
+
+create index audit_event_created_at_idx on audit_event (created_at);
+create index audit_event_actor_idx on audit_event (actor, created_at);
+
+-- Журнал только дополняется. Изменения в обход триггера все равно видны по цепочке хешей
+-- +goose StatementBegin
+create function audit_event_append_only() returns trigger as $$
+begin
+    raise exception 'audit_event is append-only';
+end;
+$$ language plpgsql;
+-- +goose StatementEnd
+
+create trigger audit_event_append_only
+    before update or delete on audit_event
+    for each row execute function audit_event_append_only();
+
+-- +goose Down
+drop trigger audit_event_append_only on audit_event;
+drop function audit_event_append_only();
+drop table audit_event;
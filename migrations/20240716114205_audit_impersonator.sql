@@ -0,0 +1,8 @@
+-- +goose Up
+alter table audit_event add column impersonator text not null default '';
+
+create index audit_event_impersonator_idx on audit_event (impersonator, created_at) where impersonator <> '';
+
+-- +goose Down
+drop index audit_event_impersonator_idx;
+alter table audit_event drop column impersonator;
@@ -0,0 +1,125 @@
+-- +goose Up
+-- Все существующие данные принадлежат арендатору по умолчанию. После заполнения default убирается,
+-- чтобы вставка без арендатора падала, а не попадала к нему
+alter table note add column tenant_id text not null default 'default';
+alter table users add column tenant_id text not null default 'default';
+alter table login_attempt add column tenant_id text not null default 'default';
+alter table refresh_token add column tenant_id text not null default 'default';
+alter table session add column tenant_id text not null default 'default';
+alter table recovery_code add column tenant_id text not null default 'default';
+alter table user_token add column tenant_id text not null default 'default';
+alter table api_key add column tenant_id text not null default 'default';
+alter table audit_event add column tenant_id text not null default 'default';
+
+alter table note alter column tenant_id drop default;
+alter table users alter column tenant_id drop default;
+alter table login_attempt alter column tenant_id drop default;
+alter table refresh_token alter column tenant_id drop default;
+alter table session alter column tenant_id drop default;
+alter table recovery_code alter column tenant_id drop default;
+alter table user_token alter column tenant_id drop default;
+alter table api_key alter column tenant_id drop default;
+alter table audit_event alter column tenant_id drop default;
+
+-- Имена пользователей, почта и ключи блокировок уникальны в пределах арендатора
+alter table users drop constraint users_username_key;
+alter table users add constraint users_tenant_id_username_key unique (tenant_id, username);
+alter table users drop constraint users_email_key;
+alter table users add constraint users_tenant_id_email_key unique (tenant_id, email);
+alter table login_attempt drop constraint login_attempt_pkey;
+alter table login_attempt add primary key (tenant_id, key);
+
+create index note_tenant_id_idx on note (tenant_id, id);
+create index audit_event_tenant_id_idx on audit_event (tenant_id, id);
+
+-- Политики RLS страхуют от запросов в обход репозиториев. force нужен, потому что приложение
+-- подключается владельцем таблиц. Арендатор берется из настройки app.tenant_id, которую клиент БД
+-- выставляет соединению перед каждым запросом
+alter table note enable row level security;
+alter table users enable row level security;
+alter table login_attempt enable row level security;
+alter table refresh_token enable row level security;
+alter table session enable row level security;
+alter table recovery_code enable row level security;
+alter table user_token enable row level security;
+alter table api_key enable row level security;
+alter table audit_event enable row level security;
+
+alter table note force row level security;
+alter table users force row level security;
+alter table login_attempt force row level security;
+alter table refresh_token force row level security;
+alter table session force row level security;
+alter table recovery_code force row level security;
+alter table user_token force row level security;
+alter table api_key force row level security;
+alter table audit_event force row level security;
+
+create policy tenant_isolation on note
+    using (tenant_id = current_setting('app.tenant_id', true))
+    with check (tenant_id = current_setting('app.tenant_id', true));
+create policy tenant_isolation on users
+    using (tenant_id = current_setting('app.tenant_id', true))
+    with check (tenant_id = current_setting('app.tenant_id', true));
+create policy tenant_isolation on login_attempt
+    using (tenant_id = current_setting('app.tenant_id', true))
+    with check (tenant_id = current_setting('app.tenant_id', true));
+create policy tenant_isolation on refresh_token
+    using (tenant_id = current_setting('app.tenant_id', true))
+    with check (tenant_id = current_setting('app.tenant_id', true));
+create policy tenant_isolation on session
+    using (tenant_id = current_setting('app.tenant_id', true))
+    with check (tenant_id = current_setting('app.tenant_id', true));
+create policy tenant_isolation on recovery_code
+    using (tenant_id = current_setting('app.tenant_id', true))
+    with check (tenant_id = current_setting('app.tenant_id', true));
+create policy tenant_isolation on user_token
+    using (tenant_id = current_setting('app.tenant_id', true))
+    with check (tenant_id = current_setting('app.tenant_id', true));
+create policy tenant_isolation on api_key
+    using (tenant_id = current_setting('app.tenant_id', true))
+    with check (tenant_id = current_setting('app.tenant_id', true));
+create policy tenant_isolation on audit_event
+    using (tenant_id = current_setting('app.tenant_id', true))
+    with check (tenant_id = current_setting('app.tenant_id', true));
+
+-- +goose Down
+drop policy tenant_isolation on note;
+drop policy tenant_isolation on users;
+drop policy tenant_isolation on login_attempt;
+drop policy tenant_isolation on refresh_token;
+drop policy tenant_isolation on session;
+drop policy tenant_isolation on recovery_code;
+drop policy tenant_isolation on user_token;
+drop policy tenant_isolation on api_key;
+drop policy tenant_isolation on audit_event;
+
+alter table note no force row level security, disable row level security;
+alter table users no force row level security, disable row level security;
+alter table login_attempt no force row level security, disable row level security;
+alter table refresh_token no force row level security, disable row level security;
+alter table session no force row level security, disable row level security;
+alter table recovery_code no force row level security, disable row level security;
+alter table user_token no force row level security, disable row level security;
+alter table api_key no force row level security, disable row level security;
+alter table audit_event no force row level security, disable row level security;
+
+drop index note_tenant_id_idx;
+drop index audit_event_tenant_id_idx;
+
+alter table login_attempt drop constraint login_attempt_pkey;
+alter table login_attempt add primary key (key);
+alter table users drop constraint users_tenant_id_email_key;
+alter table users add constraint users_email_key unique (email);
+alter table users drop constraint users_tenant_id_username_key;
+alter table users add constraint users_username_key unique (username);
+
+alter table note drop column tenant_id;
+alter table users drop column tenant_id;
+alter table login_attempt drop column tenant_id;
+alter table refresh_token drop column tenant_id;
+alter table session drop column tenant_id;
+alter table recovery_code drop column tenant_id;
+alter table user_token drop column tenant_id;
+alter table api_key drop column tenant_id;
+alter table audit_event drop column tenant_id;
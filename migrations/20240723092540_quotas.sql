@@ -0,0 +1,47 @@
+-- +goose Up
+-- owner - имя пользователя или пустая строка для арендатора целиком
+create table usage_counter (
+    tenant_id text not null,
+    owner text not null,
+    notes bigint not null default 0,
+    content_bytes bigint not null default 0,
+    attachment_bytes bigint not null default 0,
+    updated_at timestamp not null default now(),
+    primary key (tenant_id, owner)
+);
+
+create table request_counter (
+    tenant_id text not null,
+    owner text not null,
+    day date not null,
+    requests bigint not null default 0,
+    primary key (tenant_id, owner, day)
+);
+
+-- Счетчики арендаторов заполняются по уже созданным заметкам. У заметок нет автора,
+-- поэтому счетчики пользователей начинаются с нуля. RLS на время заполнения снимается,
+-- иначе владелец таблицы не увидит строк без app.tenant_id
+alter table note no force row level security;
+
+insert into usage_counter (tenant_id, owner, notes, content_bytes)
+select tenant_id, '', count(*), coalesce(sum(octet_length(title) + octet_length(content)), 0)
+from note
+group by tenant_id;
+
+alter table note force row level security;
+
+alter table usage_counter enable row level security;
+alter table request_counter enable row level security;
+alter table usage_counter force row level security;
+alter table request_counter force row level security;
+
+create policy tenant_isolation on usage_counter
+    using (tenant_id = current_setting('app.tenant_id', true))
+    with check (tenant_id = current_setting('app.tenant_id', true));
+create policy tenant_isolation on request_counter
+    using (tenant_id = current_setting('app.tenant_id', true))
+    with check (tenant_id = current_setting('app.tenant_id', true));
+
+-- +goose Down
+drop table request_counter;
+drop table usage_counter;
@@ -0,0 +1,24 @@
+-- +goose Up
+-- Ведра токенов для ограничения запросов, общие для всех реплик. key - клиент (пользователь, ключ API или адрес),
+-- время хранится с зоной, потому что пополнение считается по now() базы
+create table rate_limit_bucket (
+    tenant_id text not null,
+    key text not null,
+    method text not null,
+    tokens double precision not null,
+    updated_at timestamptz not null default now(),
+    primary key (tenant_id, key, method)
+);
+
+-- Для удаления ведер, которые давно не обновлялись и снова полные
+create index rate_limit_bucket_updated_at_idx on rate_limit_bucket (tenant_id, updated_at);
+
+alter table rate_limit_bucket enable row level security;
+alter table rate_limit_bucket force row level security;
+
+create policy tenant_isolation on rate_limit_bucket
+    using (tenant_id = current_setting('app.tenant_id', true))
+    with check (tenant_id = current_setting('app.tenant_id', true));
+
+-- +goose Down
+drop table rate_limit_bucket;